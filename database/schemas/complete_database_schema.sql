@@ -25,6 +25,47 @@ CREATE TYPE serving_unit_type AS ENUM (
     'GRAMS', 'OUNCES', 'TSP', 'TBSP', 'CUPS', 'PIECES'
 );
 
+CREATE TYPE activity_level_type AS ENUM (
+    'SEDENTARY', 'LIGHT', 'MODERATE', 'ACTIVE', 'VERY_ACTIVE'
+);
+
+CREATE TYPE survey_question_type AS ENUM (
+    'SINGLE_CHOICE', 'MULTI_CHOICE', 'NUMERIC', 'FREE_TEXT', 'SCALE'
+);
+
+CREATE TYPE food_preference_type AS ENUM (
+    'LIKE', 'DISLIKE'
+);
+
+CREATE TYPE allergen_type AS ENUM (
+    'MILK', 'EGG', 'FISH', 'SHELLFISH', 'TREE_NUTS', 'PEANUTS', 'WHEAT', 'SOY', 'SESAME'
+);
+
+CREATE TYPE diet_restriction_type AS ENUM (
+    'VEGETARIAN', 'PESCATARIAN', 'VEGAN', 'DAIRY_FREE', 'NIGHTSHADE_FREE'
+);
+
+CREATE TYPE instructions_format_type AS ENUM (
+    'MARKDOWN', 'HTML'
+);
+
+CREATE TYPE cycle_symptom_type AS ENUM (
+    'CRAMPS', 'BLOATING', 'FATIGUE', 'HEADACHE', 'CRAVINGS',
+    'MOOD_CHANGES', 'BREAST_TENDERNESS', 'ACNE', 'NAUSEA', 'INSOMNIA'
+);
+
+CREATE TYPE exercise_type AS ENUM (
+    'STRENGTH', 'ENDURANCE'
+);
+
+CREATE TYPE workout_source_type AS ENUM (
+    'MANUAL', 'GPX', 'TCX', 'FIT'
+);
+
+CREATE TYPE food_source_type AS ENUM (
+    'CURATED', 'USER', 'USDA', 'OPEN_FOOD_FACTS'
+);
+
 -- Core Tables
 
 -- Users table - user profiles with international support
@@ -35,6 +76,10 @@ CREATE TABLE USERS (
     password_hash VARCHAR(255) NOT NULL,
     full_name VARCHAR(255) NOT NULL,
     sex sex_type,
+    height_cm DECIMAL(5,1) CHECK (height_cm > 0),
+    weight_kg DECIMAL(5,1) CHECK (weight_kg > 0),
+    birth_date DATE,
+    activity_level activity_level_type,
     phone_number VARCHAR(20),
     address_line_1 VARCHAR(255),
     address_line_2 VARCHAR(255),
@@ -64,13 +109,206 @@ CREATE TABLE USER_GOALS (
     id SERIAL PRIMARY KEY,
     user_id INTEGER NOT NULL REFERENCES USERS(id) ON DELETE CASCADE,
     goal_id INTEGER NOT NULL REFERENCES GOALS(id) ON DELETE CASCADE,
+    metric VARCHAR(50),
+    unit VARCHAR(20),
+    baseline_value DECIMAL(10,2),
+    target_value DECIMAL(10,2),
+    baseline_date DATE,
+    target_date DATE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(user_id, goal_id),
+    -- A quantified goal needs every target field; the target must differ from the baseline
+    CHECK (target_value IS NULL OR (metric IS NOT NULL AND unit IS NOT NULL AND baseline_value IS NOT NULL
+                                    AND baseline_date IS NOT NULL AND target_value <> baseline_value)),
+    CHECK (target_date IS NULL OR target_date > baseline_date)
+);
+
+-- Goal Check-ins table - measurements logged against a quantified user goal
+CREATE TABLE GOAL_CHECK_INS (
+    id SERIAL PRIMARY KEY,
+    user_goal_id INTEGER NOT NULL REFERENCES USER_GOALS(id) ON DELETE CASCADE,
+    date DATE NOT NULL,
+    value DECIMAL(10,2) NOT NULL,
+    notes VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(user_goal_id, date)
+);
+
+-- Check-ins table - body metrics and wellbeing logged by users
+CREATE TABLE CHECK_INS (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES USERS(id) ON DELETE CASCADE,
+    recorded_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP, -- when the measurements were taken (UTC)
+    weight_kg DECIMAL(5,1) CHECK (weight_kg > 0),
+    body_fat_percent DECIMAL(4,1) CHECK (body_fat_percent > 0 AND body_fat_percent < 100),
+    waist_cm DECIMAL(5,1) CHECK (waist_cm > 0),
+    hip_cm DECIMAL(5,1) CHECK (hip_cm > 0),
+    energy_level SMALLINT CHECK (energy_level >= 1 AND energy_level <= 5), -- 1 exhausted to 5 energetic
+    sleep_hours DECIMAL(3,1) CHECK (sleep_hours >= 0 AND sleep_hours <= 24),
+    mood SMALLINT CHECK (mood >= 1 AND mood <= 5), -- 1 very low to 5 very good
+    notes VARCHAR(500),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK (num_nonnulls(weight_kg, body_fat_percent, waist_cm, hip_cm, energy_level, sleep_hours, mood, notes) > 0)
+);
+
+-- Cycle Tracking table - users who opted in to menstrual cycle tracking
+-- Cycle data is kept out of USERS so profile and admin listings never include it
+CREATE TABLE CYCLE_TRACKING (
+    user_id INTEGER PRIMARY KEY REFERENCES USERS(id) ON DELETE CASCADE,
+    opted_in_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Cycle Logs table - period starts and symptoms logged by opted-in users, one row per day
+CREATE TABLE CYCLE_LOGS (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES CYCLE_TRACKING(user_id) ON DELETE CASCADE,
+    date DATE NOT NULL, -- calendar date in the user timezone
+    period_started BOOLEAN NOT NULL DEFAULT false,
+    symptoms cycle_symptom_type[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(user_id, date),
+    CHECK (period_started OR cardinality(symptoms) > 0)
+);
+
+-- Exercises table - built-in exercises and custom exercises created by users
+CREATE TABLE EXERCISES (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER REFERENCES USERS(id) ON DELETE CASCADE, -- NULL for built-in exercises
+    name VARCHAR(100) NOT NULL,
+    exercise_type exercise_type NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Workout Sessions table - training sessions logged by users
+CREATE TABLE WORKOUT_SESSIONS (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES USERS(id) ON DELETE CASCADE,
+    started_at TIMESTAMP NOT NULL, -- UTC
+    duration_minutes SMALLINT CHECK (duration_minutes > 0 AND duration_minutes <= 1440),
+    notes VARCHAR(500),
+    calories_burned DECIMAL(6,1) CHECK (calories_burned >= 0),
+    source workout_source_type NOT NULL DEFAULT 'MANUAL',
+    file_hash CHAR(64), -- SHA-256 of an imported activity file
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(user_id, file_hash)
+);
+
+-- Workout Sets table - strength sets (reps and weight) and endurance efforts (duration and distance) of a session
+CREATE TABLE WORKOUT_SETS (
+    id SERIAL PRIMARY KEY,
+    session_id INTEGER NOT NULL REFERENCES WORKOUT_SESSIONS(id) ON DELETE CASCADE,
+    exercise_id INTEGER NOT NULL REFERENCES EXERCISES(id),
+    set_number SMALLINT NOT NULL CHECK (set_number > 0),
+    reps SMALLINT CHECK (reps > 0),
+    weight_kg DECIMAL(5,1) CHECK (weight_kg >= 0),
+    duration_seconds INTEGER CHECK (duration_seconds > 0),
+    distance_meters DECIMAL(8,1) CHECK (distance_meters > 0),
+    elevation_gain_meters DECIMAL(6,1) CHECK (elevation_gain_meters >= 0),
+    avg_heart_rate SMALLINT CHECK (avg_heart_rate >= 30 AND avg_heart_rate <= 250),
+    max_heart_rate SMALLINT CHECK (max_heart_rate >= 30 AND max_heart_rate <= 250),
+    UNIQUE(session_id, set_number),
+    CHECK (reps IS NOT NULL OR duration_seconds IS NOT NULL),
+    CHECK (avg_heart_rate IS NULL OR max_heart_rate IS NULL OR avg_heart_rate <= max_heart_rate)
+);
+
+-- Workout Heart Rate Samples table - heart rate recorded during an imported activity
+CREATE TABLE WORKOUT_HEART_RATE_SAMPLES (
+    session_id INTEGER NOT NULL REFERENCES WORKOUT_SESSIONS(id) ON DELETE CASCADE,
+    elapsed_seconds INTEGER NOT NULL CHECK (elapsed_seconds >= 0),
+    heart_rate SMALLINT NOT NULL CHECK (heart_rate >= 30 AND heart_rate <= 250),
+    PRIMARY KEY (session_id, elapsed_seconds)
+);
+
+-- Goal Conflicts table - data-driven rules for goals that cannot be selected together
+CREATE TABLE GOAL_CONFLICTS (
+    id SERIAL PRIMARY KEY,
+    goal_id INTEGER NOT NULL REFERENCES GOALS(id) ON DELETE CASCADE,
+    conflicting_goal_id INTEGER NOT NULL REFERENCES GOALS(id) ON DELETE CASCADE,
+    reason VARCHAR(200) NOT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    UNIQUE(user_id, goal_id)
+    CHECK (goal_id <> conflicting_goal_id),
+    UNIQUE(goal_id, conflicting_goal_id)
+);
+
+-- Surveys table - questionnaires such as the activity level assessment
+CREATE TABLE SURVEYS (
+    id SERIAL PRIMARY KEY,
+    slug VARCHAR(50) UNIQUE NOT NULL,
+    title VARCHAR(100) NOT NULL,
+    description VARCHAR(500),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Survey Versions table - immutable question sets; the highest version is current
+CREATE TABLE SURVEY_VERSIONS (
+    id SERIAL PRIMARY KEY,
+    survey_id INTEGER NOT NULL REFERENCES SURVEYS(id) ON DELETE CASCADE,
+    version INTEGER NOT NULL CHECK (version >= 1),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(survey_id, version)
+);
+
+-- Survey Questions table - ordered questions of a survey version
+CREATE TABLE SURVEY_QUESTIONS (
+    id SERIAL PRIMARY KEY,
+    survey_version_id INTEGER NOT NULL REFERENCES SURVEY_VERSIONS(id) ON DELETE CASCADE,
+    question_key VARCHAR(50) NOT NULL,
+    position INTEGER NOT NULL CHECK (position >= 1),
+    question_type survey_question_type NOT NULL,
+    prompt VARCHAR(255) NOT NULL,
+    required BOOLEAN NOT NULL DEFAULT true,
+    min_value DECIMAL(10,2), -- NUMERIC and SCALE bounds
+    max_value DECIMAL(10,2),
+    max_length INTEGER CHECK (max_length BETWEEN 1 AND 2000), -- FREE_TEXT limit
+    show_if_question_key VARCHAR(50), -- branching: only asked when this earlier question
+    show_if_values TEXT[], -- was answered with one of these option values
+    UNIQUE(survey_version_id, question_key),
+    UNIQUE(survey_version_id, position),
+    CHECK ((show_if_question_key IS NULL) = (show_if_values IS NULL))
+);
+
+-- Survey Question Options table - choices for SINGLE_CHOICE and MULTI_CHOICE questions
+CREATE TABLE SURVEY_QUESTION_OPTIONS (
+    id SERIAL PRIMARY KEY,
+    question_id INTEGER NOT NULL REFERENCES SURVEY_QUESTIONS(id) ON DELETE CASCADE,
+    value VARCHAR(50) NOT NULL,
+    label VARCHAR(100) NOT NULL,
+    position INTEGER NOT NULL CHECK (position >= 1),
+    UNIQUE(question_id, value)
+);
+
+-- Survey Responses table - a user's submission of a survey version
+CREATE TABLE SURVEY_RESPONSES (
+    id SERIAL PRIMARY KEY,
+    survey_version_id INTEGER NOT NULL REFERENCES SURVEY_VERSIONS(id),
+    user_id INTEGER NOT NULL REFERENCES USERS(id) ON DELETE CASCADE,
+    submitted_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Survey Answers table - one row per answered question of a response
+CREATE TABLE SURVEY_ANSWERS (
+    id SERIAL PRIMARY KEY,
+    response_id INTEGER NOT NULL REFERENCES SURVEY_RESPONSES(id) ON DELETE CASCADE,
+    question_id INTEGER NOT NULL REFERENCES SURVEY_QUESTIONS(id) ON DELETE CASCADE,
+    text_value VARCHAR(2000), -- FREE_TEXT
+    numeric_value DECIMAL(10,2), -- NUMERIC and SCALE
+    choice_values TEXT[], -- SINGLE_CHOICE (one value) and MULTI_CHOICE
+    UNIQUE(response_id, question_id),
+    CHECK (num_nonnulls(text_value, numeric_value, choice_values) = 1)
 );
 
 -- Food Catalog table - comprehensive food database
 CREATE TABLE FOOD_CATALOG (
     id SERIAL PRIMARY KEY,
+    user_id INTEGER REFERENCES USERS(id) ON DELETE CASCADE, -- NULL for shared catalog foods
+    source food_source_type NOT NULL DEFAULT 'CURATED',
+    source_id VARCHAR(64), -- ID of the food in its source dataset, NULL for curated and user foods
     food_name VARCHAR(255) NOT NULL,
     category food_category_type NOT NULL,
     serving_units serving_unit_type NOT NULL,
@@ -78,6 +316,15 @@ CREATE TABLE FOOD_CATALOG (
     protein_grams DECIMAL(6,2) NOT NULL,
     carbs_grams DECIMAL(6,2) NOT NULL,
     fat_grams DECIMAL(6,2) NOT NULL,
+    -- Micronutrients per serving, NULL when unknown
+    fiber_grams DECIMAL(6,2) CHECK (fiber_grams >= 0),
+    sugar_grams DECIMAL(6,2) CHECK (sugar_grams >= 0),
+    sodium_mg DECIMAL(8,2) CHECK (sodium_mg >= 0),
+    potassium_mg DECIMAL(8,2) CHECK (potassium_mg >= 0),
+    iron_mg DECIMAL(6,2) CHECK (iron_mg >= 0),
+    calcium_mg DECIMAL(8,2) CHECK (calcium_mg >= 0),
+    vitamin_d_mcg DECIMAL(6,2) CHECK (vitamin_d_mcg >= 0),
+    omega3_grams DECIMAL(6,2) CHECK (omega3_grams >= 0),
     is_non_inflammatory BOOLEAN DEFAULT false,
     is_probiotic BOOLEAN DEFAULT false,
     is_prebiotic BOOLEAN DEFAULT false,
@@ -86,39 +333,101 @@ CREATE TABLE FOOD_CATALOG (
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 
--- Food User Likes table - user food preferences (many-to-many)
+-- Food Barcodes table - GTIN barcodes of packaged catalog foods
+CREATE TABLE FOOD_BARCODES (
+    barcode VARCHAR(14) PRIMARY KEY,
+    food_id INTEGER NOT NULL REFERENCES FOOD_CATALOG(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Unknown Barcodes table - scanned barcodes no catalog food has yet, for curation
+CREATE TABLE UNKNOWN_BARCODES (
+    barcode VARCHAR(14) PRIMARY KEY,
+    lookup_count INTEGER NOT NULL DEFAULT 1 CHECK (lookup_count > 0),
+    first_seen_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    last_seen_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Food Allergens table - major allergens contained in each food
+CREATE TABLE FOOD_ALLERGENS (
+    id SERIAL PRIMARY KEY,
+    food_id INTEGER NOT NULL REFERENCES FOOD_CATALOG(id) ON DELETE CASCADE,
+    allergen allergen_type NOT NULL,
+    UNIQUE(food_id, allergen)
+);
+
+-- Food User Likes table - user food likes and dislikes (many-to-many)
 CREATE TABLE FOOD_USER_LIKES (
     id SERIAL PRIMARY KEY,
     user_id INTEGER NOT NULL REFERENCES USERS(id) ON DELETE CASCADE,
     food_id INTEGER NOT NULL REFERENCES FOOD_CATALOG(id) ON DELETE CASCADE,
+    preference food_preference_type NOT NULL DEFAULT 'LIKE',
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     UNIQUE(user_id, food_id)
 );
 
+-- User Allergies table - allergens a user must avoid
+CREATE TABLE USER_ALLERGIES (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES USERS(id) ON DELETE CASCADE,
+    allergen allergen_type NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(user_id, allergen)
+);
+
+-- User Diet Restrictions table - diet patterns a user follows
+CREATE TABLE USER_DIET_RESTRICTIONS (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES USERS(id) ON DELETE CASCADE,
+    restriction diet_restriction_type NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(user_id, restriction)
+);
+
 -- Meals table - meal definitions with nutritional totals
 CREATE TABLE MEALS (
     id SERIAL PRIMARY KEY,
     name VARCHAR(255) NOT NULL,
     description TEXT,
-    total_calories DECIMAL(8,2),
+    servings INTEGER NOT NULL DEFAULT 1 CHECK (servings > 0), -- servings the ingredient quantities make
+    total_calories DECIMAL(8,2), -- per serving
     total_protein DECIMAL(6,2),
     total_carbs DECIMAL(6,2),
     total_fat DECIMAL(6,2),
     prep_time INTEGER, -- in minutes
-    prep_instructions TEXT, -- HTML or Markdown formatted cooking instructions
+    prep_instructions TEXT, -- cooking instructions in prep_instructions_format
+    prep_instructions_format instructions_format_type NOT NULL DEFAULT 'MARKDOWN',
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 
--- User Meals table - user meal consumption tracking
+-- User Meals table - daily food diary (meals, single foods or quick-add calories)
 CREATE TABLE USER_MEALS (
     id SERIAL PRIMARY KEY,
     user_id INTEGER NOT NULL REFERENCES USERS(id) ON DELETE CASCADE,
-    meal_id INTEGER NOT NULL REFERENCES MEALS(id) ON DELETE CASCADE,
-    date DATE NOT NULL,
+    meal_id INTEGER REFERENCES MEALS(id) ON DELETE CASCADE,
+    food_id INTEGER REFERENCES FOOD_CATALOG(id) ON DELETE CASCADE,
+    date DATE NOT NULL, -- calendar date in the user's timezone
     meal_number INTEGER NOT NULL CHECK (meal_number >= 1 AND meal_number <= 6), -- 1-6 for meal ordering throughout the day
+    servings DECIMAL(6,3) NOT NULL DEFAULT 1 CHECK (servings > 0), -- portion multiplier (0.5 = half portion)
+    quick_calories DECIMAL(8,2) CHECK (quick_calories >= 0), -- quick-add calories without a meal or food
+    description VARCHAR(255),
+    is_planned BOOLEAN NOT NULL DEFAULT false, -- planned by the meal plan generator rather than logged
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    UNIQUE(user_id, meal_id, date, meal_number)
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK (num_nonnulls(meal_id, food_id, quick_calories) = 1)
+);
+
+-- User Favorites table - foods and meals a user marked for quick logging
+CREATE TABLE USER_FAVORITES (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES USERS(id) ON DELETE CASCADE,
+    food_id INTEGER REFERENCES FOOD_CATALOG(id) ON DELETE CASCADE,
+    meal_id INTEGER REFERENCES MEALS(id) ON DELETE CASCADE,
+    servings DECIMAL(6,3) NOT NULL DEFAULT 1 CHECK (servings > 0), -- servings logged by default
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK (num_nonnulls(food_id, meal_id) = 1)
 );
 
 -- Meal Ingredients table - meal composition with quantities
@@ -141,14 +450,37 @@ CREATE INDEX idx_users_created_at ON USERS(created_at);
 CREATE INDEX idx_goals_category ON GOALS(category);
 CREATE INDEX idx_user_goals_user_id ON USER_GOALS(user_id);
 CREATE INDEX idx_user_goals_goal_id ON USER_GOALS(goal_id);
+CREATE INDEX idx_goal_check_ins_user_goal_date ON GOAL_CHECK_INS(user_goal_id, date);
+CREATE INDEX idx_check_ins_user_recorded_at ON CHECK_INS(user_id, recorded_at);
+CREATE INDEX idx_cycle_logs_period_starts ON CYCLE_LOGS(user_id, date) WHERE period_started;
+CREATE UNIQUE INDEX idx_exercises_owner_name ON EXERCISES(COALESCE(user_id, 0), lower(name));
+CREATE INDEX idx_workout_sessions_user_started_at ON WORKOUT_SESSIONS(user_id, started_at);
+CREATE INDEX idx_workout_sets_exercise_id ON WORKOUT_SETS(exercise_id);
+CREATE INDEX idx_goal_conflicts_goal_id ON GOAL_CONFLICTS(goal_id);
+CREATE INDEX idx_goal_conflicts_conflicting_goal_id ON GOAL_CONFLICTS(conflicting_goal_id);
+CREATE INDEX idx_survey_questions_version_id ON SURVEY_QUESTIONS(survey_version_id);
+CREATE INDEX idx_survey_question_options_question_id ON SURVEY_QUESTION_OPTIONS(question_id);
+CREATE INDEX idx_survey_responses_user_version ON SURVEY_RESPONSES(user_id, survey_version_id);
+CREATE INDEX idx_survey_answers_response_id ON SURVEY_ANSWERS(response_id);
 CREATE INDEX idx_food_catalog_category ON FOOD_CATALOG(category);
 CREATE INDEX idx_food_catalog_serving_units ON FOOD_CATALOG(serving_units);
+CREATE INDEX idx_food_catalog_user_id ON FOOD_CATALOG(user_id);
+CREATE UNIQUE INDEX idx_food_catalog_source_id ON FOOD_CATALOG(source, source_id) WHERE source_id IS NOT NULL;
+CREATE INDEX idx_food_barcodes_food_id ON FOOD_BARCODES(food_id);
+CREATE INDEX idx_unknown_barcodes_lookup_count ON UNKNOWN_BARCODES(lookup_count DESC);
 CREATE INDEX idx_food_user_likes_user_id ON FOOD_USER_LIKES(user_id);
 CREATE INDEX idx_food_user_likes_food_id ON FOOD_USER_LIKES(food_id);
+CREATE INDEX idx_food_allergens_allergen ON FOOD_ALLERGENS(allergen);
 CREATE INDEX idx_meals_name ON MEALS(name);
 CREATE INDEX idx_user_meals_user_id ON USER_MEALS(user_id);
 CREATE INDEX idx_user_meals_date ON USER_MEALS(date);
 CREATE INDEX idx_user_meals_meal_id ON USER_MEALS(meal_id);
+CREATE INDEX idx_user_meals_user_date ON USER_MEALS(user_id, date);
+CREATE INDEX idx_user_meals_food_id ON USER_MEALS(food_id);
+CREATE INDEX idx_user_meals_planned ON USER_MEALS(user_id, date) WHERE is_planned;
+CREATE INDEX idx_user_meals_recent ON USER_MEALS(user_id, created_at DESC) WHERE NOT is_planned;
+CREATE UNIQUE INDEX idx_user_favorites_food ON USER_FAVORITES(user_id, food_id) WHERE food_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_user_favorites_meal ON USER_FAVORITES(user_id, meal_id) WHERE meal_id IS NOT NULL;
 CREATE INDEX idx_meal_ingredients_meal_id ON MEAL_INGREDIENTS(meal_id);
 CREATE INDEX idx_meal_ingredients_food_id ON MEAL_INGREDIENTS(food_id);
 
@@ -159,6 +491,10 @@ COMMENT ON COLUMN USERS.username IS 'Optional username for display purposes';
 COMMENT ON COLUMN USERS.password_hash IS 'Bcrypt hashed password for authentication';
 COMMENT ON COLUMN USERS.full_name IS 'Users full name for display purposes';
 COMMENT ON COLUMN USERS.sex IS 'Biological sex (MALE, FEMALE, OTHER)';
+COMMENT ON COLUMN USERS.height_cm IS 'Height in centimeters, used for BMR calculation';
+COMMENT ON COLUMN USERS.weight_kg IS 'Current body weight in kilograms, used for BMR and protein targets';
+COMMENT ON COLUMN USERS.birth_date IS 'Date of birth, used to derive age for BMR calculation';
+COMMENT ON COLUMN USERS.activity_level IS 'Daily activity level (SEDENTARY, LIGHT, MODERATE, ACTIVE, VERY_ACTIVE) used for TDEE';
 COMMENT ON COLUMN USERS.phone_number IS 'Optional phone number for contact';
 COMMENT ON COLUMN USERS.city IS 'City of residence';
 COMMENT ON COLUMN USERS.state_province_code IS 'State or province of residence';
@@ -176,24 +512,144 @@ COMMENT ON COLUMN GOALS.description IS 'Goal description (max 200 chars)';
 COMMENT ON TABLE USER_GOALS IS 'Junction table linking users to their selected goals';
 COMMENT ON COLUMN USER_GOALS.user_id IS 'Foreign key to USERS table';
 COMMENT ON COLUMN USER_GOALS.goal_id IS 'Foreign key to GOALS table';
+COMMENT ON COLUMN USER_GOALS.metric IS 'What is measured for a quantified goal (e.g., body_weight, 10k_time)';
+COMMENT ON COLUMN USER_GOALS.unit IS 'Unit of the metric (e.g., kg, min)';
+COMMENT ON COLUMN USER_GOALS.baseline_value IS 'Metric value when the target was set';
+COMMENT ON COLUMN USER_GOALS.target_value IS 'Metric value to reach; below the baseline means lower is better';
+COMMENT ON COLUMN USER_GOALS.baseline_date IS 'Date the baseline was measured';
+COMMENT ON COLUMN USER_GOALS.target_date IS 'Optional deadline for reaching the target';
+
+COMMENT ON TABLE GOAL_CHECK_INS IS 'Measurements logged against a quantified user goal, one per day; body metric goals read CHECK_INS instead';
+COMMENT ON COLUMN GOAL_CHECK_INS.user_goal_id IS 'Foreign key to USER_GOALS table';
+COMMENT ON COLUMN GOAL_CHECK_INS.date IS 'Calendar date of the measurement in the user timezone';
+COMMENT ON COLUMN GOAL_CHECK_INS.value IS 'Measured metric value in the goal unit';
+
+COMMENT ON TABLE CHECK_INS IS 'Body metrics and wellbeing logged by users; every measurement is optional but at least one is required';
+COMMENT ON COLUMN CHECK_INS.user_id IS 'Foreign key to USERS table (owner of the check-in)';
+COMMENT ON COLUMN CHECK_INS.recorded_at IS 'When the measurements were taken, in UTC';
+COMMENT ON COLUMN CHECK_INS.weight_kg IS 'Body weight in kilograms';
+COMMENT ON COLUMN CHECK_INS.body_fat_percent IS 'Body fat percentage';
+COMMENT ON COLUMN CHECK_INS.waist_cm IS 'Waist circumference in centimeters';
+COMMENT ON COLUMN CHECK_INS.hip_cm IS 'Hip circumference in centimeters';
+COMMENT ON COLUMN CHECK_INS.energy_level IS 'Self-rated energy from 1 (exhausted) to 5 (energetic)';
+COMMENT ON COLUMN CHECK_INS.sleep_hours IS 'Hours slept the previous night';
+COMMENT ON COLUMN CHECK_INS.mood IS 'Self-rated mood from 1 (very low) to 5 (very good)';
+
+COMMENT ON TABLE CYCLE_TRACKING IS 'Users who opted in to menstrual cycle tracking; opting out deletes the row and every cycle log';
+COMMENT ON COLUMN CYCLE_TRACKING.opted_in_at IS 'When the user opted in';
+
+COMMENT ON TABLE CYCLE_LOGS IS 'Private cycle logs of opted-in users; only read for the owning user and never joined into user listings';
+COMMENT ON COLUMN CYCLE_LOGS.user_id IS 'Foreign key to CYCLE_TRACKING table (owner of the log)';
+COMMENT ON COLUMN CYCLE_LOGS.date IS 'Calendar date of the log in the user timezone';
+COMMENT ON COLUMN CYCLE_LOGS.period_started IS 'Whether a period started on this date; phase predictions are based on these dates';
+COMMENT ON COLUMN CYCLE_LOGS.symptoms IS 'Symptoms noticed on this date';
+
+COMMENT ON TABLE EXERCISES IS 'Exercises that workout sets are logged against; built-in rows are shared, custom rows belong to one user';
+COMMENT ON COLUMN EXERCISES.user_id IS 'Foreign key to USERS table (owner of a custom exercise, NULL for built-in exercises)';
+COMMENT ON COLUMN EXERCISES.exercise_type IS 'STRENGTH exercises are logged as reps and weight, ENDURANCE exercises as duration and distance';
+
+COMMENT ON TABLE WORKOUT_SESSIONS IS 'Training sessions logged by users';
+COMMENT ON COLUMN WORKOUT_SESSIONS.user_id IS 'Foreign key to USERS table (owner of the session)';
+COMMENT ON COLUMN WORKOUT_SESSIONS.started_at IS 'When the session started, in UTC';
+COMMENT ON COLUMN WORKOUT_SESSIONS.duration_minutes IS 'Optional total length of the session';
+COMMENT ON COLUMN WORKOUT_SESSIONS.calories_burned IS 'Energy expended in kcal, from the activity file or estimated from MET values; counted in the daily energy balance';
+COMMENT ON COLUMN WORKOUT_SESSIONS.source IS 'MANUAL for logged sessions, otherwise the format of the imported activity file';
+COMMENT ON COLUMN WORKOUT_SESSIONS.file_hash IS 'SHA-256 of the imported activity file; re-uploads of the same file are rejected';
+
+COMMENT ON TABLE WORKOUT_SETS IS 'Sets of a workout session; personal records and estimated one-rep maxes are derived from these';
+COMMENT ON COLUMN WORKOUT_SETS.session_id IS 'Foreign key to WORKOUT_SESSIONS table';
+COMMENT ON COLUMN WORKOUT_SETS.exercise_id IS 'Foreign key to EXERCISES table';
+COMMENT ON COLUMN WORKOUT_SETS.set_number IS 'Position of the set within the session, starting at 1';
+COMMENT ON COLUMN WORKOUT_SETS.weight_kg IS 'Load lifted in kilograms (0 for bodyweight sets)';
+COMMENT ON COLUMN WORKOUT_SETS.duration_seconds IS 'Length of an endurance effort in seconds';
+COMMENT ON COLUMN WORKOUT_SETS.distance_meters IS 'Distance covered in meters; pace is derived from duration and distance';
+COMMENT ON COLUMN WORKOUT_SETS.elevation_gain_meters IS 'Total climb of an endurance effort in meters';
+COMMENT ON COLUMN WORKOUT_SETS.avg_heart_rate IS 'Average heart rate in beats per minute';
+COMMENT ON COLUMN WORKOUT_SETS.max_heart_rate IS 'Maximum heart rate in beats per minute';
+
+COMMENT ON TABLE WORKOUT_HEART_RATE_SAMPLES IS 'Heart rate samples of an imported activity, at most one per second';
+COMMENT ON COLUMN WORKOUT_HEART_RATE_SAMPLES.session_id IS 'Foreign key to WORKOUT_SESSIONS table';
+COMMENT ON COLUMN WORKOUT_HEART_RATE_SAMPLES.elapsed_seconds IS 'Seconds since the session started';
+
+COMMENT ON TABLE GOAL_CONFLICTS IS 'Pairs of mutually exclusive goals, checked when a user selects a goal (rules apply in both directions)';
+COMMENT ON COLUMN GOAL_CONFLICTS.goal_id IS 'Foreign key to GOALS table';
+COMMENT ON COLUMN GOAL_CONFLICTS.conflicting_goal_id IS 'Foreign key to the GOALS row that cannot be selected together with goal_id';
+COMMENT ON COLUMN GOAL_CONFLICTS.reason IS 'User-facing explanation returned with the violation';
+
+COMMENT ON TABLE SURVEYS IS 'Questionnaires such as the activity level assessment and food preference intake';
+COMMENT ON COLUMN SURVEYS.slug IS 'Stable identifier used by clients (e.g., activity-level)';
+
+COMMENT ON TABLE SURVEY_VERSIONS IS 'Immutable question sets of a survey; responses always reference the version they answered';
+COMMENT ON COLUMN SURVEY_VERSIONS.version IS 'Sequential version number per survey; the highest is the current version';
+
+COMMENT ON TABLE SURVEY_QUESTIONS IS 'Ordered questions of a survey version';
+COMMENT ON COLUMN SURVEY_QUESTIONS.question_key IS 'Identifier of the question within the version, used as the answer key';
+COMMENT ON COLUMN SURVEY_QUESTIONS.question_type IS 'Question type (SINGLE_CHOICE, MULTI_CHOICE, NUMERIC, FREE_TEXT, SCALE)';
+COMMENT ON COLUMN SURVEY_QUESTIONS.required IS 'Whether the question must be answered when it is shown';
+COMMENT ON COLUMN SURVEY_QUESTIONS.min_value IS 'Lowest accepted answer for NUMERIC and SCALE questions';
+COMMENT ON COLUMN SURVEY_QUESTIONS.max_value IS 'Highest accepted answer for NUMERIC and SCALE questions';
+COMMENT ON COLUMN SURVEY_QUESTIONS.max_length IS 'Longest accepted FREE_TEXT answer (defaults to 2000 characters)';
+COMMENT ON COLUMN SURVEY_QUESTIONS.show_if_question_key IS 'Earlier choice question that controls whether this question is shown';
+COMMENT ON COLUMN SURVEY_QUESTIONS.show_if_values IS 'Option values of the controlling question that show this question';
+
+COMMENT ON TABLE SURVEY_QUESTION_OPTIONS IS 'Choices offered by SINGLE_CHOICE and MULTI_CHOICE questions';
+COMMENT ON COLUMN SURVEY_QUESTION_OPTIONS.value IS 'Stored answer value';
+COMMENT ON COLUMN SURVEY_QUESTION_OPTIONS.label IS 'Text displayed to the user';
+
+COMMENT ON TABLE SURVEY_RESPONSES IS 'Survey submissions by users; versions with responses cannot be deleted';
+COMMENT ON TABLE SURVEY_ANSWERS IS 'Answers of a response; exactly one value column is set depending on the question type';
 
 COMMENT ON TABLE FOOD_CATALOG IS 'Comprehensive food database with nutritional information and health properties';
+COMMENT ON COLUMN FOOD_CATALOG.user_id IS 'Foreign key to USERS table (owner of a custom food created by a diary import, NULL for shared catalog foods); custom foods are only visible to their owner';
+COMMENT ON COLUMN FOOD_CATALOG.source IS 'Where the food comes from: CURATED seed data, USER foods created by diary imports, or the USDA and OPEN_FOOD_FACTS bulk imports';
+COMMENT ON COLUMN FOOD_CATALOG.source_id IS 'ID of the food in its source dataset (FoodData Central fdc_id or Open Food Facts code); re-imports update the food with the same source and source_id';
 COMMENT ON COLUMN FOOD_CATALOG.category IS 'Food category from enum (MEAT, FISH, GRAIN, etc.)';
 COMMENT ON COLUMN FOOD_CATALOG.serving_units IS 'Unit of measurement from enum (GRAMS, OUNCES, etc.)';
+COMMENT ON COLUMN FOOD_CATALOG.fiber_grams IS 'Dietary fiber per serving in grams (NULL when unknown)';
+COMMENT ON COLUMN FOOD_CATALOG.sugar_grams IS 'Total sugars per serving in grams (NULL when unknown)';
+COMMENT ON COLUMN FOOD_CATALOG.sodium_mg IS 'Sodium per serving in milligrams (NULL when unknown)';
+COMMENT ON COLUMN FOOD_CATALOG.potassium_mg IS 'Potassium per serving in milligrams (NULL when unknown)';
+COMMENT ON COLUMN FOOD_CATALOG.iron_mg IS 'Iron per serving in milligrams (NULL when unknown)';
+COMMENT ON COLUMN FOOD_CATALOG.calcium_mg IS 'Calcium per serving in milligrams (NULL when unknown)';
+COMMENT ON COLUMN FOOD_CATALOG.vitamin_d_mcg IS 'Vitamin D per serving in micrograms (NULL when unknown)';
+COMMENT ON COLUMN FOOD_CATALOG.omega3_grams IS 'Omega-3 fatty acids (ALA, EPA and DHA) per serving in grams (NULL when unknown)';
 COMMENT ON COLUMN FOOD_CATALOG.is_non_inflammatory IS 'Boolean flag indicating anti-inflammatory properties';
 COMMENT ON COLUMN FOOD_CATALOG.is_probiotic IS 'Boolean flag indicating probiotic content';
 COMMENT ON COLUMN FOOD_CATALOG.is_prebiotic IS 'Boolean flag indicating prebiotic content';
 
+COMMENT ON TABLE FOOD_BARCODES IS 'GTIN barcodes of packaged foods, stored as 8, 13 or 14 digits (UPC-A codes with a leading zero)';
+COMMENT ON COLUMN FOOD_BARCODES.food_id IS 'Foreign key to FOOD_CATALOG table';
+
+COMMENT ON TABLE UNKNOWN_BARCODES IS 'Valid barcodes users scanned that no catalog food has; a barcode is removed once an import adds it to FOOD_BARCODES';
+COMMENT ON COLUMN UNKNOWN_BARCODES.lookup_count IS 'Number of lookups of the barcode, to curate the most scanned products first';
+
+COMMENT ON TABLE FOOD_ALLERGENS IS 'Major allergens contained in catalog foods';
+
 COMMENT ON TABLE FOOD_USER_LIKES IS 'Junction table tracking user food preferences';
 COMMENT ON COLUMN FOOD_USER_LIKES.user_id IS 'Foreign key to USERS table';
 COMMENT ON COLUMN FOOD_USER_LIKES.food_id IS 'Foreign key to FOOD_CATALOG table';
+COMMENT ON COLUMN FOOD_USER_LIKES.preference IS 'LIKE or DISLIKE; disliked foods are hidden from the users catalog';
+
+COMMENT ON TABLE USER_ALLERGIES IS 'Allergens a user must avoid; foods containing them are hidden from the users catalog';
+COMMENT ON TABLE USER_DIET_RESTRICTIONS IS 'Diet patterns a user follows (vegetarian, dairy-free, etc.) that exclude food categories or allergens';
 
 COMMENT ON TABLE MEALS IS 'Stores meal definitions with nutritional totals and preparation instructions';
+COMMENT ON COLUMN MEALS.servings IS 'Number of servings the MEAL_INGREDIENTS quantities make; nutrition totals are per serving';
 COMMENT ON COLUMN MEALS.prep_time IS 'Preparation time in minutes';
-COMMENT ON COLUMN MEALS.prep_instructions IS 'HTML or Markdown formatted cooking instructions';
+COMMENT ON COLUMN MEALS.prep_instructions IS 'Cooking instructions as submitted; HTML is stripped to an allowlist before it is stored';
+COMMENT ON COLUMN MEALS.prep_instructions_format IS 'Format of prep_instructions (MARKDOWN or HTML); both are rendered to sanitized HTML when read';
 
-COMMENT ON TABLE USER_MEALS IS 'Tracks user meal consumption by date and meal number';
+COMMENT ON TABLE USER_MEALS IS 'Daily food diary: meals, single foods or quick-add calories by date and meal number';
+COMMENT ON COLUMN USER_MEALS.meal_id IS 'Logged meal (exactly one of meal_id, food_id, quick_calories is set)';
+COMMENT ON COLUMN USER_MEALS.food_id IS 'Logged food eaten on its own, without a meal';
+COMMENT ON COLUMN USER_MEALS.date IS 'Calendar date of consumption in the users timezone';
 COMMENT ON COLUMN USER_MEALS.meal_number IS 'Meal order (1-6 for meal ordering throughout the day)';
+COMMENT ON COLUMN USER_MEALS.servings IS 'Portion multiplier applied to the meal totals or food serving (e.g., 0.5 for half portion)';
+COMMENT ON COLUMN USER_MEALS.quick_calories IS 'Quick-add calories logged without a meal or food';
+COMMENT ON COLUMN USER_MEALS.is_planned IS 'Planned by the meal plan generator; planned rows are excluded from the diary and nutrition reports';
+
+COMMENT ON TABLE USER_FAVORITES IS 'Foods and meals a user marked as favorites for quick logging (exactly one of food_id, meal_id is set)';
+COMMENT ON COLUMN USER_FAVORITES.servings IS 'Servings logged when the favorite is logged without a servings override';
 
 COMMENT ON TABLE MEAL_INGREDIENTS IS 'Junction table defining meal composition with quantities';
 COMMENT ON COLUMN MEAL_INGREDIENTS.quantity IS 'Amount of food item';
@@ -201,8 +657,21 @@ COMMENT ON COLUMN MEAL_INGREDIENTS.unit IS 'Unit of measurement from serving_uni
 
 -- Key Relationships:
 -- 1. USERS 1:N USER_GOALS (users can have multiple goals)
--- 2. USERS 1:N FOOD_USER_LIKES (users can like multiple foods)
+-- 2. USERS 1:N FOOD_USER_LIKES (users can like or dislike multiple foods)
 -- 3. USERS 1:N USER_MEALS (users can consume multiple meals)
 -- 4. MEALS 1:N MEAL_INGREDIENTS (meals can have multiple ingredients)
 -- 5. FOOD_CATALOG 1:N MEAL_INGREDIENTS (foods can be used in multiple meals)
 -- 6. GOALS 1:N USER_GOALS (goals can be assigned to multiple users)
+-- 7. FOOD_CATALOG 1:N USER_MEALS (foods can be logged directly in the diary)
+-- 8. GOALS 1:N GOAL_CONFLICTS (goals can exclude other goals)
+-- 9. USER_GOALS 1:N GOAL_CHECK_INS (quantified goals track measurements over time)
+-- 10. USERS 1:N CHECK_INS (users log body metrics and wellbeing over time)
+-- 11. SURVEYS 1:N SURVEY_VERSIONS (surveys are versioned; each version has its own questions)
+-- 12. SURVEY_VERSIONS 1:N SURVEY_QUESTIONS 1:N SURVEY_QUESTION_OPTIONS (questions and their choices)
+-- 13. USERS 1:N SURVEY_RESPONSES 1:N SURVEY_ANSWERS (users answer specific survey versions)
+-- 14. FOOD_CATALOG 1:N FOOD_ALLERGENS (foods can contain multiple allergens)
+-- 15. USERS 1:N USER_ALLERGIES (users can declare multiple allergies)
+-- 16. USERS 1:N USER_DIET_RESTRICTIONS (users can follow multiple diet patterns)
+-- 17. USERS 1:N FOOD_CATALOG (users own the custom foods their diary imports created)
+-- 18. FOOD_CATALOG 1:N FOOD_BARCODES (packaged foods can have several barcodes)
+-- 19. USERS 1:N USER_FAVORITES (users can favorite foods and meals for quick logging)
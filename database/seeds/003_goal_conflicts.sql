@@ -0,0 +1,27 @@
+-- Goal conflict rules
+-- Pairs of goals that cannot be selected together. Rules are checked in both
+-- directions, so each pair is listed once. Run after 001_initial_goals.sql.
+
+INSERT INTO GOAL_CONFLICTS (goal_id, conflicting_goal_id, reason)
+SELECT g1.id, g2.id, rules.reason
+FROM (VALUES
+    -- Weight goals are mutually exclusive
+    ('Weight', 'Lose', 'Weight', 'Gain', 'You cannot lose and gain weight at the same time'),
+    ('Weight', 'Lose', 'Weight', 'Maintain', 'Losing weight and maintaining weight are opposite targets'),
+    ('Weight', 'Maintain', 'Weight', 'Gain', 'Maintaining weight and gaining weight are opposite targets'),
+
+    -- Appearance goals that need a calorie surplus vs. a deficit
+    ('Appearance', 'Bulk', 'Appearance', 'Definition/Cut', 'Bulking needs a calorie surplus while cutting needs a deficit'),
+    ('Appearance', 'Bulk', 'Appearance', 'Lean', 'Bulking accepts fat gain while a lean build minimizes it'),
+
+    -- Gain and maintain are exclusive within a category
+    ('Strength', 'Gain', 'Strength', 'Maintain', 'Choose either to gain or to maintain strength'),
+    ('Endurance', 'Gain', 'Endurance', 'Maintain', 'Choose either to gain or to maintain endurance'),
+
+    -- Cross-category conflicts
+    ('Weight', 'Lose', 'Appearance', 'Bulk', 'Bulking requires a calorie surplus, which conflicts with losing weight'),
+    ('Weight', 'Gain', 'Appearance', 'Definition/Cut', 'Cutting requires a calorie deficit, which conflicts with gaining weight')
+) AS rules(category, name, conflicting_category, conflicting_name, reason)
+JOIN GOALS g1 ON g1.category = rules.category::goal_category AND g1.name = rules.name
+JOIN GOALS g2 ON g2.category = rules.conflicting_category::goal_category AND g2.name = rules.conflicting_name
+ON CONFLICT (goal_id, conflicting_goal_id) DO NOTHING;
@@ -0,0 +1,118 @@
+-- Profile completion surveys
+-- Version 1 of the activity level assessment and the food preference intake.
+-- Questions with a show_if condition are only asked when the earlier question
+-- was answered with one of the listed values. Later versions are published
+-- through POST /api/surveys/{id}/versions rather than by editing this file.
+
+INSERT INTO SURVEYS (slug, title, description) VALUES
+    ('activity-level', 'Activity Level Assessment',
+     'How active you are at work and in your free time, used to estimate your daily energy needs'),
+    ('food-preferences', 'Food Preference Intake',
+     'Your diet style, foods to avoid, allergies and cooking habits, used to personalize meal suggestions')
+ON CONFLICT (slug) DO NOTHING;
+
+INSERT INTO SURVEY_VERSIONS (survey_id, version)
+SELECT id, 1 FROM SURVEYS WHERE slug IN ('activity-level', 'food-preferences')
+ON CONFLICT (survey_id, version) DO NOTHING;
+
+INSERT INTO SURVEY_QUESTIONS (survey_version_id, question_key, position, question_type, prompt, required,
+    min_value, max_value, max_length, show_if_question_key, show_if_values)
+SELECT v.id, q.question_key, q.position, q.question_type::survey_question_type, q.prompt, q.required,
+    q.min_value, q.max_value, q.max_length, q.show_if_question_key, q.show_if_values
+FROM (VALUES
+    -- Activity level assessment
+    ('activity-level', 'daily_activity', 1, 'SINGLE_CHOICE', 'How active are you during a typical day, not counting exercise?', true,
+     NULL::DECIMAL, NULL::DECIMAL, NULL::INTEGER, NULL, NULL::TEXT[]),
+    ('activity-level', 'exercises_regularly', 2, 'SINGLE_CHOICE', 'Do you exercise regularly?', true,
+     NULL, NULL, NULL, NULL, NULL),
+    ('activity-level', 'exercise_days_per_week', 3, 'NUMERIC', 'How many days a week do you exercise?', true,
+     1, 7, NULL, 'exercises_regularly', ARRAY['YES']),
+    ('activity-level', 'exercise_minutes_per_session', 4, 'NUMERIC', 'How many minutes does a typical session last?', true,
+     10, 300, NULL, 'exercises_regularly', ARRAY['YES']),
+    ('activity-level', 'exercise_intensity', 5, 'SINGLE_CHOICE', 'How hard are your sessions usually?', true,
+     NULL, NULL, NULL, 'exercises_regularly', ARRAY['YES']),
+    ('activity-level', 'exercise_types', 6, 'MULTI_CHOICE', 'What kinds of exercise do you do?', false,
+     NULL, NULL, NULL, 'exercises_regularly', ARRAY['YES']),
+    ('activity-level', 'daily_steps', 7, 'SINGLE_CHOICE', 'About how many steps do you take per day?', false,
+     NULL, NULL, NULL, NULL, NULL),
+
+    -- Food preference intake
+    ('food-preferences', 'diet_style', 1, 'SINGLE_CHOICE', 'Which best describes the way you eat?', true,
+     NULL, NULL, NULL, NULL, NULL),
+    ('food-preferences', 'avoided_categories', 2, 'MULTI_CHOICE', 'Are there food groups you avoid?', false,
+     NULL, NULL, NULL, NULL, NULL),
+    ('food-preferences', 'has_allergies', 3, 'SINGLE_CHOICE', 'Do you have any food allergies or intolerances?', true,
+     NULL, NULL, NULL, NULL, NULL),
+    ('food-preferences', 'allergy_details', 4, 'FREE_TEXT', 'Which foods, and how severe is the reaction?', true,
+     NULL, NULL, 500, 'has_allergies', ARRAY['YES']),
+    ('food-preferences', 'meals_per_day', 5, 'NUMERIC', 'How many meals and snacks do you eat on a typical day?', true,
+     1, 6, NULL, NULL, NULL),
+    ('food-preferences', 'cooking_time', 6, 'SINGLE_CHOICE', 'How much time do you usually have to prepare a meal?', true,
+     NULL, NULL, NULL, NULL, NULL),
+    ('food-preferences', 'spice_tolerance', 7, 'SCALE', 'How much do you enjoy spicy food? (1 = not at all, 5 = the hotter the better)', false,
+     1, 5, NULL, NULL, NULL),
+    ('food-preferences', 'other_preferences', 8, 'FREE_TEXT', 'Anything else we should know about what you like to eat?', false,
+     NULL, NULL, 500, NULL, NULL)
+) AS q(slug, question_key, position, question_type, prompt, required,
+       min_value, max_value, max_length, show_if_question_key, show_if_values)
+JOIN SURVEYS s ON s.slug = q.slug
+JOIN SURVEY_VERSIONS v ON v.survey_id = s.id AND v.version = 1
+ON CONFLICT (survey_version_id, question_key) DO NOTHING;
+
+INSERT INTO SURVEY_QUESTION_OPTIONS (question_id, value, label, position)
+SELECT sq.id, o.value, o.label, o.position
+FROM (VALUES
+    ('activity-level', 'daily_activity', 'MOSTLY_SITTING', 'Mostly sitting (desk work, driving)', 1),
+    ('activity-level', 'daily_activity', 'SOME_WALKING', 'Sitting with some walking or standing', 2),
+    ('activity-level', 'daily_activity', 'ON_FEET', 'On my feet most of the day', 3),
+    ('activity-level', 'daily_activity', 'PHYSICAL_WORK', 'Physically demanding work', 4),
+
+    ('activity-level', 'exercises_regularly', 'YES', 'Yes', 1),
+    ('activity-level', 'exercises_regularly', 'NO', 'No', 2),
+
+    ('activity-level', 'exercise_intensity', 'LIGHT', 'Light (easy walking, stretching)', 1),
+    ('activity-level', 'exercise_intensity', 'MODERATE', 'Moderate (brisk walking, easy cycling)', 2),
+    ('activity-level', 'exercise_intensity', 'VIGOROUS', 'Vigorous (running, heavy lifting, intervals)', 3),
+
+    ('activity-level', 'exercise_types', 'WALKING', 'Walking or hiking', 1),
+    ('activity-level', 'exercise_types', 'RUNNING', 'Running', 2),
+    ('activity-level', 'exercise_types', 'CYCLING', 'Cycling', 3),
+    ('activity-level', 'exercise_types', 'SWIMMING', 'Swimming', 4),
+    ('activity-level', 'exercise_types', 'STRENGTH', 'Strength training', 5),
+    ('activity-level', 'exercise_types', 'YOGA_PILATES', 'Yoga or pilates', 6),
+    ('activity-level', 'exercise_types', 'SPORTS', 'Team or racket sports', 7),
+    ('activity-level', 'exercise_types', 'OTHER', 'Other', 8),
+
+    ('activity-level', 'daily_steps', 'UNDER_5000', 'Fewer than 5,000', 1),
+    ('activity-level', 'daily_steps', '5000_TO_7500', '5,000 to 7,500', 2),
+    ('activity-level', 'daily_steps', '7500_TO_10000', '7,500 to 10,000', 3),
+    ('activity-level', 'daily_steps', 'OVER_10000', 'More than 10,000', 4),
+    ('activity-level', 'daily_steps', 'NOT_TRACKED', 'I do not track my steps', 5),
+
+    ('food-preferences', 'diet_style', 'OMNIVORE', 'I eat everything', 1),
+    ('food-preferences', 'diet_style', 'PESCATARIAN', 'Pescatarian (fish but no meat)', 2),
+    ('food-preferences', 'diet_style', 'VEGETARIAN', 'Vegetarian', 3),
+    ('food-preferences', 'diet_style', 'VEGAN', 'Vegan', 4),
+    ('food-preferences', 'diet_style', 'OTHER', 'Other', 5),
+
+    ('food-preferences', 'avoided_categories', 'MEAT', 'Meat', 1),
+    ('food-preferences', 'avoided_categories', 'FISH', 'Fish and seafood', 2),
+    ('food-preferences', 'avoided_categories', 'DAIRY', 'Dairy', 3),
+    ('food-preferences', 'avoided_categories', 'GRAIN', 'Grains', 4),
+    ('food-preferences', 'avoided_categories', 'LEGUMES', 'Legumes', 5),
+    ('food-preferences', 'avoided_categories', 'NUTS', 'Nuts', 6),
+    ('food-preferences', 'avoided_categories', 'NIGHTSHADES', 'Nightshades (tomatoes, peppers, eggplant)', 7),
+    ('food-preferences', 'avoided_categories', 'SWEETENER', 'Added sugar and sweeteners', 8),
+
+    ('food-preferences', 'has_allergies', 'YES', 'Yes', 1),
+    ('food-preferences', 'has_allergies', 'NO', 'No', 2),
+
+    ('food-preferences', 'cooking_time', 'UNDER_15', 'Less than 15 minutes', 1),
+    ('food-preferences', 'cooking_time', '15_TO_30', '15 to 30 minutes', 2),
+    ('food-preferences', 'cooking_time', '30_TO_60', '30 to 60 minutes', 3),
+    ('food-preferences', 'cooking_time', 'OVER_60', 'More than an hour', 4)
+) AS o(slug, question_key, value, label, position)
+JOIN SURVEYS s ON s.slug = o.slug
+JOIN SURVEY_VERSIONS v ON v.survey_id = s.id AND v.version = 1
+JOIN SURVEY_QUESTIONS sq ON sq.survey_version_id = v.id AND sq.question_key = o.question_key
+ON CONFLICT (question_id, value) DO NOTHING;
@@ -0,0 +1,36 @@
+-- Food allergens
+-- Major allergens contained in catalog foods, matched by food name. Diet
+-- restrictions rely on these too: dairy-free and vegan exclude MILK, vegan
+-- excludes EGG. Run after 001_food_catalog_seeds.sql.
+
+INSERT INTO FOOD_ALLERGENS (food_id, allergen)
+SELECT f.id, allergens.allergen::allergen_type
+FROM (VALUES
+    -- Dairy (coconut milk is dairy-free)
+    ('Cheddar Cheese', 'MILK'),
+    ('Goat Cheese', 'MILK'),
+    ('Greek Yogurt - Plain', 'MILK'),
+    ('Goat Milk', 'MILK'),
+    ('A2 Milk', 'MILK'),
+    ('Cow Milk - Regular', 'MILK'),
+
+    -- Eggs
+    ('Eggs - Large Chicken', 'EGG'),
+    ('Eggs - Duck', 'EGG'),
+    ('Eggs - Quail', 'EGG'),
+
+    -- Fish
+    ('Salmon - Wild Atlantic', 'FISH'),
+    ('Cod Fillet', 'FISH'),
+    ('Tuna - Yellowfin', 'FISH'),
+    ('Sardines', 'FISH'),
+
+    -- Nuts
+    ('Almonds - Whole', 'TREE_NUTS'),
+    ('Almonds - Blanched', 'TREE_NUTS'),
+    ('Walnuts', 'TREE_NUTS'),
+    ('Pistachios', 'TREE_NUTS'),
+    ('Peanuts', 'PEANUTS')
+) AS allergens(food_name, allergen)
+JOIN FOOD_CATALOG f ON f.food_name = allergens.food_name
+ON CONFLICT (food_id, allergen) DO NOTHING;
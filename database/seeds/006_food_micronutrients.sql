@@ -0,0 +1,97 @@
+-- Food micronutrients
+-- Fiber, sugar, sodium, potassium, iron, calcium, vitamin D and omega-3 per
+-- serving of each catalog food, matched by food name. Values are rounded
+-- USDA FoodData Central figures for the food's serving unit. Run after
+-- 001_food_catalog_seeds.sql.
+
+UPDATE FOOD_CATALOG AS f
+SET fiber_grams = v.fiber_grams,
+    sugar_grams = v.sugar_grams,
+    sodium_mg = v.sodium_mg,
+    potassium_mg = v.potassium_mg,
+    iron_mg = v.iron_mg,
+    calcium_mg = v.calcium_mg,
+    vitamin_d_mcg = v.vitamin_d_mcg,
+    omega3_grams = v.omega3_grams,
+    updated_at = CURRENT_TIMESTAMP
+FROM (VALUES
+    -- food_name, fiber g, sugar g, sodium mg, potassium mg, iron mg, calcium mg, vitamin D mcg, omega-3 g
+
+    -- Fish (per ounce)
+    ('Salmon - Wild Atlantic', 0, 0, 16, 150, 0.3, 4, 4.0, 0.60),
+    ('Cod Fillet', 0, 0, 22, 120, 0.1, 4, 0.3, 0.05),
+    ('Tuna - Yellowfin', 0, 0, 13, 125, 0.2, 1, 0.5, 0.07),
+    ('Sardines', 0, 0, 85, 110, 0.8, 108, 1.4, 0.40),
+
+    -- Meats (per ounce)
+    ('Chicken Breast - Skinless', 0, 0, 21, 90, 0.3, 4, 0, 0.01),
+    ('Ground Beef - 85% Lean', 0, 0, 20, 85, 0.7, 5, 0, 0.01),
+    ('Bison - Ground', 0, 0, 22, 100, 0.8, 4, 0, 0.02),
+    ('Pork Tenderloin', 0, 0, 15, 115, 0.3, 2, 0.2, 0),
+
+    -- Dairy and eggs (per ounce, cup or egg)
+    ('Cheddar Cheese', 0, 0.1, 180, 22, 0.2, 200, 0.3, 0.03),
+    ('Goat Cheese', 0, 0.1, 130, 7, 0.5, 40, 0.1, 0.01),
+    ('Greek Yogurt - Plain', 0, 7, 85, 330, 0.2, 250, 0, 0),
+    ('Coconut Milk - Canned', 5, 8, 30, 500, 7.5, 40, 0, 0),
+    ('Goat Milk', 0, 11, 120, 500, 0.1, 330, 3.0, 0.10),
+    ('A2 Milk', 0, 12, 105, 390, 0.1, 300, 2.9, 0.02),
+    ('Cow Milk - Regular', 0, 12, 105, 390, 0.1, 300, 2.9, 0.02),
+    ('Eggs - Large Chicken', 0, 0.2, 70, 70, 0.9, 28, 1.1, 0.04),
+    ('Eggs - Duck', 0, 0.7, 100, 155, 2.7, 45, 1.3, 0.05),
+    ('Eggs - Quail', 0, 0, 13, 12, 0.3, 6, 0.1, 0.01),
+
+    -- Vegetables (per cup)
+    ('Broccoli', 2.4, 1.5, 30, 290, 0.7, 45, 0, 0.02),
+    ('Spinach - Raw', 0.7, 0.1, 24, 170, 0.8, 30, 0, 0.04),
+    ('Asparagus', 2.8, 2.5, 3, 270, 2.9, 32, 0, 0.01),
+    ('Sweet Potato', 6.6, 13, 70, 950, 1.4, 76, 0, 0),
+    ('Kale', 2.6, 0.6, 30, 300, 1.0, 90, 0, 0.12),
+    ('Carrots', 3.6, 6, 90, 410, 0.4, 43, 0, 0),
+    ('Sauerkraut', 4.1, 2.5, 940, 240, 2.1, 43, 0, 0.03),
+    ('Kimchee', 2.4, 1.6, 750, 230, 0.4, 50, 0, 0.02),
+
+    -- Nightshades (per cup)
+    ('Bell Pepper - Red', 3.1, 6, 6, 310, 0.6, 10, 0, 0.05),
+    ('Tomatoes', 2.2, 4.7, 9, 430, 0.5, 18, 0, 0),
+    ('Eggplant', 2.5, 3, 2, 120, 0.2, 6, 0, 0.01),
+    ('Potato - Russet', 3.2, 1.5, 8, 630, 1.6, 20, 0, 0.02),
+
+    -- Grains (per cup cooked)
+    ('Brown Rice - Cooked', 3.2, 0.7, 10, 155, 0.8, 20, 0, 0.03),
+    ('White Rice - Cooked', 0.6, 0.1, 2, 55, 1.9, 16, 0, 0.02),
+    ('Quinoa - Cooked', 5.2, 1.6, 13, 320, 2.8, 31, 0, 0.16),
+
+    -- Nuts and seeds (per ounce or tablespoon)
+    ('Almonds - Whole', 3.5, 1.2, 0, 210, 1.0, 76, 0, 0),
+    ('Almonds - Blanched', 2.8, 1.2, 5, 190, 0.9, 65, 0, 0),
+    ('Walnuts', 1.9, 0.7, 1, 125, 0.8, 28, 0, 2.50),
+    ('Pistachios', 3.0, 2.2, 0, 285, 1.1, 30, 0, 0.07),
+    ('Chia Seeds', 4.1, 0, 2, 50, 0.8, 80, 0, 2.20),
+    ('Flaxseeds - Ground', 1.9, 0.1, 2, 57, 0.4, 18, 0, 1.60),
+
+    -- Legumes (per ounce or cup cooked)
+    ('Peanuts', 2.4, 1.3, 5, 200, 1.3, 26, 0, 0),
+    ('Black Beans - Cooked', 15, 0.6, 2, 610, 3.6, 46, 0, 0.18),
+    ('Lentils - Cooked', 15.6, 3.6, 4, 730, 6.6, 38, 0, 0.07),
+
+    -- Oils (per tablespoon)
+    ('Olive Oil - Extra Virgin', 0, 0, 0, 0, 0.1, 0, 0, 0.10),
+    ('Coconut Oil', 0, 0, 0, 0, 0, 0, 0, 0),
+    ('Avocado Oil', 0, 0, 0, 0, 0, 0, 0, 0.13),
+
+    -- Fruits (per cup or medium fruit)
+    ('Blueberries', 3.6, 15, 1, 115, 0.4, 9, 0, 0.09),
+    ('Strawberries', 3.0, 7.4, 2, 230, 0.6, 24, 0, 0.10),
+    ('Avocado', 10, 1, 10, 730, 0.9, 18, 0, 0.16),
+    ('Apple - Medium', 4.4, 19, 2, 195, 0.2, 11, 0, 0.02),
+    ('Banana - Medium', 3.1, 14, 1, 420, 0.3, 6, 0, 0.03),
+    ('Grapes', 0.8, 15, 2, 175, 0.3, 9, 0, 0.01),
+    ('Orange', 3.1, 12, 0, 240, 0.1, 52, 0, 0.01),
+
+    -- Herbs and spices (per teaspoon)
+    ('Turmeric - Ground', 0.7, 0.1, 1, 60, 1.6, 5, 0, 0),
+    ('Ginger - Fresh', 0, 0, 0, 8, 0, 0, 0, 0),
+    ('Garlic - Fresh', 0.1, 0, 1, 12, 0.1, 5, 0, 0)
+) AS v(food_name, fiber_grams, sugar_grams, sodium_mg, potassium_mg, iron_mg, calcium_mg, vitamin_d_mcg, omega3_grams)
+WHERE f.food_name = v.food_name;
@@ -0,0 +1,44 @@
+-- Built-in exercises
+-- Shared by every user (user_id is NULL); users can add custom exercises of
+-- their own through the workout service.
+
+INSERT INTO EXERCISES (name, exercise_type) VALUES
+-- Strength: compound lifts
+('Back Squat', 'STRENGTH'),
+('Front Squat', 'STRENGTH'),
+('Deadlift', 'STRENGTH'),
+('Romanian Deadlift', 'STRENGTH'),
+('Bench Press', 'STRENGTH'),
+('Incline Dumbbell Press', 'STRENGTH'),
+('Overhead Press', 'STRENGTH'),
+('Barbell Row', 'STRENGTH'),
+('Hip Thrust', 'STRENGTH'),
+('Walking Lunge', 'STRENGTH'),
+('Bulgarian Split Squat', 'STRENGTH'),
+('Leg Press', 'STRENGTH'),
+
+-- Strength: bodyweight and accessories
+('Pull-up', 'STRENGTH'),
+('Chin-up', 'STRENGTH'),
+('Push-up', 'STRENGTH'),
+('Dip', 'STRENGTH'),
+('Lat Pulldown', 'STRENGTH'),
+('Seated Cable Row', 'STRENGTH'),
+('Lateral Raise', 'STRENGTH'),
+('Biceps Curl', 'STRENGTH'),
+('Triceps Pushdown', 'STRENGTH'),
+('Glute Bridge', 'STRENGTH'),
+('Calf Raise', 'STRENGTH'),
+
+-- Endurance
+('Running', 'ENDURANCE'),
+('Treadmill Running', 'ENDURANCE'),
+('Walking', 'ENDURANCE'),
+('Hiking', 'ENDURANCE'),
+('Cycling', 'ENDURANCE'),
+('Indoor Cycling', 'ENDURANCE'),
+('Swimming', 'ENDURANCE'),
+('Rowing', 'ENDURANCE'),
+('Elliptical', 'ENDURANCE'),
+('Stair Climber', 'ENDURANCE'),
+('Jump Rope', 'ENDURANCE');
@@ -0,0 +1,2362 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        v5.29.3
+// source: proto/diary.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Diary entry data structure. Exactly one of meal_id, food_id or
+// quick_calories is set; nutrition values are already scaled by servings.
+type DiaryEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MealId        int32                  `protobuf:"varint,3,opt,name=meal_id,json=mealId,proto3" json:"meal_id,omitempty"`
+	FoodId        int32                  `protobuf:"varint,4,opt,name=food_id,json=foodId,proto3" json:"food_id,omitempty"`
+	Date          string                 `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD in the user's timezone
+	MealNumber    int32                  `protobuf:"varint,6,opt,name=meal_number,json=mealNumber,proto3" json:"meal_number,omitempty"`
+	Servings      float64                `protobuf:"fixed64,7,opt,name=servings,proto3" json:"servings,omitempty"`
+	QuickCalories float64                `protobuf:"fixed64,8,opt,name=quick_calories,json=quickCalories,proto3" json:"quick_calories,omitempty"`
+	Description   string                 `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+	Name          string                 `protobuf:"bytes,10,opt,name=name,proto3" json:"name,omitempty"`
+	Calories      float64                `protobuf:"fixed64,11,opt,name=calories,proto3" json:"calories,omitempty"`
+	ProteinGrams  float64                `protobuf:"fixed64,12,opt,name=protein_grams,json=proteinGrams,proto3" json:"protein_grams,omitempty"`
+	CarbsGrams    float64                `protobuf:"fixed64,13,opt,name=carbs_grams,json=carbsGrams,proto3" json:"carbs_grams,omitempty"`
+	FatGrams      float64                `protobuf:"fixed64,14,opt,name=fat_grams,json=fatGrams,proto3" json:"fat_grams,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiaryEntry) Reset() {
+	*x = DiaryEntry{}
+	mi := &file_proto_diary_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiaryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiaryEntry) ProtoMessage() {}
+
+func (x *DiaryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiaryEntry.ProtoReflect.Descriptor instead.
+func (*DiaryEntry) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DiaryEntry) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DiaryEntry) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DiaryEntry) GetMealId() int32 {
+	if x != nil {
+		return x.MealId
+	}
+	return 0
+}
+
+func (x *DiaryEntry) GetFoodId() int32 {
+	if x != nil {
+		return x.FoodId
+	}
+	return 0
+}
+
+func (x *DiaryEntry) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DiaryEntry) GetMealNumber() int32 {
+	if x != nil {
+		return x.MealNumber
+	}
+	return 0
+}
+
+func (x *DiaryEntry) GetServings() float64 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *DiaryEntry) GetQuickCalories() float64 {
+	if x != nil {
+		return x.QuickCalories
+	}
+	return 0
+}
+
+func (x *DiaryEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DiaryEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiaryEntry) GetCalories() float64 {
+	if x != nil {
+		return x.Calories
+	}
+	return 0
+}
+
+func (x *DiaryEntry) GetProteinGrams() float64 {
+	if x != nil {
+		return x.ProteinGrams
+	}
+	return 0
+}
+
+func (x *DiaryEntry) GetCarbsGrams() float64 {
+	if x != nil {
+		return x.CarbsGrams
+	}
+	return 0
+}
+
+func (x *DiaryEntry) GetFatGrams() float64 {
+	if x != nil {
+		return x.FatGrams
+	}
+	return 0
+}
+
+func (x *DiaryEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DiaryEntry) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Request/Response messages
+type LogDiaryEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MealId        int32                  `protobuf:"varint,2,opt,name=meal_id,json=mealId,proto3" json:"meal_id,omitempty"`
+	FoodId        int32                  `protobuf:"varint,3,opt,name=food_id,json=foodId,proto3" json:"food_id,omitempty"`
+	Date          string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"` // optional, defaults to today in the user's timezone
+	MealNumber    int32                  `protobuf:"varint,5,opt,name=meal_number,json=mealNumber,proto3" json:"meal_number,omitempty"`
+	Servings      float64                `protobuf:"fixed64,6,opt,name=servings,proto3" json:"servings,omitempty"`                                      // optional, defaults to 1
+	QuickCalories *float64               `protobuf:"fixed64,7,opt,name=quick_calories,json=quickCalories,proto3,oneof" json:"quick_calories,omitempty"` // set for a quick-add entry, which may be 0
+	Description   string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogDiaryEntryRequest) Reset() {
+	*x = LogDiaryEntryRequest{}
+	mi := &file_proto_diary_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogDiaryEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogDiaryEntryRequest) ProtoMessage() {}
+
+func (x *LogDiaryEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogDiaryEntryRequest.ProtoReflect.Descriptor instead.
+func (*LogDiaryEntryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LogDiaryEntryRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LogDiaryEntryRequest) GetMealId() int32 {
+	if x != nil {
+		return x.MealId
+	}
+	return 0
+}
+
+func (x *LogDiaryEntryRequest) GetFoodId() int32 {
+	if x != nil {
+		return x.FoodId
+	}
+	return 0
+}
+
+func (x *LogDiaryEntryRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *LogDiaryEntryRequest) GetMealNumber() int32 {
+	if x != nil {
+		return x.MealNumber
+	}
+	return 0
+}
+
+func (x *LogDiaryEntryRequest) GetServings() float64 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *LogDiaryEntryRequest) GetQuickCalories() float64 {
+	if x != nil && x.QuickCalories != nil {
+		return *x.QuickCalories
+	}
+	return 0
+}
+
+func (x *LogDiaryEntryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type LogDiaryEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *DiaryEntry            `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogDiaryEntryResponse) Reset() {
+	*x = LogDiaryEntryResponse{}
+	mi := &file_proto_diary_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogDiaryEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogDiaryEntryResponse) ProtoMessage() {}
+
+func (x *LogDiaryEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogDiaryEntryResponse.ProtoReflect.Descriptor instead.
+func (*LogDiaryEntryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LogDiaryEntryResponse) GetEntry() *DiaryEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *LogDiaryEntryResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type UpdateDiaryEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MealId        int32                  `protobuf:"varint,3,opt,name=meal_id,json=mealId,proto3" json:"meal_id,omitempty"`
+	FoodId        int32                  `protobuf:"varint,4,opt,name=food_id,json=foodId,proto3" json:"food_id,omitempty"`
+	Date          string                 `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	MealNumber    int32                  `protobuf:"varint,6,opt,name=meal_number,json=mealNumber,proto3" json:"meal_number,omitempty"`
+	Servings      float64                `protobuf:"fixed64,7,opt,name=servings,proto3" json:"servings,omitempty"`
+	QuickCalories *float64               `protobuf:"fixed64,8,opt,name=quick_calories,json=quickCalories,proto3,oneof" json:"quick_calories,omitempty"` // set to switch the entry to quick-add
+	Description   *string                `protobuf:"bytes,9,opt,name=description,proto3,oneof" json:"description,omitempty"`                            // set to change it; empty clears it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDiaryEntryRequest) Reset() {
+	*x = UpdateDiaryEntryRequest{}
+	mi := &file_proto_diary_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDiaryEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDiaryEntryRequest) ProtoMessage() {}
+
+func (x *UpdateDiaryEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDiaryEntryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDiaryEntryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateDiaryEntryRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateDiaryEntryRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateDiaryEntryRequest) GetMealId() int32 {
+	if x != nil {
+		return x.MealId
+	}
+	return 0
+}
+
+func (x *UpdateDiaryEntryRequest) GetFoodId() int32 {
+	if x != nil {
+		return x.FoodId
+	}
+	return 0
+}
+
+func (x *UpdateDiaryEntryRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *UpdateDiaryEntryRequest) GetMealNumber() int32 {
+	if x != nil {
+		return x.MealNumber
+	}
+	return 0
+}
+
+func (x *UpdateDiaryEntryRequest) GetServings() float64 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *UpdateDiaryEntryRequest) GetQuickCalories() float64 {
+	if x != nil && x.QuickCalories != nil {
+		return *x.QuickCalories
+	}
+	return 0
+}
+
+func (x *UpdateDiaryEntryRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type UpdateDiaryEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *DiaryEntry            `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDiaryEntryResponse) Reset() {
+	*x = UpdateDiaryEntryResponse{}
+	mi := &file_proto_diary_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDiaryEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDiaryEntryResponse) ProtoMessage() {}
+
+func (x *UpdateDiaryEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDiaryEntryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDiaryEntryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateDiaryEntryResponse) GetEntry() *DiaryEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *UpdateDiaryEntryResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DeleteDiaryEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDiaryEntryRequest) Reset() {
+	*x = DeleteDiaryEntryRequest{}
+	mi := &file_proto_diary_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDiaryEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDiaryEntryRequest) ProtoMessage() {}
+
+func (x *DeleteDiaryEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDiaryEntryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDiaryEntryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteDiaryEntryRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteDiaryEntryRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type DeleteDiaryEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDiaryEntryResponse) Reset() {
+	*x = DeleteDiaryEntryResponse{}
+	mi := &file_proto_diary_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDiaryEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDiaryEntryResponse) ProtoMessage() {}
+
+func (x *DeleteDiaryEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDiaryEntryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDiaryEntryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteDiaryEntryResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeleteDiaryEntryResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListDiaryEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // optional, defaults to today in the user's timezone
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDiaryEntriesRequest) Reset() {
+	*x = ListDiaryEntriesRequest{}
+	mi := &file_proto_diary_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDiaryEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiaryEntriesRequest) ProtoMessage() {}
+
+func (x *ListDiaryEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiaryEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListDiaryEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListDiaryEntriesRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListDiaryEntriesRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type ListDiaryEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Entries       []*DiaryEntry          `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDiaryEntriesResponse) Reset() {
+	*x = ListDiaryEntriesResponse{}
+	mi := &file_proto_diary_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDiaryEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiaryEntriesResponse) ProtoMessage() {}
+
+func (x *ListDiaryEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiaryEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListDiaryEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListDiaryEntriesResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ListDiaryEntriesResponse) GetEntries() []*DiaryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListDiaryEntriesResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ImportDiaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Csv           []byte                 `protobuf:"bytes,2,opt,name=csv,proto3" json:"csv,omitempty"` // a per-food diary export
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportDiaryRequest) Reset() {
+	*x = ImportDiaryRequest{}
+	mi := &file_proto_diary_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportDiaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDiaryRequest) ProtoMessage() {}
+
+func (x *ImportDiaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDiaryRequest.ProtoReflect.Descriptor instead.
+func (*ImportDiaryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ImportDiaryRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ImportDiaryRequest) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+// Outcome of one line of an imported file. Status is MATCHED (logged as a
+// catalog food), CREATED (logged as a custom food created by the import) or
+// SKIPPED (not logged, see reason).
+type DiaryImportRow struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Line            int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Status          string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	FoodName        string                 `protobuf:"bytes,3,opt,name=food_name,json=foodName,proto3" json:"food_name,omitempty"`
+	Date            string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	MealNumber      int32                  `protobuf:"varint,5,opt,name=meal_number,json=mealNumber,proto3" json:"meal_number,omitempty"`
+	FoodId          int32                  `protobuf:"varint,6,opt,name=food_id,json=foodId,proto3" json:"food_id,omitempty"`
+	MatchedFoodName string                 `protobuf:"bytes,7,opt,name=matched_food_name,json=matchedFoodName,proto3" json:"matched_food_name,omitempty"`
+	Servings        float64                `protobuf:"fixed64,8,opt,name=servings,proto3" json:"servings,omitempty"`
+	Reason          string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DiaryImportRow) Reset() {
+	*x = DiaryImportRow{}
+	mi := &file_proto_diary_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiaryImportRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiaryImportRow) ProtoMessage() {}
+
+func (x *DiaryImportRow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiaryImportRow.ProtoReflect.Descriptor instead.
+func (*DiaryImportRow) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DiaryImportRow) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *DiaryImportRow) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DiaryImportRow) GetFoodName() string {
+	if x != nil {
+		return x.FoodName
+	}
+	return ""
+}
+
+func (x *DiaryImportRow) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DiaryImportRow) GetMealNumber() int32 {
+	if x != nil {
+		return x.MealNumber
+	}
+	return 0
+}
+
+func (x *DiaryImportRow) GetFoodId() int32 {
+	if x != nil {
+		return x.FoodId
+	}
+	return 0
+}
+
+func (x *DiaryImportRow) GetMatchedFoodName() string {
+	if x != nil {
+		return x.MatchedFoodName
+	}
+	return ""
+}
+
+func (x *DiaryImportRow) GetServings() float64 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *DiaryImportRow) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ImportDiaryResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Matched            int32                  `protobuf:"varint,1,opt,name=matched,proto3" json:"matched,omitempty"`
+	Created            int32                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Skipped            int32                  `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	CustomFoodsCreated int32                  `protobuf:"varint,4,opt,name=custom_foods_created,json=customFoodsCreated,proto3" json:"custom_foods_created,omitempty"`
+	Rows               []*DiaryImportRow      `protobuf:"bytes,5,rep,name=rows,proto3" json:"rows,omitempty"`
+	Error              string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ImportDiaryResponse) Reset() {
+	*x = ImportDiaryResponse{}
+	mi := &file_proto_diary_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportDiaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDiaryResponse) ProtoMessage() {}
+
+func (x *ImportDiaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDiaryResponse.ProtoReflect.Descriptor instead.
+func (*ImportDiaryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ImportDiaryResponse) GetMatched() int32 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+func (x *ImportDiaryResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportDiaryResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ImportDiaryResponse) GetCustomFoodsCreated() int32 {
+	if x != nil {
+		return x.CustomFoodsCreated
+	}
+	return 0
+}
+
+func (x *ImportDiaryResponse) GetRows() []*DiaryImportRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ImportDiaryResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DraftDiaryEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`                                // e.g. "2 eggs and a cup of brown rice"
+	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`                                // optional, defaults to today in the user's timezone
+	MealNumber    int32                  `protobuf:"varint,4,opt,name=meal_number,json=mealNumber,proto3" json:"meal_number,omitempty"` // optional
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DraftDiaryEntryRequest) Reset() {
+	*x = DraftDiaryEntryRequest{}
+	mi := &file_proto_diary_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DraftDiaryEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftDiaryEntryRequest) ProtoMessage() {}
+
+func (x *DraftDiaryEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftDiaryEntryRequest.ProtoReflect.Descriptor instead.
+func (*DraftDiaryEntryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DraftDiaryEntryRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DraftDiaryEntryRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *DraftDiaryEntryRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DraftDiaryEntryRequest) GetMealNumber() int32 {
+	if x != nil {
+		return x.MealNumber
+	}
+	return 0
+}
+
+// A catalog food an item of the text can be logged as. Confidence runs from
+// 0 to 1; note says what was assumed to size the item.
+type DraftFoodMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FoodId        int32                  `protobuf:"varint,1,opt,name=food_id,json=foodId,proto3" json:"food_id,omitempty"`
+	FoodName      string                 `protobuf:"bytes,2,opt,name=food_name,json=foodName,proto3" json:"food_name,omitempty"`
+	ServingUnits  string                 `protobuf:"bytes,3,opt,name=serving_units,json=servingUnits,proto3" json:"serving_units,omitempty"`
+	Servings      float64                `protobuf:"fixed64,4,opt,name=servings,proto3" json:"servings,omitempty"`
+	Calories      float64                `protobuf:"fixed64,5,opt,name=calories,proto3" json:"calories,omitempty"`
+	Confidence    float64                `protobuf:"fixed64,6,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Note          string                 `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DraftFoodMatch) Reset() {
+	*x = DraftFoodMatch{}
+	mi := &file_proto_diary_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DraftFoodMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftFoodMatch) ProtoMessage() {}
+
+func (x *DraftFoodMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftFoodMatch.ProtoReflect.Descriptor instead.
+func (*DraftFoodMatch) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DraftFoodMatch) GetFoodId() int32 {
+	if x != nil {
+		return x.FoodId
+	}
+	return 0
+}
+
+func (x *DraftFoodMatch) GetFoodName() string {
+	if x != nil {
+		return x.FoodName
+	}
+	return ""
+}
+
+func (x *DraftFoodMatch) GetServingUnits() string {
+	if x != nil {
+		return x.ServingUnits
+	}
+	return ""
+}
+
+func (x *DraftFoodMatch) GetServings() float64 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *DraftFoodMatch) GetCalories() float64 {
+	if x != nil {
+		return x.Calories
+	}
+	return 0
+}
+
+func (x *DraftFoodMatch) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *DraftFoodMatch) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// One food of the text. match is the best catalog food, unset when nothing
+// matched, and alternatives are the next best.
+type DraftDiaryItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Unit          string                 `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"` // serving unit, empty when none was given
+	FoodPhrase    string                 `protobuf:"bytes,4,opt,name=food_phrase,json=foodPhrase,proto3" json:"food_phrase,omitempty"`
+	Match         *DraftFoodMatch        `protobuf:"bytes,5,opt,name=match,proto3" json:"match,omitempty"`
+	Alternatives  []*DraftFoodMatch      `protobuf:"bytes,6,rep,name=alternatives,proto3" json:"alternatives,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DraftDiaryItem) Reset() {
+	*x = DraftDiaryItem{}
+	mi := &file_proto_diary_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DraftDiaryItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftDiaryItem) ProtoMessage() {}
+
+func (x *DraftDiaryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftDiaryItem.ProtoReflect.Descriptor instead.
+func (*DraftDiaryItem) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DraftDiaryItem) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *DraftDiaryItem) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *DraftDiaryItem) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *DraftDiaryItem) GetFoodPhrase() string {
+	if x != nil {
+		return x.FoodPhrase
+	}
+	return ""
+}
+
+func (x *DraftDiaryItem) GetMatch() *DraftFoodMatch {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+func (x *DraftDiaryItem) GetAlternatives() []*DraftFoodMatch {
+	if x != nil {
+		return x.Alternatives
+	}
+	return nil
+}
+
+type DraftDiaryEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	MealNumber    int32                  `protobuf:"varint,2,opt,name=meal_number,json=mealNumber,proto3" json:"meal_number,omitempty"`
+	Items         []*DraftDiaryItem      `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Unresolved    int32                  `protobuf:"varint,4,opt,name=unresolved,proto3" json:"unresolved,omitempty"` // items without a match
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DraftDiaryEntryResponse) Reset() {
+	*x = DraftDiaryEntryResponse{}
+	mi := &file_proto_diary_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DraftDiaryEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftDiaryEntryResponse) ProtoMessage() {}
+
+func (x *DraftDiaryEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftDiaryEntryResponse.ProtoReflect.Descriptor instead.
+func (*DraftDiaryEntryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DraftDiaryEntryResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DraftDiaryEntryResponse) GetMealNumber() int32 {
+	if x != nil {
+		return x.MealNumber
+	}
+	return 0
+}
+
+func (x *DraftDiaryEntryResponse) GetItems() []*DraftDiaryItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *DraftDiaryEntryResponse) GetUnresolved() int32 {
+	if x != nil {
+		return x.Unresolved
+	}
+	return 0
+}
+
+func (x *DraftDiaryEntryResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// A favorite food or meal. Exactly one of food_id or meal_id is set;
+// calories are scaled by servings.
+type Favorite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FoodId        int32                  `protobuf:"varint,2,opt,name=food_id,json=foodId,proto3" json:"food_id,omitempty"`
+	MealId        int32                  `protobuf:"varint,3,opt,name=meal_id,json=mealId,proto3" json:"meal_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Servings      float64                `protobuf:"fixed64,5,opt,name=servings,proto3" json:"servings,omitempty"`
+	Calories      float64                `protobuf:"fixed64,6,opt,name=calories,proto3" json:"calories,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Favorite) Reset() {
+	*x = Favorite{}
+	mi := &file_proto_diary_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Favorite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Favorite) ProtoMessage() {}
+
+func (x *Favorite) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Favorite.ProtoReflect.Descriptor instead.
+func (*Favorite) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Favorite) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Favorite) GetFoodId() int32 {
+	if x != nil {
+		return x.FoodId
+	}
+	return 0
+}
+
+func (x *Favorite) GetMealId() int32 {
+	if x != nil {
+		return x.MealId
+	}
+	return 0
+}
+
+func (x *Favorite) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Favorite) GetServings() float64 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *Favorite) GetCalories() float64 {
+	if x != nil {
+		return x.Calories
+	}
+	return 0
+}
+
+func (x *Favorite) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListFavoritesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFavoritesRequest) Reset() {
+	*x = ListFavoritesRequest{}
+	mi := &file_proto_diary_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFavoritesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavoritesRequest) ProtoMessage() {}
+
+func (x *ListFavoritesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavoritesRequest.ProtoReflect.Descriptor instead.
+func (*ListFavoritesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListFavoritesRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListFavoritesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Favorites     []*Favorite            `protobuf:"bytes,1,rep,name=favorites,proto3" json:"favorites,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFavoritesResponse) Reset() {
+	*x = ListFavoritesResponse{}
+	mi := &file_proto_diary_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFavoritesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavoritesResponse) ProtoMessage() {}
+
+func (x *ListFavoritesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavoritesResponse.ProtoReflect.Descriptor instead.
+func (*ListFavoritesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListFavoritesResponse) GetFavorites() []*Favorite {
+	if x != nil {
+		return x.Favorites
+	}
+	return nil
+}
+
+func (x *ListFavoritesResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type AddFavoriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FoodId        int32                  `protobuf:"varint,2,opt,name=food_id,json=foodId,proto3" json:"food_id,omitempty"`
+	MealId        int32                  `protobuf:"varint,3,opt,name=meal_id,json=mealId,proto3" json:"meal_id,omitempty"`
+	Servings      float64                `protobuf:"fixed64,4,opt,name=servings,proto3" json:"servings,omitempty"` // optional, defaults to 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddFavoriteRequest) Reset() {
+	*x = AddFavoriteRequest{}
+	mi := &file_proto_diary_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavoriteRequest) ProtoMessage() {}
+
+func (x *AddFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavoriteRequest.ProtoReflect.Descriptor instead.
+func (*AddFavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AddFavoriteRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddFavoriteRequest) GetFoodId() int32 {
+	if x != nil {
+		return x.FoodId
+	}
+	return 0
+}
+
+func (x *AddFavoriteRequest) GetMealId() int32 {
+	if x != nil {
+		return x.MealId
+	}
+	return 0
+}
+
+func (x *AddFavoriteRequest) GetServings() float64 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+type AddFavoriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Favorite      *Favorite              `protobuf:"bytes,1,opt,name=favorite,proto3" json:"favorite,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddFavoriteResponse) Reset() {
+	*x = AddFavoriteResponse{}
+	mi := &file_proto_diary_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFavoriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavoriteResponse) ProtoMessage() {}
+
+func (x *AddFavoriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavoriteResponse.ProtoReflect.Descriptor instead.
+func (*AddFavoriteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AddFavoriteResponse) GetFavorite() *Favorite {
+	if x != nil {
+		return x.Favorite
+	}
+	return nil
+}
+
+func (x *AddFavoriteResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RemoveFavoriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFavoriteRequest) Reset() {
+	*x = RemoveFavoriteRequest{}
+	mi := &file_proto_diary_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavoriteRequest) ProtoMessage() {}
+
+func (x *RemoveFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavoriteRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RemoveFavoriteRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RemoveFavoriteRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RemoveFavoriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFavoriteResponse) Reset() {
+	*x = RemoveFavoriteResponse{}
+	mi := &file_proto_diary_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFavoriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavoriteResponse) ProtoMessage() {}
+
+func (x *RemoveFavoriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavoriteResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFavoriteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RemoveFavoriteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RemoveFavoriteResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// A food or meal from the user's diary history. Exactly one of food_id or
+// meal_id is set; servings are those it was last logged with.
+type RecentItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FoodId        int32                  `protobuf:"varint,1,opt,name=food_id,json=foodId,proto3" json:"food_id,omitempty"`
+	MealId        int32                  `protobuf:"varint,2,opt,name=meal_id,json=mealId,proto3" json:"meal_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Servings      float64                `protobuf:"fixed64,4,opt,name=servings,proto3" json:"servings,omitempty"`
+	Calories      float64                `protobuf:"fixed64,5,opt,name=calories,proto3" json:"calories,omitempty"`
+	LastLogged    string                 `protobuf:"bytes,6,opt,name=last_logged,json=lastLogged,proto3" json:"last_logged,omitempty"` // YYYY-MM-DD
+	TimesLogged   int32                  `protobuf:"varint,7,opt,name=times_logged,json=timesLogged,proto3" json:"times_logged,omitempty"`
+	Favorite      bool                   `protobuf:"varint,8,opt,name=favorite,proto3" json:"favorite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecentItem) Reset() {
+	*x = RecentItem{}
+	mi := &file_proto_diary_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecentItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecentItem) ProtoMessage() {}
+
+func (x *RecentItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecentItem.ProtoReflect.Descriptor instead.
+func (*RecentItem) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RecentItem) GetFoodId() int32 {
+	if x != nil {
+		return x.FoodId
+	}
+	return 0
+}
+
+func (x *RecentItem) GetMealId() int32 {
+	if x != nil {
+		return x.MealId
+	}
+	return 0
+}
+
+func (x *RecentItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecentItem) GetServings() float64 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *RecentItem) GetCalories() float64 {
+	if x != nil {
+		return x.Calories
+	}
+	return 0
+}
+
+func (x *RecentItem) GetLastLogged() string {
+	if x != nil {
+		return x.LastLogged
+	}
+	return ""
+}
+
+func (x *RecentItem) GetTimesLogged() int32 {
+	if x != nil {
+		return x.TimesLogged
+	}
+	return 0
+}
+
+func (x *RecentItem) GetFavorite() bool {
+	if x != nil {
+		return x.Favorite
+	}
+	return false
+}
+
+type ListRecentItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // optional, defaults to 20
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecentItemsRequest) Reset() {
+	*x = ListRecentItemsRequest{}
+	mi := &file_proto_diary_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecentItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecentItemsRequest) ProtoMessage() {}
+
+func (x *ListRecentItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecentItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListRecentItemsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListRecentItemsRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListRecentItemsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListRecentItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*RecentItem          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecentItemsResponse) Reset() {
+	*x = ListRecentItemsResponse{}
+	mi := &file_proto_diary_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecentItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecentItemsResponse) ProtoMessage() {}
+
+func (x *ListRecentItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecentItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListRecentItemsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListRecentItemsResponse) GetItems() []*RecentItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListRecentItemsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CopyDiaryEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FromDate      string                 `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`
+	ToDate        string                 `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`                      // optional, defaults to today in the user's timezone
+	MealNumber    int32                  `protobuf:"varint,4,opt,name=meal_number,json=mealNumber,proto3" json:"meal_number,omitempty"`         // optional, copies one meal slot instead of the whole day
+	ToMealNumber  int32                  `protobuf:"varint,5,opt,name=to_meal_number,json=toMealNumber,proto3" json:"to_meal_number,omitempty"` // optional, the slot the meal is copied into
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyDiaryEntriesRequest) Reset() {
+	*x = CopyDiaryEntriesRequest{}
+	mi := &file_proto_diary_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyDiaryEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyDiaryEntriesRequest) ProtoMessage() {}
+
+func (x *CopyDiaryEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyDiaryEntriesRequest.ProtoReflect.Descriptor instead.
+func (*CopyDiaryEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CopyDiaryEntriesRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CopyDiaryEntriesRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *CopyDiaryEntriesRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *CopyDiaryEntriesRequest) GetMealNumber() int32 {
+	if x != nil {
+		return x.MealNumber
+	}
+	return 0
+}
+
+func (x *CopyDiaryEntriesRequest) GetToMealNumber() int32 {
+	if x != nil {
+		return x.ToMealNumber
+	}
+	return 0
+}
+
+type CopyDiaryEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Copied        int32                  `protobuf:"varint,2,opt,name=copied,proto3" json:"copied,omitempty"`
+	Entries       []*DiaryEntry          `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"` // the diary of the target date
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyDiaryEntriesResponse) Reset() {
+	*x = CopyDiaryEntriesResponse{}
+	mi := &file_proto_diary_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyDiaryEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyDiaryEntriesResponse) ProtoMessage() {}
+
+func (x *CopyDiaryEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_diary_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyDiaryEntriesResponse.ProtoReflect.Descriptor instead.
+func (*CopyDiaryEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_diary_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CopyDiaryEntriesResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CopyDiaryEntriesResponse) GetCopied() int32 {
+	if x != nil {
+		return x.Copied
+	}
+	return 0
+}
+
+func (x *CopyDiaryEntriesResponse) GetEntries() []*DiaryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *CopyDiaryEntriesResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_proto_diary_proto protoreflect.FileDescriptor
+
+const file_proto_diary_proto_rawDesc = "" +
+	"\n" +
+	"\x11proto/diary.proto\x12\x04user\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8a\x04\n" +
+	"\n" +
+	"DiaryEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x17\n" +
+	"\ameal_id\x18\x03 \x01(\x05R\x06mealId\x12\x17\n" +
+	"\afood_id\x18\x04 \x01(\x05R\x06foodId\x12\x12\n" +
+	"\x04date\x18\x05 \x01(\tR\x04date\x12\x1f\n" +
+	"\vmeal_number\x18\x06 \x01(\x05R\n" +
+	"mealNumber\x12\x1a\n" +
+	"\bservings\x18\a \x01(\x01R\bservings\x12%\n" +
+	"\x0equick_calories\x18\b \x01(\x01R\rquickCalories\x12 \n" +
+	"\vdescription\x18\t \x01(\tR\vdescription\x12\x12\n" +
+	"\x04name\x18\n" +
+	" \x01(\tR\x04name\x12\x1a\n" +
+	"\bcalories\x18\v \x01(\x01R\bcalories\x12#\n" +
+	"\rprotein_grams\x18\f \x01(\x01R\fproteinGrams\x12\x1f\n" +
+	"\vcarbs_grams\x18\r \x01(\x01R\n" +
+	"carbsGrams\x12\x1b\n" +
+	"\tfat_grams\x18\x0e \x01(\x01R\bfatGrams\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x93\x02\n" +
+	"\x14LogDiaryEntryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x17\n" +
+	"\ameal_id\x18\x02 \x01(\x05R\x06mealId\x12\x17\n" +
+	"\afood_id\x18\x03 \x01(\x05R\x06foodId\x12\x12\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\x12\x1f\n" +
+	"\vmeal_number\x18\x05 \x01(\x05R\n" +
+	"mealNumber\x12\x1a\n" +
+	"\bservings\x18\x06 \x01(\x01R\bservings\x12*\n" +
+	"\x0equick_calories\x18\a \x01(\x01H\x00R\rquickCalories\x88\x01\x01\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescriptionB\x11\n" +
+	"\x0f_quick_calories\"U\n" +
+	"\x15LogDiaryEntryResponse\x12&\n" +
+	"\x05entry\x18\x01 \x01(\v2\x10.user.DiaryEntryR\x05entry\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xbb\x02\n" +
+	"\x17UpdateDiaryEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x17\n" +
+	"\ameal_id\x18\x03 \x01(\x05R\x06mealId\x12\x17\n" +
+	"\afood_id\x18\x04 \x01(\x05R\x06foodId\x12\x12\n" +
+	"\x04date\x18\x05 \x01(\tR\x04date\x12\x1f\n" +
+	"\vmeal_number\x18\x06 \x01(\x05R\n" +
+	"mealNumber\x12\x1a\n" +
+	"\bservings\x18\a \x01(\x01R\bservings\x12*\n" +
+	"\x0equick_calories\x18\b \x01(\x01H\x00R\rquickCalories\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\t \x01(\tH\x01R\vdescription\x88\x01\x01B\x11\n" +
+	"\x0f_quick_caloriesB\x0e\n" +
+	"\f_description\"X\n" +
+	"\x18UpdateDiaryEntryResponse\x12&\n" +
+	"\x05entry\x18\x01 \x01(\v2\x10.user.DiaryEntryR\x05entry\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"B\n" +
+	"\x17DeleteDiaryEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"J\n" +
+	"\x18DeleteDiaryEntryResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"F\n" +
+	"\x17ListDiaryEntriesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\"p\n" +
+	"\x18ListDiaryEntriesResponse\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12*\n" +
+	"\aentries\x18\x02 \x03(\v2\x10.user.DiaryEntryR\aentries\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"?\n" +
+	"\x12ImportDiaryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x10\n" +
+	"\x03csv\x18\x02 \x01(\fR\x03csv\"\x87\x02\n" +
+	"\x0eDiaryImportRow\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
+	"\tfood_name\x18\x03 \x01(\tR\bfoodName\x12\x12\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\x12\x1f\n" +
+	"\vmeal_number\x18\x05 \x01(\x05R\n" +
+	"mealNumber\x12\x17\n" +
+	"\afood_id\x18\x06 \x01(\x05R\x06foodId\x12*\n" +
+	"\x11matched_food_name\x18\a \x01(\tR\x0fmatchedFoodName\x12\x1a\n" +
+	"\bservings\x18\b \x01(\x01R\bservings\x12\x16\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason\"\xd5\x01\n" +
+	"\x13ImportDiaryResponse\x12\x18\n" +
+	"\amatched\x18\x01 \x01(\x05R\amatched\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12\x18\n" +
+	"\askipped\x18\x03 \x01(\x05R\askipped\x120\n" +
+	"\x14custom_foods_created\x18\x04 \x01(\x05R\x12customFoodsCreated\x12(\n" +
+	"\x04rows\x18\x05 \x03(\v2\x14.user.DiaryImportRowR\x04rows\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"z\n" +
+	"\x16DraftDiaryEntryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\x12\x1f\n" +
+	"\vmeal_number\x18\x04 \x01(\x05R\n" +
+	"mealNumber\"\xd7\x01\n" +
+	"\x0eDraftFoodMatch\x12\x17\n" +
+	"\afood_id\x18\x01 \x01(\x05R\x06foodId\x12\x1b\n" +
+	"\tfood_name\x18\x02 \x01(\tR\bfoodName\x12#\n" +
+	"\rserving_units\x18\x03 \x01(\tR\fservingUnits\x12\x1a\n" +
+	"\bservings\x18\x04 \x01(\x01R\bservings\x12\x1a\n" +
+	"\bcalories\x18\x05 \x01(\x01R\bcalories\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x06 \x01(\x01R\n" +
+	"confidence\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04note\"\xdb\x01\n" +
+	"\x0eDraftDiaryItem\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x01R\bquantity\x12\x12\n" +
+	"\x04unit\x18\x03 \x01(\tR\x04unit\x12\x1f\n" +
+	"\vfood_phrase\x18\x04 \x01(\tR\n" +
+	"foodPhrase\x12*\n" +
+	"\x05match\x18\x05 \x01(\v2\x14.user.DraftFoodMatchR\x05match\x128\n" +
+	"\falternatives\x18\x06 \x03(\v2\x14.user.DraftFoodMatchR\falternatives\"\xb0\x01\n" +
+	"\x17DraftDiaryEntryResponse\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1f\n" +
+	"\vmeal_number\x18\x02 \x01(\x05R\n" +
+	"mealNumber\x12*\n" +
+	"\x05items\x18\x03 \x03(\v2\x14.user.DraftDiaryItemR\x05items\x12\x1e\n" +
+	"\n" +
+	"unresolved\x18\x04 \x01(\x05R\n" +
+	"unresolved\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\xd3\x01\n" +
+	"\bFavorite\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\afood_id\x18\x02 \x01(\x05R\x06foodId\x12\x17\n" +
+	"\ameal_id\x18\x03 \x01(\x05R\x06mealId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1a\n" +
+	"\bservings\x18\x05 \x01(\x01R\bservings\x12\x1a\n" +
+	"\bcalories\x18\x06 \x01(\x01R\bcalories\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"/\n" +
+	"\x14ListFavoritesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"[\n" +
+	"\x15ListFavoritesResponse\x12,\n" +
+	"\tfavorites\x18\x01 \x03(\v2\x0e.user.FavoriteR\tfavorites\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"{\n" +
+	"\x12AddFavoriteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x17\n" +
+	"\afood_id\x18\x02 \x01(\x05R\x06foodId\x12\x17\n" +
+	"\ameal_id\x18\x03 \x01(\x05R\x06mealId\x12\x1a\n" +
+	"\bservings\x18\x04 \x01(\x01R\bservings\"W\n" +
+	"\x13AddFavoriteResponse\x12*\n" +
+	"\bfavorite\x18\x01 \x01(\v2\x0e.user.FavoriteR\bfavorite\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"@\n" +
+	"\x15RemoveFavoriteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x05R\x06userId\"H\n" +
+	"\x16RemoveFavoriteResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xea\x01\n" +
+	"\n" +
+	"RecentItem\x12\x17\n" +
+	"\afood_id\x18\x01 \x01(\x05R\x06foodId\x12\x17\n" +
+	"\ameal_id\x18\x02 \x01(\x05R\x06mealId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bservings\x18\x04 \x01(\x01R\bservings\x12\x1a\n" +
+	"\bcalories\x18\x05 \x01(\x01R\bcalories\x12\x1f\n" +
+	"\vlast_logged\x18\x06 \x01(\tR\n" +
+	"lastLogged\x12!\n" +
+	"\ftimes_logged\x18\a \x01(\x05R\vtimesLogged\x12\x1a\n" +
+	"\bfavorite\x18\b \x01(\bR\bfavorite\"G\n" +
+	"\x16ListRecentItemsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"W\n" +
+	"\x17ListRecentItemsResponse\x12&\n" +
+	"\x05items\x18\x01 \x03(\v2\x10.user.RecentItemR\x05items\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xaf\x01\n" +
+	"\x17CopyDiaryEntriesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1b\n" +
+	"\tfrom_date\x18\x02 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x03 \x01(\tR\x06toDate\x12\x1f\n" +
+	"\vmeal_number\x18\x04 \x01(\x05R\n" +
+	"mealNumber\x12$\n" +
+	"\x0eto_meal_number\x18\x05 \x01(\x05R\ftoMealNumber\"\x88\x01\n" +
+	"\x18CopyDiaryEntriesResponse\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x16\n" +
+	"\x06copied\x18\x02 \x01(\x05R\x06copied\x12*\n" +
+	"\aentries\x18\x03 \x03(\v2\x10.user.DiaryEntryR\aentries\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error2\xe3\x06\n" +
+	"\fDiaryService\x12H\n" +
+	"\rLogDiaryEntry\x12\x1a.user.LogDiaryEntryRequest\x1a\x1b.user.LogDiaryEntryResponse\x12Q\n" +
+	"\x10UpdateDiaryEntry\x12\x1d.user.UpdateDiaryEntryRequest\x1a\x1e.user.UpdateDiaryEntryResponse\x12Q\n" +
+	"\x10DeleteDiaryEntry\x12\x1d.user.DeleteDiaryEntryRequest\x1a\x1e.user.DeleteDiaryEntryResponse\x12Q\n" +
+	"\x10ListDiaryEntries\x12\x1d.user.ListDiaryEntriesRequest\x1a\x1e.user.ListDiaryEntriesResponse\x12B\n" +
+	"\vImportDiary\x12\x18.user.ImportDiaryRequest\x1a\x19.user.ImportDiaryResponse\x12N\n" +
+	"\x0fDraftDiaryEntry\x12\x1c.user.DraftDiaryEntryRequest\x1a\x1d.user.DraftDiaryEntryResponse\x12H\n" +
+	"\rListFavorites\x12\x1a.user.ListFavoritesRequest\x1a\x1b.user.ListFavoritesResponse\x12B\n" +
+	"\vAddFavorite\x12\x18.user.AddFavoriteRequest\x1a\x19.user.AddFavoriteResponse\x12K\n" +
+	"\x0eRemoveFavorite\x12\x1b.user.RemoveFavoriteRequest\x1a\x1c.user.RemoveFavoriteResponse\x12N\n" +
+	"\x0fListRecentItems\x12\x1c.user.ListRecentItemsRequest\x1a\x1d.user.ListRecentItemsResponse\x12Q\n" +
+	"\x10CopyDiaryEntries\x12\x1d.user.CopyDiaryEntriesRequest\x1a\x1e.user.CopyDiaryEntriesResponseB\tZ\a./protob\x06proto3"
+
+var (
+	file_proto_diary_proto_rawDescOnce sync.Once
+	file_proto_diary_proto_rawDescData []byte
+)
+
+func file_proto_diary_proto_rawDescGZIP() []byte {
+	file_proto_diary_proto_rawDescOnce.Do(func() {
+		file_proto_diary_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_diary_proto_rawDesc), len(file_proto_diary_proto_rawDesc)))
+	})
+	return file_proto_diary_proto_rawDescData
+}
+
+var file_proto_diary_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_proto_diary_proto_goTypes = []any{
+	(*DiaryEntry)(nil),               // 0: user.DiaryEntry
+	(*LogDiaryEntryRequest)(nil),     // 1: user.LogDiaryEntryRequest
+	(*LogDiaryEntryResponse)(nil),    // 2: user.LogDiaryEntryResponse
+	(*UpdateDiaryEntryRequest)(nil),  // 3: user.UpdateDiaryEntryRequest
+	(*UpdateDiaryEntryResponse)(nil), // 4: user.UpdateDiaryEntryResponse
+	(*DeleteDiaryEntryRequest)(nil),  // 5: user.DeleteDiaryEntryRequest
+	(*DeleteDiaryEntryResponse)(nil), // 6: user.DeleteDiaryEntryResponse
+	(*ListDiaryEntriesRequest)(nil),  // 7: user.ListDiaryEntriesRequest
+	(*ListDiaryEntriesResponse)(nil), // 8: user.ListDiaryEntriesResponse
+	(*ImportDiaryRequest)(nil),       // 9: user.ImportDiaryRequest
+	(*DiaryImportRow)(nil),           // 10: user.DiaryImportRow
+	(*ImportDiaryResponse)(nil),      // 11: user.ImportDiaryResponse
+	(*DraftDiaryEntryRequest)(nil),   // 12: user.DraftDiaryEntryRequest
+	(*DraftFoodMatch)(nil),           // 13: user.DraftFoodMatch
+	(*DraftDiaryItem)(nil),           // 14: user.DraftDiaryItem
+	(*DraftDiaryEntryResponse)(nil),  // 15: user.DraftDiaryEntryResponse
+	(*Favorite)(nil),                 // 16: user.Favorite
+	(*ListFavoritesRequest)(nil),     // 17: user.ListFavoritesRequest
+	(*ListFavoritesResponse)(nil),    // 18: user.ListFavoritesResponse
+	(*AddFavoriteRequest)(nil),       // 19: user.AddFavoriteRequest
+	(*AddFavoriteResponse)(nil),      // 20: user.AddFavoriteResponse
+	(*RemoveFavoriteRequest)(nil),    // 21: user.RemoveFavoriteRequest
+	(*RemoveFavoriteResponse)(nil),   // 22: user.RemoveFavoriteResponse
+	(*RecentItem)(nil),               // 23: user.RecentItem
+	(*ListRecentItemsRequest)(nil),   // 24: user.ListRecentItemsRequest
+	(*ListRecentItemsResponse)(nil),  // 25: user.ListRecentItemsResponse
+	(*CopyDiaryEntriesRequest)(nil),  // 26: user.CopyDiaryEntriesRequest
+	(*CopyDiaryEntriesResponse)(nil), // 27: user.CopyDiaryEntriesResponse
+	(*timestamppb.Timestamp)(nil),    // 28: google.protobuf.Timestamp
+}
+var file_proto_diary_proto_depIdxs = []int32{
+	28, // 0: user.DiaryEntry.created_at:type_name -> google.protobuf.Timestamp
+	28, // 1: user.DiaryEntry.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: user.LogDiaryEntryResponse.entry:type_name -> user.DiaryEntry
+	0,  // 3: user.UpdateDiaryEntryResponse.entry:type_name -> user.DiaryEntry
+	0,  // 4: user.ListDiaryEntriesResponse.entries:type_name -> user.DiaryEntry
+	10, // 5: user.ImportDiaryResponse.rows:type_name -> user.DiaryImportRow
+	13, // 6: user.DraftDiaryItem.match:type_name -> user.DraftFoodMatch
+	13, // 7: user.DraftDiaryItem.alternatives:type_name -> user.DraftFoodMatch
+	14, // 8: user.DraftDiaryEntryResponse.items:type_name -> user.DraftDiaryItem
+	28, // 9: user.Favorite.created_at:type_name -> google.protobuf.Timestamp
+	16, // 10: user.ListFavoritesResponse.favorites:type_name -> user.Favorite
+	16, // 11: user.AddFavoriteResponse.favorite:type_name -> user.Favorite
+	23, // 12: user.ListRecentItemsResponse.items:type_name -> user.RecentItem
+	0,  // 13: user.CopyDiaryEntriesResponse.entries:type_name -> user.DiaryEntry
+	1,  // 14: user.DiaryService.LogDiaryEntry:input_type -> user.LogDiaryEntryRequest
+	3,  // 15: user.DiaryService.UpdateDiaryEntry:input_type -> user.UpdateDiaryEntryRequest
+	5,  // 16: user.DiaryService.DeleteDiaryEntry:input_type -> user.DeleteDiaryEntryRequest
+	7,  // 17: user.DiaryService.ListDiaryEntries:input_type -> user.ListDiaryEntriesRequest
+	9,  // 18: user.DiaryService.ImportDiary:input_type -> user.ImportDiaryRequest
+	12, // 19: user.DiaryService.DraftDiaryEntry:input_type -> user.DraftDiaryEntryRequest
+	17, // 20: user.DiaryService.ListFavorites:input_type -> user.ListFavoritesRequest
+	19, // 21: user.DiaryService.AddFavorite:input_type -> user.AddFavoriteRequest
+	21, // 22: user.DiaryService.RemoveFavorite:input_type -> user.RemoveFavoriteRequest
+	24, // 23: user.DiaryService.ListRecentItems:input_type -> user.ListRecentItemsRequest
+	26, // 24: user.DiaryService.CopyDiaryEntries:input_type -> user.CopyDiaryEntriesRequest
+	2,  // 25: user.DiaryService.LogDiaryEntry:output_type -> user.LogDiaryEntryResponse
+	4,  // 26: user.DiaryService.UpdateDiaryEntry:output_type -> user.UpdateDiaryEntryResponse
+	6,  // 27: user.DiaryService.DeleteDiaryEntry:output_type -> user.DeleteDiaryEntryResponse
+	8,  // 28: user.DiaryService.ListDiaryEntries:output_type -> user.ListDiaryEntriesResponse
+	11, // 29: user.DiaryService.ImportDiary:output_type -> user.ImportDiaryResponse
+	15, // 30: user.DiaryService.DraftDiaryEntry:output_type -> user.DraftDiaryEntryResponse
+	18, // 31: user.DiaryService.ListFavorites:output_type -> user.ListFavoritesResponse
+	20, // 32: user.DiaryService.AddFavorite:output_type -> user.AddFavoriteResponse
+	22, // 33: user.DiaryService.RemoveFavorite:output_type -> user.RemoveFavoriteResponse
+	25, // 34: user.DiaryService.ListRecentItems:output_type -> user.ListRecentItemsResponse
+	27, // 35: user.DiaryService.CopyDiaryEntries:output_type -> user.CopyDiaryEntriesResponse
+	25, // [25:36] is the sub-list for method output_type
+	14, // [14:25] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_proto_diary_proto_init() }
+func file_proto_diary_proto_init() {
+	if File_proto_diary_proto != nil {
+		return
+	}
+	file_proto_diary_proto_msgTypes[1].OneofWrappers = []any{}
+	file_proto_diary_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_diary_proto_rawDesc), len(file_proto_diary_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   28,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_diary_proto_goTypes,
+		DependencyIndexes: file_proto_diary_proto_depIdxs,
+		MessageInfos:      file_proto_diary_proto_msgTypes,
+	}.Build()
+	File_proto_diary_proto = out.File
+	file_proto_diary_proto_goTypes = nil
+	file_proto_diary_proto_depIdxs = nil
+}
@@ -0,0 +1,505 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: proto/diary.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DiaryService_LogDiaryEntry_FullMethodName    = "/user.DiaryService/LogDiaryEntry"
+	DiaryService_UpdateDiaryEntry_FullMethodName = "/user.DiaryService/UpdateDiaryEntry"
+	DiaryService_DeleteDiaryEntry_FullMethodName = "/user.DiaryService/DeleteDiaryEntry"
+	DiaryService_ListDiaryEntries_FullMethodName = "/user.DiaryService/ListDiaryEntries"
+	DiaryService_ImportDiary_FullMethodName      = "/user.DiaryService/ImportDiary"
+	DiaryService_DraftDiaryEntry_FullMethodName  = "/user.DiaryService/DraftDiaryEntry"
+	DiaryService_ListFavorites_FullMethodName    = "/user.DiaryService/ListFavorites"
+	DiaryService_AddFavorite_FullMethodName      = "/user.DiaryService/AddFavorite"
+	DiaryService_RemoveFavorite_FullMethodName   = "/user.DiaryService/RemoveFavorite"
+	DiaryService_ListRecentItems_FullMethodName  = "/user.DiaryService/ListRecentItems"
+	DiaryService_CopyDiaryEntries_FullMethodName = "/user.DiaryService/CopyDiaryEntries"
+)
+
+// DiaryServiceClient is the client API for DiaryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Food diary gRPC definitions
+type DiaryServiceClient interface {
+	LogDiaryEntry(ctx context.Context, in *LogDiaryEntryRequest, opts ...grpc.CallOption) (*LogDiaryEntryResponse, error)
+	UpdateDiaryEntry(ctx context.Context, in *UpdateDiaryEntryRequest, opts ...grpc.CallOption) (*UpdateDiaryEntryResponse, error)
+	DeleteDiaryEntry(ctx context.Context, in *DeleteDiaryEntryRequest, opts ...grpc.CallOption) (*DeleteDiaryEntryResponse, error)
+	ListDiaryEntries(ctx context.Context, in *ListDiaryEntriesRequest, opts ...grpc.CallOption) (*ListDiaryEntriesResponse, error)
+	ImportDiary(ctx context.Context, in *ImportDiaryRequest, opts ...grpc.CallOption) (*ImportDiaryResponse, error)
+	DraftDiaryEntry(ctx context.Context, in *DraftDiaryEntryRequest, opts ...grpc.CallOption) (*DraftDiaryEntryResponse, error)
+	ListFavorites(ctx context.Context, in *ListFavoritesRequest, opts ...grpc.CallOption) (*ListFavoritesResponse, error)
+	AddFavorite(ctx context.Context, in *AddFavoriteRequest, opts ...grpc.CallOption) (*AddFavoriteResponse, error)
+	RemoveFavorite(ctx context.Context, in *RemoveFavoriteRequest, opts ...grpc.CallOption) (*RemoveFavoriteResponse, error)
+	ListRecentItems(ctx context.Context, in *ListRecentItemsRequest, opts ...grpc.CallOption) (*ListRecentItemsResponse, error)
+	CopyDiaryEntries(ctx context.Context, in *CopyDiaryEntriesRequest, opts ...grpc.CallOption) (*CopyDiaryEntriesResponse, error)
+}
+
+type diaryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDiaryServiceClient(cc grpc.ClientConnInterface) DiaryServiceClient {
+	return &diaryServiceClient{cc}
+}
+
+func (c *diaryServiceClient) LogDiaryEntry(ctx context.Context, in *LogDiaryEntryRequest, opts ...grpc.CallOption) (*LogDiaryEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogDiaryEntryResponse)
+	err := c.cc.Invoke(ctx, DiaryService_LogDiaryEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diaryServiceClient) UpdateDiaryEntry(ctx context.Context, in *UpdateDiaryEntryRequest, opts ...grpc.CallOption) (*UpdateDiaryEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDiaryEntryResponse)
+	err := c.cc.Invoke(ctx, DiaryService_UpdateDiaryEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diaryServiceClient) DeleteDiaryEntry(ctx context.Context, in *DeleteDiaryEntryRequest, opts ...grpc.CallOption) (*DeleteDiaryEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDiaryEntryResponse)
+	err := c.cc.Invoke(ctx, DiaryService_DeleteDiaryEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diaryServiceClient) ListDiaryEntries(ctx context.Context, in *ListDiaryEntriesRequest, opts ...grpc.CallOption) (*ListDiaryEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDiaryEntriesResponse)
+	err := c.cc.Invoke(ctx, DiaryService_ListDiaryEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diaryServiceClient) ImportDiary(ctx context.Context, in *ImportDiaryRequest, opts ...grpc.CallOption) (*ImportDiaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportDiaryResponse)
+	err := c.cc.Invoke(ctx, DiaryService_ImportDiary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diaryServiceClient) DraftDiaryEntry(ctx context.Context, in *DraftDiaryEntryRequest, opts ...grpc.CallOption) (*DraftDiaryEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DraftDiaryEntryResponse)
+	err := c.cc.Invoke(ctx, DiaryService_DraftDiaryEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diaryServiceClient) ListFavorites(ctx context.Context, in *ListFavoritesRequest, opts ...grpc.CallOption) (*ListFavoritesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFavoritesResponse)
+	err := c.cc.Invoke(ctx, DiaryService_ListFavorites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diaryServiceClient) AddFavorite(ctx context.Context, in *AddFavoriteRequest, opts ...grpc.CallOption) (*AddFavoriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddFavoriteResponse)
+	err := c.cc.Invoke(ctx, DiaryService_AddFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diaryServiceClient) RemoveFavorite(ctx context.Context, in *RemoveFavoriteRequest, opts ...grpc.CallOption) (*RemoveFavoriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFavoriteResponse)
+	err := c.cc.Invoke(ctx, DiaryService_RemoveFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diaryServiceClient) ListRecentItems(ctx context.Context, in *ListRecentItemsRequest, opts ...grpc.CallOption) (*ListRecentItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecentItemsResponse)
+	err := c.cc.Invoke(ctx, DiaryService_ListRecentItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diaryServiceClient) CopyDiaryEntries(ctx context.Context, in *CopyDiaryEntriesRequest, opts ...grpc.CallOption) (*CopyDiaryEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CopyDiaryEntriesResponse)
+	err := c.cc.Invoke(ctx, DiaryService_CopyDiaryEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DiaryServiceServer is the server API for DiaryService service.
+// All implementations must embed UnimplementedDiaryServiceServer
+// for forward compatibility.
+//
+// Food diary gRPC definitions
+type DiaryServiceServer interface {
+	LogDiaryEntry(context.Context, *LogDiaryEntryRequest) (*LogDiaryEntryResponse, error)
+	UpdateDiaryEntry(context.Context, *UpdateDiaryEntryRequest) (*UpdateDiaryEntryResponse, error)
+	DeleteDiaryEntry(context.Context, *DeleteDiaryEntryRequest) (*DeleteDiaryEntryResponse, error)
+	ListDiaryEntries(context.Context, *ListDiaryEntriesRequest) (*ListDiaryEntriesResponse, error)
+	ImportDiary(context.Context, *ImportDiaryRequest) (*ImportDiaryResponse, error)
+	DraftDiaryEntry(context.Context, *DraftDiaryEntryRequest) (*DraftDiaryEntryResponse, error)
+	ListFavorites(context.Context, *ListFavoritesRequest) (*ListFavoritesResponse, error)
+	AddFavorite(context.Context, *AddFavoriteRequest) (*AddFavoriteResponse, error)
+	RemoveFavorite(context.Context, *RemoveFavoriteRequest) (*RemoveFavoriteResponse, error)
+	ListRecentItems(context.Context, *ListRecentItemsRequest) (*ListRecentItemsResponse, error)
+	CopyDiaryEntries(context.Context, *CopyDiaryEntriesRequest) (*CopyDiaryEntriesResponse, error)
+	mustEmbedUnimplementedDiaryServiceServer()
+}
+
+// UnimplementedDiaryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDiaryServiceServer struct{}
+
+func (UnimplementedDiaryServiceServer) LogDiaryEntry(context.Context, *LogDiaryEntryRequest) (*LogDiaryEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogDiaryEntry not implemented")
+}
+func (UnimplementedDiaryServiceServer) UpdateDiaryEntry(context.Context, *UpdateDiaryEntryRequest) (*UpdateDiaryEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDiaryEntry not implemented")
+}
+func (UnimplementedDiaryServiceServer) DeleteDiaryEntry(context.Context, *DeleteDiaryEntryRequest) (*DeleteDiaryEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDiaryEntry not implemented")
+}
+func (UnimplementedDiaryServiceServer) ListDiaryEntries(context.Context, *ListDiaryEntriesRequest) (*ListDiaryEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDiaryEntries not implemented")
+}
+func (UnimplementedDiaryServiceServer) ImportDiary(context.Context, *ImportDiaryRequest) (*ImportDiaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportDiary not implemented")
+}
+func (UnimplementedDiaryServiceServer) DraftDiaryEntry(context.Context, *DraftDiaryEntryRequest) (*DraftDiaryEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DraftDiaryEntry not implemented")
+}
+func (UnimplementedDiaryServiceServer) ListFavorites(context.Context, *ListFavoritesRequest) (*ListFavoritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFavorites not implemented")
+}
+func (UnimplementedDiaryServiceServer) AddFavorite(context.Context, *AddFavoriteRequest) (*AddFavoriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFavorite not implemented")
+}
+func (UnimplementedDiaryServiceServer) RemoveFavorite(context.Context, *RemoveFavoriteRequest) (*RemoveFavoriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFavorite not implemented")
+}
+func (UnimplementedDiaryServiceServer) ListRecentItems(context.Context, *ListRecentItemsRequest) (*ListRecentItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecentItems not implemented")
+}
+func (UnimplementedDiaryServiceServer) CopyDiaryEntries(context.Context, *CopyDiaryEntriesRequest) (*CopyDiaryEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyDiaryEntries not implemented")
+}
+func (UnimplementedDiaryServiceServer) mustEmbedUnimplementedDiaryServiceServer() {}
+func (UnimplementedDiaryServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeDiaryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DiaryServiceServer will
+// result in compilation errors.
+type UnsafeDiaryServiceServer interface {
+	mustEmbedUnimplementedDiaryServiceServer()
+}
+
+func RegisterDiaryServiceServer(s grpc.ServiceRegistrar, srv DiaryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDiaryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DiaryService_ServiceDesc, srv)
+}
+
+func _DiaryService_LogDiaryEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogDiaryEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiaryServiceServer).LogDiaryEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiaryService_LogDiaryEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiaryServiceServer).LogDiaryEntry(ctx, req.(*LogDiaryEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiaryService_UpdateDiaryEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDiaryEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiaryServiceServer).UpdateDiaryEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiaryService_UpdateDiaryEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiaryServiceServer).UpdateDiaryEntry(ctx, req.(*UpdateDiaryEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiaryService_DeleteDiaryEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDiaryEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiaryServiceServer).DeleteDiaryEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiaryService_DeleteDiaryEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiaryServiceServer).DeleteDiaryEntry(ctx, req.(*DeleteDiaryEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiaryService_ListDiaryEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDiaryEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiaryServiceServer).ListDiaryEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiaryService_ListDiaryEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiaryServiceServer).ListDiaryEntries(ctx, req.(*ListDiaryEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiaryService_ImportDiary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportDiaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiaryServiceServer).ImportDiary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiaryService_ImportDiary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiaryServiceServer).ImportDiary(ctx, req.(*ImportDiaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiaryService_DraftDiaryEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DraftDiaryEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiaryServiceServer).DraftDiaryEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiaryService_DraftDiaryEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiaryServiceServer).DraftDiaryEntry(ctx, req.(*DraftDiaryEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiaryService_ListFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFavoritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiaryServiceServer).ListFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiaryService_ListFavorites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiaryServiceServer).ListFavorites(ctx, req.(*ListFavoritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiaryService_AddFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiaryServiceServer).AddFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiaryService_AddFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiaryServiceServer).AddFavorite(ctx, req.(*AddFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiaryService_RemoveFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiaryServiceServer).RemoveFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiaryService_RemoveFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiaryServiceServer).RemoveFavorite(ctx, req.(*RemoveFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiaryService_ListRecentItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecentItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiaryServiceServer).ListRecentItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiaryService_ListRecentItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiaryServiceServer).ListRecentItems(ctx, req.(*ListRecentItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiaryService_CopyDiaryEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyDiaryEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiaryServiceServer).CopyDiaryEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiaryService_CopyDiaryEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiaryServiceServer).CopyDiaryEntries(ctx, req.(*CopyDiaryEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DiaryService_ServiceDesc is the grpc.ServiceDesc for DiaryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DiaryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.DiaryService",
+	HandlerType: (*DiaryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LogDiaryEntry",
+			Handler:    _DiaryService_LogDiaryEntry_Handler,
+		},
+		{
+			MethodName: "UpdateDiaryEntry",
+			Handler:    _DiaryService_UpdateDiaryEntry_Handler,
+		},
+		{
+			MethodName: "DeleteDiaryEntry",
+			Handler:    _DiaryService_DeleteDiaryEntry_Handler,
+		},
+		{
+			MethodName: "ListDiaryEntries",
+			Handler:    _DiaryService_ListDiaryEntries_Handler,
+		},
+		{
+			MethodName: "ImportDiary",
+			Handler:    _DiaryService_ImportDiary_Handler,
+		},
+		{
+			MethodName: "DraftDiaryEntry",
+			Handler:    _DiaryService_DraftDiaryEntry_Handler,
+		},
+		{
+			MethodName: "ListFavorites",
+			Handler:    _DiaryService_ListFavorites_Handler,
+		},
+		{
+			MethodName: "AddFavorite",
+			Handler:    _DiaryService_AddFavorite_Handler,
+		},
+		{
+			MethodName: "RemoveFavorite",
+			Handler:    _DiaryService_RemoveFavorite_Handler,
+		},
+		{
+			MethodName: "ListRecentItems",
+			Handler:    _DiaryService_ListRecentItems_Handler,
+		},
+		{
+			MethodName: "CopyDiaryEntries",
+			Handler:    _DiaryService_CopyDiaryEntries_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/diary.proto",
+}
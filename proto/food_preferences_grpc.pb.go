@@ -0,0 +1,353 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: proto/food_preferences.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	FoodPreferenceService_GetFoodPreferences_FullMethodName  = "/user.FoodPreferenceService/GetFoodPreferences"
+	FoodPreferenceService_SetFoodPreference_FullMethodName   = "/user.FoodPreferenceService/SetFoodPreference"
+	FoodPreferenceService_ClearFoodPreference_FullMethodName = "/user.FoodPreferenceService/ClearFoodPreference"
+	FoodPreferenceService_SetAllergies_FullMethodName        = "/user.FoodPreferenceService/SetAllergies"
+	FoodPreferenceService_SetDietRestrictions_FullMethodName = "/user.FoodPreferenceService/SetDietRestrictions"
+	FoodPreferenceService_ListFoodsForUser_FullMethodName    = "/user.FoodPreferenceService/ListFoodsForUser"
+	FoodPreferenceService_LookupBarcode_FullMethodName       = "/user.FoodPreferenceService/LookupBarcode"
+)
+
+// FoodPreferenceServiceClient is the client API for FoodPreferenceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Food preference gRPC definitions
+type FoodPreferenceServiceClient interface {
+	GetFoodPreferences(ctx context.Context, in *GetFoodPreferencesRequest, opts ...grpc.CallOption) (*FoodPreferencesResponse, error)
+	SetFoodPreference(ctx context.Context, in *SetFoodPreferenceRequest, opts ...grpc.CallOption) (*FoodPreferencesResponse, error)
+	ClearFoodPreference(ctx context.Context, in *ClearFoodPreferenceRequest, opts ...grpc.CallOption) (*FoodPreferencesResponse, error)
+	SetAllergies(ctx context.Context, in *SetAllergiesRequest, opts ...grpc.CallOption) (*FoodPreferencesResponse, error)
+	SetDietRestrictions(ctx context.Context, in *SetDietRestrictionsRequest, opts ...grpc.CallOption) (*FoodPreferencesResponse, error)
+	ListFoodsForUser(ctx context.Context, in *ListFoodsForUserRequest, opts ...grpc.CallOption) (*ListFoodsForUserResponse, error)
+	LookupBarcode(ctx context.Context, in *LookupBarcodeRequest, opts ...grpc.CallOption) (*LookupBarcodeResponse, error)
+}
+
+type foodPreferenceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFoodPreferenceServiceClient(cc grpc.ClientConnInterface) FoodPreferenceServiceClient {
+	return &foodPreferenceServiceClient{cc}
+}
+
+func (c *foodPreferenceServiceClient) GetFoodPreferences(ctx context.Context, in *GetFoodPreferencesRequest, opts ...grpc.CallOption) (*FoodPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FoodPreferencesResponse)
+	err := c.cc.Invoke(ctx, FoodPreferenceService_GetFoodPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foodPreferenceServiceClient) SetFoodPreference(ctx context.Context, in *SetFoodPreferenceRequest, opts ...grpc.CallOption) (*FoodPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FoodPreferencesResponse)
+	err := c.cc.Invoke(ctx, FoodPreferenceService_SetFoodPreference_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foodPreferenceServiceClient) ClearFoodPreference(ctx context.Context, in *ClearFoodPreferenceRequest, opts ...grpc.CallOption) (*FoodPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FoodPreferencesResponse)
+	err := c.cc.Invoke(ctx, FoodPreferenceService_ClearFoodPreference_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foodPreferenceServiceClient) SetAllergies(ctx context.Context, in *SetAllergiesRequest, opts ...grpc.CallOption) (*FoodPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FoodPreferencesResponse)
+	err := c.cc.Invoke(ctx, FoodPreferenceService_SetAllergies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foodPreferenceServiceClient) SetDietRestrictions(ctx context.Context, in *SetDietRestrictionsRequest, opts ...grpc.CallOption) (*FoodPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FoodPreferencesResponse)
+	err := c.cc.Invoke(ctx, FoodPreferenceService_SetDietRestrictions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foodPreferenceServiceClient) ListFoodsForUser(ctx context.Context, in *ListFoodsForUserRequest, opts ...grpc.CallOption) (*ListFoodsForUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFoodsForUserResponse)
+	err := c.cc.Invoke(ctx, FoodPreferenceService_ListFoodsForUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foodPreferenceServiceClient) LookupBarcode(ctx context.Context, in *LookupBarcodeRequest, opts ...grpc.CallOption) (*LookupBarcodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LookupBarcodeResponse)
+	err := c.cc.Invoke(ctx, FoodPreferenceService_LookupBarcode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FoodPreferenceServiceServer is the server API for FoodPreferenceService service.
+// All implementations must embed UnimplementedFoodPreferenceServiceServer
+// for forward compatibility.
+//
+// Food preference gRPC definitions
+type FoodPreferenceServiceServer interface {
+	GetFoodPreferences(context.Context, *GetFoodPreferencesRequest) (*FoodPreferencesResponse, error)
+	SetFoodPreference(context.Context, *SetFoodPreferenceRequest) (*FoodPreferencesResponse, error)
+	ClearFoodPreference(context.Context, *ClearFoodPreferenceRequest) (*FoodPreferencesResponse, error)
+	SetAllergies(context.Context, *SetAllergiesRequest) (*FoodPreferencesResponse, error)
+	SetDietRestrictions(context.Context, *SetDietRestrictionsRequest) (*FoodPreferencesResponse, error)
+	ListFoodsForUser(context.Context, *ListFoodsForUserRequest) (*ListFoodsForUserResponse, error)
+	LookupBarcode(context.Context, *LookupBarcodeRequest) (*LookupBarcodeResponse, error)
+	mustEmbedUnimplementedFoodPreferenceServiceServer()
+}
+
+// UnimplementedFoodPreferenceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFoodPreferenceServiceServer struct{}
+
+func (UnimplementedFoodPreferenceServiceServer) GetFoodPreferences(context.Context, *GetFoodPreferencesRequest) (*FoodPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFoodPreferences not implemented")
+}
+func (UnimplementedFoodPreferenceServiceServer) SetFoodPreference(context.Context, *SetFoodPreferenceRequest) (*FoodPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFoodPreference not implemented")
+}
+func (UnimplementedFoodPreferenceServiceServer) ClearFoodPreference(context.Context, *ClearFoodPreferenceRequest) (*FoodPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearFoodPreference not implemented")
+}
+func (UnimplementedFoodPreferenceServiceServer) SetAllergies(context.Context, *SetAllergiesRequest) (*FoodPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAllergies not implemented")
+}
+func (UnimplementedFoodPreferenceServiceServer) SetDietRestrictions(context.Context, *SetDietRestrictionsRequest) (*FoodPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDietRestrictions not implemented")
+}
+func (UnimplementedFoodPreferenceServiceServer) ListFoodsForUser(context.Context, *ListFoodsForUserRequest) (*ListFoodsForUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFoodsForUser not implemented")
+}
+func (UnimplementedFoodPreferenceServiceServer) LookupBarcode(context.Context, *LookupBarcodeRequest) (*LookupBarcodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupBarcode not implemented")
+}
+func (UnimplementedFoodPreferenceServiceServer) mustEmbedUnimplementedFoodPreferenceServiceServer() {}
+func (UnimplementedFoodPreferenceServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeFoodPreferenceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FoodPreferenceServiceServer will
+// result in compilation errors.
+type UnsafeFoodPreferenceServiceServer interface {
+	mustEmbedUnimplementedFoodPreferenceServiceServer()
+}
+
+func RegisterFoodPreferenceServiceServer(s grpc.ServiceRegistrar, srv FoodPreferenceServiceServer) {
+	// If the following call pancis, it indicates UnimplementedFoodPreferenceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FoodPreferenceService_ServiceDesc, srv)
+}
+
+func _FoodPreferenceService_GetFoodPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFoodPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FoodPreferenceServiceServer).GetFoodPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FoodPreferenceService_GetFoodPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FoodPreferenceServiceServer).GetFoodPreferences(ctx, req.(*GetFoodPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FoodPreferenceService_SetFoodPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFoodPreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FoodPreferenceServiceServer).SetFoodPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FoodPreferenceService_SetFoodPreference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FoodPreferenceServiceServer).SetFoodPreference(ctx, req.(*SetFoodPreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FoodPreferenceService_ClearFoodPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearFoodPreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FoodPreferenceServiceServer).ClearFoodPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FoodPreferenceService_ClearFoodPreference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FoodPreferenceServiceServer).ClearFoodPreference(ctx, req.(*ClearFoodPreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FoodPreferenceService_SetAllergies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAllergiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FoodPreferenceServiceServer).SetAllergies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FoodPreferenceService_SetAllergies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FoodPreferenceServiceServer).SetAllergies(ctx, req.(*SetAllergiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FoodPreferenceService_SetDietRestrictions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDietRestrictionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FoodPreferenceServiceServer).SetDietRestrictions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FoodPreferenceService_SetDietRestrictions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FoodPreferenceServiceServer).SetDietRestrictions(ctx, req.(*SetDietRestrictionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FoodPreferenceService_ListFoodsForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFoodsForUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FoodPreferenceServiceServer).ListFoodsForUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FoodPreferenceService_ListFoodsForUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FoodPreferenceServiceServer).ListFoodsForUser(ctx, req.(*ListFoodsForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FoodPreferenceService_LookupBarcode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupBarcodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FoodPreferenceServiceServer).LookupBarcode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FoodPreferenceService_LookupBarcode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FoodPreferenceServiceServer).LookupBarcode(ctx, req.(*LookupBarcodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FoodPreferenceService_ServiceDesc is the grpc.ServiceDesc for FoodPreferenceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FoodPreferenceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.FoodPreferenceService",
+	HandlerType: (*FoodPreferenceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetFoodPreferences",
+			Handler:    _FoodPreferenceService_GetFoodPreferences_Handler,
+		},
+		{
+			MethodName: "SetFoodPreference",
+			Handler:    _FoodPreferenceService_SetFoodPreference_Handler,
+		},
+		{
+			MethodName: "ClearFoodPreference",
+			Handler:    _FoodPreferenceService_ClearFoodPreference_Handler,
+		},
+		{
+			MethodName: "SetAllergies",
+			Handler:    _FoodPreferenceService_SetAllergies_Handler,
+		},
+		{
+			MethodName: "SetDietRestrictions",
+			Handler:    _FoodPreferenceService_SetDietRestrictions_Handler,
+		},
+		{
+			MethodName: "ListFoodsForUser",
+			Handler:    _FoodPreferenceService_ListFoodsForUser_Handler,
+		},
+		{
+			MethodName: "LookupBarcode",
+			Handler:    _FoodPreferenceService_LookupBarcode_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/food_preferences.proto",
+}
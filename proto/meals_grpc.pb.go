@@ -0,0 +1,239 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: proto/meals.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MealService_ScaleMeal_FullMethodName              = "/user.MealService/ScaleMeal"
+	MealService_PlanBatchCook_FullMethodName          = "/user.MealService/PlanBatchCook"
+	MealService_GetMeal_FullMethodName                = "/user.MealService/GetMeal"
+	MealService_UpdateMealInstructions_FullMethodName = "/user.MealService/UpdateMealInstructions"
+)
+
+// MealServiceClient is the client API for MealService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Meal (recipe) gRPC definitions
+type MealServiceClient interface {
+	ScaleMeal(ctx context.Context, in *ScaleMealRequest, opts ...grpc.CallOption) (*ScaledMealResponse, error)
+	PlanBatchCook(ctx context.Context, in *PlanBatchCookRequest, opts ...grpc.CallOption) (*PlanBatchCookResponse, error)
+	GetMeal(ctx context.Context, in *GetMealRequest, opts ...grpc.CallOption) (*MealResponse, error)
+	UpdateMealInstructions(ctx context.Context, in *UpdateMealInstructionsRequest, opts ...grpc.CallOption) (*MealResponse, error)
+}
+
+type mealServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMealServiceClient(cc grpc.ClientConnInterface) MealServiceClient {
+	return &mealServiceClient{cc}
+}
+
+func (c *mealServiceClient) ScaleMeal(ctx context.Context, in *ScaleMealRequest, opts ...grpc.CallOption) (*ScaledMealResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScaledMealResponse)
+	err := c.cc.Invoke(ctx, MealService_ScaleMeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealServiceClient) PlanBatchCook(ctx context.Context, in *PlanBatchCookRequest, opts ...grpc.CallOption) (*PlanBatchCookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlanBatchCookResponse)
+	err := c.cc.Invoke(ctx, MealService_PlanBatchCook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealServiceClient) GetMeal(ctx context.Context, in *GetMealRequest, opts ...grpc.CallOption) (*MealResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MealResponse)
+	err := c.cc.Invoke(ctx, MealService_GetMeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealServiceClient) UpdateMealInstructions(ctx context.Context, in *UpdateMealInstructionsRequest, opts ...grpc.CallOption) (*MealResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MealResponse)
+	err := c.cc.Invoke(ctx, MealService_UpdateMealInstructions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MealServiceServer is the server API for MealService service.
+// All implementations must embed UnimplementedMealServiceServer
+// for forward compatibility.
+//
+// Meal (recipe) gRPC definitions
+type MealServiceServer interface {
+	ScaleMeal(context.Context, *ScaleMealRequest) (*ScaledMealResponse, error)
+	PlanBatchCook(context.Context, *PlanBatchCookRequest) (*PlanBatchCookResponse, error)
+	GetMeal(context.Context, *GetMealRequest) (*MealResponse, error)
+	UpdateMealInstructions(context.Context, *UpdateMealInstructionsRequest) (*MealResponse, error)
+	mustEmbedUnimplementedMealServiceServer()
+}
+
+// UnimplementedMealServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMealServiceServer struct{}
+
+func (UnimplementedMealServiceServer) ScaleMeal(context.Context, *ScaleMealRequest) (*ScaledMealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScaleMeal not implemented")
+}
+func (UnimplementedMealServiceServer) PlanBatchCook(context.Context, *PlanBatchCookRequest) (*PlanBatchCookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanBatchCook not implemented")
+}
+func (UnimplementedMealServiceServer) GetMeal(context.Context, *GetMealRequest) (*MealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMeal not implemented")
+}
+func (UnimplementedMealServiceServer) UpdateMealInstructions(context.Context, *UpdateMealInstructionsRequest) (*MealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMealInstructions not implemented")
+}
+func (UnimplementedMealServiceServer) mustEmbedUnimplementedMealServiceServer() {}
+func (UnimplementedMealServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeMealServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MealServiceServer will
+// result in compilation errors.
+type UnsafeMealServiceServer interface {
+	mustEmbedUnimplementedMealServiceServer()
+}
+
+func RegisterMealServiceServer(s grpc.ServiceRegistrar, srv MealServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMealServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MealService_ServiceDesc, srv)
+}
+
+func _MealService_ScaleMeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScaleMealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealServiceServer).ScaleMeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealService_ScaleMeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealServiceServer).ScaleMeal(ctx, req.(*ScaleMealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealService_PlanBatchCook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanBatchCookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealServiceServer).PlanBatchCook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealService_PlanBatchCook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealServiceServer).PlanBatchCook(ctx, req.(*PlanBatchCookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealService_GetMeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealServiceServer).GetMeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealService_GetMeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealServiceServer).GetMeal(ctx, req.(*GetMealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealService_UpdateMealInstructions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMealInstructionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealServiceServer).UpdateMealInstructions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealService_UpdateMealInstructions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealServiceServer).UpdateMealInstructions(ctx, req.(*UpdateMealInstructionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MealService_ServiceDesc is the grpc.ServiceDesc for MealService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MealService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.MealService",
+	HandlerType: (*MealServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScaleMeal",
+			Handler:    _MealService_ScaleMeal_Handler,
+		},
+		{
+			MethodName: "PlanBatchCook",
+			Handler:    _MealService_PlanBatchCook_Handler,
+		},
+		{
+			MethodName: "GetMeal",
+			Handler:    _MealService_GetMeal_Handler,
+		},
+		{
+			MethodName: "UpdateMealInstructions",
+			Handler:    _MealService_UpdateMealInstructions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/meals.proto",
+}
@@ -0,0 +1,439 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        v5.29.3
+// source: proto/shopping_list.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A food to buy, in its purchasing unit (POUNDS, OUNCES, CUPS, TBSP, TSP or PIECES)
+type ShoppingItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FoodId        int32                  `protobuf:"varint,1,opt,name=food_id,json=foodId,proto3" json:"food_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Unit          string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShoppingItem) Reset() {
+	*x = ShoppingItem{}
+	mi := &file_proto_shopping_list_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShoppingItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingItem) ProtoMessage() {}
+
+func (x *ShoppingItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shopping_list_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingItem.ProtoReflect.Descriptor instead.
+func (*ShoppingItem) Descriptor() ([]byte, []int) {
+	return file_proto_shopping_list_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ShoppingItem) GetFoodId() int32 {
+	if x != nil {
+		return x.FoodId
+	}
+	return 0
+}
+
+func (x *ShoppingItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShoppingItem) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ShoppingItem) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+// The items of one food category
+type ShoppingAisle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Items         []*ShoppingItem        `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShoppingAisle) Reset() {
+	*x = ShoppingAisle{}
+	mi := &file_proto_shopping_list_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShoppingAisle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingAisle) ProtoMessage() {}
+
+func (x *ShoppingAisle) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shopping_list_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingAisle.ProtoReflect.Descriptor instead.
+func (*ShoppingAisle) Descriptor() ([]byte, []int) {
+	return file_proto_shopping_list_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ShoppingAisle) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ShoppingAisle) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShoppingAisle) GetItems() []*ShoppingItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ShoppingList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Aisles        []*ShoppingAisle       `protobuf:"bytes,3,rep,name=aisles,proto3" json:"aisles,omitempty"`
+	Notes         []string               `protobuf:"bytes,4,rep,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShoppingList) Reset() {
+	*x = ShoppingList{}
+	mi := &file_proto_shopping_list_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShoppingList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingList) ProtoMessage() {}
+
+func (x *ShoppingList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shopping_list_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingList.ProtoReflect.Descriptor instead.
+func (*ShoppingList) Descriptor() ([]byte, []int) {
+	return file_proto_shopping_list_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ShoppingList) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ShoppingList) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *ShoppingList) GetAisles() []*ShoppingAisle {
+	if x != nil {
+		return x.Aisles
+	}
+	return nil
+}
+
+func (x *ShoppingList) GetNotes() []string {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+// Request/Response messages
+type GetShoppingListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // optional, defaults to today in the user's timezone
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // optional, defaults to six days after start_date
+	Format        string                 `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`                        // json (default), markdown or text
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShoppingListRequest) Reset() {
+	*x = GetShoppingListRequest{}
+	mi := &file_proto_shopping_list_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShoppingListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShoppingListRequest) ProtoMessage() {}
+
+func (x *GetShoppingListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shopping_list_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShoppingListRequest.ProtoReflect.Descriptor instead.
+func (*GetShoppingListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_shopping_list_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetShoppingListRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetShoppingListRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetShoppingListRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetShoppingListRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ShoppingListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          *ShoppingList          `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Document      string                 `protobuf:"bytes,2,opt,name=document,proto3" json:"document,omitempty"` // the rendered list for the markdown and text formats
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShoppingListResponse) Reset() {
+	*x = ShoppingListResponse{}
+	mi := &file_proto_shopping_list_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShoppingListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingListResponse) ProtoMessage() {}
+
+func (x *ShoppingListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_shopping_list_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingListResponse.ProtoReflect.Descriptor instead.
+func (*ShoppingListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_shopping_list_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ShoppingListResponse) GetList() *ShoppingList {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ShoppingListResponse) GetDocument() string {
+	if x != nil {
+		return x.Document
+	}
+	return ""
+}
+
+func (x *ShoppingListResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_proto_shopping_list_proto protoreflect.FileDescriptor
+
+const file_proto_shopping_list_proto_rawDesc = "" +
+	"\n" +
+	"\x19proto/shopping_list.proto\x12\x04user\"k\n" +
+	"\fShoppingItem\x12\x17\n" +
+	"\afood_id\x18\x01 \x01(\x05R\x06foodId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x01R\bquantity\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\"i\n" +
+	"\rShoppingAisle\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12(\n" +
+	"\x05items\x18\x03 \x03(\v2\x12.user.ShoppingItemR\x05items\"\x8b\x01\n" +
+	"\fShoppingList\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12+\n" +
+	"\x06aisles\x18\x03 \x03(\v2\x13.user.ShoppingAisleR\x06aisles\x12\x14\n" +
+	"\x05notes\x18\x04 \x03(\tR\x05notes\"\x83\x01\n" +
+	"\x16GetShoppingListRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\x12\x16\n" +
+	"\x06format\x18\x04 \x01(\tR\x06format\"p\n" +
+	"\x14ShoppingListResponse\x12&\n" +
+	"\x04list\x18\x01 \x01(\v2\x12.user.ShoppingListR\x04list\x12\x1a\n" +
+	"\bdocument\x18\x02 \x01(\tR\bdocument\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error2b\n" +
+	"\x13ShoppingListService\x12K\n" +
+	"\x0fGetShoppingList\x12\x1c.user.GetShoppingListRequest\x1a\x1a.user.ShoppingListResponseB\tZ\a./protob\x06proto3"
+
+var (
+	file_proto_shopping_list_proto_rawDescOnce sync.Once
+	file_proto_shopping_list_proto_rawDescData []byte
+)
+
+func file_proto_shopping_list_proto_rawDescGZIP() []byte {
+	file_proto_shopping_list_proto_rawDescOnce.Do(func() {
+		file_proto_shopping_list_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_shopping_list_proto_rawDesc), len(file_proto_shopping_list_proto_rawDesc)))
+	})
+	return file_proto_shopping_list_proto_rawDescData
+}
+
+var file_proto_shopping_list_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_shopping_list_proto_goTypes = []any{
+	(*ShoppingItem)(nil),           // 0: user.ShoppingItem
+	(*ShoppingAisle)(nil),          // 1: user.ShoppingAisle
+	(*ShoppingList)(nil),           // 2: user.ShoppingList
+	(*GetShoppingListRequest)(nil), // 3: user.GetShoppingListRequest
+	(*ShoppingListResponse)(nil),   // 4: user.ShoppingListResponse
+}
+var file_proto_shopping_list_proto_depIdxs = []int32{
+	0, // 0: user.ShoppingAisle.items:type_name -> user.ShoppingItem
+	1, // 1: user.ShoppingList.aisles:type_name -> user.ShoppingAisle
+	2, // 2: user.ShoppingListResponse.list:type_name -> user.ShoppingList
+	3, // 3: user.ShoppingListService.GetShoppingList:input_type -> user.GetShoppingListRequest
+	4, // 4: user.ShoppingListService.GetShoppingList:output_type -> user.ShoppingListResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_proto_shopping_list_proto_init() }
+func file_proto_shopping_list_proto_init() {
+	if File_proto_shopping_list_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_shopping_list_proto_rawDesc), len(file_proto_shopping_list_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_shopping_list_proto_goTypes,
+		DependencyIndexes: file_proto_shopping_list_proto_depIdxs,
+		MessageInfos:      file_proto_shopping_list_proto_msgTypes,
+	}.Build()
+	File_proto_shopping_list_proto = out.File
+	file_proto_shopping_list_proto_goTypes = nil
+	file_proto_shopping_list_proto_depIdxs = nil
+}
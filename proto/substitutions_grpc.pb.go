@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: proto/substitutions.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SubstitutionService_SuggestSubstitutes_FullMethodName = "/user.SubstitutionService/SuggestSubstitutes"
+	SubstitutionService_ApplySubstitute_FullMethodName    = "/user.SubstitutionService/ApplySubstitute"
+)
+
+// SubstitutionServiceClient is the client API for SubstitutionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Food substitution gRPC definitions
+type SubstitutionServiceClient interface {
+	SuggestSubstitutes(ctx context.Context, in *SuggestSubstitutesRequest, opts ...grpc.CallOption) (*SuggestSubstitutesResponse, error)
+	ApplySubstitute(ctx context.Context, in *ApplySubstituteRequest, opts ...grpc.CallOption) (*ApplySubstituteResponse, error)
+}
+
+type substitutionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSubstitutionServiceClient(cc grpc.ClientConnInterface) SubstitutionServiceClient {
+	return &substitutionServiceClient{cc}
+}
+
+func (c *substitutionServiceClient) SuggestSubstitutes(ctx context.Context, in *SuggestSubstitutesRequest, opts ...grpc.CallOption) (*SuggestSubstitutesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestSubstitutesResponse)
+	err := c.cc.Invoke(ctx, SubstitutionService_SuggestSubstitutes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *substitutionServiceClient) ApplySubstitute(ctx context.Context, in *ApplySubstituteRequest, opts ...grpc.CallOption) (*ApplySubstituteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplySubstituteResponse)
+	err := c.cc.Invoke(ctx, SubstitutionService_ApplySubstitute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SubstitutionServiceServer is the server API for SubstitutionService service.
+// All implementations must embed UnimplementedSubstitutionServiceServer
+// for forward compatibility.
+//
+// Food substitution gRPC definitions
+type SubstitutionServiceServer interface {
+	SuggestSubstitutes(context.Context, *SuggestSubstitutesRequest) (*SuggestSubstitutesResponse, error)
+	ApplySubstitute(context.Context, *ApplySubstituteRequest) (*ApplySubstituteResponse, error)
+	mustEmbedUnimplementedSubstitutionServiceServer()
+}
+
+// UnimplementedSubstitutionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSubstitutionServiceServer struct{}
+
+func (UnimplementedSubstitutionServiceServer) SuggestSubstitutes(context.Context, *SuggestSubstitutesRequest) (*SuggestSubstitutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestSubstitutes not implemented")
+}
+func (UnimplementedSubstitutionServiceServer) ApplySubstitute(context.Context, *ApplySubstituteRequest) (*ApplySubstituteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplySubstitute not implemented")
+}
+func (UnimplementedSubstitutionServiceServer) mustEmbedUnimplementedSubstitutionServiceServer() {}
+func (UnimplementedSubstitutionServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeSubstitutionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SubstitutionServiceServer will
+// result in compilation errors.
+type UnsafeSubstitutionServiceServer interface {
+	mustEmbedUnimplementedSubstitutionServiceServer()
+}
+
+func RegisterSubstitutionServiceServer(s grpc.ServiceRegistrar, srv SubstitutionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSubstitutionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SubstitutionService_ServiceDesc, srv)
+}
+
+func _SubstitutionService_SuggestSubstitutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestSubstitutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubstitutionServiceServer).SuggestSubstitutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubstitutionService_SuggestSubstitutes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubstitutionServiceServer).SuggestSubstitutes(ctx, req.(*SuggestSubstitutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubstitutionService_ApplySubstitute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplySubstituteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubstitutionServiceServer).ApplySubstitute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubstitutionService_ApplySubstitute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubstitutionServiceServer).ApplySubstitute(ctx, req.(*ApplySubstituteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SubstitutionService_ServiceDesc is the grpc.ServiceDesc for SubstitutionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SubstitutionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.SubstitutionService",
+	HandlerType: (*SubstitutionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SuggestSubstitutes",
+			Handler:    _SubstitutionService_SuggestSubstitutes_Handler,
+		},
+		{
+			MethodName: "ApplySubstitute",
+			Handler:    _SubstitutionService_ApplySubstitute_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/substitutions.proto",
+}
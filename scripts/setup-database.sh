@@ -27,6 +27,11 @@ echo "🌱 Loading seed data..."
 docker-compose exec postgres psql -U smartfit -d smartfitgirl -c "$(cat database/seeds/001_food_catalog_seeds.sql)"
 docker-compose exec postgres psql -U smartfit -d smartfitgirl -c "$(cat database/seeds/001_initial_goals.sql)"
 docker-compose exec postgres psql -U smartfit -d smartfitgirl -c "$(cat database/seeds/002_test_users.sql)"
+docker-compose exec postgres psql -U smartfit -d smartfitgirl -c "$(cat database/seeds/003_goal_conflicts.sql)"
+docker-compose exec postgres psql -U smartfit -d smartfitgirl -c "$(cat database/seeds/004_surveys.sql)"
+docker-compose exec postgres psql -U smartfit -d smartfitgirl -c "$(cat database/seeds/005_food_allergens.sql)"
+docker-compose exec postgres psql -U smartfit -d smartfitgirl -c "$(cat database/seeds/006_food_micronutrients.sql)"
+docker-compose exec postgres psql -U smartfit -d smartfitgirl -c "$(cat database/seeds/007_exercises.sql)"
 
 echo ""
 echo "✅ Database setup complete!"
@@ -34,6 +34,7 @@ echo "Service URLs:"
 echo "  - API Service:      http://localhost:8080"
 echo "  - User Service:     http://localhost:8082"
 echo "  - Meal Service:     http://localhost:8083"
-echo "  - Tracking Service: http://localhost:8084"
+echo "  - Check-in Service: http://localhost:8084"
+echo "  - Workout Service:  http://localhost:8089"
 echo "  - Web Client:       http://localhost:5050"
 echo "  - PostgreSQL:       localhost:5432"
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TestAdminMiddleware checks that catalog writes are limited to the emails in
+// ADMIN_EMAILS, compared without case
+func TestAdminMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	admins := parseAdminEmails(" Admin@Example.com, ,ops@example.com")
+	if len(admins) != 2 {
+		t.Fatalf("Expected 2 admin emails, got %d", len(admins))
+	}
+
+	tests := []struct {
+		email  string
+		status int
+	}{
+		{"admin@example.com", http.StatusCreated},
+		{"OPS@example.com", http.StatusCreated},
+		{"user@example.com", http.StatusForbidden},
+		{"", http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		r := gin.New()
+		r.POST("/api/goals",
+			func(c *gin.Context) { c.Set("email", tt.email) },
+			adminMiddleware(admins),
+			func(c *gin.Context) { c.Status(http.StatusCreated) })
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("POST", "/api/goals", nil))
+
+		if w.Code != tt.status {
+			t.Errorf("Email %q: expected status %d, got %d", tt.email, tt.status, w.Code)
+		}
+	}
+
+	if len(parseAdminEmails("")) != 0 {
+		t.Error("Expected no admins when ADMIN_EMAILS is empty")
+	}
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Check-in routes are served by check-in-service. The handlers below forward
+// to the service proxy; they exist so each route is documented in Swagger.
+
+// CheckInRequest defines the payload for creating or replacing a check-in
+type CheckInRequest struct {
+	RecordedAt     *time.Time `json:"recordedAt,omitempty" example:"2025-03-10T07:30:00Z"`
+	WeightKg       *float64   `json:"weightKg,omitempty" example:"72.4"`
+	BodyFatPercent *float64   `json:"bodyFatPercent,omitempty" example:"24.5"`
+	WaistCm        *float64   `json:"waistCm,omitempty" example:"78"`
+	HipCm          *float64   `json:"hipCm,omitempty" example:"98"`
+	EnergyLevel    *int       `json:"energyLevel,omitempty" example:"4" minimum:"1" maximum:"5"`
+	SleepHours     *float64   `json:"sleepHours,omitempty" example:"7.5" minimum:"0" maximum:"24"`
+	Mood           *int       `json:"mood,omitempty" example:"3" minimum:"1" maximum:"5"`
+	Notes          *string    `json:"notes,omitempty" example:"Slept badly, big training day yesterday" maxLength:"500"`
+}
+
+// CheckInResponse defines a body metrics and wellbeing check-in
+type CheckInResponse struct {
+	ID             int       `json:"id" example:"1"`
+	RecordedAt     time.Time `json:"recordedAt"`
+	WeightKg       *float64  `json:"weightKg,omitempty" example:"72.4"`
+	BodyFatPercent *float64  `json:"bodyFatPercent,omitempty" example:"24.5"`
+	WaistCm        *float64  `json:"waistCm,omitempty" example:"78"`
+	HipCm          *float64  `json:"hipCm,omitempty" example:"98"`
+	EnergyLevel    *int      `json:"energyLevel,omitempty" example:"4"`
+	SleepHours     *float64  `json:"sleepHours,omitempty" example:"7.5"`
+	Mood           *int      `json:"mood,omitempty" example:"3"`
+	Notes          *string   `json:"notes,omitempty" example:"Slept badly, big training day yesterday"`
+	CreatedAt      time.Time `json:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
+
+// listCheckInsHandler godoc
+// @Summary      List Check-ins
+// @Description  List the authenticated user's check-ins, newest first
+// @Tags         check-ins
+// @Produce      json
+// @Security     Bearer
+// @Param        from   query     string  false  "Only check-ins recorded at or after this RFC 3339 time"
+// @Param        to     query     string  false  "Only check-ins recorded before this RFC 3339 time"
+// @Param        limit  query     int     false  "Maximum number of check-ins (1-500, default 100)"
+// @Success      200    {array}   CheckInResponse
+// @Failure      400    {object}  ErrorResponse
+// @Failure      401    {object}  ErrorResponse
+// @Router       /api/check-ins [get]
+func listCheckInsHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// createCheckInHandler godoc
+// @Summary      Create Check-in
+// @Description  Log body metrics and wellbeing. Every field is optional but at least one measurement or notes is required. recordedAt defaults to now and cannot be in the future.
+// @Tags         check-ins
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        checkIn  body      CheckInRequest  true  "Check-in"
+// @Success      201      {object}  CheckInResponse
+// @Failure      400      {object}  ErrorResponse
+// @Failure      401      {object}  ErrorResponse
+// @Router       /api/check-ins [post]
+func createCheckInHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// getCheckInHandler godoc
+// @Summary      Get Check-in
+// @Tags         check-ins
+// @Produce      json
+// @Security     Bearer
+// @Param        id   path      int  true  "Check-in ID"
+// @Success      200  {object}  CheckInResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /api/check-ins/{id} [get]
+func getCheckInHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// updateCheckInHandler godoc
+// @Summary      Update Check-in
+// @Description  Replace a check-in's measurements; fields left out are cleared, except recordedAt which is kept
+// @Tags         check-ins
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id       path      int             true  "Check-in ID"
+// @Param        checkIn  body      CheckInRequest  true  "Check-in"
+// @Success      200      {object}  CheckInResponse
+// @Failure      400      {object}  ErrorResponse
+// @Failure      401      {object}  ErrorResponse
+// @Failure      404      {object}  ErrorResponse
+// @Router       /api/check-ins/{id} [put]
+func updateCheckInHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// deleteCheckInHandler godoc
+// @Summary      Delete Check-in
+// @Tags         check-ins
+// @Security     Bearer
+// @Param        id  path  int  true  "Check-in ID"
+// @Success      204
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /api/check-ins/{id} [delete]
+func deleteCheckInHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
@@ -0,0 +1,383 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	pb "api-service/proto"
+	"github.com/gin-gonic/gin"
+)
+
+// CycleLogRequest defines the request payload for logging a cycle day.
+// At least one of periodStarted or symptoms is required.
+type CycleLogRequest struct {
+	Date          string   `json:"date" example:"2025-03-10"`
+	PeriodStarted bool     `json:"periodStarted" example:"true"`
+	Symptoms      []string `json:"symptoms" example:"CRAMPS,FATIGUE"`
+}
+
+// CycleLogResponse defines a logged cycle day
+type CycleLogResponse struct {
+	Date          string   `json:"date" example:"2025-03-10"`
+	PeriodStarted bool     `json:"periodStarted" example:"true"`
+	Symptoms      []string `json:"symptoms" example:"CRAMPS,FATIGUE"`
+}
+
+// CycleTrackingResponse defines whether cycle tracking is enabled
+type CycleTrackingResponse struct {
+	Enabled bool `json:"enabled" example:"true"`
+}
+
+// SuggestedFoodResponse defines a catalog food rich in the phase's focus nutrient
+type SuggestedFoodResponse struct {
+	FoodID         int32   `json:"foodId" example:"20"`
+	FoodName       string  `json:"foodName" example:"Spinach - Raw"`
+	Category       string  `json:"category" example:"VEGETABLE"`
+	ServingUnits   string  `json:"servingUnits" example:"CUPS"`
+	Calories       float64 `json:"calories" example:"7"`
+	NutrientAmount float64 `json:"nutrientAmount" example:"0.8"`
+	NutrientUnit   string  `json:"nutrientUnit" example:"mg"`
+	Liked          bool    `json:"liked" example:"false"`
+}
+
+// CyclePhaseResponse defines the predicted cycle phase of a date and how it
+// adjusts targets and food suggestions
+type CyclePhaseResponse struct {
+	Date                     string                  `json:"date" example:"2025-03-12"`
+	Phase                    string                  `json:"phase" example:"MENSTRUAL"`
+	CycleDay                 int32                   `json:"cycleDay" example:"3"`
+	CycleLength              int32                   `json:"cycleLength" example:"30"`
+	CyclesUsed               int32                   `json:"cyclesUsed" example:"1"`
+	LastPeriodStart          string                  `json:"lastPeriodStart" example:"2025-03-10"`
+	NextPeriodStart          string                  `json:"nextPeriodStart" example:"2025-04-09"`
+	OvulationDate            string                  `json:"ovulationDate" example:"2025-03-25"`
+	Projected                bool                    `json:"projected" example:"false"`
+	CalorieAdjustmentPercent float64                 `json:"calorieAdjustmentPercent" example:"0"`
+	FocusNutrient            string                  `json:"focusNutrient,omitempty" example:"iron"`
+	SuggestedFoods           []SuggestedFoodResponse `json:"suggestedFoods"`
+	Notes                    []string                `json:"notes"`
+}
+
+// enableCycleTrackingHandler godoc
+// @Summary      Enable Cycle Tracking
+// @Description  Opt in to menstrual cycle tracking. Only available when the profile's biological sex is FEMALE or not set; cycle phases stop adjusting targets if it later changes. Cycle data is stored apart from the user profile and is only ever returned to its owner.
+// @Tags         cycle
+// @Produce      json
+// @Security     Bearer
+// @Success      200  {object}  CycleTrackingResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/cycle/tracking [post]
+func enableCycleTrackingHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Cycle service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewCycleServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.EnableCycleTracking(ctx, &pb.CycleTrackingRequest{
+			UserId: int32(c.GetInt("user_id")),
+		})
+		if err != nil {
+			log.Printf("Error calling EnableCycleTracking: %v", err)
+			c.JSON(500, gin.H{"error": "Cycle service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to enable cycle tracking")
+			return
+		}
+
+		c.JSON(200, CycleTrackingResponse{Enabled: resp.Enabled})
+	}
+}
+
+// disableCycleTrackingHandler godoc
+// @Summary      Disable Cycle Tracking
+// @Description  Opt out of menstrual cycle tracking. All of the user's cycle logs are deleted and targets stop being adjusted by phase.
+// @Tags         cycle
+// @Produce      json
+// @Security     Bearer
+// @Success      200  {object}  CycleTrackingResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/cycle/tracking [delete]
+func disableCycleTrackingHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Cycle service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewCycleServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.DisableCycleTracking(ctx, &pb.CycleTrackingRequest{
+			UserId: int32(c.GetInt("user_id")),
+		})
+		if err != nil {
+			log.Printf("Error calling DisableCycleTracking: %v", err)
+			c.JSON(500, gin.H{"error": "Cycle service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to disable cycle tracking")
+			return
+		}
+
+		c.JSON(200, CycleTrackingResponse{Enabled: resp.Enabled})
+	}
+}
+
+// logCycleDayHandler godoc
+// @Summary      Log Cycle Day
+// @Description  Log a period start and/or symptoms for a day, replacing any earlier log for that date. Symptoms are CRAMPS, BLOATING, FATIGUE, HEADACHE, CRAVINGS, MOOD_CHANGES, BREAST_TENDERNESS, ACNE, NAUSEA or INSOMNIA. The date defaults to today in the user's timezone. Cycle tracking must be enabled.
+// @Tags         cycle
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        request  body      CycleLogRequest  true  "Cycle day"
+// @Success      201      {object}  CycleLogResponse
+// @Failure      400      {object}  ErrorResponse
+// @Failure      401      {object}  ErrorResponse
+// @Failure      409      {object}  ErrorResponse
+// @Failure      500      {object}  ErrorResponse
+// @Router       /api/cycle/logs [post]
+func logCycleDayHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req CycleLogRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(400, gin.H{"error": "Invalid request payload"})
+			return
+		}
+
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Cycle service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewCycleServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.LogCycleDay(ctx, &pb.LogCycleDayRequest{
+			UserId:        int32(c.GetInt("user_id")),
+			Date:          req.Date,
+			PeriodStarted: req.PeriodStarted,
+			Symptoms:      req.Symptoms,
+		})
+		if err != nil {
+			log.Printf("Error calling LogCycleDay: %v", err)
+			c.JSON(500, gin.H{"error": "Cycle service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to log cycle day")
+			return
+		}
+
+		c.JSON(201, toCycleLogResponse(resp.Log))
+	}
+}
+
+// listCycleLogsHandler godoc
+// @Summary      List Cycle Logs
+// @Description  List the authenticated user's cycle logs, oldest first. The range defaults to the 90 days ending today in the user's timezone and may span at most 366 days.
+// @Tags         cycle
+// @Produce      json
+// @Security     Bearer
+// @Param        startDate  query     string  false  "First day (YYYY-MM-DD)"
+// @Param        endDate    query     string  false  "Last day (YYYY-MM-DD)"
+// @Success      200        {array}   CycleLogResponse
+// @Failure      400        {object}  ErrorResponse
+// @Failure      401        {object}  ErrorResponse
+// @Failure      409        {object}  ErrorResponse
+// @Failure      500        {object}  ErrorResponse
+// @Router       /api/cycle/logs [get]
+func listCycleLogsHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Cycle service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewCycleServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.ListCycleLogs(ctx, &pb.ListCycleLogsRequest{
+			UserId:    int32(c.GetInt("user_id")),
+			StartDate: c.Query("startDate"),
+			EndDate:   c.Query("endDate"),
+		})
+		if err != nil {
+			log.Printf("Error calling ListCycleLogs: %v", err)
+			c.JSON(500, gin.H{"error": "Cycle service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to list cycle logs")
+			return
+		}
+
+		logs := make([]CycleLogResponse, len(resp.Logs))
+		for i, cycleLog := range resp.Logs {
+			logs[i] = toCycleLogResponse(cycleLog)
+		}
+
+		c.JSON(200, logs)
+	}
+}
+
+// deleteCycleLogHandler godoc
+// @Summary      Delete Cycle Log
+// @Description  Delete the authenticated user's cycle log for a date
+// @Tags         cycle
+// @Produce      json
+// @Security     Bearer
+// @Param        date  path      string  true  "Log date (YYYY-MM-DD)"
+// @Success      200   {object}  MessageResponse
+// @Failure      400   {object}  ErrorResponse
+// @Failure      401   {object}  ErrorResponse
+// @Failure      404   {object}  ErrorResponse
+// @Failure      500   {object}  ErrorResponse
+// @Router       /api/cycle/logs/{date} [delete]
+func deleteCycleLogHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Cycle service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewCycleServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.DeleteCycleLog(ctx, &pb.DeleteCycleLogRequest{
+			UserId: int32(c.GetInt("user_id")),
+			Date:   c.Param("date"),
+		})
+		if err != nil {
+			log.Printf("Error calling DeleteCycleLog: %v", err)
+			c.JSON(500, gin.H{"error": "Cycle service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to delete cycle log")
+			return
+		}
+
+		c.JSON(200, MessageResponse{Message: resp.Message})
+	}
+}
+
+// getCyclePhaseHandler godoc
+// @Summary      Get Cycle Phase
+// @Description  Predict the cycle phase of a date from the user's logged period starts, averaging up to the last six cycles. Returns how the phase adjusts calorie targets and catalog foods rich in the phase's focus nutrient (iron while menstruating, fiber in the luteal phase), filtered by the user's preferences. The date defaults to today in the user's timezone. Rejected when the profile's biological sex is no longer FEMALE or unset.
+// @Tags         cycle
+// @Produce      json
+// @Security     Bearer
+// @Param        date  query     string  false  "Date (YYYY-MM-DD)"
+// @Success      200   {object}  CyclePhaseResponse
+// @Failure      400   {object}  ErrorResponse
+// @Failure      401   {object}  ErrorResponse
+// @Failure      409   {object}  ErrorResponse
+// @Failure      500   {object}  ErrorResponse
+// @Router       /api/cycle/phase [get]
+func getCyclePhaseHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Cycle service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewCycleServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.GetCyclePhase(ctx, &pb.GetCyclePhaseRequest{
+			UserId: int32(c.GetInt("user_id")),
+			Date:   c.Query("date"),
+		})
+		if err != nil {
+			log.Printf("Error calling GetCyclePhase: %v", err)
+			c.JSON(500, gin.H{"error": "Cycle service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to get cycle phase")
+			return
+		}
+
+		phase := resp.Phase
+		foods := make([]SuggestedFoodResponse, len(phase.SuggestedFoods))
+		for i, food := range phase.SuggestedFoods {
+			foods[i] = SuggestedFoodResponse{
+				FoodID:         food.FoodId,
+				FoodName:       food.FoodName,
+				Category:       food.Category,
+				ServingUnits:   food.ServingUnits,
+				Calories:       food.Calories,
+				NutrientAmount: food.NutrientAmount,
+				NutrientUnit:   food.NutrientUnit,
+				Liked:          food.Liked,
+			}
+		}
+
+		c.JSON(200, CyclePhaseResponse{
+			Date:                     phase.Date,
+			Phase:                    phase.Phase,
+			CycleDay:                 phase.CycleDay,
+			CycleLength:              phase.CycleLength,
+			CyclesUsed:               phase.CyclesUsed,
+			LastPeriodStart:          phase.LastPeriodStart,
+			NextPeriodStart:          phase.NextPeriodStart,
+			OvulationDate:            phase.OvulationDate,
+			Projected:                phase.Projected,
+			CalorieAdjustmentPercent: phase.CalorieAdjustmentPercent,
+			FocusNutrient:            phase.FocusNutrient,
+			SuggestedFoods:           foods,
+			Notes:                    nonNilStrings(phase.Notes),
+		})
+	}
+}
+
+// toCycleLogResponse converts a proto cycle log to its JSON representation
+func toCycleLogResponse(cycleLog *pb.CycleLog) CycleLogResponse {
+	return CycleLogResponse{
+		Date:          cycleLog.Date,
+		PeriodStarted: cycleLog.PeriodStarted,
+		Symptoms:      nonNilStrings(cycleLog.Symptoms),
+	}
+}
@@ -0,0 +1,577 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	pb "api-service/proto"
+	"github.com/gin-gonic/gin"
+)
+
+// DiaryEntryRequest defines the request payload for logging or editing a diary entry.
+// Exactly one of mealId, foodId or quickCalories identifies what was eaten;
+// quickCalories may be 0. When editing, an empty description clears it.
+type DiaryEntryRequest struct {
+	MealID        int32    `json:"mealId" example:"3"`
+	FoodID        int32    `json:"foodId" example:"0"`
+	Date          string   `json:"date" example:"2025-03-09"`
+	MealNumber    int32    `json:"mealNumber" example:"1"`
+	Servings      float64  `json:"servings" example:"0.5"`
+	QuickCalories *float64 `json:"quickCalories,omitempty" example:"0"`
+	Description   *string  `json:"description,omitempty" example:"Half portion at lunch"`
+}
+
+// DiaryEntryResponse defines a single diary entry with nutrition scaled by servings
+type DiaryEntryResponse struct {
+	ID            int32   `json:"id" example:"41"`
+	MealID        int32   `json:"mealId,omitempty" example:"3"`
+	FoodID        int32   `json:"foodId,omitempty" example:"0"`
+	Date          string  `json:"date" example:"2025-03-09"`
+	MealNumber    int32   `json:"mealNumber" example:"1"`
+	Servings      float64 `json:"servings" example:"0.5"`
+	QuickCalories float64 `json:"quickCalories,omitempty" example:"0"`
+	Description   string  `json:"description,omitempty" example:"Half portion at lunch"`
+	Name          string  `json:"name" example:"Overnight Oats"`
+	Calories      float64 `json:"calories" example:"210"`
+	ProteinGrams  float64 `json:"proteinGrams" example:"9"`
+	CarbsGrams    float64 `json:"carbsGrams" example:"30"`
+	FatGrams      float64 `json:"fatGrams" example:"6"`
+}
+
+// DiaryTotals defines the summed nutrition for a diary day
+type DiaryTotals struct {
+	Calories     float64 `json:"calories" example:"1850"`
+	ProteinGrams float64 `json:"proteinGrams" example:"120"`
+	CarbsGrams   float64 `json:"carbsGrams" example:"190"`
+	FatGrams     float64 `json:"fatGrams" example:"62"`
+}
+
+// DiaryDayResponse defines the diary entries logged for one day
+type DiaryDayResponse struct {
+	Date    string               `json:"date" example:"2025-03-09"`
+	Entries []DiaryEntryResponse `json:"entries"`
+	Totals  DiaryTotals          `json:"totals"`
+}
+
+// Diary imports are capped below the gRPC message size limit and get longer
+// than other calls, since a file can hold years of entries
+const (
+	maxDiaryImportBytes = 2 << 20
+	diaryImportTimeout  = 60 * time.Second
+)
+
+// DiaryImportRow defines the outcome of one line of an imported diary file.
+// Status is MATCHED (logged as a catalog food), CREATED (logged as a custom
+// food created by the import) or SKIPPED (not logged, see reason).
+type DiaryImportRow struct {
+	Line            int32   `json:"line" example:"2"`
+	Status          string  `json:"status" example:"MATCHED"`
+	FoodName        string  `json:"foodName" example:"Bananas, raw"`
+	Date            string  `json:"date,omitempty" example:"2025-03-08"`
+	MealNumber      int32   `json:"mealNumber,omitempty" example:"1"`
+	FoodID          int32   `json:"foodId,omitempty" example:"49"`
+	MatchedFoodName string  `json:"matchedFoodName,omitempty" example:"Banana - Medium"`
+	Servings        float64 `json:"servings,omitempty" example:"2"`
+	Reason          string  `json:"reason,omitempty" example:""`
+}
+
+// DiaryImportResponse defines the report of a diary import
+type DiaryImportResponse struct {
+	Matched            int32            `json:"matched" example:"412"`
+	Created            int32            `json:"created" example:"37"`
+	Skipped            int32            `json:"skipped" example:"5"`
+	CustomFoodsCreated int32            `json:"customFoodsCreated" example:"12"`
+	Rows               []DiaryImportRow `json:"rows"`
+}
+
+// DiaryDraftRequest defines the request payload for parsing a typed diary entry
+type DiaryDraftRequest struct {
+	Text       string `json:"text" binding:"required" example:"2 eggs and a cup of brown rice"`
+	Date       string `json:"date" example:"2025-03-09"`
+	MealNumber int32  `json:"mealNumber" example:"1"`
+}
+
+// DiaryDraftMatch defines a catalog food an item of typed text can be logged
+// as. Confidence runs from 0 to 1; note says what was assumed to size the item.
+type DiaryDraftMatch struct {
+	FoodID       int32   `json:"foodId" example:"31"`
+	FoodName     string  `json:"foodName" example:"Brown Rice - Cooked"`
+	ServingUnits string  `json:"servingUnits" example:"CUPS"`
+	Servings     float64 `json:"servings" example:"1"`
+	Calories     float64 `json:"calories" example:"216"`
+	Confidence   float64 `json:"confidence" example:"1"`
+	Note         string  `json:"note,omitempty" example:""`
+}
+
+// DiaryDraftItem defines one food of typed text. Match is the best catalog
+// food, null when nothing matched, and alternatives are the next best.
+type DiaryDraftItem struct {
+	Text         string            `json:"text" example:"a cup of brown rice"`
+	Quantity     float64           `json:"quantity" example:"1"`
+	Unit         string            `json:"unit,omitempty" example:"CUPS"`
+	FoodPhrase   string            `json:"foodPhrase" example:"brown rice"`
+	Match        *DiaryDraftMatch  `json:"match"`
+	Alternatives []DiaryDraftMatch `json:"alternatives"`
+}
+
+// DiaryDraftResponse defines the draft diary entry parsed from typed text
+type DiaryDraftResponse struct {
+	Date       string           `json:"date" example:"2025-03-09"`
+	MealNumber int32            `json:"mealNumber" example:"1"`
+	Items      []DiaryDraftItem `json:"items"`
+	Unresolved int32            `json:"unresolved" example:"0"`
+}
+
+// MessageResponse defines a plain confirmation message
+type MessageResponse struct {
+	Message string `json:"message" example:"Diary entry with ID 41 deleted successfully"`
+}
+
+// listDiaryHandler godoc
+// @Summary      List Diary Entries
+// @Description  List the authenticated user's food diary for one day. The date defaults to today in the user's timezone.
+// @Tags         diary
+// @Produce      json
+// @Security     Bearer
+// @Param        date  query     string  false  "Diary date (YYYY-MM-DD)"
+// @Success      200   {object}  DiaryDayResponse
+// @Failure      400   {object}  ErrorResponse
+// @Failure      401   {object}  ErrorResponse
+// @Failure      500   {object}  ErrorResponse
+// @Router       /api/diary [get]
+func listDiaryHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Diary service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewDiaryServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.ListDiaryEntries(ctx, &pb.ListDiaryEntriesRequest{
+			UserId: int32(c.GetInt("user_id")),
+			Date:   c.Query("date"),
+		})
+		if err != nil {
+			log.Printf("Error calling ListDiaryEntries: %v", err)
+			c.JSON(500, gin.H{"error": "Diary service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to list diary entries")
+			return
+		}
+
+		day := DiaryDayResponse{
+			Date:    resp.Date,
+			Entries: make([]DiaryEntryResponse, len(resp.Entries)),
+		}
+		for i, entry := range resp.Entries {
+			day.Entries[i] = toDiaryEntryResponse(entry)
+			day.Totals.Calories += entry.Calories
+			day.Totals.ProteinGrams += entry.ProteinGrams
+			day.Totals.CarbsGrams += entry.CarbsGrams
+			day.Totals.FatGrams += entry.FatGrams
+		}
+
+		c.JSON(200, day)
+	}
+}
+
+// logDiaryHandler godoc
+// @Summary      Log Diary Entry
+// @Description  Log a meal, a single food or quick-add calories. Servings multiplies the meal totals or food serving (0.5 = half portion). The date defaults to today in the user's timezone.
+// @Tags         diary
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        request  body      DiaryEntryRequest  true  "Diary entry"
+// @Success      201      {object}  DiaryEntryResponse
+// @Failure      400      {object}  ErrorResponse
+// @Failure      401      {object}  ErrorResponse
+// @Failure      500      {object}  ErrorResponse
+// @Router       /api/diary [post]
+func logDiaryHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req DiaryEntryRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(400, gin.H{"error": "Invalid request payload"})
+			return
+		}
+
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Diary service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewDiaryServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		description := ""
+		if req.Description != nil {
+			description = *req.Description
+		}
+		resp, err := client.LogDiaryEntry(ctx, &pb.LogDiaryEntryRequest{
+			UserId:        int32(c.GetInt("user_id")),
+			MealId:        req.MealID,
+			FoodId:        req.FoodID,
+			Date:          req.Date,
+			MealNumber:    req.MealNumber,
+			Servings:      req.Servings,
+			QuickCalories: req.QuickCalories,
+			Description:   description,
+		})
+		if err != nil {
+			log.Printf("Error calling LogDiaryEntry: %v", err)
+			c.JSON(500, gin.H{"error": "Diary service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to log diary entry")
+			return
+		}
+
+		c.JSON(201, toDiaryEntryResponse(resp.Entry))
+	}
+}
+
+// updateDiaryHandler godoc
+// @Summary      Update Diary Entry
+// @Description  Edit a diary entry. Omitted fields keep their current value; an empty description clears it.
+// @Tags         diary
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id       path      int                true  "Diary entry ID"
+// @Param        request  body      DiaryEntryRequest  true  "Fields to change"
+// @Success      200      {object}  DiaryEntryResponse
+// @Failure      400      {object}  ErrorResponse
+// @Failure      401      {object}  ErrorResponse
+// @Failure      404      {object}  ErrorResponse
+// @Failure      500      {object}  ErrorResponse
+// @Router       /api/diary/{id} [put]
+func updateDiaryHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(400, gin.H{"error": "Invalid diary entry ID"})
+			return
+		}
+
+		var req DiaryEntryRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(400, gin.H{"error": "Invalid request payload"})
+			return
+		}
+
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Diary service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewDiaryServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.UpdateDiaryEntry(ctx, &pb.UpdateDiaryEntryRequest{
+			Id:            int32(id),
+			UserId:        int32(c.GetInt("user_id")),
+			MealId:        req.MealID,
+			FoodId:        req.FoodID,
+			Date:          req.Date,
+			MealNumber:    req.MealNumber,
+			Servings:      req.Servings,
+			QuickCalories: req.QuickCalories,
+			Description:   req.Description,
+		})
+		if err != nil {
+			log.Printf("Error calling UpdateDiaryEntry: %v", err)
+			c.JSON(500, gin.H{"error": "Diary service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to update diary entry")
+			return
+		}
+
+		c.JSON(200, toDiaryEntryResponse(resp.Entry))
+	}
+}
+
+// deleteDiaryHandler godoc
+// @Summary      Delete Diary Entry
+// @Description  Delete one of the authenticated user's diary entries
+// @Tags         diary
+// @Produce      json
+// @Security     Bearer
+// @Param        id   path      int  true  "Diary entry ID"
+// @Success      200  {object}  MessageResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/diary/{id} [delete]
+func deleteDiaryHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(400, gin.H{"error": "Invalid diary entry ID"})
+			return
+		}
+
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Diary service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewDiaryServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.DeleteDiaryEntry(ctx, &pb.DeleteDiaryEntryRequest{
+			Id:     int32(id),
+			UserId: int32(c.GetInt("user_id")),
+		})
+		if err != nil {
+			log.Printf("Error calling DeleteDiaryEntry: %v", err)
+			c.JSON(500, gin.H{"error": "Diary service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to delete diary entry")
+			return
+		}
+
+		c.JSON(200, MessageResponse{Message: resp.Message})
+	}
+}
+
+// importDiaryHandler godoc
+// @Summary      Import Diary
+// @Description  Import a per-food diary CSV export from MyFitnessPal, Cronometer, Lose It! or a similar tracker. Columns are found by header: date, meal, food name and calories are required; servings, protein, carbs, fat, fiber, sugar and sodium are optional. Slashed dates are read month first. Breakfast, lunch, dinner and snacks are logged as meals 1 to 4 ("Meal N" as meal N). Each food is matched to the catalog by name, with servings sized to the row's calories; unmatched foods become custom foods visible only to the user. Rows dated after today or already in the diary are skipped, so re-importing a file is safe. The report lists the outcome of every line.
+// @Tags         diary
+// @Accept       multipart/form-data
+// @Produce      json
+// @Security     Bearer
+// @Param        file  formData  file  true  "Diary CSV export (at most 2 MB)"
+// @Success      200   {object}  DiaryImportResponse
+// @Failure      400   {object}  ErrorResponse
+// @Failure      401   {object}  ErrorResponse
+// @Failure      404   {object}  ErrorResponse
+// @Failure      413   {object}  ErrorResponse
+// @Failure      500   {object}  ErrorResponse
+// @Router       /api/diary/import [post]
+func importDiaryHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxDiaryImportBytes+64<<10)
+		file, err := c.FormFile("file")
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				c.JSON(413, gin.H{"error": "File too large: at most 2 MB"})
+				return
+			}
+			c.JSON(400, gin.H{"error": "file is required"})
+			return
+		}
+		if file.Size > maxDiaryImportBytes {
+			c.JSON(413, gin.H{"error": "File too large: at most 2 MB"})
+			return
+		}
+		reader, err := file.Open()
+		if err != nil {
+			c.JSON(400, gin.H{"error": "file is required"})
+			return
+		}
+		defer reader.Close()
+		data, err := io.ReadAll(reader)
+		if err != nil {
+			c.JSON(400, gin.H{"error": "file is required"})
+			return
+		}
+
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Diary service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewDiaryServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), diaryImportTimeout)
+		defer cancel()
+
+		resp, err := client.ImportDiary(ctx, &pb.ImportDiaryRequest{
+			UserId: int32(c.GetInt("user_id")),
+			Csv:    data,
+		})
+		if err != nil {
+			log.Printf("Error calling ImportDiary: %v", err)
+			c.JSON(500, gin.H{"error": "Diary service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to import diary")
+			return
+		}
+
+		report := DiaryImportResponse{
+			Matched:            resp.Matched,
+			Created:            resp.Created,
+			Skipped:            resp.Skipped,
+			CustomFoodsCreated: resp.CustomFoodsCreated,
+			Rows:               make([]DiaryImportRow, len(resp.Rows)),
+		}
+		for i, row := range resp.Rows {
+			report.Rows[i] = DiaryImportRow{
+				Line:            row.Line,
+				Status:          row.Status,
+				FoodName:        row.FoodName,
+				Date:            row.Date,
+				MealNumber:      row.MealNumber,
+				FoodID:          row.FoodId,
+				MatchedFoodName: row.MatchedFoodName,
+				Servings:        row.Servings,
+				Reason:          row.Reason,
+			}
+		}
+
+		c.JSON(200, report)
+	}
+}
+
+// draftDiaryHandler godoc
+// @Summary      Parse Diary Text
+// @Description  Parse a typed description such as "2 eggs and a cup of brown rice" into a draft diary entry. Foods are separated by commas, "with", "plus" or "and" before a quantity. Quantities can be numbers, fractions ("1/2", "1 1/2", "½") or words ("a", "two", "half a", "a dozen"); units are grams, ounces, teaspoons, tablespoons, cups and pieces, with kilograms, pounds, milliliters, liters and fluid ounces converted. Each item is resolved against the food catalog with up to three alternatives and a confidence from 0 to 1, lowered when the unit or quantity had to be assumed. Nothing is logged: confirm an item by logging its foodId and servings with POST /api/diary.
+// @Tags         diary
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        request  body      DiaryDraftRequest  true  "Typed diary entry (at most 500 characters)"
+// @Success      200      {object}  DiaryDraftResponse
+// @Failure      400      {object}  ErrorResponse
+// @Failure      401      {object}  ErrorResponse
+// @Failure      404      {object}  ErrorResponse
+// @Failure      500      {object}  ErrorResponse
+// @Router       /api/diary/parse [post]
+func draftDiaryHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req DiaryDraftRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(400, gin.H{"error": "Invalid request payload"})
+			return
+		}
+
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Diary service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewDiaryServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.DraftDiaryEntry(ctx, &pb.DraftDiaryEntryRequest{
+			UserId:     int32(c.GetInt("user_id")),
+			Text:       req.Text,
+			Date:       req.Date,
+			MealNumber: req.MealNumber,
+		})
+		if err != nil {
+			log.Printf("Error calling DraftDiaryEntry: %v", err)
+			c.JSON(500, gin.H{"error": "Diary service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to parse diary text")
+			return
+		}
+
+		draft := DiaryDraftResponse{
+			Date:       resp.Date,
+			MealNumber: resp.MealNumber,
+			Items:      make([]DiaryDraftItem, len(resp.Items)),
+			Unresolved: resp.Unresolved,
+		}
+		for i, item := range resp.Items {
+			draft.Items[i] = DiaryDraftItem{
+				Text:         item.Text,
+				Quantity:     item.Quantity,
+				Unit:         item.Unit,
+				FoodPhrase:   item.FoodPhrase,
+				Alternatives: make([]DiaryDraftMatch, len(item.Alternatives)),
+			}
+			if item.Match != nil {
+				match := toDiaryDraftMatch(item.Match)
+				draft.Items[i].Match = &match
+			}
+			for j, alternative := range item.Alternatives {
+				draft.Items[i].Alternatives[j] = toDiaryDraftMatch(alternative)
+			}
+		}
+
+		c.JSON(200, draft)
+	}
+}
+
+// toDiaryDraftMatch converts a proto draft match to its JSON representation
+func toDiaryDraftMatch(match *pb.DraftFoodMatch) DiaryDraftMatch {
+	return DiaryDraftMatch{
+		FoodID:       match.FoodId,
+		FoodName:     match.FoodName,
+		ServingUnits: match.ServingUnits,
+		Servings:     match.Servings,
+		Calories:     match.Calories,
+		Confidence:   match.Confidence,
+		Note:         match.Note,
+	}
+}
+
+// toDiaryEntryResponse converts a proto diary entry to its JSON representation
+func toDiaryEntryResponse(entry *pb.DiaryEntry) DiaryEntryResponse {
+	return DiaryEntryResponse{
+		ID:            entry.Id,
+		MealID:        entry.MealId,
+		FoodID:        entry.FoodId,
+		Date:          entry.Date,
+		MealNumber:    entry.MealNumber,
+		Servings:      entry.Servings,
+		QuickCalories: entry.QuickCalories,
+		Description:   entry.Description,
+		Name:          entry.Name,
+		Calories:      entry.Calories,
+		ProteinGrams:  entry.ProteinGrams,
+		CarbsGrams:    entry.CarbsGrams,
+		FatGrams:      entry.FatGrams,
+	}
+}
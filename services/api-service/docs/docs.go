@@ -15,14 +15,72 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/protected": {
+        "/api/check-ins": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Access a protected endpoint that requires JWT authentication",
+                "description": "List the authenticated user's check-ins, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "check-ins"
+                ],
+                "summary": "List Check-ins",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only check-ins recorded at or after this RFC 3339 time",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only check-ins recorded before this RFC 3339 time",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of check-ins (1-500, default 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.CheckInResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Log body metrics and wellbeing. Every field is optional but at least one measurement or notes is required. recordedAt defaults to now and cannot be in the future.",
                 "consumes": [
                     "application/json"
                 ],
@@ -30,14 +88,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "protected"
+                    "check-ins"
+                ],
+                "summary": "Create Check-in",
+                "parameters": [
+                    {
+                        "description": "Check-in",
+                        "name": "checkIn",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.CheckInRequest"
+                        }
+                    }
                 ],
-                "summary": "Protected Endpoint",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/main.ProtectedResponse"
+                            "$ref": "#/definitions/main.CheckInResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
                         }
                     },
                     "401": {
@@ -49,9 +124,57 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/login": {
-            "post": {
-                "description": "Authenticate user with email and password, returns JWT token",
+        "/api/check-ins/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "check-ins"
+                ],
+                "summary": "Get Check-in",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Check-in ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.CheckInResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Replace a check-in's measurements; fields left out are cleared, except recordedAt which is kept",
                 "consumes": [
                     "application/json"
                 ],
@@ -59,17 +182,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "authentication"
+                    "check-ins"
                 ],
-                "summary": "User Login",
+                "summary": "Update Check-in",
                 "parameters": [
                     {
-                        "description": "Login credentials",
-                        "name": "request",
+                        "type": "integer",
+                        "description": "Check-in ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Check-in",
+                        "name": "checkIn",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/main.LoginRequest"
+                            "$ref": "#/definitions/main.CheckInRequest"
                         }
                     }
                 ],
@@ -77,7 +207,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/main.LoginResponse"
+                            "$ref": "#/definitions/main.CheckInResponse"
                         }
                     },
                     "400": {
@@ -92,8 +222,45 @@ const docTemplate = `{
                             "$ref": "#/definitions/main.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "tags": [
+                    "check-ins"
+                ],
+                "summary": "Delete Check-in",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Check-in ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/main.ErrorResponse"
                         }
@@ -101,95 +268,6743 @@ const docTemplate = `{
                 }
             }
         },
-        "/health": {
+        "/api/cycle/logs": {
             "get": {
-                "description": "Check if the API service is healthy and running",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "Bearer": []
+                    }
                 ],
+                "description": "List the authenticated user's cycle logs, oldest first. The range defaults to the 90 days ending today in the user's timezone and may span at most 366 days.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "health"
+                    "cycle"
+                ],
+                "summary": "List Cycle Logs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "startDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD)",
+                        "name": "endDate",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Health Check",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/main.HealthResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.CycleLogResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "main.ErrorResponse": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string",
-                    "example": "Invalid request"
-                }
-            }
-        },
-        "main.HealthResponse": {
-            "type": "object",
-            "properties": {
-                "service": {
-                    "type": "string",
-                    "example": "api-service"
-                },
-                "status": {
-                    "type": "string",
-                    "example": "healthy"
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Log a period start and/or symptoms for a day, replacing any earlier log for that date. Symptoms are CRAMPS, BLOATING, FATIGUE, HEADACHE, CRAVINGS, MOOD_CHANGES, BREAST_TENDERNESS, ACNE, NAUSEA or INSOMNIA. The date defaults to today in the user's timezone. Cycle tracking must be enabled.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cycle"
+                ],
+                "summary": "Log Cycle Day",
+                "parameters": [
+                    {
+                        "description": "Cycle day",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.CycleLogRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/main.CycleLogResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
                 }
             }
         },
-        "main.LoginRequest": {
-            "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
-            "properties": {
-                "email": {
-                    "type": "string",
-                    "example": "user@example.com"
-                },
-                "password": {
+        "/api/cycle/logs/{date}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete the authenticated user's cycle log for a date",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cycle"
+                ],
+                "summary": "Delete Cycle Log",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Log date (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/cycle/phase": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Predict the cycle phase of a date from the user's logged period starts, averaging up to the last six cycles. Returns how the phase adjusts calorie targets and catalog foods rich in the phase's focus nutrient (iron while menstruating, fiber in the luteal phase), filtered by the user's preferences. The date defaults to today in the user's timezone. Rejected when the profile's biological sex is no longer FEMALE or unset.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cycle"
+                ],
+                "summary": "Get Cycle Phase",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Date (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.CyclePhaseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/cycle/tracking": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Opt in to menstrual cycle tracking. Only available when the profile's biological sex is FEMALE or not set; cycle phases stop adjusting targets if it later changes. Cycle data is stored apart from the user profile and is only ever returned to its owner.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cycle"
+                ],
+                "summary": "Enable Cycle Tracking",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.CycleTrackingResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Opt out of menstrual cycle tracking. All of the user's cycle logs are deleted and targets stop being adjusted by phase.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cycle"
+                ],
+                "summary": "Disable Cycle Tracking",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.CycleTrackingResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/diary": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "List the authenticated user's food diary for one day. The date defaults to today in the user's timezone.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "diary"
+                ],
+                "summary": "List Diary Entries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Diary date (YYYY-MM-DD)",
+                        "name": "date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.DiaryDayResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Log a meal, a single food or quick-add calories. Servings multiplies the meal totals or food serving (0.5 = half portion). The date defaults to today in the user's timezone.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "diary"
+                ],
+                "summary": "Log Diary Entry",
+                "parameters": [
+                    {
+                        "description": "Diary entry",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.DiaryEntryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/main.DiaryEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/diary/copy": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Copy a day's diary entries, or those of one meal slot (mealNumber), onto another date. toDate defaults to today in the user's timezone; toMealNumber moves a copied meal slot into another slot. Entries already on the target date are kept. Returns the target date's diary.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "diary"
+                ],
+                "summary": "Copy Diary Entries",
+                "parameters": [
+                    {
+                        "description": "Source and target of the copy",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.CopyDiaryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/main.CopyDiaryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/diary/favorites": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "List the authenticated user's favorite foods and meals by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "diary"
+                ],
+                "summary": "List Favorites",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.FavoriteResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Mark a food or meal as a favorite for quick logging. Servings (default 1) is what the favorite is usually logged with; adding a favorite again updates it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "diary"
+                ],
+                "summary": "Add Favorite",
+                "parameters": [
+                    {
+                        "description": "Favorite food or meal",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.FavoriteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/main.FavoriteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/diary/favorites/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Remove one of the authenticated user's favorites",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "diary"
+                ],
+                "summary": "Remove Favorite",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Favorite ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/diary/import": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Import a per-food diary CSV export from MyFitnessPal, Cronometer, Lose It! or a similar tracker. Columns are found by header: date, meal, food name and calories are required; servings, protein, carbs, fat, fiber, sugar and sodium are optional. Slashed dates are read month first. Breakfast, lunch, dinner and snacks are logged as meals 1 to 4 (\"Meal N\" as meal N). Each food is matched to the catalog by name, with servings sized to the row's calories; unmatched foods become custom foods visible only to the user. Rows dated after today or already in the diary are skipped, so re-importing a file is safe. The report lists the outcome of every line.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "diary"
+                ],
+                "summary": "Import Diary",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Diary CSV export (at most 2 MB)",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.DiaryImportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/diary/parse": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Parse a typed description such as \"2 eggs and a cup of brown rice\" into a draft diary entry. Foods are separated by commas, \"with\", \"plus\" or \"and\" before a quantity. Quantities can be numbers, fractions (\"1/2\", \"1 1/2\", \"½\") or words (\"a\", \"two\", \"half a\", \"a dozen\"); units are grams, ounces, teaspoons, tablespoons, cups and pieces, with kilograms, pounds, milliliters, liters and fluid ounces converted. Each item is resolved against the food catalog with up to three alternatives and a confidence from 0 to 1, lowered when the unit or quantity had to be assumed. Nothing is logged: confirm an item by logging its foodId and servings with POST /api/diary.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "diary"
+                ],
+                "summary": "Parse Diary Text",
+                "parameters": [
+                    {
+                        "description": "Typed diary entry (at most 500 characters)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.DiaryDraftRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.DiaryDraftResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/diary/recent": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "List the foods and meals the authenticated user logged in the last 30 days, most recently logged first, with the servings each was last logged with and how often it was logged. Log one again with POST /api/diary.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "diary"
+                ],
+                "summary": "List Recent Items",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Number of items (default 20, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.RecentItemResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/diary/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Edit a diary entry. Omitted fields keep their current value; an empty description clears it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "diary"
+                ],
+                "summary": "Update Diary Entry",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Diary entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.DiaryEntryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.DiaryEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete one of the authenticated user's diary entries",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "diary"
+                ],
+                "summary": "Delete Diary Entry",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Diary entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.MessageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/diary/{id}/substitute": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Swap the food of a single-food diary or meal plan entry, or one ingredient (ingredientId) of a meal entry, for a substitute. The substitute must be one the food could be replaced with (see Suggest Substitutes) and is sized the same way; a meal ingredient is stored in the substitute's serving unit and the meal's totals are recomputed. A meal also used by other entries or favorites is copied for this entry, whose mealId then changes. Returns the entry's meal with recomputed totals.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "diary"
+                ],
+                "summary": "Apply Substitute",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Diary or meal plan entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Substitute",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.ApplySubstituteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.SwappedMealResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exercises": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "List the built-in exercises and the authenticated user's custom exercises, by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workouts"
+                ],
+                "summary": "List Exercises",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only STRENGTH or ENDURANCE exercises",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.ExerciseResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Add a custom exercise. The name must not match a built-in exercise or another of the user's own (case-insensitive).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workouts"
+                ],
+                "summary": "Create Exercise",
+                "parameters": [
+                    {
+                        "description": "Exercise",
+                        "name": "exercise",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.ExerciseRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/main.ExerciseResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/exercises/{id}/records": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "The user's best sets of an exercise. Strength: estimated one-rep max (Epley, from sets of up to 12 reps), heaviest weight, most reps and best set volume. Endurance: longest distance, longest duration and fastest pace over at least 1 km. Ties keep the earliest set.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workouts"
+                ],
+                "summary": "Get Personal Records",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Exercise ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.ExerciseRecordsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/foods/barcode/{code}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Find the catalog food with a scanned EAN-8, UPC-A, EAN-13 or GTIN-14 barcode. The check digit is validated, and barcode is returned in its stored form (UPC-A codes as EAN-13). A valid barcode no food has yet responds 404 and is recorded so the product can be added to the catalog.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "foods"
+                ],
+                "summary": "Look Up Barcode",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Barcode digits",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.BarcodeFoodResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/goals": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "List available fitness goals, optionally filtered by category",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "goals"
+                ],
+                "summary": "List Goals",
+                "parameters": [
+                    {
+                        "enum": [
+                            "Weight",
+                            "Appearance",
+                            "Strength",
+                            "Endurance"
+                        ],
+                        "type": "string",
+                        "description": "Goal category",
+                        "name": "category",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.GoalResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Add a fitness goal to the catalog. Admins only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "goals"
+                ],
+                "summary": "Create Goal",
+                "parameters": [
+                    {
+                        "description": "Goal",
+                        "name": "goal",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.GoalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/main.GoalResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/goals/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "goals"
+                ],
+                "summary": "Get Goal",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Goal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.GoalResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Rename or recategorize a catalog goal. Admins only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "goals"
+                ],
+                "summary": "Update Goal",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Goal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Goal",
+                        "name": "goal",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.GoalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.GoalResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a goal; users who selected it lose the selection. Admins only.",
+                "tags": [
+                    "goals"
+                ],
+                "summary": "Delete Goal",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Goal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/meal-plan": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the authenticated user's planned meals for a date range of up to 31 days. The start date defaults to today in the user's timezone and the end date to six days later; days without a plan are returned with no meals.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "meal-plan"
+                ],
+                "summary": "Get Meal Plan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "startDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD)",
+                        "name": "endDate",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.MealPlanResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Plan meals for up to 14 days from the user's calorie and macro targets, goals and food preferences. Each main meal combines a protein, a carbohydrate, a vegetable and an added fat; small meals are single-food snacks. Portions are rounded to practical serving steps and solved so each day lands within 5% of calories, 10% of protein and 15% of carbs and fat; days that miss are listed in notes. Liked foods are favored, disliked foods, allergens and diet exclusions are never used, and nonInflammatoryOnly restricts the plan to non-inflammatory foods. favorAntiInflammatory and favorGutHealth rotate foods that improve the inflammation and gut-health scores ahead of others without excluding anything. The plan replaces any earlier plan for the same days and is kept apart from the diary.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "meal-plan"
+                ],
+                "summary": "Generate Meal Plan",
+                "parameters": [
+                    {
+                        "description": "Meal plan options",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.MealPlanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/main.MealPlanResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/meals/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get a meal with its ingredients and nutrition per serving. Preparation instructions are returned both as stored (raw, with their MARKDOWN or HTML format) and as html rendered server-side through an allowlist that removes scripts, styles, event handlers and non-http links. Clients should only ever insert html into a page.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "meals"
+                ],
+                "summary": "Get Meal",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Meal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.MealResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/meals/{id}/batch-cook": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Cook one scaled batch of a meal and plan its servings across up to 7 consecutive days in the same meal slot (1-6) of the authenticated user's plan. Anything already planned in those slots is replaced. Servings are split as evenly as quarter servings allow, with the earliest days taking any remainder. prepMinutesSaved is the prep time avoided by not cooking the meal every day.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "meals"
+                ],
+                "summary": "Batch Cook Meal",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Meal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Batch options",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.BatchCookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/main.BatchCookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/meals/{id}/scale": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Scale a meal's recipe to a number of servings (up to 50). Ingredient quantities are rounded to kitchen-friendly amounts: whole pieces, grams in 5 g steps above 100 g, quarter ounces, and spoon measures promoted to tablespoons or cups as they grow. Nutrition covers all servings.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "meals"
+                ],
+                "summary": "Scale Meal",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Meal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of servings",
+                        "name": "servings",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.ScaledMealResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/nutrition/targets": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Daily calorie and macro targets. BMR uses Mifflin-St Jeor from the user's height, weight, age and biological sex, scaled by activity level and adjusted for the user's Weight and Appearance goals. For users who opted in to cycle tracking, today's cycle phase is returned and applied (calories +5% as carbohydrate in the luteal phase).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "nutrition"
+                ],
+                "summary": "Nutrition Targets",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.NutritionTargetsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Profile is missing height, weight, birth date or sex",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/progress/weight": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Smooth daily weigh-ins from check-ins into an exponentially weighted moving average trend (days with several weigh-ins are averaged). weeklyRateKg is the trend's slope over the last 14 days, a plateau is a trend that stayed within 0.3 kg for 14 days or more, and energyBalance converts the rate into a daily calorie balance (7700 kcal per kg) and subtracts it from the average calories logged in the diary to estimate maintenance calories.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progress"
+                ],
+                "summary": "Weight Progress",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Days ending today in the user's timezone (1-365, default 90)",
+                        "name": "days",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.WeightProgressResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/protected": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Access a protected endpoint that requires JWT authentication",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "protected"
+                ],
+                "summary": "Protected Endpoint",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.ProtectedResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/reports/health-scores": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Score each logged day and meal for inflammation and gut health from the catalog foods eaten, weighted by portion. The inflammation score is the percentage of calories from foods not flagged non-inflammatory (nightshades always count), so 0 is best. The gut-health score gives up to 50 points for 1 probiotic serving and 50 for 2 prebiotic servings a day, so 100 is best; a meal is measured against its share of the day. Trends are the least-squares change per week over logged days and are omitted with fewer than two. Meals without ingredients and quick-add calories are not scored, and ingredients whose unit does not convert to their food's serving unit are left out and noted. The range defaults to the last 28 days.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Health Scores",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD), defaults to 27 days before endDate",
+                        "name": "startDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD), defaults to today",
+                        "name": "endDate",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.HealthScoresResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/reports/nutrition": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Summarize consumed calories, calories burned in workouts, net calories (consumed minus burned), macros, micronutrients and non-inflammatory/probiotic/prebiotic food counts per day, week (Monday start) or month. Day boundaries follow the user's timezone; the range defaults to the last 7 days, 4 weeks or 3 months. nutrientGaps compares every logged day with the reference intakes for the user's sex and age and flags days below a minimum (fiber, potassium, iron, calcium, vitamin D, omega-3) or above a limit (sodium); it is skipped with a note when sex or birth date is missing. Meal ingredients are converted to their food's serving unit; any in a unit that cannot be converted (e.g. pieces of a food measured in grams) are left out, counted in unconvertedIngredients and noted.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Nutrition Report",
+                "parameters": [
+                    {
+                        "enum": [
+                            "day",
+                            "week",
+                            "month"
+                        ],
+                        "type": "string",
+                        "description": "day, week or month",
+                        "name": "granularity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "startDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD), defaults to today",
+                        "name": "endDate",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.NutritionReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/shopping-list": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Build one grocery list from the authenticated user's planned meals. Meal ingredients and single planned foods are added up per food, converted to one purchasing unit per food (ounces up to a pound, then pounds; teaspoons, tablespoons or cups; whole pieces) and rounded up, then grouped into aisles by food category. The range defaults to the week starting today and may cover up to 31 days. format=markdown returns a Markdown checklist and format=text plain text.",
+                "produces": [
+                    "application/json",
+                    "text/plain",
+                    "text/markdown"
+                ],
+                "tags": [
+                    "meal-plan"
+                ],
+                "summary": "Shopping List",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "startDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD)",
+                        "name": "endDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "json (default), markdown or text",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.ShoppingListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/surveys": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "surveys"
+                ],
+                "summary": "List Surveys",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.SurveyResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create a survey without questions; publish questions with POST /api/surveys/{id}/versions. Admins only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "surveys"
+                ],
+                "summary": "Create Survey",
+                "parameters": [
+                    {
+                        "description": "Survey",
+                        "name": "survey",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.SurveyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/main.SurveyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug already exists",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/surveys/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get a survey with the questions of its current version",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "surveys"
+                ],
+                "summary": "Get Survey",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Survey ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.SurveyDetailResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update a survey's slug, title and description; questions are changed by publishing a new version. Admins only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "surveys"
+                ],
+                "summary": "Update Survey",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Survey ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Survey",
+                        "name": "survey",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.SurveyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.SurveyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Slug already exists",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a survey and its versions. Surveys that users have answered cannot be deleted. Admins only.",
+                "tags": [
+                    "surveys"
+                ],
+                "summary": "Delete Survey",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Survey ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Survey has responses",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/surveys/{id}/versions": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Publish a new immutable version of a survey's questions, which becomes the current version. Choice questions need at least two options; NUMERIC questions may set minValue and maxValue; SCALE questions need whole-number bounds with 2 to 11 steps; FREE_TEXT answers are limited to maxLength (default 2000) characters. showIf branches on an earlier choice question: the question is only asked when that answer includes one of the listed values. Questions are required unless required is false. Admins only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "surveys"
+                ],
+                "summary": "Publish Survey Version",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Survey ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Questions",
+                        "name": "version",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.SurveyVersionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/main.SurveyVersionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/surveys/{id}/versions/{version}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "surveys"
+                ],
+                "summary": "Get Survey Version",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Survey ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Version number",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.SurveyVersionResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/me/food-preferences": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the authenticated user's liked and disliked foods, allergies and diet restrictions",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "foods"
+                ],
+                "summary": "My Food Preferences",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.FoodPreferencesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/me/food-preferences/allergies": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Replace the authenticated user's allergies. Foods containing any of them are left out of the user's catalog. An empty list clears them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "foods"
+                ],
+                "summary": "Set Allergies",
+                "parameters": [
+                    {
+                        "description": "Allergies",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.AllergiesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.FoodPreferencesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/me/food-preferences/diets": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Replace the authenticated user's diet patterns. VEGETARIAN excludes meat and fish, PESCATARIAN meat, VEGAN meat, fish, milk and eggs, DAIRY_FREE foods containing milk and NIGHTSHADE_FREE nightshades. An empty list clears them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "foods"
+                ],
+                "summary": "Set Diet Restrictions",
+                "parameters": [
+                    {
+                        "description": "Diet restrictions",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.DietRestrictionsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.FoodPreferencesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/me/food-preferences/foods/{foodId}": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Like or dislike a catalog food, replacing any earlier preference. Disliked foods are left out of the user's catalog.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "foods"
+                ],
+                "summary": "Like or Dislike Food",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Food ID",
+                        "name": "foodId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Preference",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.FoodPreferenceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.FoodPreferencesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Remove the authenticated user's like or dislike of a food",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "foods"
+                ],
+                "summary": "Clear Food Preference",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Food ID",
+                        "name": "foodId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.FoodPreferencesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/me/foods": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "List the food catalog without the foods the authenticated user dislikes, is allergic to or excludes by diet. Liked foods come first. excludedCount is the number of foods hidden.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "foods"
+                ],
+                "summary": "My Food Catalog",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Food category (e.g., VEGETABLE)",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only list liked foods",
+                        "name": "likedOnly",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.UserFoodsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/me/foods/{foodId}/substitutes": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Rank the foods the authenticated user may eat that can replace an amount of a catalog food. Candidates come from the same or a compatible category (meat, fish and legumes; grains and legumes; dairy and dairy alternatives; vegetables and nightshades; fats and oils; nuts and seeds) and skip disliked foods, allergens and diet exclusions. Each is sized to the same amount when the units convert (mass, volume) or to the same calories otherwise, and ranked by macro distance. A non-inflammatory food is only replaced by non-inflammatory foods.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "foods"
+                ],
+                "summary": "Suggest Substitutes",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Food ID",
+                        "name": "foodId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Amount to replace (default 1)",
+                        "name": "quantity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unit of the amount (GRAMS, OUNCES, TSP, TBSP, CUPS, PIECES; default the food's serving unit)",
+                        "name": "unit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only suggest non-inflammatory foods",
+                        "name": "nonInflammatoryOnly",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of substitutes (default 5, max 20)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.SubstitutesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/me/goals": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "List the goals selected by the authenticated user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "goals"
+                ],
+                "summary": "My Goals",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.UserGoalResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Add a goal to the authenticated user's goals. Mutually exclusive goals are defined by the GOAL_CONFLICTS rules; a conflicting selection is rejected with one violation per already-selected goal it conflicts with.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "goals"
+                ],
+                "summary": "Select Goal",
+                "parameters": [
+                    {
+                        "description": "Goal to select",
+                        "name": "goal",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.AssignGoalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/main.UserGoalResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Goal not found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Goal already selected",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Goal conflicts with selected goals",
+                        "schema": {
+                            "$ref": "#/definitions/main.GoalConflictResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/me/goals/{goalId}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Remove a goal from the authenticated user's goals",
+                "tags": [
+                    "goals"
+                ],
+                "summary": "Deselect Goal",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Goal ID",
+                        "name": "goalId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Goal not selected",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/me/goals/{goalId}/check-ins": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Log a measurement for a quantified goal. date defaults to today in the user's timezone and cannot be in the future or before the baseline date; logging the same date again replaces the earlier value. Body metrics (body_weight, body_fat, waist, hip) are read from body check-ins instead, so they are logged with POST /api/check-ins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "goals"
+                ],
+                "summary": "Log Goal Check-in",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Goal ID",
+                        "name": "goalId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Measurement",
+                        "name": "checkIn",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.GoalCheckInRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/main.GoalCheckInResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Goal not selected",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Goal has no target, or tracks a body metric",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/me/goals/{goalId}/progress": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Progress of a quantified goal from its baseline toward the target. The weekly rate is a least-squares trend through the baseline and check-ins, and projectedDate extends it from the latest check-in. With a deadline, requiredRatePerWeek is the pace needed from today and onTrack says whether the projection meets the deadline. Body metric goals use the user's body check-ins, averaged per day and converted to the goal unit.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "goals"
+                ],
+                "summary": "Goal Progress",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Goal ID",
+                        "name": "goalId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.GoalProgressResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Goal not selected",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Goal has no target",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/me/goals/{goalId}/target": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Quantify a selected goal, e.g. lose 5 kg by March or run 10k in under 50 minutes. Body metrics must use a unit their check-ins convert to: body_weight in kg or lb, body_fat in %, waist and hip in cm or in. A target below the baseline means lower is better. baselineDate defaults to today in the user's timezone; targetDate is optional. Replacing a target keeps earlier check-ins, but progress only counts those from the new baseline date.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "goals"
+                ],
+                "summary": "Set Goal Target",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Goal ID",
+                        "name": "goalId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target",
+                        "name": "target",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.GoalTargetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.UserGoalResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Goal not selected",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/me/surveys/{surveyId}/responses": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Submit the authenticated user's answers, keyed by question key, to the current version (or the given version). Answers are an option value for SINGLE_CHOICE, a list of option values for MULTI_CHOICE, a number for NUMERIC and SCALE, and text for FREE_TEXT. Questions hidden by showIf must be left out. Every rejected answer is reported as a violation.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "surveys"
+                ],
+                "summary": "Submit Survey",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Survey ID",
+                        "name": "surveyId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Answers",
+                        "name": "response",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.SurveyAnswersRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/main.SurveyAnswersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Survey version not found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid answers",
+                        "schema": {
+                            "$ref": "#/definitions/main.SurveyValidationResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/users/me/surveys/{surveyId}/responses/latest": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the authenticated user's most recent response to a survey, in any version",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "surveys"
+                ],
+                "summary": "My Latest Survey Response",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Survey ID",
+                        "name": "surveyId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.SurveyAnswersResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "No response yet",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/workouts": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "List the authenticated user's workouts with their sets, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workouts"
+                ],
+                "summary": "List Workouts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only workouts started at or after this RFC 3339 time",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only workouts started before this RFC 3339 time",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of workouts (1-200, default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/main.WorkoutResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Log a training session with up to 100 sets, numbered in the order given. startedAt defaults to now and cannot be in the future.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workouts"
+                ],
+                "summary": "Log Workout",
+                "parameters": [
+                    {
+                        "description": "Workout",
+                        "name": "workout",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.WorkoutRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/main.WorkoutResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/workouts/import": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Import a GPX, TCX or FIT file (up to 25 MB) as an endurance workout with one set holding its duration, distance, elevation gain and heart rate; heart rate samples are stored too. The exercise follows the file's sport (running, cycling, walking, hiking, swimming, rowing) unless exerciseId is given. Calories come from the file or are estimated from the exercise's MET value and the user's weight, and count toward the nutrition report's calories burned. A file that was already imported is rejected with 409.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workouts"
+                ],
+                "summary": "Import Activity File",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "GPX, TCX or FIT activity file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Endurance exercise to log the activity as",
+                        "name": "exerciseId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/main.WorkoutResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/workouts/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workouts"
+                ],
+                "summary": "Get Workout",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Workout ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.WorkoutResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Replace a workout and all of its sets; fields left out are cleared, except startedAt which is kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workouts"
+                ],
+                "summary": "Update Workout",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Workout ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Workout",
+                        "name": "workout",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.WorkoutRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.WorkoutResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "tags": [
+                    "workouts"
+                ],
+                "summary": "Delete Workout",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Workout ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/workouts/{id}/heart-rate": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Heart rate samples of a workout imported from an activity file, at most one per second; empty for logged workouts",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workouts"
+                ],
+                "summary": "Get Workout Heart Rate",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Workout ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.WorkoutHeartRateResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/login": {
+            "post": {
+                "description": "Authenticate user with email and password, returns JWT token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "authentication"
+                ],
+                "summary": "User Login",
+                "parameters": [
+                    {
+                        "description": "Login credentials",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/main.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/main.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Check if the API service is healthy and running",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Health Check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/main.HealthResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "main.AllergiesRequest": {
+            "type": "object",
+            "properties": {
+                "allergies": {
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                        "enum": [
+                            "MILK",
+                            "EGG",
+                            "FISH",
+                            "SHELLFISH",
+                            "TREE_NUTS",
+                            "PEANUTS",
+                            "WHEAT",
+                            "SOY",
+                            "SESAME"
+                        ]
+                    },
+                    "example": [
+                        "PEANUTS"
+                    ]
+                }
+            }
+        },
+        "main.ApplySubstituteRequest": {
+            "type": "object",
+            "required": [
+                "foodId"
+            ],
+            "properties": {
+                "foodId": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "ingredientId": {
+                    "type": "integer",
+                    "example": 90
+                },
+                "nonInflammatoryOnly": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "main.AssignGoalRequest": {
+            "type": "object",
+            "properties": {
+                "goalId": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "main.BarcodeFoodResponse": {
+            "type": "object",
+            "properties": {
+                "barcode": {
+                    "type": "string",
+                    "example": "0012345678905"
+                },
+                "food": {
+                    "$ref": "#/definitions/main.FoodResponse"
+                }
+            }
+        },
+        "main.BatchCookRequest": {
+            "type": "object",
+            "required": [
+                "days",
+                "mealNumber"
+            ],
+            "properties": {
+                "days": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "mealNumber": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "servings": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "startDate": {
+                    "type": "string",
+                    "example": "2025-06-02"
+                }
+            }
+        },
+        "main.BatchCookResponse": {
+            "type": "object",
+            "properties": {
+                "batch": {
+                    "$ref": "#/definitions/main.ScaledMealResponse"
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.BatchEntryResponse"
+                    }
+                },
+                "prepMinutesSaved": {
+                    "type": "integer",
+                    "example": 80
+                }
+            }
+        },
+        "main.BatchEntryResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2025-06-02"
+                },
+                "entryId": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "mealNumber": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "servings": {
+                    "type": "number",
+                    "example": 1.5
+                }
+            }
+        },
+        "main.CheckInRequest": {
+            "type": "object",
+            "properties": {
+                "bodyFatPercent": {
+                    "type": "number",
+                    "example": 24.5
+                },
+                "energyLevel": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1,
+                    "example": 4
+                },
+                "hipCm": {
+                    "type": "number",
+                    "example": 98
+                },
+                "mood": {
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1,
+                    "example": 3
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Slept badly, big training day yesterday"
+                },
+                "recordedAt": {
+                    "type": "string",
+                    "example": "2025-03-10T07:30:00Z"
+                },
+                "sleepHours": {
+                    "type": "number",
+                    "maximum": 24,
+                    "minimum": 0,
+                    "example": 7.5
+                },
+                "waistCm": {
+                    "type": "number",
+                    "example": 78
+                },
+                "weightKg": {
+                    "type": "number",
+                    "example": 72.4
+                }
+            }
+        },
+        "main.CheckInResponse": {
+            "type": "object",
+            "properties": {
+                "bodyFatPercent": {
+                    "type": "number",
+                    "example": 24.5
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "energyLevel": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "hipCm": {
+                    "type": "number",
+                    "example": 98
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "mood": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "Slept badly, big training day yesterday"
+                },
+                "recordedAt": {
+                    "type": "string"
+                },
+                "sleepHours": {
+                    "type": "number",
+                    "example": 7.5
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "waistCm": {
+                    "type": "number",
+                    "example": 78
+                },
+                "weightKg": {
+                    "type": "number",
+                    "example": 72.4
+                }
+            }
+        },
+        "main.CopyDiaryRequest": {
+            "type": "object",
+            "required": [
+                "fromDate"
+            ],
+            "properties": {
+                "fromDate": {
+                    "type": "string",
+                    "example": "2025-03-08"
+                },
+                "mealNumber": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "toDate": {
+                    "type": "string",
+                    "example": "2025-03-09"
+                },
+                "toMealNumber": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "main.CopyDiaryResponse": {
+            "type": "object",
+            "properties": {
+                "copied": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2025-03-09"
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.DiaryEntryResponse"
+                    }
+                }
+            }
+        },
+        "main.CycleLogRequest": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2025-03-10"
+                },
+                "periodStarted": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "symptoms": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "CRAMPS",
+                        "FATIGUE"
+                    ]
+                }
+            }
+        },
+        "main.CycleLogResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2025-03-10"
+                },
+                "periodStarted": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "symptoms": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "CRAMPS",
+                        "FATIGUE"
+                    ]
+                }
+            }
+        },
+        "main.CyclePhaseResponse": {
+            "type": "object",
+            "properties": {
+                "calorieAdjustmentPercent": {
+                    "type": "number",
+                    "example": 0
+                },
+                "cycleDay": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "cycleLength": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "cyclesUsed": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2025-03-12"
+                },
+                "focusNutrient": {
+                    "type": "string",
+                    "example": "iron"
+                },
+                "lastPeriodStart": {
+                    "type": "string",
+                    "example": "2025-03-10"
+                },
+                "nextPeriodStart": {
+                    "type": "string",
+                    "example": "2025-04-09"
+                },
+                "notes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "ovulationDate": {
+                    "type": "string",
+                    "example": "2025-03-25"
+                },
+                "phase": {
+                    "type": "string",
+                    "example": "MENSTRUAL"
+                },
+                "projected": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "suggestedFoods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.SuggestedFoodResponse"
+                    }
+                }
+            }
+        },
+        "main.CycleTrackingResponse": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "main.DayScoresResponse": {
+            "type": "object",
+            "properties": {
+                "calories": {
+                    "type": "number",
+                    "example": 2150
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2025-03-10"
+                },
+                "gutHealthScore": {
+                    "type": "number",
+                    "example": 75
+                },
+                "inflammationScore": {
+                    "type": "number",
+                    "example": 18
+                },
+                "meals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.MealScoresResponse"
+                    }
+                },
+                "prebioticServings": {
+                    "type": "number",
+                    "example": 1
+                },
+                "probioticServings": {
+                    "type": "number",
+                    "example": 1
+                }
+            }
+        },
+        "main.DiaryDayResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2025-03-09"
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.DiaryEntryResponse"
+                    }
+                },
+                "totals": {
+                    "$ref": "#/definitions/main.DiaryTotals"
+                }
+            }
+        },
+        "main.DiaryDraftItem": {
+            "type": "object",
+            "properties": {
+                "alternatives": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.DiaryDraftMatch"
+                    }
+                },
+                "foodPhrase": {
+                    "type": "string",
+                    "example": "brown rice"
+                },
+                "match": {
+                    "$ref": "#/definitions/main.DiaryDraftMatch"
+                },
+                "quantity": {
+                    "type": "number",
+                    "example": 1
+                },
+                "text": {
+                    "type": "string",
+                    "example": "a cup of brown rice"
+                },
+                "unit": {
+                    "type": "string",
+                    "example": "CUPS"
+                }
+            }
+        },
+        "main.DiaryDraftMatch": {
+            "type": "object",
+            "properties": {
+                "calories": {
+                    "type": "number",
+                    "example": 216
+                },
+                "confidence": {
+                    "type": "number",
+                    "example": 1
+                },
+                "foodId": {
+                    "type": "integer",
+                    "example": 31
+                },
+                "foodName": {
+                    "type": "string",
+                    "example": "Brown Rice - Cooked"
+                },
+                "note": {
+                    "type": "string",
+                    "example": ""
+                },
+                "servingUnits": {
+                    "type": "string",
+                    "example": "CUPS"
+                },
+                "servings": {
+                    "type": "number",
+                    "example": 1
+                }
+            }
+        },
+        "main.DiaryDraftRequest": {
+            "type": "object",
+            "required": [
+                "text"
+            ],
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2025-03-09"
+                },
+                "mealNumber": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "text": {
+                    "type": "string",
+                    "example": "2 eggs and a cup of brown rice"
+                }
+            }
+        },
+        "main.DiaryDraftResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2025-03-09"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.DiaryDraftItem"
+                    }
+                },
+                "mealNumber": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "unresolved": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "main.DiaryEntryRequest": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2025-03-09"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Half portion at lunch"
+                },
+                "foodId": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "mealId": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "mealNumber": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quickCalories": {
+                    "type": "number",
+                    "example": 0
+                },
+                "servings": {
+                    "type": "number",
+                    "example": 0.5
+                }
+            }
+        },
+        "main.DiaryEntryResponse": {
+            "type": "object",
+            "properties": {
+                "calories": {
+                    "type": "number",
+                    "example": 210
+                },
+                "carbsGrams": {
+                    "type": "number",
+                    "example": 30
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2025-03-09"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Half portion at lunch"
+                },
+                "fatGrams": {
+                    "type": "number",
+                    "example": 6
+                },
+                "foodId": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 41
+                },
+                "mealId": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "mealNumber": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Overnight Oats"
+                },
+                "proteinGrams": {
+                    "type": "number",
+                    "example": 9
+                },
+                "quickCalories": {
+                    "type": "number",
+                    "example": 0
+                },
+                "servings": {
+                    "type": "number",
+                    "example": 0.5
+                }
+            }
+        },
+        "main.DiaryImportResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer",
+                    "example": 37
+                },
+                "customFoodsCreated": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "matched": {
+                    "type": "integer",
+                    "example": 412
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.DiaryImportRow"
+                    }
+                },
+                "skipped": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "main.DiaryImportRow": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2025-03-08"
+                },
+                "foodId": {
+                    "type": "integer",
+                    "example": 49
+                },
+                "foodName": {
+                    "type": "string",
+                    "example": "Bananas, raw"
+                },
+                "line": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "matchedFoodName": {
+                    "type": "string",
+                    "example": "Banana - Medium"
+                },
+                "mealNumber": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "reason": {
+                    "type": "string",
+                    "example": ""
+                },
+                "servings": {
+                    "type": "number",
+                    "example": 2
+                },
+                "status": {
+                    "type": "string",
+                    "example": "MATCHED"
+                }
+            }
+        },
+        "main.DiaryTotals": {
+            "type": "object",
+            "properties": {
+                "calories": {
+                    "type": "number",
+                    "example": 1850
+                },
+                "carbsGrams": {
+                    "type": "number",
+                    "example": 190
+                },
+                "fatGrams": {
+                    "type": "number",
+                    "example": 62
+                },
+                "proteinGrams": {
+                    "type": "number",
+                    "example": 120
+                }
+            }
+        },
+        "main.DietRestrictionsRequest": {
+            "type": "object",
+            "properties": {
+                "dietRestrictions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                        "enum": [
+                            "VEGETARIAN",
+                            "PESCATARIAN",
+                            "VEGAN",
+                            "DAIRY_FREE",
+                            "NIGHTSHADE_FREE"
+                        ]
+                    },
+                    "example": [
+                        "PESCATARIAN"
+                    ]
+                }
+            }
+        },
+        "main.EnergyBalanceResponse": {
+            "type": "object",
+            "properties": {
+                "averageIntakeCalories": {
+                    "type": "number",
+                    "example": 1800
+                },
+                "dailyBalanceCalories": {
+                    "type": "number",
+                    "example": -660
+                },
+                "endDate": {
+                    "type": "string",
+                    "example": "2025-03-28"
+                },
+                "estimatedMaintenanceCalories": {
+                    "type": "number",
+                    "example": 2460
+                },
+                "intakeDays": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "startDate": {
+                    "type": "string",
+                    "example": "2025-03-15"
+                }
+            }
+        },
+        "main.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "Invalid request"
+                }
+            }
+        },
+        "main.ExerciseRecordsResponse": {
+            "type": "object",
+            "properties": {
+                "exercise": {
+                    "$ref": "#/definitions/main.ExerciseResponse"
+                },
+                "records": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.PersonalRecordResponse"
+                    }
+                }
+            }
+        },
+        "main.ExerciseRequest": {
+            "type": "object",
+            "properties": {
+                "exerciseType": {
+                    "type": "string",
+                    "enum": [
+                        "STRENGTH",
+                        "ENDURANCE"
+                    ],
+                    "example": "ENDURANCE"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Trail Running"
+                }
+            }
+        },
+        "main.ExerciseResponse": {
+            "type": "object",
+            "properties": {
+                "custom": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "exerciseType": {
+                    "type": "string",
+                    "example": "STRENGTH"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Back Squat"
+                }
+            }
+        },
+        "main.FavoriteRequest": {
+            "type": "object",
+            "properties": {
+                "foodId": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "mealId": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "servings": {
+                    "type": "number",
+                    "example": 1.5
+                }
+            }
+        },
+        "main.FavoriteResponse": {
+            "type": "object",
+            "properties": {
+                "calories": {
+                    "type": "number",
+                    "example": 195
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "foodId": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "mealId": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Greek Yogurt - Plain"
+                },
+                "servings": {
+                    "type": "number",
+                    "example": 1.5
+                }
+            }
+        },
+        "main.FoodPreferenceRequest": {
+            "type": "object",
+            "required": [
+                "preference"
+            ],
+            "properties": {
+                "preference": {
+                    "type": "string",
+                    "enum": [
+                        "LIKE",
+                        "DISLIKE"
+                    ],
+                    "example": "LIKE"
+                }
+            }
+        },
+        "main.FoodPreferencesResponse": {
+            "type": "object",
+            "properties": {
+                "allergies": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "PEANUTS"
+                    ]
+                },
+                "dietRestrictions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "PESCATARIAN"
+                    ]
+                },
+                "dislikes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.FoodResponse"
+                    }
+                },
+                "likes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.FoodResponse"
+                    }
+                }
+            }
+        },
+        "main.FoodResponse": {
+            "type": "object",
+            "properties": {
+                "allergens": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "FISH"
+                    ]
+                },
+                "calories": {
+                    "type": "number",
+                    "example": 206
+                },
+                "carbsGrams": {
+                    "type": "number",
+                    "example": 0
+                },
+                "category": {
+                    "type": "string",
+                    "example": "FISH"
+                },
+                "fatGrams": {
+                    "type": "number",
+                    "example": 9.2
+                },
+                "foodName": {
+                    "type": "string",
+                    "example": "Salmon - Wild Atlantic"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "isNonInflammatory": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "isPrebiotic": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "isProbiotic": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "liked": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "proteinGrams": {
+                    "type": "number",
+                    "example": 28.8
+                },
+                "servingUnits": {
+                    "type": "string",
+                    "example": "OUNCES"
+                }
+            }
+        },
+        "main.GoalCheckInRequest": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2025-01-15"
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Morning, before breakfast"
+                },
+                "value": {
+                    "type": "number",
+                    "example": 78.5
+                }
+            }
+        },
+        "main.GoalCheckInResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2025-01-15"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "Morning, before breakfast"
+                },
+                "value": {
+                    "type": "number",
+                    "example": 78.5
+                }
+            }
+        },
+        "main.GoalConflictResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "goal conflicts with selected goals"
+                },
+                "violations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.ViolationResponse"
+                    }
+                }
+            }
+        },
+        "main.GoalProgressResponse": {
+            "type": "object",
+            "properties": {
+                "achieved": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "baselineDate": {
+                    "type": "string",
+                    "example": "2025-01-01"
+                },
+                "baselineValue": {
+                    "type": "number",
+                    "example": 80
+                },
+                "category": {
+                    "type": "string",
+                    "example": "Weight"
+                },
+                "checkIns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.GoalCheckInResponse"
+                    }
+                },
+                "currentValue": {
+                    "type": "number",
+                    "example": 78.5
+                },
+                "goalId": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "metric": {
+                    "type": "string",
+                    "example": "body_weight"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Lose"
+                },
+                "onTrack": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "progressPercent": {
+                    "type": "number",
+                    "example": 30
+                },
+                "projectedDate": {
+                    "type": "string",
+                    "example": "2025-02-17"
+                },
+                "ratePerWeek": {
+                    "type": "number",
+                    "example": -0.75
+                },
+                "remaining": {
+                    "type": "number",
+                    "example": 3.5
+                },
+                "requiredRatePerWeek": {
+                    "type": "number",
+                    "example": -0.54
+                },
+                "targetDate": {
+                    "type": "string",
+                    "example": "2025-03-01"
+                },
+                "targetValue": {
+                    "type": "number",
+                    "example": 75
+                },
+                "unit": {
+                    "type": "string",
+                    "example": "kg"
+                }
+            }
+        },
+        "main.GoalRefResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "example": "Weight"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Lose"
+                }
+            }
+        },
+        "main.GoalRequest": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "enum": [
+                        "Weight",
+                        "Appearance",
+                        "Strength",
+                        "Endurance"
+                    ],
+                    "example": "Weight"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "example": "Lose body weight gradually and sustainably"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "Lose"
+                }
+            }
+        },
+        "main.GoalResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "example": "Weight"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Lose body weight gradually and sustainably"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Lose"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.GoalTargetRequest": {
+            "type": "object",
+            "properties": {
+                "baselineDate": {
+                    "type": "string",
+                    "example": "2025-01-01"
+                },
+                "baselineValue": {
+                    "type": "number",
+                    "example": 80
+                },
+                "metric": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "body_weight"
+                },
+                "targetDate": {
+                    "type": "string",
+                    "example": "2025-03-01"
+                },
+                "targetValue": {
+                    "type": "number",
+                    "example": 75
+                },
+                "unit": {
+                    "type": "string",
+                    "maxLength": 20,
+                    "example": "kg"
+                }
+            }
+        },
+        "main.HealthResponse": {
+            "type": "object",
+            "properties": {
+                "service": {
+                    "type": "string",
+                    "example": "api-service"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "healthy"
+                }
+            }
+        },
+        "main.HealthScoresResponse": {
+            "type": "object",
+            "properties": {
+                "averageGutHealthScore": {
+                    "type": "number",
+                    "example": 68
+                },
+                "averageInflammationScore": {
+                    "type": "number",
+                    "example": 21
+                },
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.DayScoresResponse"
+                    }
+                },
+                "endDate": {
+                    "type": "string",
+                    "example": "2025-03-12"
+                },
+                "gutHealthTrend": {
+                    "$ref": "#/definitions/main.ScoreTrendResponse"
+                },
+                "inflammationTrend": {
+                    "$ref": "#/definitions/main.ScoreTrendResponse"
+                },
+                "notes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "startDate": {
+                    "type": "string",
+                    "example": "2025-02-13"
+                }
+            }
+        },
+        "main.HeartRateSampleResponse": {
+            "type": "object",
+            "properties": {
+                "elapsedSeconds": {
+                    "type": "integer",
+                    "example": 300
+                },
+                "heartRate": {
+                    "type": "integer",
+                    "example": 148
+                }
+            }
+        },
+        "main.LoginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "user@example.com"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 6,
+                    "example": "password123"
+                }
+            }
+        },
+        "main.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                },
+                "user": {}
+            }
+        },
+        "main.MealFoodResponse": {
+            "type": "object",
+            "properties": {
+                "calories": {
+                    "type": "number",
+                    "example": 620
+                },
+                "carbsGrams": {
+                    "type": "number",
+                    "example": 0
+                },
+                "entryId": {
+                    "type": "integer",
+                    "example": 40
+                },
+                "fatGrams": {
+                    "type": "number",
+                    "example": 28
+                },
+                "foodId": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "mealId": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Salmon - Wild Atlantic"
+                },
+                "proteinGrams": {
+                    "type": "number",
+                    "example": 88
+                },
+                "servings": {
+                    "type": "number",
+                    "example": 4
+                }
+            }
+        },
+        "main.MealIngredientResponse": {
+            "type": "object",
+            "properties": {
+                "foodId": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 90
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Chicken Breast - Skinless"
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "diced"
+                },
+                "quantity": {
+                    "type": "number",
+                    "example": 12
+                },
+                "unit": {
+                    "type": "string",
+                    "example": "OUNCES"
+                }
+            }
+        },
+        "main.MealPlanDayResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2025-03-10"
+                },
+                "meals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.PlannedMealResponse"
+                    }
+                },
+                "totals": {
+                    "$ref": "#/definitions/main.DiaryTotals"
+                }
+            }
+        },
+        "main.MealPlanRequest": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "favorAntiInflammatory": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "favorGutHealth": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "mealsPerDay": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "nonInflammatoryOnly": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "startDate": {
+                    "type": "string",
+                    "example": "2025-03-10"
+                }
+            }
+        },
+        "main.MealPlanResponse": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.MealPlanDayResponse"
+                    }
+                },
+                "endDate": {
+                    "type": "string",
+                    "example": "2025-03-16"
+                },
+                "notes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "startDate": {
+                    "type": "string",
+                    "example": "2025-03-10"
+                },
+                "targets": {
+                    "$ref": "#/definitions/main.DiaryTotals"
+                }
+            }
+        },
+        "main.MealResponse": {
+            "type": "object",
+            "properties": {
+                "calories": {
+                    "type": "number",
+                    "example": 600
+                },
+                "carbsGrams": {
+                    "type": "number",
+                    "example": 55
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Chicken, brown rice and a soft boiled egg"
+                },
+                "fatGrams": {
+                    "type": "number",
+                    "example": 18
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "ingredients": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.MealIngredientResponse"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Chicken Bowl"
+                },
+                "prepInstructions": {
+                    "$ref": "#/definitions/main.PrepInstructionsResponse"
+                },
+                "prepTime": {
+                    "type": "integer",
+                    "example": 40
+                },
+                "proteinGrams": {
+                    "type": "number",
+                    "example": 45
+                },
+                "servings": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "main.MealScoresResponse": {
+            "type": "object",
+            "properties": {
+                "calories": {
+                    "type": "number",
+                    "example": 520
+                },
+                "gutHealthScore": {
+                    "type": "number",
+                    "example": 100
+                },
+                "inflammationScore": {
+                    "type": "number",
+                    "example": 12
+                },
+                "mealNumber": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "main.MessageResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Diary entry with ID 41 deleted successfully"
+                }
+            }
+        },
+        "main.NutrientGapResponse": {
+            "type": "object",
+            "properties": {
+                "averageIntake": {
+                    "type": "number",
+                    "example": 14.4
+                },
+                "flaggedDates": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "2025-03-10",
+                        "2025-03-12"
+                    ]
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "MINIMUM"
+                },
+                "nutrient": {
+                    "type": "string",
+                    "example": "iron"
+                },
+                "percentOfReference": {
+                    "type": "number",
+                    "example": 80
+                },
+                "referenceIntake": {
+                    "type": "number",
+                    "example": 18
+                },
+                "unit": {
+                    "type": "string",
+                    "example": "mg"
+                }
+            }
+        },
+        "main.NutritionPeriodResponse": {
+            "type": "object",
+            "properties": {
+                "calciumMg": {
+                    "type": "number",
+                    "example": 4800
+                },
+                "calories": {
+                    "type": "number",
+                    "example": 9500
+                },
+                "caloriesBurned": {
+                    "type": "number",
+                    "example": 1450
+                },
+                "carbsGrams": {
+                    "type": "number",
+                    "example": 980
+                },
+                "daysLogged": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "fatGrams": {
+                    "type": "number",
+                    "example": 320
+                },
+                "fiberGrams": {
+                    "type": "number",
+                    "example": 150
+                },
+                "ironMg": {
+                    "type": "number",
+                    "example": 72
+                },
+                "netCalories": {
+                    "type": "number",
+                    "example": 8050
+                },
+                "nonInflammatoryFoods": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "omega3Grams": {
+                    "type": "number",
+                    "example": 6.5
+                },
+                "periodEnd": {
+                    "type": "string",
+                    "example": "2025-03-16"
+                },
+                "periodStart": {
+                    "type": "string",
+                    "example": "2025-03-10"
+                },
+                "potassiumMg": {
+                    "type": "number",
+                    "example": 14500
+                },
+                "prebioticFoods": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "probioticFoods": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "proteinGrams": {
+                    "type": "number",
+                    "example": 610
+                },
+                "sodiumMg": {
+                    "type": "number",
+                    "example": 11200
+                },
+                "sugarGrams": {
+                    "type": "number",
+                    "example": 210
+                },
+                "unconvertedIngredients": {
+                    "description": "Meal ingredients left out because their unit does not convert to the food's serving unit",
+                    "type": "integer",
+                    "example": 0
+                },
+                "untrackedItems": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "vitaminDMcg": {
+                    "type": "number",
+                    "example": 45
+                }
+            }
+        },
+        "main.NutritionReportResponse": {
+            "type": "object",
+            "properties": {
+                "endDate": {
+                    "type": "string",
+                    "example": "2025-03-12"
+                },
+                "granularity": {
+                    "type": "string",
+                    "example": "week"
+                },
+                "notes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "nutrientGaps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.NutrientGapResponse"
+                    }
+                },
+                "periods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.NutritionPeriodResponse"
+                    }
+                },
+                "startDate": {
+                    "type": "string",
+                    "example": "2025-02-17"
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "America/New_York"
+                }
+            }
+        },
+        "main.NutritionTargetsResponse": {
+            "type": "object",
+            "properties": {
+                "activityLevel": {
+                    "type": "string",
+                    "example": "LIGHT"
+                },
+                "bmr": {
+                    "type": "number",
+                    "example": 1300
+                },
+                "calorieAdjustmentPercent": {
+                    "type": "number",
+                    "example": -20
+                },
+                "calories": {
+                    "type": "number",
+                    "example": 1430
+                },
+                "carbsGrams": {
+                    "type": "number",
+                    "example": 117
+                },
+                "cyclePhase": {
+                    "type": "string",
+                    "example": "LUTEAL"
+                },
+                "fatGrams": {
+                    "type": "number",
+                    "example": 48
+                },
+                "goals": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Weight/Lose",
+                        "Strength/Gain"
+                    ]
+                },
+                "notes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "proteinGrams": {
+                    "type": "number",
+                    "example": 132
+                },
+                "tdee": {
+                    "type": "number",
+                    "example": 1788
+                }
+            }
+        },
+        "main.PersonalRecordResponse": {
+            "type": "object",
+            "properties": {
+                "achievedAt": {
+                    "type": "string"
+                },
+                "record": {
+                    "type": "string",
+                    "enum": [
+                        "ESTIMATED_ONE_REP_MAX",
+                        "HEAVIEST_WEIGHT",
+                        "MOST_REPS",
+                        "BEST_SET_VOLUME",
+                        "LONGEST_DISTANCE",
+                        "LONGEST_DURATION",
+                        "FASTEST_PACE"
+                    ],
+                    "example": "ESTIMATED_ONE_REP_MAX"
+                },
+                "setNumber": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "unit": {
+                    "type": "string",
+                    "example": "kg"
+                },
+                "value": {
+                    "type": "number",
+                    "example": 116.7
+                },
+                "workoutId": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "main.PlannedFoodResponse": {
+            "type": "object",
+            "properties": {
+                "calories": {
+                    "type": "number",
+                    "example": 698
+                },
+                "carbsGrams": {
+                    "type": "number",
+                    "example": 0
+                },
+                "entryId": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "fatGrams": {
+                    "type": "number",
+                    "example": 31.5
+                },
+                "foodId": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Salmon - Wild Atlantic"
+                },
+                "proteinGrams": {
+                    "type": "number",
+                    "example": 99
+                },
+                "servingUnits": {
+                    "type": "string",
+                    "example": "OUNCES"
+                },
+                "servings": {
+                    "type": "number",
+                    "example": 4.5
+                }
+            }
+        },
+        "main.PlannedMealResponse": {
+            "type": "object",
+            "properties": {
+                "foods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.PlannedFoodResponse"
+                    }
+                },
+                "mealNumber": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "totals": {
+                    "$ref": "#/definitions/main.DiaryTotals"
+                }
+            }
+        },
+        "main.PrepInstructionsResponse": {
+            "type": "object",
+            "properties": {
+                "format": {
+                    "type": "string",
+                    "example": "MARKDOWN"
+                },
+                "html": {
+                    "type": "string",
+                    "example": "\u003col\u003e\u003cli\u003eSear the \u003cstrong\u003echicken\u003c/strong\u003e\u003c/li\u003e\u003c/ol\u003e"
+                },
+                "raw": {
+                    "type": "string",
+                    "example": "1. Sear the **chicken**"
+                }
+            }
+        },
+        "main.ProtectedResponse": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "user@example.com"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "This is a protected endpoint"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "main.QuestionOptionResponse": {
+            "type": "object",
+            "properties": {
+                "label": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Yes"
+                },
+                "value": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "YES"
+                }
+            }
+        },
+        "main.RecentItemResponse": {
+            "type": "object",
+            "properties": {
+                "calories": {
+                    "type": "number",
+                    "example": 195
+                },
+                "favorite": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "foodId": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "lastLogged": {
+                    "type": "string",
+                    "example": "2025-03-09"
+                },
+                "mealId": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Greek Yogurt - Plain"
+                },
+                "servings": {
+                    "type": "number",
+                    "example": 1.5
+                },
+                "timesLogged": {
+                    "type": "integer",
+                    "example": 9
+                }
+            }
+        },
+        "main.ScaledMealResponse": {
+            "type": "object",
+            "properties": {
+                "calories": {
+                    "type": "number",
+                    "example": 1800
+                },
+                "carbsGrams": {
+                    "type": "number",
+                    "example": 165
+                },
+                "fatGrams": {
+                    "type": "number",
+                    "example": 54
+                },
+                "ingredients": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.MealIngredientResponse"
+                    }
+                },
+                "mealId": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Chicken Bowl"
+                },
+                "prepTime": {
+                    "type": "integer",
+                    "example": 40
+                },
+                "proteinGrams": {
+                    "type": "number",
+                    "example": 135
+                },
+                "recipeServings": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "servings": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "main.ScoreTrendResponse": {
+            "type": "object",
+            "properties": {
+                "direction": {
+                    "type": "string",
+                    "example": "improving"
+                },
+                "pointsPerWeek": {
+                    "type": "number",
+                    "example": -3.5
+                }
+            }
+        },
+        "main.ShoppingAisleResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "example": "FISH"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.ShoppingItemResponse"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Seafood"
+                }
+            }
+        },
+        "main.ShoppingItemResponse": {
+            "type": "object",
+            "properties": {
+                "foodId": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Salmon - Wild Atlantic"
+                },
+                "quantity": {
+                    "type": "number",
+                    "example": 1.25
+                },
+                "unit": {
+                    "type": "string",
+                    "example": "POUNDS"
+                }
+            }
+        },
+        "main.ShoppingListResponse": {
+            "type": "object",
+            "properties": {
+                "aisles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.ShoppingAisleResponse"
+                    }
+                },
+                "endDate": {
+                    "type": "string",
+                    "example": "2025-06-08"
+                },
+                "notes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "startDate": {
+                    "type": "string",
+                    "example": "2025-06-02"
+                }
+            }
+        },
+        "main.ShowIfResponse": {
+            "type": "object",
+            "properties": {
+                "questionKey": {
+                    "type": "string",
+                    "example": "exercises_regularly"
+                },
+                "values": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "YES"
+                    ]
+                }
+            }
+        },
+        "main.SubstituteResponse": {
+            "type": "object",
+            "properties": {
+                "calories": {
+                    "type": "number",
+                    "example": 620
+                },
+                "carbsGrams": {
+                    "type": "number",
+                    "example": 0
+                },
+                "category": {
+                    "type": "string",
+                    "example": "FISH"
+                },
+                "distance": {
+                    "type": "number",
+                    "example": 0.302
+                },
+                "fatGrams": {
+                    "type": "number",
+                    "example": 28
+                },
+                "foodId": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "isNonInflammatory": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "liked": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Salmon - Wild Atlantic"
+                },
+                "proteinGrams": {
+                    "type": "number",
+                    "example": 88
+                },
+                "sameCategory": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "servingUnits": {
+                    "type": "string",
+                    "example": "OUNCES"
+                },
+                "servings": {
+                    "type": "number",
+                    "example": 4
+                }
+            }
+        },
+        "main.SubstitutesResponse": {
+            "type": "object",
+            "properties": {
+                "original": {
+                    "$ref": "#/definitions/main.SubstituteResponse"
+                },
+                "substitutes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.SubstituteResponse"
+                    }
+                }
+            }
+        },
+        "main.SuggestedFoodResponse": {
+            "type": "object",
+            "properties": {
+                "calories": {
+                    "type": "number",
+                    "example": 7
+                },
+                "category": {
+                    "type": "string",
+                    "example": "VEGETABLE"
+                },
+                "foodId": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "foodName": {
+                    "type": "string",
+                    "example": "Spinach - Raw"
+                },
+                "liked": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "nutrientAmount": {
+                    "type": "number",
+                    "example": 0.8
+                },
+                "nutrientUnit": {
+                    "type": "string",
+                    "example": "mg"
+                },
+                "servingUnits": {
+                    "type": "string",
+                    "example": "CUPS"
+                }
+            }
+        },
+        "main.SurveyAnswersRequest": {
+            "type": "object",
+            "properties": {
+                "answers": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "main.SurveyAnswersResponse": {
+            "type": "object",
+            "properties": {
+                "answers": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "submittedAt": {
+                    "type": "string"
+                },
+                "surveyId": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "main.SurveyDetailResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "currentVersion": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "description": {
+                    "type": "string",
+                    "example": "How active you are at work and in your free time"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "questions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.SurveyQuestionResponse"
+                    }
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "activity-level"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Activity Level Assessment"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.SurveyQuestionRequest": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "exercise_days_per_week"
+                },
+                "maxLength": {
+                    "type": "integer",
+                    "example": 500
+                },
+                "maxValue": {
+                    "type": "number",
+                    "example": 7
+                },
+                "minValue": {
+                    "type": "number",
+                    "example": 1
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.QuestionOptionResponse"
+                    }
+                },
+                "prompt": {
                     "type": "string",
-                    "minLength": 6,
-                    "example": "password123"
+                    "maxLength": 255,
+                    "example": "How many days a week do you exercise?"
+                },
+                "required": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "showIf": {
+                    "$ref": "#/definitions/main.ShowIfResponse"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "SINGLE_CHOICE",
+                        "MULTI_CHOICE",
+                        "NUMERIC",
+                        "FREE_TEXT",
+                        "SCALE"
+                    ],
+                    "example": "NUMERIC"
                 }
             }
         },
-        "main.LoginResponse": {
+        "main.SurveyQuestionResponse": {
             "type": "object",
             "properties": {
-                "token": {
+                "id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "key": {
                     "type": "string",
-                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                    "maxLength": 50,
+                    "example": "exercise_days_per_week"
                 },
-                "user": {}
+                "maxLength": {
+                    "type": "integer",
+                    "example": 500
+                },
+                "maxValue": {
+                    "type": "number",
+                    "example": 7
+                },
+                "minValue": {
+                    "type": "number",
+                    "example": 1
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.QuestionOptionResponse"
+                    }
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "prompt": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "How many days a week do you exercise?"
+                },
+                "required": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "showIf": {
+                    "$ref": "#/definitions/main.ShowIfResponse"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "SINGLE_CHOICE",
+                        "MULTI_CHOICE",
+                        "NUMERIC",
+                        "FREE_TEXT",
+                        "SCALE"
+                    ],
+                    "example": "NUMERIC"
+                }
             }
         },
-        "main.ProtectedResponse": {
+        "main.SurveyRequest": {
             "type": "object",
             "properties": {
-                "email": {
+                "description": {
                     "type": "string",
-                    "example": "user@example.com"
+                    "maxLength": 500,
+                    "example": "How active you are at work and in your free time"
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "activity-level"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Activity Level Assessment"
+                }
+            }
+        },
+        "main.SurveyResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "currentVersion": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "description": {
+                    "type": "string",
+                    "example": "How active you are at work and in your free time"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "activity-level"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Activity Level Assessment"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.SurveyValidationResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "survey response is invalid"
+                },
+                "violations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.ViolationResponse"
+                    }
+                }
+            }
+        },
+        "main.SurveyVersionRequest": {
+            "type": "object",
+            "properties": {
+                "questions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.SurveyQuestionRequest"
+                    }
+                }
+            }
+        },
+        "main.SurveyVersionResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "questions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.SurveyQuestionResponse"
+                    }
+                },
+                "surveyId": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "main.SwappedMealResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2025-06-02"
+                },
+                "foods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.MealFoodResponse"
+                    }
+                },
+                "isPlanned": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "mealNumber": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "totals": {
+                    "$ref": "#/definitions/main.DiaryTotals"
+                }
+            }
+        },
+        "main.UserFoodsResponse": {
+            "type": "object",
+            "properties": {
+                "excludedCount": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "foods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.FoodResponse"
+                    }
+                }
+            }
+        },
+        "main.UserGoalResponse": {
+            "type": "object",
+            "properties": {
+                "baselineDate": {
+                    "type": "string",
+                    "example": "2025-01-01"
+                },
+                "baselineValue": {
+                    "type": "number",
+                    "example": 80
+                },
+                "category": {
+                    "type": "string",
+                    "example": "Weight"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Lose body weight gradually and sustainably"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "metric": {
+                    "type": "string",
+                    "example": "body_weight"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Lose"
+                },
+                "selectedAt": {
+                    "type": "string"
+                },
+                "targetDate": {
+                    "type": "string",
+                    "example": "2025-03-01"
+                },
+                "targetValue": {
+                    "type": "number",
+                    "example": 75
+                },
+                "unit": {
+                    "type": "string",
+                    "example": "kg"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.ViolationResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "GOAL_CONFLICT"
+                },
+                "conflictingGoal": {
+                    "$ref": "#/definitions/main.GoalRefResponse"
+                },
+                "field": {
+                    "type": "string",
+                    "example": "goalId"
                 },
                 "message": {
                     "type": "string",
-                    "example": "This is a protected endpoint"
+                    "example": "Weight Gain conflicts with your selected goal Weight Lose: You cannot lose and gain weight at the same time"
+                }
+            }
+        },
+        "main.WeightProgressResponse": {
+            "type": "object",
+            "properties": {
+                "endDate": {
+                    "type": "string",
+                    "example": "2025-03-28"
                 },
-                "user_id": {
+                "energyBalance": {
+                    "$ref": "#/definitions/main.EnergyBalanceResponse"
+                },
+                "latestWeightKg": {
+                    "type": "number",
+                    "example": 77.3
+                },
+                "notes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "plateau": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "plateauDays": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.WeightTrendPointResponse"
+                    }
+                },
+                "startDate": {
+                    "type": "string",
+                    "example": "2024-12-29"
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "America/Chicago"
+                },
+                "trendWeightKg": {
+                    "type": "number",
+                    "example": 78.12
+                },
+                "weeklyRateKg": {
+                    "type": "number",
+                    "example": -0.6
+                },
+                "weighIns": {
+                    "type": "integer",
+                    "example": 61
+                }
+            }
+        },
+        "main.WeightTrendPointResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2025-03-28"
+                },
+                "trendKg": {
+                    "type": "number",
+                    "example": 78.12
+                },
+                "weightKg": {
+                    "type": "number",
+                    "example": 77.3
+                }
+            }
+        },
+        "main.WorkoutHeartRateResponse": {
+            "type": "object",
+            "properties": {
+                "samples": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.HeartRateSampleResponse"
+                    }
+                },
+                "workoutId": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "main.WorkoutRequest": {
+            "type": "object",
+            "properties": {
+                "caloriesBurned": {
+                    "type": "number",
+                    "maximum": 10000,
+                    "minimum": 0,
+                    "example": 420
+                },
+                "durationMinutes": {
+                    "type": "integer",
+                    "maximum": 1440,
+                    "minimum": 1,
+                    "example": 60
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Leg day"
+                },
+                "sets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.WorkoutSetRequest"
+                    }
+                },
+                "startedAt": {
+                    "type": "string",
+                    "example": "2025-03-10T18:00:00Z"
+                }
+            }
+        },
+        "main.WorkoutResponse": {
+            "type": "object",
+            "properties": {
+                "caloriesBurned": {
+                    "type": "number",
+                    "example": 420
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "durationMinutes": {
+                    "type": "integer",
+                    "example": 60
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "Leg day"
+                },
+                "sets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/main.WorkoutSetResponse"
+                    }
+                },
+                "source": {
+                    "type": "string",
+                    "enum": [
+                        "MANUAL",
+                        "GPX",
+                        "TCX",
+                        "FIT"
+                    ],
+                    "example": "MANUAL"
+                },
+                "startedAt": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "main.WorkoutSetRequest": {
+            "type": "object",
+            "properties": {
+                "avgHeartRate": {
+                    "type": "integer",
+                    "maximum": 250,
+                    "minimum": 30,
+                    "example": 152
+                },
+                "distanceMeters": {
+                    "type": "number",
+                    "example": 5000
+                },
+                "durationSeconds": {
+                    "type": "integer",
+                    "maximum": 86400,
+                    "minimum": 1,
+                    "example": 1500
+                },
+                "elevationGainMeters": {
+                    "type": "number",
+                    "maximum": 20000,
+                    "minimum": 0,
+                    "example": 45
+                },
+                "exerciseId": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "maxHeartRate": {
+                    "type": "integer",
+                    "maximum": 250,
+                    "minimum": 30,
+                    "example": 171
+                },
+                "reps": {
+                    "type": "integer",
+                    "maximum": 1000,
+                    "minimum": 1,
+                    "example": 5
+                },
+                "weightKg": {
+                    "type": "number",
+                    "maximum": 1000,
+                    "minimum": 0,
+                    "example": 100
+                }
+            }
+        },
+        "main.WorkoutSetResponse": {
+            "type": "object",
+            "properties": {
+                "avgHeartRate": {
+                    "type": "integer",
+                    "example": 152
+                },
+                "distanceMeters": {
+                    "type": "number",
+                    "example": 5000
+                },
+                "durationSeconds": {
+                    "type": "integer",
+                    "example": 1500
+                },
+                "elevationGainMeters": {
+                    "type": "number",
+                    "example": 45
+                },
+                "estimatedOneRepMaxKg": {
+                    "type": "number",
+                    "example": 116.7
+                },
+                "exerciseId": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "exerciseName": {
+                    "type": "string",
+                    "example": "Back Squat"
+                },
+                "exerciseType": {
+                    "type": "string",
+                    "example": "STRENGTH"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 11
+                },
+                "maxHeartRate": {
+                    "type": "integer",
+                    "example": 171
+                },
+                "paceSecondsPerKm": {
+                    "type": "number",
+                    "example": 300
+                },
+                "reps": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "setNumber": {
                     "type": "integer",
                     "example": 1
+                },
+                "weightKg": {
+                    "type": "number",
+                    "example": 100
                 }
             }
         }
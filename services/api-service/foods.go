@@ -0,0 +1,388 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	pb "api-service/proto"
+	"github.com/gin-gonic/gin"
+)
+
+// FoodResponse defines a catalog food. liked is set for foods the user likes.
+type FoodResponse struct {
+	ID                int32    `json:"id" example:"1"`
+	FoodName          string   `json:"foodName" example:"Salmon - Wild Atlantic"`
+	Category          string   `json:"category" example:"FISH"`
+	ServingUnits      string   `json:"servingUnits" example:"OUNCES"`
+	Calories          float64  `json:"calories" example:"206"`
+	ProteinGrams      float64  `json:"proteinGrams" example:"28.8"`
+	CarbsGrams        float64  `json:"carbsGrams" example:"0"`
+	FatGrams          float64  `json:"fatGrams" example:"9.2"`
+	IsNonInflammatory bool     `json:"isNonInflammatory" example:"true"`
+	IsProbiotic       bool     `json:"isProbiotic" example:"false"`
+	IsPrebiotic       bool     `json:"isPrebiotic" example:"false"`
+	Allergens         []string `json:"allergens" example:"FISH"`
+	Liked             bool     `json:"liked" example:"true"`
+}
+
+// FoodPreferencesResponse defines a user's liked and disliked foods, allergies and diet restrictions
+type FoodPreferencesResponse struct {
+	Likes            []FoodResponse `json:"likes"`
+	Dislikes         []FoodResponse `json:"dislikes"`
+	Allergies        []string       `json:"allergies" example:"PEANUTS"`
+	DietRestrictions []string       `json:"dietRestrictions" example:"PESCATARIAN"`
+}
+
+// FoodPreferenceRequest defines the payload for liking or disliking a food
+type FoodPreferenceRequest struct {
+	Preference string `json:"preference" binding:"required" example:"LIKE" enums:"LIKE,DISLIKE"`
+}
+
+// AllergiesRequest defines the payload for replacing a user's allergies
+type AllergiesRequest struct {
+	Allergies []string `json:"allergies" example:"PEANUTS" enums:"MILK,EGG,FISH,SHELLFISH,TREE_NUTS,PEANUTS,WHEAT,SOY,SESAME"`
+}
+
+// DietRestrictionsRequest defines the payload for replacing a user's diet restrictions
+type DietRestrictionsRequest struct {
+	DietRestrictions []string `json:"dietRestrictions" example:"PESCATARIAN" enums:"VEGETARIAN,PESCATARIAN,VEGAN,DAIRY_FREE,NIGHTSHADE_FREE"`
+}
+
+// UserFoodsResponse defines the catalog filtered by a user's preferences
+type UserFoodsResponse struct {
+	Foods         []FoodResponse `json:"foods"`
+	ExcludedCount int32          `json:"excludedCount" example:"12"`
+}
+
+// BarcodeFoodResponse defines the catalog food found for a scanned barcode
+type BarcodeFoodResponse struct {
+	Barcode string       `json:"barcode" example:"0012345678905"`
+	Food    FoodResponse `json:"food"`
+}
+
+// getFoodPreferencesHandler godoc
+// @Summary      My Food Preferences
+// @Description  Get the authenticated user's liked and disliked foods, allergies and diet restrictions
+// @Tags         foods
+// @Produce      json
+// @Security     Bearer
+// @Success      200  {object}  FoodPreferencesResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/users/me/food-preferences [get]
+func getFoodPreferencesHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		callFoodPreferences(c, dbGatewayAddr, "Failed to get food preferences",
+			func(ctx context.Context, client pb.FoodPreferenceServiceClient, userID int32) (*pb.FoodPreferencesResponse, error) {
+				return client.GetFoodPreferences(ctx, &pb.GetFoodPreferencesRequest{UserId: userID})
+			})
+	}
+}
+
+// setFoodPreferenceHandler godoc
+// @Summary      Like or Dislike Food
+// @Description  Like or dislike a catalog food, replacing any earlier preference. Disliked foods are left out of the user's catalog.
+// @Tags         foods
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        foodId   path      int                    true  "Food ID"
+// @Param        request  body      FoodPreferenceRequest  true  "Preference"
+// @Success      200      {object}  FoodPreferencesResponse
+// @Failure      400      {object}  ErrorResponse
+// @Failure      401      {object}  ErrorResponse
+// @Failure      404      {object}  ErrorResponse
+// @Failure      500      {object}  ErrorResponse
+// @Router       /api/users/me/food-preferences/foods/{foodId} [put]
+func setFoodPreferenceHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		foodID, err := strconv.Atoi(c.Param("foodId"))
+		if err != nil {
+			c.JSON(400, gin.H{"error": "Invalid food ID"})
+			return
+		}
+
+		var req FoodPreferenceRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(400, gin.H{"error": "Invalid request payload"})
+			return
+		}
+
+		callFoodPreferences(c, dbGatewayAddr, "Failed to set food preference",
+			func(ctx context.Context, client pb.FoodPreferenceServiceClient, userID int32) (*pb.FoodPreferencesResponse, error) {
+				return client.SetFoodPreference(ctx, &pb.SetFoodPreferenceRequest{
+					UserId:     userID,
+					FoodId:     int32(foodID),
+					Preference: req.Preference,
+				})
+			})
+	}
+}
+
+// clearFoodPreferenceHandler godoc
+// @Summary      Clear Food Preference
+// @Description  Remove the authenticated user's like or dislike of a food
+// @Tags         foods
+// @Produce      json
+// @Security     Bearer
+// @Param        foodId  path      int  true  "Food ID"
+// @Success      200     {object}  FoodPreferencesResponse
+// @Failure      400     {object}  ErrorResponse
+// @Failure      401     {object}  ErrorResponse
+// @Failure      404     {object}  ErrorResponse
+// @Failure      500     {object}  ErrorResponse
+// @Router       /api/users/me/food-preferences/foods/{foodId} [delete]
+func clearFoodPreferenceHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		foodID, err := strconv.Atoi(c.Param("foodId"))
+		if err != nil {
+			c.JSON(400, gin.H{"error": "Invalid food ID"})
+			return
+		}
+
+		callFoodPreferences(c, dbGatewayAddr, "Failed to clear food preference",
+			func(ctx context.Context, client pb.FoodPreferenceServiceClient, userID int32) (*pb.FoodPreferencesResponse, error) {
+				return client.ClearFoodPreference(ctx, &pb.ClearFoodPreferenceRequest{UserId: userID, FoodId: int32(foodID)})
+			})
+	}
+}
+
+// setAllergiesHandler godoc
+// @Summary      Set Allergies
+// @Description  Replace the authenticated user's allergies. Foods containing any of them are left out of the user's catalog. An empty list clears them.
+// @Tags         foods
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        request  body      AllergiesRequest  true  "Allergies"
+// @Success      200      {object}  FoodPreferencesResponse
+// @Failure      400      {object}  ErrorResponse
+// @Failure      401      {object}  ErrorResponse
+// @Failure      404      {object}  ErrorResponse
+// @Failure      500      {object}  ErrorResponse
+// @Router       /api/users/me/food-preferences/allergies [put]
+func setAllergiesHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req AllergiesRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(400, gin.H{"error": "Invalid request payload"})
+			return
+		}
+
+		callFoodPreferences(c, dbGatewayAddr, "Failed to set allergies",
+			func(ctx context.Context, client pb.FoodPreferenceServiceClient, userID int32) (*pb.FoodPreferencesResponse, error) {
+				return client.SetAllergies(ctx, &pb.SetAllergiesRequest{UserId: userID, Allergies: req.Allergies})
+			})
+	}
+}
+
+// setDietRestrictionsHandler godoc
+// @Summary      Set Diet Restrictions
+// @Description  Replace the authenticated user's diet patterns. VEGETARIAN excludes meat and fish, PESCATARIAN meat, VEGAN meat, fish, milk and eggs, DAIRY_FREE foods containing milk and NIGHTSHADE_FREE nightshades. An empty list clears them.
+// @Tags         foods
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        request  body      DietRestrictionsRequest  true  "Diet restrictions"
+// @Success      200      {object}  FoodPreferencesResponse
+// @Failure      400      {object}  ErrorResponse
+// @Failure      401      {object}  ErrorResponse
+// @Failure      404      {object}  ErrorResponse
+// @Failure      500      {object}  ErrorResponse
+// @Router       /api/users/me/food-preferences/diets [put]
+func setDietRestrictionsHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req DietRestrictionsRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(400, gin.H{"error": "Invalid request payload"})
+			return
+		}
+
+		callFoodPreferences(c, dbGatewayAddr, "Failed to set diet restrictions",
+			func(ctx context.Context, client pb.FoodPreferenceServiceClient, userID int32) (*pb.FoodPreferencesResponse, error) {
+				return client.SetDietRestrictions(ctx, &pb.SetDietRestrictionsRequest{UserId: userID, DietRestrictions: req.DietRestrictions})
+			})
+	}
+}
+
+// listUserFoodsHandler godoc
+// @Summary      My Food Catalog
+// @Description  List the food catalog without the foods the authenticated user dislikes, is allergic to or excludes by diet. Liked foods come first. excludedCount is the number of foods hidden.
+// @Tags         foods
+// @Produce      json
+// @Security     Bearer
+// @Param        category   query     string  false  "Food category (e.g., VEGETABLE)"
+// @Param        likedOnly  query     bool    false  "Only list liked foods"
+// @Success      200        {object}  UserFoodsResponse
+// @Failure      400        {object}  ErrorResponse
+// @Failure      401        {object}  ErrorResponse
+// @Failure      500        {object}  ErrorResponse
+// @Router       /api/users/me/foods [get]
+func listUserFoodsHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		likedOnly := false
+		if value := c.Query("likedOnly"); value != "" {
+			parsed, err := strconv.ParseBool(value)
+			if err != nil {
+				c.JSON(400, gin.H{"error": "likedOnly must be true or false"})
+				return
+			}
+			likedOnly = parsed
+		}
+
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Food service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewFoodPreferenceServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.ListFoodsForUser(ctx, &pb.ListFoodsForUserRequest{
+			UserId:    int32(c.GetInt("user_id")),
+			Category:  c.Query("category"),
+			LikedOnly: likedOnly,
+		})
+		if err != nil {
+			log.Printf("Error calling ListFoodsForUser: %v", err)
+			c.JSON(500, gin.H{"error": "Food service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to list foods")
+			return
+		}
+
+		c.JSON(200, UserFoodsResponse{
+			Foods:         toFoodResponses(resp.Foods),
+			ExcludedCount: resp.ExcludedCount,
+		})
+	}
+}
+
+// lookupBarcodeHandler godoc
+// @Summary      Look Up Barcode
+// @Description  Find the catalog food with a scanned EAN-8, UPC-A, EAN-13 or GTIN-14 barcode. The check digit is validated, and barcode is returned in its stored form (UPC-A codes as EAN-13). A valid barcode no food has yet responds 404 and is recorded so the product can be added to the catalog.
+// @Tags         foods
+// @Produce      json
+// @Security     Bearer
+// @Param        code  path      string  true  "Barcode digits"
+// @Success      200   {object}  BarcodeFoodResponse
+// @Failure      400   {object}  ErrorResponse
+// @Failure      401   {object}  ErrorResponse
+// @Failure      404   {object}  ErrorResponse
+// @Failure      500   {object}  ErrorResponse
+// @Router       /api/foods/barcode/{code} [get]
+func lookupBarcodeHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Food service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewFoodPreferenceServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.LookupBarcode(ctx, &pb.LookupBarcodeRequest{
+			UserId:  int32(c.GetInt("user_id")),
+			Barcode: c.Param("code"),
+		})
+		if err != nil {
+			log.Printf("Error calling LookupBarcode: %v", err)
+			c.JSON(500, gin.H{"error": "Food service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to look up barcode")
+			return
+		}
+
+		c.JSON(200, BarcodeFoodResponse{
+			Barcode: resp.Barcode,
+			Food:    toFoodResponse(resp.Food),
+		})
+	}
+}
+
+// callFoodPreferences runs a FoodPreferenceService call for the authenticated
+// user and responds with the resulting preferences
+func callFoodPreferences(c *gin.Context, dbGatewayAddr, fallback string,
+	call func(context.Context, pb.FoodPreferenceServiceClient, int32) (*pb.FoodPreferencesResponse, error)) {
+	conn, err := dialService(dbGatewayAddr)
+	if err != nil {
+		log.Printf("Failed to connect to DB gateway: %v", err)
+		c.JSON(500, gin.H{"error": "Food service unavailable"})
+		return
+	}
+	defer conn.Close()
+
+	client := pb.NewFoodPreferenceServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+
+	resp, err := call(ctx, client, int32(c.GetInt("user_id")))
+	if err != nil {
+		log.Printf("Error calling FoodPreferenceService: %v", err)
+		c.JSON(500, gin.H{"error": "Food service unavailable"})
+		return
+	}
+	if resp.Error != "" {
+		respondServiceError(c, resp.Error, fallback)
+		return
+	}
+
+	preferences := resp.Preferences
+	c.JSON(200, FoodPreferencesResponse{
+		Likes:            toFoodResponses(preferences.Likes),
+		Dislikes:         toFoodResponses(preferences.Dislikes),
+		Allergies:        nonNilStrings(preferences.Allergies),
+		DietRestrictions: nonNilStrings(preferences.DietRestrictions),
+	})
+}
+
+func toFoodResponses(foods []*pb.Food) []FoodResponse {
+	responses := make([]FoodResponse, len(foods))
+	for i, food := range foods {
+		responses[i] = toFoodResponse(food)
+	}
+	return responses
+}
+
+func toFoodResponse(food *pb.Food) FoodResponse {
+	return FoodResponse{
+		ID:                food.Id,
+		FoodName:          food.FoodName,
+		Category:          food.Category,
+		ServingUnits:      food.ServingUnits,
+		Calories:          food.Calories,
+		ProteinGrams:      food.ProteinGrams,
+		CarbsGrams:        food.CarbsGrams,
+		FatGrams:          food.FatGrams,
+		IsNonInflammatory: food.IsNonInflammatory,
+		IsProbiotic:       food.IsProbiotic,
+		IsPrebiotic:       food.IsPrebiotic,
+		Allergens:         nonNilStrings(food.Allergens),
+		Liked:             food.Liked,
+	}
+}
+
+// nonNilStrings keeps empty lists as [] rather than null in JSON
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,269 @@
+package main
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Goal routes are served by survey-service. The handlers below forward to
+// the service proxy; they exist so each route is documented in Swagger.
+
+// GoalRequest defines the payload for creating or updating a goal
+type GoalRequest struct {
+	Category    string  `json:"category" example:"Weight" enums:"Weight,Appearance,Strength,Endurance"`
+	Name        string  `json:"name" example:"Lose" maxLength:"50"`
+	Description *string `json:"description,omitempty" example:"Lose body weight gradually and sustainably" maxLength:"200"`
+}
+
+// GoalResponse defines a fitness goal
+type GoalResponse struct {
+	ID          int       `json:"id" example:"1"`
+	Category    string    `json:"category" example:"Weight"`
+	Name        string    `json:"name" example:"Lose"`
+	Description *string   `json:"description,omitempty" example:"Lose body weight gradually and sustainably"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// AssignGoalRequest defines the payload for selecting a goal
+type AssignGoalRequest struct {
+	GoalID int `json:"goalId" example:"1"`
+}
+
+// UserGoalResponse defines a goal selected by the user
+type UserGoalResponse struct {
+	GoalResponse
+	SelectedAt    time.Time `json:"selectedAt"`
+	Metric        *string   `json:"metric,omitempty" example:"body_weight"`
+	Unit          *string   `json:"unit,omitempty" example:"kg"`
+	BaselineValue *float64  `json:"baselineValue,omitempty" example:"80"`
+	TargetValue   *float64  `json:"targetValue,omitempty" example:"75"`
+	BaselineDate  *string   `json:"baselineDate,omitempty" example:"2025-01-01"`
+	TargetDate    *string   `json:"targetDate,omitempty" example:"2025-03-01"`
+}
+
+// GoalTargetRequest defines the payload for quantifying a selected goal
+type GoalTargetRequest struct {
+	Metric        string  `json:"metric" example:"body_weight" maxLength:"50"`
+	Unit          string  `json:"unit" example:"kg" maxLength:"20"`
+	BaselineValue float64 `json:"baselineValue" example:"80"`
+	TargetValue   float64 `json:"targetValue" example:"75"`
+	BaselineDate  string  `json:"baselineDate,omitempty" example:"2025-01-01"`
+	TargetDate    string  `json:"targetDate,omitempty" example:"2025-03-01"`
+}
+
+// GoalCheckInRequest defines the payload for logging a goal measurement
+type GoalCheckInRequest struct {
+	Value float64 `json:"value" example:"78.5"`
+	Date  string  `json:"date,omitempty" example:"2025-01-15"`
+	Notes *string `json:"notes,omitempty" example:"Morning, before breakfast" maxLength:"255"`
+}
+
+// GoalCheckInResponse defines a logged goal measurement
+type GoalCheckInResponse struct {
+	ID    int     `json:"id" example:"1"`
+	Date  string  `json:"date" example:"2025-01-15"`
+	Value float64 `json:"value" example:"78.5"`
+	Notes *string `json:"notes,omitempty" example:"Morning, before breakfast"`
+}
+
+// GoalProgressResponse defines progress toward a quantified goal
+type GoalProgressResponse struct {
+	GoalID              int                   `json:"goalId" example:"1"`
+	Category            string                `json:"category" example:"Weight"`
+	Name                string                `json:"name" example:"Lose"`
+	Metric              string                `json:"metric" example:"body_weight"`
+	Unit                string                `json:"unit" example:"kg"`
+	BaselineValue       float64               `json:"baselineValue" example:"80"`
+	TargetValue         float64               `json:"targetValue" example:"75"`
+	BaselineDate        string                `json:"baselineDate" example:"2025-01-01"`
+	TargetDate          *string               `json:"targetDate,omitempty" example:"2025-03-01"`
+	CurrentValue        float64               `json:"currentValue" example:"78.5"`
+	ProgressPercent     float64               `json:"progressPercent" example:"30"`
+	Remaining           float64               `json:"remaining" example:"3.5"`
+	Achieved            bool                  `json:"achieved" example:"false"`
+	RatePerWeek         *float64              `json:"ratePerWeek,omitempty" example:"-0.75"`
+	ProjectedDate       *string               `json:"projectedDate,omitempty" example:"2025-02-17"`
+	RequiredRatePerWeek *float64              `json:"requiredRatePerWeek,omitempty" example:"-0.54"`
+	OnTrack             *bool                 `json:"onTrack,omitempty" example:"true"`
+	CheckIns            []GoalCheckInResponse `json:"checkIns"`
+}
+
+// GoalRefResponse identifies a goal in a violation
+type GoalRefResponse struct {
+	ID       int    `json:"id" example:"1"`
+	Category string `json:"category" example:"Weight"`
+	Name     string `json:"name" example:"Lose"`
+}
+
+// ViolationResponse describes a field that failed validation
+type ViolationResponse struct {
+	Field           string           `json:"field" example:"goalId"`
+	Code            string           `json:"code" example:"GOAL_CONFLICT"`
+	Message         string           `json:"message" example:"Weight Gain conflicts with your selected goal Weight Lose: You cannot lose and gain weight at the same time"`
+	ConflictingGoal *GoalRefResponse `json:"conflictingGoal,omitempty"`
+}
+
+// GoalConflictResponse is returned when a selected goal conflicts with the user's goals
+type GoalConflictResponse struct {
+	Error      string              `json:"error" example:"goal conflicts with selected goals"`
+	Violations []ViolationResponse `json:"violations"`
+}
+
+// listGoalsHandler godoc
+// @Summary      List Goals
+// @Description  List available fitness goals, optionally filtered by category
+// @Tags         goals
+// @Produce      json
+// @Security     Bearer
+// @Param        category  query     string  false  "Goal category"  Enums(Weight, Appearance, Strength, Endurance)
+// @Success      200       {array}   GoalResponse
+// @Failure      400       {object}  ErrorResponse
+// @Failure      401       {object}  ErrorResponse
+// @Router       /api/goals [get]
+func listGoalsHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// createGoalHandler godoc
+// @Summary      Create Goal
+// @Description  Add a fitness goal to the catalog. Admins only.
+// @Tags         goals
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        goal  body      GoalRequest  true  "Goal"
+// @Success      201   {object}  GoalResponse
+// @Failure      400   {object}  ErrorResponse
+// @Failure      401   {object}  ErrorResponse
+// @Failure      403   {object}  ErrorResponse
+// @Router       /api/goals [post]
+func createGoalHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// getGoalHandler godoc
+// @Summary      Get Goal
+// @Tags         goals
+// @Produce      json
+// @Security     Bearer
+// @Param        id   path      int  true  "Goal ID"
+// @Success      200  {object}  GoalResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /api/goals/{id} [get]
+func getGoalHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// updateGoalHandler godoc
+// @Summary      Update Goal
+// @Description  Rename or recategorize a catalog goal. Admins only.
+// @Tags         goals
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id    path      int          true  "Goal ID"
+// @Param        goal  body      GoalRequest  true  "Goal"
+// @Success      200   {object}  GoalResponse
+// @Failure      400   {object}  ErrorResponse
+// @Failure      401   {object}  ErrorResponse
+// @Failure      403   {object}  ErrorResponse
+// @Failure      404   {object}  ErrorResponse
+// @Router       /api/goals/{id} [put]
+func updateGoalHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// deleteGoalHandler godoc
+// @Summary      Delete Goal
+// @Description  Delete a goal; users who selected it lose the selection. Admins only.
+// @Tags         goals
+// @Security     Bearer
+// @Param        id  path  int  true  "Goal ID"
+// @Success      204
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /api/goals/{id} [delete]
+func deleteGoalHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// listUserGoalsHandler godoc
+// @Summary      My Goals
+// @Description  List the goals selected by the authenticated user
+// @Tags         goals
+// @Produce      json
+// @Security     Bearer
+// @Success      200  {array}   UserGoalResponse
+// @Failure      401  {object}  ErrorResponse
+// @Router       /api/users/me/goals [get]
+func listUserGoalsHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// assignUserGoalHandler godoc
+// @Summary      Select Goal
+// @Description  Add a goal to the authenticated user's goals. Mutually exclusive goals are defined by the GOAL_CONFLICTS rules; a conflicting selection is rejected with one violation per already-selected goal it conflicts with.
+// @Tags         goals
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        goal  body      AssignGoalRequest  true  "Goal to select"
+// @Success      201   {object}  UserGoalResponse
+// @Failure      400   {object}  ErrorResponse
+// @Failure      401   {object}  ErrorResponse
+// @Failure      404   {object}  ErrorResponse  "Goal not found"
+// @Failure      409   {object}  ErrorResponse  "Goal already selected"
+// @Failure      422   {object}  GoalConflictResponse  "Goal conflicts with selected goals"
+// @Router       /api/users/me/goals [post]
+func assignUserGoalHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// unassignUserGoalHandler godoc
+// @Summary      Deselect Goal
+// @Description  Remove a goal from the authenticated user's goals
+// @Tags         goals
+// @Security     Bearer
+// @Param        goalId  path  int  true  "Goal ID"
+// @Success      204
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse  "Goal not selected"
+// @Router       /api/users/me/goals/{goalId} [delete]
+func unassignUserGoalHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// setUserGoalTargetHandler godoc
+// @Summary      Set Goal Target
+// @Description  Quantify a selected goal, e.g. lose 5 kg by March or run 10k in under 50 minutes. Body metrics must use a unit their check-ins convert to: body_weight in kg or lb, body_fat in %, waist and hip in cm or in. A target below the baseline means lower is better. baselineDate defaults to today in the user's timezone; targetDate is optional. Replacing a target keeps earlier check-ins, but progress only counts those from the new baseline date.
+// @Tags         goals
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        goalId  path      int                true  "Goal ID"
+// @Param        target  body      GoalTargetRequest  true  "Target"
+// @Success      200     {object}  UserGoalResponse
+// @Failure      400     {object}  ErrorResponse
+// @Failure      401     {object}  ErrorResponse
+// @Failure      404     {object}  ErrorResponse  "Goal not selected"
+// @Router       /api/users/me/goals/{goalId}/target [put]
+func setUserGoalTargetHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// logGoalCheckInHandler godoc
+// @Summary      Log Goal Check-in
+// @Description  Log a measurement for a quantified goal. date defaults to today in the user's timezone and cannot be in the future or before the baseline date; logging the same date again replaces the earlier value. Body metrics (body_weight, body_fat, waist, hip) are read from body check-ins instead, so they are logged with POST /api/check-ins.
+// @Tags         goals
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        goalId   path      int                 true  "Goal ID"
+// @Param        checkIn  body      GoalCheckInRequest  true  "Measurement"
+// @Success      201      {object}  GoalCheckInResponse
+// @Failure      400      {object}  ErrorResponse
+// @Failure      401      {object}  ErrorResponse
+// @Failure      404      {object}  ErrorResponse  "Goal not selected"
+// @Failure      409      {object}  ErrorResponse  "Goal has no target, or tracks a body metric"
+// @Router       /api/users/me/goals/{goalId}/check-ins [post]
+func logGoalCheckInHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// getUserGoalProgressHandler godoc
+// @Summary      Goal Progress
+// @Description  Progress of a quantified goal from its baseline toward the target. The weekly rate is a least-squares trend through the baseline and check-ins, and projectedDate extends it from the latest check-in. With a deadline, requiredRatePerWeek is the pace needed from today and onTrack says whether the projection meets the deadline. Body metric goals use the user's body check-ins, averaged per day and converted to the goal unit.
+// @Tags         goals
+// @Produce      json
+// @Security     Bearer
+// @Param        goalId  path      int  true  "Goal ID"
+// @Success      200     {object}  GoalProgressResponse
+// @Failure      401     {object}  ErrorResponse
+// @Failure      404     {object}  ErrorResponse  "Goal not selected"
+// @Failure      409     {object}  ErrorResponse  "Goal has no target"
+// @Router       /api/users/me/goals/{goalId}/progress [get]
+func getUserGoalProgressHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
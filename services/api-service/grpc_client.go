@@ -0,0 +1,43 @@
+package main
+
+import (
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// callTimeout bounds each gRPC call made on behalf of an HTTP request
+const callTimeout = 5 * time.Second
+
+// dialService opens a gRPC connection to an internal service
+func dialService(addr string) (*grpc.ClientConn, error) {
+	return grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+}
+
+// serviceErrorStatus maps an error message returned by a microservice to an HTTP status
+func serviceErrorStatus(message string) int {
+	switch {
+	case strings.Contains(message, "not found"):
+		return 404
+	case strings.HasPrefix(message, "invalid"), strings.Contains(message, "required"):
+		return 400
+	case strings.Contains(message, "not enabled"):
+		return 409
+	default:
+		return 500
+	}
+}
+
+// respondServiceError writes a microservice error, hiding internal details behind fallback
+func respondServiceError(c *gin.Context, message, fallback string) {
+	status := serviceErrorStatus(message)
+	if status == 500 {
+		log.Printf("%s: %s", fallback, message)
+		message = fallback
+	}
+	c.JSON(status, ErrorResponse{Error: message})
+}
@@ -36,6 +36,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -72,7 +73,12 @@ func main() {
 	// Environment variables
 	port := getEnv("SERVICE_PORT", "8080")
 	userServiceAddr := getEnv("USER_SERVICE_ADDR", "user-service:8082")
+	dbGatewayAddr := getEnv("DB_GATEWAY_ADDR", "db-gateway-service:8086")
+	surveyServiceURL := getEnv("SURVEY_SERVICE_URL", "http://survey-service:8080")
+	checkInServiceURL := getEnv("CHECK_IN_SERVICE_URL", "http://check-in-service:8080")
+	workoutServiceURL := getEnv("WORKOUT_SERVICE_URL", "http://workout-service:8080")
 	jwtSecret := getEnv("JWT_SECRET", "your-super-secret-jwt-key-change-in-production")
+	adminEmails := parseAdminEmails(getEnv("ADMIN_EMAILS", ""))
 
 	// Create Gin router
 	r := gin.Default()
@@ -107,10 +113,159 @@ func main() {
 	api := r.Group("/api")
 	{
 		api.GET("/protected", authMiddleware(jwtSecret), protectedEndpoint)
+
+		// Food diary (meal-service is not implemented yet, so these go straight to the DB gateway)
+		diary := api.Group("/diary", authMiddleware(jwtSecret))
+		{
+			diary.GET("", listDiaryHandler(dbGatewayAddr))
+			diary.POST("", logDiaryHandler(dbGatewayAddr))
+			diary.POST("/import", importDiaryHandler(dbGatewayAddr))
+			diary.POST("/parse", draftDiaryHandler(dbGatewayAddr))
+			diary.POST("/copy", copyDiaryHandler(dbGatewayAddr))
+			diary.GET("/recent", listRecentItemsHandler(dbGatewayAddr))
+			diary.GET("/favorites", listFavoritesHandler(dbGatewayAddr))
+			diary.POST("/favorites", addFavoriteHandler(dbGatewayAddr))
+			diary.DELETE("/favorites/:id", removeFavoriteHandler(dbGatewayAddr))
+			diary.PUT("/:id", updateDiaryHandler(dbGatewayAddr))
+			diary.DELETE("/:id", deleteDiaryHandler(dbGatewayAddr))
+			diary.POST("/:id/substitute", applySubstituteHandler(dbGatewayAddr))
+		}
+
+		reports := api.Group("/reports", authMiddleware(jwtSecret))
+		{
+			reports.GET("/nutrition", nutritionReportHandler(dbGatewayAddr))
+			reports.GET("/health-scores", healthScoresHandler(dbGatewayAddr))
+		}
+
+		nutrition := api.Group("/nutrition", authMiddleware(jwtSecret))
+		{
+			nutrition.GET("/targets", nutritionTargetsHandler(dbGatewayAddr))
+		}
+
+		// Menstrual cycle tracking (opt-in; cycle data is only returned to its owner)
+		cycle := api.Group("/cycle", authMiddleware(jwtSecret))
+		{
+			cycle.POST("/tracking", enableCycleTrackingHandler(dbGatewayAddr))
+			cycle.DELETE("/tracking", disableCycleTrackingHandler(dbGatewayAddr))
+			cycle.GET("/logs", listCycleLogsHandler(dbGatewayAddr))
+			cycle.POST("/logs", logCycleDayHandler(dbGatewayAddr))
+			cycle.DELETE("/logs/:date", deleteCycleLogHandler(dbGatewayAddr))
+			cycle.GET("/phase", getCyclePhaseHandler(dbGatewayAddr))
+		}
+
+		mealPlan := api.Group("/meal-plan", authMiddleware(jwtSecret))
+		{
+			mealPlan.GET("", getMealPlanHandler(dbGatewayAddr))
+			mealPlan.POST("", generateMealPlanHandler(dbGatewayAddr))
+		}
+		api.GET("/shopping-list", authMiddleware(jwtSecret), shoppingListHandler(dbGatewayAddr))
+
+		meals := api.Group("/meals", authMiddleware(jwtSecret))
+		{
+			meals.GET("/:id", getMealHandler(dbGatewayAddr))
+			meals.GET("/:id/scale", scaleMealHandler(dbGatewayAddr))
+			meals.POST("/:id/batch-cook", batchCookHandler(dbGatewayAddr))
+		}
+
+		progress := api.Group("/progress", authMiddleware(jwtSecret))
+		{
+			progress.GET("/weight", weightProgressHandler(dbGatewayAddr))
+		}
+
+		foods := api.Group("/foods", authMiddleware(jwtSecret))
+		{
+			foods.GET("/barcode/:code", lookupBarcodeHandler(dbGatewayAddr))
+		}
+
+		// Food preferences, the catalog filtered by them and substitutes
+		api.GET("/users/me/foods", authMiddleware(jwtSecret), listUserFoodsHandler(dbGatewayAddr))
+		api.GET("/users/me/foods/:foodId/substitutes", authMiddleware(jwtSecret), suggestSubstitutesHandler(dbGatewayAddr))
+		foodPreferences := api.Group("/users/me/food-preferences", authMiddleware(jwtSecret))
+		{
+			foodPreferences.GET("", getFoodPreferencesHandler(dbGatewayAddr))
+			foodPreferences.PUT("/foods/:foodId", setFoodPreferenceHandler(dbGatewayAddr))
+			foodPreferences.DELETE("/foods/:foodId", clearFoodPreferenceHandler(dbGatewayAddr))
+			foodPreferences.PUT("/allergies", setAllergiesHandler(dbGatewayAddr))
+			foodPreferences.PUT("/diets", setDietRestrictionsHandler(dbGatewayAddr))
+		}
+
+		// Goals (proxied to survey-service)
+		surveyProxy := serviceProxy(surveyServiceURL, "Survey service")
+		goals := api.Group("/goals", authMiddleware(jwtSecret))
+		{
+			goals.GET("", listGoalsHandler(surveyProxy))
+			goals.POST("", adminMiddleware(adminEmails), createGoalHandler(surveyProxy))
+			goals.GET("/:id", getGoalHandler(surveyProxy))
+			goals.PUT("/:id", adminMiddleware(adminEmails), updateGoalHandler(surveyProxy))
+			goals.DELETE("/:id", adminMiddleware(adminEmails), deleteGoalHandler(surveyProxy))
+		}
+
+		myGoals := api.Group("/users/me/goals", authMiddleware(jwtSecret))
+		{
+			myGoals.GET("", listUserGoalsHandler(surveyProxy))
+			myGoals.POST("", assignUserGoalHandler(surveyProxy))
+			myGoals.DELETE("/:goalId", unassignUserGoalHandler(surveyProxy))
+			myGoals.PUT("/:goalId/target", setUserGoalTargetHandler(surveyProxy))
+			myGoals.POST("/:goalId/check-ins", logGoalCheckInHandler(surveyProxy))
+			myGoals.GET("/:goalId/progress", getUserGoalProgressHandler(surveyProxy))
+		}
+
+		// Surveys (proxied to survey-service)
+		surveys := api.Group("/surveys", authMiddleware(jwtSecret))
+		{
+			surveys.GET("", listSurveysHandler(surveyProxy))
+			surveys.POST("", adminMiddleware(adminEmails), createSurveyHandler(surveyProxy))
+			surveys.GET("/:id", getSurveyHandler(surveyProxy))
+			surveys.PUT("/:id", adminMiddleware(adminEmails), updateSurveyHandler(surveyProxy))
+			surveys.DELETE("/:id", adminMiddleware(adminEmails), deleteSurveyHandler(surveyProxy))
+			surveys.POST("/:id/versions", adminMiddleware(adminEmails), createSurveyVersionHandler(surveyProxy))
+			surveys.GET("/:id/versions/:version", getSurveyVersionHandler(surveyProxy))
+		}
+
+		mySurveys := api.Group("/users/me/surveys", authMiddleware(jwtSecret))
+		{
+			mySurveys.POST("/:surveyId/responses", submitSurveyResponseHandler(surveyProxy))
+			mySurveys.GET("/:surveyId/responses/latest", getLatestSurveyResponseHandler(surveyProxy))
+		}
+
+		// Body metrics and wellbeing check-ins (proxied to check-in-service)
+		checkInProxy := serviceProxy(checkInServiceURL, "Check-in service")
+		checkIns := api.Group("/check-ins", authMiddleware(jwtSecret))
+		{
+			checkIns.GET("", listCheckInsHandler(checkInProxy))
+			checkIns.POST("", createCheckInHandler(checkInProxy))
+			checkIns.GET("/:id", getCheckInHandler(checkInProxy))
+			checkIns.PUT("/:id", updateCheckInHandler(checkInProxy))
+			checkIns.DELETE("/:id", deleteCheckInHandler(checkInProxy))
+		}
+
+		// Exercises, workouts and personal records (proxied to workout-service)
+		workoutProxy := serviceProxy(workoutServiceURL, "Workout service")
+		exercises := api.Group("/exercises", authMiddleware(jwtSecret))
+		{
+			exercises.GET("", listExercisesHandler(workoutProxy))
+			exercises.POST("", createExerciseHandler(workoutProxy))
+			exercises.GET("/:id/records", getExerciseRecordsHandler(workoutProxy))
+		}
+
+		workouts := api.Group("/workouts", authMiddleware(jwtSecret))
+		{
+			workouts.GET("", listWorkoutsHandler(workoutProxy))
+			workouts.POST("", createWorkoutHandler(workoutProxy))
+			workouts.POST("/import", importWorkoutHandler(workoutProxy))
+			workouts.GET("/:id", getWorkoutHandler(workoutProxy))
+			workouts.PUT("/:id", updateWorkoutHandler(workoutProxy))
+			workouts.DELETE("/:id", deleteWorkoutHandler(workoutProxy))
+			workouts.GET("/:id/heart-rate", getWorkoutHeartRateHandler(workoutProxy))
+		}
 	}
 
 	log.Printf("API service starting on port %s", port)
 	log.Printf("User service address: %s", userServiceAddr)
+	log.Printf("DB gateway address: %s", dbGatewayAddr)
+	log.Printf("Survey service URL: %s", surveyServiceURL)
+	log.Printf("Check-in service URL: %s", checkInServiceURL)
+	log.Printf("Workout service URL: %s", workoutServiceURL)
 	r.Run(":" + port)
 }
 
@@ -246,6 +401,31 @@ func authMiddleware(jwtSecret string) gin.HandlerFunc {
 	}
 }
 
+// adminMiddleware lets only admins through to shared catalog writes. It runs
+// after authMiddleware and checks the email of the signed token against the
+// ADMIN_EMAILS list; with no admins configured, catalog writes are disabled.
+func adminMiddleware(adminEmails map[string]bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !adminEmails[strings.ToLower(c.GetString("email"))] {
+			c.JSON(403, gin.H{"error": "Admin access required"})
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// parseAdminEmails reads a comma-separated list of admin emails
+func parseAdminEmails(list string) map[string]bool {
+	emails := map[string]bool{}
+	for _, email := range strings.Split(list, ",") {
+		if email = strings.ToLower(strings.TrimSpace(email)); email != "" {
+			emails[email] = true
+		}
+	}
+	return emails
+}
+
 // HealthResponse defines the response structure for health checks
 type HealthResponse struct {
 	Status  string `json:"status" example:"healthy"`
@@ -0,0 +1,221 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	pb "api-service/proto"
+	"github.com/gin-gonic/gin"
+)
+
+// MealPlanRequest defines the request payload for generating a meal plan.
+// Zero values fall back to the defaults: today, 7 days and meals per day from the user's goals.
+type MealPlanRequest struct {
+	StartDate             string `json:"startDate" example:"2025-03-10"`
+	Days                  int32  `json:"days" example:"7"`
+	MealsPerDay           int32  `json:"mealsPerDay" example:"0"`
+	NonInflammatoryOnly   bool   `json:"nonInflammatoryOnly" example:"true"`
+	FavorAntiInflammatory bool   `json:"favorAntiInflammatory" example:"false"`
+	FavorGutHealth        bool   `json:"favorGutHealth" example:"true"`
+}
+
+// PlannedFoodResponse defines one planned food with nutrition scaled by servings
+type PlannedFoodResponse struct {
+	EntryID      int32   `json:"entryId" example:"120"`
+	FoodID       int32   `json:"foodId" example:"1"`
+	Name         string  `json:"name" example:"Salmon - Wild Atlantic"`
+	ServingUnits string  `json:"servingUnits" example:"OUNCES"`
+	Servings     float64 `json:"servings" example:"4.5"`
+	Calories     float64 `json:"calories" example:"698"`
+	ProteinGrams float64 `json:"proteinGrams" example:"99"`
+	CarbsGrams   float64 `json:"carbsGrams" example:"0"`
+	FatGrams     float64 `json:"fatGrams" example:"31.5"`
+}
+
+// PlannedMealResponse defines the foods planned for one meal with their totals
+type PlannedMealResponse struct {
+	MealNumber int32                 `json:"mealNumber" example:"1"`
+	Foods      []PlannedFoodResponse `json:"foods"`
+	Totals     DiaryTotals           `json:"totals"`
+}
+
+// MealPlanDayResponse defines the meals planned for one day
+type MealPlanDayResponse struct {
+	Date   string                `json:"date" example:"2025-03-10"`
+	Meals  []PlannedMealResponse `json:"meals"`
+	Totals DiaryTotals           `json:"totals"`
+}
+
+// MealPlanResponse defines a meal plan over a date range. Targets are only
+// returned when the plan was just generated.
+type MealPlanResponse struct {
+	StartDate string                `json:"startDate" example:"2025-03-10"`
+	EndDate   string                `json:"endDate" example:"2025-03-16"`
+	Days      []MealPlanDayResponse `json:"days"`
+	Targets   *DiaryTotals          `json:"targets,omitempty"`
+	Notes     []string              `json:"notes"`
+}
+
+// generateMealPlanHandler godoc
+// @Summary      Generate Meal Plan
+// @Description  Plan meals for up to 14 days from the user's calorie and macro targets, goals and food preferences. Each main meal combines a protein, a carbohydrate, a vegetable and an added fat; small meals are single-food snacks. Portions are rounded to practical serving steps and solved so each day lands within 5% of calories, 10% of protein and 15% of carbs and fat; days that miss are listed in notes. Liked foods are favored, disliked foods, allergens and diet exclusions are never used, and nonInflammatoryOnly restricts the plan to non-inflammatory foods. favorAntiInflammatory and favorGutHealth rotate foods that improve the inflammation and gut-health scores ahead of others without excluding anything. The plan replaces any earlier plan for the same days and is kept apart from the diary.
+// @Tags         meal-plan
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        request  body      MealPlanRequest  true  "Meal plan options"
+// @Success      201      {object}  MealPlanResponse
+// @Failure      400      {object}  ErrorResponse
+// @Failure      401      {object}  ErrorResponse
+// @Failure      404      {object}  ErrorResponse
+// @Failure      500      {object}  ErrorResponse
+// @Router       /api/meal-plan [post]
+func generateMealPlanHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req MealPlanRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(400, gin.H{"error": "Invalid request payload"})
+			return
+		}
+
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Meal plan service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewMealPlanServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.GenerateMealPlan(ctx, &pb.GenerateMealPlanRequest{
+			UserId:                int32(c.GetInt("user_id")),
+			StartDate:             req.StartDate,
+			Days:                  req.Days,
+			MealsPerDay:           req.MealsPerDay,
+			NonInflammatoryOnly:   req.NonInflammatoryOnly,
+			FavorAntiInflammatory: req.FavorAntiInflammatory,
+			FavorGutHealth:        req.FavorGutHealth,
+		})
+		if err != nil {
+			log.Printf("Error calling GenerateMealPlan: %v", err)
+			c.JSON(500, gin.H{"error": "Meal plan service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to generate meal plan")
+			return
+		}
+
+		c.JSON(201, toMealPlanResponse(resp.Plan))
+	}
+}
+
+// getMealPlanHandler godoc
+// @Summary      Get Meal Plan
+// @Description  Get the authenticated user's planned meals for a date range of up to 31 days. The start date defaults to today in the user's timezone and the end date to six days later; days without a plan are returned with no meals.
+// @Tags         meal-plan
+// @Produce      json
+// @Security     Bearer
+// @Param        startDate  query     string  false  "First day (YYYY-MM-DD)"
+// @Param        endDate    query     string  false  "Last day (YYYY-MM-DD)"
+// @Success      200        {object}  MealPlanResponse
+// @Failure      400        {object}  ErrorResponse
+// @Failure      401        {object}  ErrorResponse
+// @Failure      500        {object}  ErrorResponse
+// @Router       /api/meal-plan [get]
+func getMealPlanHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Meal plan service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewMealPlanServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.GetMealPlan(ctx, &pb.GetMealPlanRequest{
+			UserId:    int32(c.GetInt("user_id")),
+			StartDate: c.Query("startDate"),
+			EndDate:   c.Query("endDate"),
+		})
+		if err != nil {
+			log.Printf("Error calling GetMealPlan: %v", err)
+			c.JSON(500, gin.H{"error": "Meal plan service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to get meal plan")
+			return
+		}
+
+		c.JSON(200, toMealPlanResponse(resp.Plan))
+	}
+}
+
+// toMealPlanResponse converts a protobuf meal plan into its JSON response
+func toMealPlanResponse(plan *pb.MealPlan) MealPlanResponse {
+	result := MealPlanResponse{
+		StartDate: plan.StartDate,
+		EndDate:   plan.EndDate,
+		Days:      make([]MealPlanDayResponse, len(plan.Days)),
+		Notes:     nonNilStrings(plan.Notes),
+	}
+	if plan.Targets != nil {
+		result.Targets = &DiaryTotals{
+			Calories:     plan.Targets.Calories,
+			ProteinGrams: plan.Targets.ProteinGrams,
+			CarbsGrams:   plan.Targets.CarbsGrams,
+			FatGrams:     plan.Targets.FatGrams,
+		}
+	}
+
+	for i, day := range plan.Days {
+		result.Days[i] = MealPlanDayResponse{
+			Date:  day.Date,
+			Meals: make([]PlannedMealResponse, len(day.Meals)),
+			Totals: DiaryTotals{
+				Calories:     day.Calories,
+				ProteinGrams: day.ProteinGrams,
+				CarbsGrams:   day.CarbsGrams,
+				FatGrams:     day.FatGrams,
+			},
+		}
+		for j, meal := range day.Meals {
+			foods := make([]PlannedFoodResponse, len(meal.Foods))
+			for k, food := range meal.Foods {
+				foods[k] = PlannedFoodResponse{
+					EntryID:      food.EntryId,
+					FoodID:       food.FoodId,
+					Name:         food.Name,
+					ServingUnits: food.ServingUnits,
+					Servings:     food.Servings,
+					Calories:     food.Calories,
+					ProteinGrams: food.ProteinGrams,
+					CarbsGrams:   food.CarbsGrams,
+					FatGrams:     food.FatGrams,
+				}
+			}
+			result.Days[i].Meals[j] = PlannedMealResponse{
+				MealNumber: meal.MealNumber,
+				Foods:      foods,
+				Totals: DiaryTotals{
+					Calories:     meal.Calories,
+					ProteinGrams: meal.ProteinGrams,
+					CarbsGrams:   meal.CarbsGrams,
+					FatGrams:     meal.FatGrams,
+				},
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,320 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	pb "api-service/proto"
+	"github.com/gin-gonic/gin"
+)
+
+// MealIngredientResponse defines one ingredient of a meal in kitchen-friendly units
+type MealIngredientResponse struct {
+	ID       int32   `json:"id,omitempty" example:"90"`
+	FoodID   int32   `json:"foodId" example:"5"`
+	Name     string  `json:"name" example:"Chicken Breast - Skinless"`
+	Quantity float64 `json:"quantity" example:"12"`
+	Unit     string  `json:"unit" example:"OUNCES"`
+	Notes    string  `json:"notes,omitempty" example:"diced"`
+}
+
+// PrepInstructionsResponse defines a meal's instructions as stored and as
+// sanitized HTML. Only html is safe to insert into a page; raw is for editing.
+type PrepInstructionsResponse struct {
+	Format string `json:"format" example:"MARKDOWN"`
+	Raw    string `json:"raw" example:"1. Sear the **chicken**"`
+	HTML   string `json:"html" example:"<ol><li>Sear the <strong>chicken</strong></li></ol>"`
+}
+
+// MealResponse defines a meal with its ingredients. Nutrition is per serving.
+type MealResponse struct {
+	ID               int32                     `json:"id" example:"3"`
+	Name             string                    `json:"name" example:"Chicken Bowl"`
+	Description      string                    `json:"description" example:"Chicken, brown rice and a soft boiled egg"`
+	Servings         int32                     `json:"servings" example:"2"`
+	PrepTime         int32                     `json:"prepTime" example:"40"`
+	Ingredients      []MealIngredientResponse  `json:"ingredients"`
+	Calories         float64                   `json:"calories" example:"600"`
+	ProteinGrams     float64                   `json:"proteinGrams" example:"45"`
+	CarbsGrams       float64                   `json:"carbsGrams" example:"55"`
+	FatGrams         float64                   `json:"fatGrams" example:"18"`
+	PrepInstructions *PrepInstructionsResponse `json:"prepInstructions,omitempty"`
+}
+
+// ScaledMealResponse defines a meal scaled to a number of servings. Nutrition
+// covers all servings.
+type ScaledMealResponse struct {
+	MealID         int32                    `json:"mealId" example:"3"`
+	Name           string                   `json:"name" example:"Chicken Bowl"`
+	RecipeServings int32                    `json:"recipeServings" example:"2"`
+	Servings       int32                    `json:"servings" example:"3"`
+	PrepTime       int32                    `json:"prepTime" example:"40"`
+	Ingredients    []MealIngredientResponse `json:"ingredients"`
+	Calories       float64                  `json:"calories" example:"1800"`
+	ProteinGrams   float64                  `json:"proteinGrams" example:"135"`
+	CarbsGrams     float64                  `json:"carbsGrams" example:"165"`
+	FatGrams       float64                  `json:"fatGrams" example:"54"`
+}
+
+// BatchCookRequest defines the request payload for batch cooking a meal.
+// startDate defaults to today and servings to one per day.
+type BatchCookRequest struct {
+	StartDate  string `json:"startDate" example:"2025-06-02"`
+	Days       int32  `json:"days" binding:"required" example:"3"`
+	MealNumber int32  `json:"mealNumber" binding:"required" example:"2"`
+	Servings   int32  `json:"servings" example:"4"`
+}
+
+// BatchEntryResponse defines a planned serving of the batch on one day
+type BatchEntryResponse struct {
+	EntryID    int32   `json:"entryId" example:"120"`
+	Date       string  `json:"date" example:"2025-06-02"`
+	MealNumber int32   `json:"mealNumber" example:"2"`
+	Servings   float64 `json:"servings" example:"1.5"`
+}
+
+// BatchCookResponse defines a cooked batch and where its servings are planned
+type BatchCookResponse struct {
+	Batch            ScaledMealResponse   `json:"batch"`
+	Entries          []BatchEntryResponse `json:"entries"`
+	PrepMinutesSaved int32                `json:"prepMinutesSaved" example:"80"`
+}
+
+// getMealHandler godoc
+// @Summary      Get Meal
+// @Description  Get a meal with its ingredients and nutrition per serving. Preparation instructions are returned both as stored (raw, with their MARKDOWN or HTML format) and as html rendered server-side through an allowlist that removes scripts, styles, event handlers and non-http links. Clients should only ever insert html into a page.
+// @Tags         meals
+// @Produce      json
+// @Security     Bearer
+// @Param        id   path      int  true  "Meal ID"
+// @Success      200  {object}  MealResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/meals/{id} [get]
+func getMealHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(400, gin.H{"error": "Invalid meal ID"})
+			return
+		}
+
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Meal service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewMealServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.GetMeal(ctx, &pb.GetMealRequest{MealId: int32(id)})
+		if err != nil {
+			log.Printf("Error calling GetMeal: %v", err)
+			c.JSON(500, gin.H{"error": "Meal service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to get meal")
+			return
+		}
+
+		meal := MealResponse{
+			ID:           resp.Meal.Id,
+			Name:         resp.Meal.Name,
+			Description:  resp.Meal.Description,
+			Servings:     resp.Meal.Servings,
+			PrepTime:     resp.Meal.PrepTime,
+			Ingredients:  toMealIngredientResponses(resp.Meal.Ingredients),
+			Calories:     resp.Meal.Calories,
+			ProteinGrams: resp.Meal.ProteinGrams,
+			CarbsGrams:   resp.Meal.CarbsGrams,
+			FatGrams:     resp.Meal.FatGrams,
+		}
+		if instructions := resp.Meal.PrepInstructions; instructions != nil {
+			meal.PrepInstructions = &PrepInstructionsResponse{
+				Format: instructions.Format,
+				Raw:    instructions.Raw,
+				HTML:   instructions.Html,
+			}
+		}
+
+		c.JSON(200, meal)
+	}
+}
+
+// scaleMealHandler godoc
+// @Summary      Scale Meal
+// @Description  Scale a meal's recipe to a number of servings (up to 50). Ingredient quantities are rounded to kitchen-friendly amounts: whole pieces, grams in 5 g steps above 100 g, quarter ounces, and spoon measures promoted to tablespoons or cups as they grow. Nutrition covers all servings.
+// @Tags         meals
+// @Produce      json
+// @Security     Bearer
+// @Param        id        path      int  true  "Meal ID"
+// @Param        servings  query     int  true  "Number of servings"
+// @Success      200       {object}  ScaledMealResponse
+// @Failure      400       {object}  ErrorResponse
+// @Failure      401       {object}  ErrorResponse
+// @Failure      404       {object}  ErrorResponse
+// @Failure      500       {object}  ErrorResponse
+// @Router       /api/meals/{id}/scale [get]
+func scaleMealHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(400, gin.H{"error": "Invalid meal ID"})
+			return
+		}
+
+		servings, err := strconv.Atoi(c.Query("servings"))
+		if err != nil || servings <= 0 {
+			c.JSON(400, gin.H{"error": "servings must be a positive integer"})
+			return
+		}
+
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Meal service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewMealServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.ScaleMeal(ctx, &pb.ScaleMealRequest{
+			MealId:   int32(id),
+			Servings: int32(servings),
+		})
+		if err != nil {
+			log.Printf("Error calling ScaleMeal: %v", err)
+			c.JSON(500, gin.H{"error": "Meal service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to scale meal")
+			return
+		}
+
+		c.JSON(200, toScaledMealResponse(resp.Meal))
+	}
+}
+
+// batchCookHandler godoc
+// @Summary      Batch Cook Meal
+// @Description  Cook one scaled batch of a meal and plan its servings across up to 7 consecutive days in the same meal slot (1-6) of the authenticated user's plan. Anything already planned in those slots is replaced. Servings are split as evenly as quarter servings allow, with the earliest days taking any remainder. prepMinutesSaved is the prep time avoided by not cooking the meal every day.
+// @Tags         meals
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id       path      int               true  "Meal ID"
+// @Param        request  body      BatchCookRequest  true  "Batch options"
+// @Success      201      {object}  BatchCookResponse
+// @Failure      400      {object}  ErrorResponse
+// @Failure      401      {object}  ErrorResponse
+// @Failure      404      {object}  ErrorResponse
+// @Failure      500      {object}  ErrorResponse
+// @Router       /api/meals/{id}/batch-cook [post]
+func batchCookHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(400, gin.H{"error": "Invalid meal ID"})
+			return
+		}
+
+		var req BatchCookRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(400, gin.H{"error": "Invalid request payload"})
+			return
+		}
+
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Meal service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewMealServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.PlanBatchCook(ctx, &pb.PlanBatchCookRequest{
+			UserId:     int32(c.GetInt("user_id")),
+			MealId:     int32(id),
+			StartDate:  req.StartDate,
+			Days:       req.Days,
+			MealNumber: req.MealNumber,
+			Servings:   req.Servings,
+		})
+		if err != nil {
+			log.Printf("Error calling PlanBatchCook: %v", err)
+			c.JSON(500, gin.H{"error": "Meal service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to plan batch cook")
+			return
+		}
+
+		batch := BatchCookResponse{
+			Batch:            toScaledMealResponse(resp.Batch),
+			Entries:          make([]BatchEntryResponse, len(resp.Entries)),
+			PrepMinutesSaved: resp.PrepMinutesSaved,
+		}
+		for i, entry := range resp.Entries {
+			batch.Entries[i] = BatchEntryResponse{
+				EntryID:    entry.EntryId,
+				Date:       entry.Date,
+				MealNumber: entry.MealNumber,
+				Servings:   entry.Servings,
+			}
+		}
+
+		c.JSON(201, batch)
+	}
+}
+
+// toScaledMealResponse converts a scaled meal to its JSON form
+func toScaledMealResponse(meal *pb.ScaledMeal) ScaledMealResponse {
+	return ScaledMealResponse{
+		MealID:         meal.MealId,
+		Name:           meal.Name,
+		RecipeServings: meal.RecipeServings,
+		Servings:       meal.Servings,
+		PrepTime:       meal.PrepTime,
+		Ingredients:    toMealIngredientResponses(meal.Ingredients),
+		Calories:       meal.Calories,
+		ProteinGrams:   meal.ProteinGrams,
+		CarbsGrams:     meal.CarbsGrams,
+		FatGrams:       meal.FatGrams,
+	}
+}
+
+// toMealIngredientResponses converts meal ingredients to their JSON form
+func toMealIngredientResponses(ingredients []*pb.MealIngredient) []MealIngredientResponse {
+	result := make([]MealIngredientResponse, len(ingredients))
+	for i, ingredient := range ingredients {
+		result[i] = MealIngredientResponse{
+			ID:       ingredient.Id,
+			FoodID:   ingredient.FoodId,
+			Name:     ingredient.Name,
+			Quantity: ingredient.Quantity,
+			Unit:     ingredient.Unit,
+			Notes:    ingredient.Notes,
+		}
+	}
+	return result
+}
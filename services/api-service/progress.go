@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	pb "api-service/proto"
+	"github.com/gin-gonic/gin"
+)
+
+// WeightTrendPointResponse defines a day's weight and smoothed trend
+type WeightTrendPointResponse struct {
+	Date     string  `json:"date" example:"2025-03-28"`
+	WeightKg float64 `json:"weightKg" example:"77.3"`
+	TrendKg  float64 `json:"trendKg" example:"78.12"`
+}
+
+// EnergyBalanceResponse compares the weight trend with logged intake
+type EnergyBalanceResponse struct {
+	StartDate                    string  `json:"startDate" example:"2025-03-15"`
+	EndDate                      string  `json:"endDate" example:"2025-03-28"`
+	IntakeDays                   int32   `json:"intakeDays" example:"12"`
+	AverageIntakeCalories        float64 `json:"averageIntakeCalories" example:"1800"`
+	DailyBalanceCalories         float64 `json:"dailyBalanceCalories" example:"-660"`
+	EstimatedMaintenanceCalories float64 `json:"estimatedMaintenanceCalories" example:"2460"`
+}
+
+// WeightProgressResponse defines the smoothed weight trend and its analysis
+type WeightProgressResponse struct {
+	StartDate      string                     `json:"startDate" example:"2024-12-29"`
+	EndDate        string                     `json:"endDate" example:"2025-03-28"`
+	Timezone       string                     `json:"timezone" example:"America/Chicago"`
+	WeighIns       int32                      `json:"weighIns" example:"61"`
+	LatestWeightKg float64                    `json:"latestWeightKg" example:"77.3"`
+	TrendWeightKg  float64                    `json:"trendWeightKg" example:"78.12"`
+	WeeklyRateKg   *float64                   `json:"weeklyRateKg,omitempty" example:"-0.6"`
+	Plateau        bool                       `json:"plateau" example:"false"`
+	PlateauDays    int32                      `json:"plateauDays" example:"0"`
+	EnergyBalance  *EnergyBalanceResponse     `json:"energyBalance,omitempty"`
+	Points         []WeightTrendPointResponse `json:"points"`
+	Notes          []string                   `json:"notes"`
+}
+
+// weightProgressHandler godoc
+// @Summary      Weight Progress
+// @Description  Smooth daily weigh-ins from check-ins into an exponentially weighted moving average trend (days with several weigh-ins are averaged). weeklyRateKg is the trend's slope over the last 14 days, a plateau is a trend that stayed within 0.3 kg for 14 days or more, and energyBalance converts the rate into a daily calorie balance (7700 kcal per kg) and subtracts it from the average calories logged in the diary to estimate maintenance calories.
+// @Tags         progress
+// @Produce      json
+// @Security     Bearer
+// @Param        days  query     int  false  "Days ending today in the user's timezone (1-365, default 90)"
+// @Success      200   {object}  WeightProgressResponse
+// @Failure      400   {object}  ErrorResponse
+// @Failure      401   {object}  ErrorResponse
+// @Failure      500   {object}  ErrorResponse
+// @Router       /api/progress/weight [get]
+func weightProgressHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var days int
+		if value := c.Query("days"); value != "" {
+			parsed, err := strconv.Atoi(value)
+			if err != nil {
+				c.JSON(400, gin.H{"error": "invalid days: must be a whole number"})
+				return
+			}
+			days = parsed
+		}
+
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Progress service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewProgressServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.GetWeightProgress(ctx, &pb.GetWeightProgressRequest{
+			UserId: int32(c.GetInt("user_id")),
+			Days:   int32(days),
+		})
+		if err != nil {
+			log.Printf("Error calling GetWeightProgress: %v", err)
+			c.JSON(500, gin.H{"error": "Progress service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to get weight progress")
+			return
+		}
+
+		progress := resp.Progress
+		result := WeightProgressResponse{
+			StartDate:      progress.StartDate,
+			EndDate:        progress.EndDate,
+			Timezone:       progress.Timezone,
+			WeighIns:       progress.WeighIns,
+			LatestWeightKg: progress.LatestWeightKg,
+			TrendWeightKg:  progress.TrendWeightKg,
+			WeeklyRateKg:   progress.WeeklyRateKg,
+			Plateau:        progress.Plateau,
+			PlateauDays:    progress.PlateauDays,
+			Points:         make([]WeightTrendPointResponse, len(progress.Points)),
+			Notes:          progress.Notes,
+		}
+		if result.Notes == nil {
+			result.Notes = []string{}
+		}
+		for i, p := range progress.Points {
+			result.Points[i] = WeightTrendPointResponse{
+				Date:     p.Date,
+				WeightKg: p.WeightKg,
+				TrendKg:  p.TrendKg,
+			}
+		}
+		if balance := progress.EnergyBalance; balance != nil {
+			result.EnergyBalance = &EnergyBalanceResponse{
+				StartDate:                    balance.StartDate,
+				EndDate:                      balance.EndDate,
+				IntakeDays:                   balance.IntakeDays,
+				AverageIntakeCalories:        balance.AverageIntakeCalories,
+				DailyBalanceCalories:         balance.DailyBalanceCalories,
+				EstimatedMaintenanceCalories: balance.EstimatedMaintenanceCalories,
+			}
+		}
+
+		c.JSON(200, result)
+	}
+}
@@ -0,0 +1,782 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        v5.29.3
+// source: proto/meal_plan.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A food portion planned for a meal; servings multiplies the food's serving unit
+type PlannedFood struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       int32                  `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"` // USER_MEALS id of the planned entry
+	FoodId        int32                  `protobuf:"varint,2,opt,name=food_id,json=foodId,proto3" json:"food_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ServingUnits  string                 `protobuf:"bytes,4,opt,name=serving_units,json=servingUnits,proto3" json:"serving_units,omitempty"`
+	Servings      float64                `protobuf:"fixed64,5,opt,name=servings,proto3" json:"servings,omitempty"`
+	Calories      float64                `protobuf:"fixed64,6,opt,name=calories,proto3" json:"calories,omitempty"`
+	ProteinGrams  float64                `protobuf:"fixed64,7,opt,name=protein_grams,json=proteinGrams,proto3" json:"protein_grams,omitempty"`
+	CarbsGrams    float64                `protobuf:"fixed64,8,opt,name=carbs_grams,json=carbsGrams,proto3" json:"carbs_grams,omitempty"`
+	FatGrams      float64                `protobuf:"fixed64,9,opt,name=fat_grams,json=fatGrams,proto3" json:"fat_grams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlannedFood) Reset() {
+	*x = PlannedFood{}
+	mi := &file_proto_meal_plan_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlannedFood) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlannedFood) ProtoMessage() {}
+
+func (x *PlannedFood) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_meal_plan_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlannedFood.ProtoReflect.Descriptor instead.
+func (*PlannedFood) Descriptor() ([]byte, []int) {
+	return file_proto_meal_plan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PlannedFood) GetEntryId() int32 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+func (x *PlannedFood) GetFoodId() int32 {
+	if x != nil {
+		return x.FoodId
+	}
+	return 0
+}
+
+func (x *PlannedFood) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlannedFood) GetServingUnits() string {
+	if x != nil {
+		return x.ServingUnits
+	}
+	return ""
+}
+
+func (x *PlannedFood) GetServings() float64 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *PlannedFood) GetCalories() float64 {
+	if x != nil {
+		return x.Calories
+	}
+	return 0
+}
+
+func (x *PlannedFood) GetProteinGrams() float64 {
+	if x != nil {
+		return x.ProteinGrams
+	}
+	return 0
+}
+
+func (x *PlannedFood) GetCarbsGrams() float64 {
+	if x != nil {
+		return x.CarbsGrams
+	}
+	return 0
+}
+
+func (x *PlannedFood) GetFatGrams() float64 {
+	if x != nil {
+		return x.FatGrams
+	}
+	return 0
+}
+
+// The foods planned for one meal_number slot
+type PlannedMeal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MealNumber    int32                  `protobuf:"varint,1,opt,name=meal_number,json=mealNumber,proto3" json:"meal_number,omitempty"` // 1-6
+	Foods         []*PlannedFood         `protobuf:"bytes,2,rep,name=foods,proto3" json:"foods,omitempty"`
+	Calories      float64                `protobuf:"fixed64,3,opt,name=calories,proto3" json:"calories,omitempty"`
+	ProteinGrams  float64                `protobuf:"fixed64,4,opt,name=protein_grams,json=proteinGrams,proto3" json:"protein_grams,omitempty"`
+	CarbsGrams    float64                `protobuf:"fixed64,5,opt,name=carbs_grams,json=carbsGrams,proto3" json:"carbs_grams,omitempty"`
+	FatGrams      float64                `protobuf:"fixed64,6,opt,name=fat_grams,json=fatGrams,proto3" json:"fat_grams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlannedMeal) Reset() {
+	*x = PlannedMeal{}
+	mi := &file_proto_meal_plan_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlannedMeal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlannedMeal) ProtoMessage() {}
+
+func (x *PlannedMeal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_meal_plan_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlannedMeal.ProtoReflect.Descriptor instead.
+func (*PlannedMeal) Descriptor() ([]byte, []int) {
+	return file_proto_meal_plan_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlannedMeal) GetMealNumber() int32 {
+	if x != nil {
+		return x.MealNumber
+	}
+	return 0
+}
+
+func (x *PlannedMeal) GetFoods() []*PlannedFood {
+	if x != nil {
+		return x.Foods
+	}
+	return nil
+}
+
+func (x *PlannedMeal) GetCalories() float64 {
+	if x != nil {
+		return x.Calories
+	}
+	return 0
+}
+
+func (x *PlannedMeal) GetProteinGrams() float64 {
+	if x != nil {
+		return x.ProteinGrams
+	}
+	return 0
+}
+
+func (x *PlannedMeal) GetCarbsGrams() float64 {
+	if x != nil {
+		return x.CarbsGrams
+	}
+	return 0
+}
+
+func (x *PlannedMeal) GetFatGrams() float64 {
+	if x != nil {
+		return x.FatGrams
+	}
+	return 0
+}
+
+// The meals planned for one calendar day
+type MealPlanDay struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
+	Meals         []*PlannedMeal         `protobuf:"bytes,2,rep,name=meals,proto3" json:"meals,omitempty"`
+	Calories      float64                `protobuf:"fixed64,3,opt,name=calories,proto3" json:"calories,omitempty"`
+	ProteinGrams  float64                `protobuf:"fixed64,4,opt,name=protein_grams,json=proteinGrams,proto3" json:"protein_grams,omitempty"`
+	CarbsGrams    float64                `protobuf:"fixed64,5,opt,name=carbs_grams,json=carbsGrams,proto3" json:"carbs_grams,omitempty"`
+	FatGrams      float64                `protobuf:"fixed64,6,opt,name=fat_grams,json=fatGrams,proto3" json:"fat_grams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MealPlanDay) Reset() {
+	*x = MealPlanDay{}
+	mi := &file_proto_meal_plan_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealPlanDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealPlanDay) ProtoMessage() {}
+
+func (x *MealPlanDay) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_meal_plan_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealPlanDay.ProtoReflect.Descriptor instead.
+func (*MealPlanDay) Descriptor() ([]byte, []int) {
+	return file_proto_meal_plan_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MealPlanDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *MealPlanDay) GetMeals() []*PlannedMeal {
+	if x != nil {
+		return x.Meals
+	}
+	return nil
+}
+
+func (x *MealPlanDay) GetCalories() float64 {
+	if x != nil {
+		return x.Calories
+	}
+	return 0
+}
+
+func (x *MealPlanDay) GetProteinGrams() float64 {
+	if x != nil {
+		return x.ProteinGrams
+	}
+	return 0
+}
+
+func (x *MealPlanDay) GetCarbsGrams() float64 {
+	if x != nil {
+		return x.CarbsGrams
+	}
+	return 0
+}
+
+func (x *MealPlanDay) GetFatGrams() float64 {
+	if x != nil {
+		return x.FatGrams
+	}
+	return 0
+}
+
+// The daily targets a plan was generated for
+type MealPlanTargets struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calories      float64                `protobuf:"fixed64,1,opt,name=calories,proto3" json:"calories,omitempty"`
+	ProteinGrams  float64                `protobuf:"fixed64,2,opt,name=protein_grams,json=proteinGrams,proto3" json:"protein_grams,omitempty"`
+	CarbsGrams    float64                `protobuf:"fixed64,3,opt,name=carbs_grams,json=carbsGrams,proto3" json:"carbs_grams,omitempty"`
+	FatGrams      float64                `protobuf:"fixed64,4,opt,name=fat_grams,json=fatGrams,proto3" json:"fat_grams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MealPlanTargets) Reset() {
+	*x = MealPlanTargets{}
+	mi := &file_proto_meal_plan_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealPlanTargets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealPlanTargets) ProtoMessage() {}
+
+func (x *MealPlanTargets) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_meal_plan_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealPlanTargets.ProtoReflect.Descriptor instead.
+func (*MealPlanTargets) Descriptor() ([]byte, []int) {
+	return file_proto_meal_plan_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MealPlanTargets) GetCalories() float64 {
+	if x != nil {
+		return x.Calories
+	}
+	return 0
+}
+
+func (x *MealPlanTargets) GetProteinGrams() float64 {
+	if x != nil {
+		return x.ProteinGrams
+	}
+	return 0
+}
+
+func (x *MealPlanTargets) GetCarbsGrams() float64 {
+	if x != nil {
+		return x.CarbsGrams
+	}
+	return 0
+}
+
+func (x *MealPlanTargets) GetFatGrams() float64 {
+	if x != nil {
+		return x.FatGrams
+	}
+	return 0
+}
+
+type MealPlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // YYYY-MM-DD
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // YYYY-MM-DD, inclusive
+	Days          []*MealPlanDay         `protobuf:"bytes,3,rep,name=days,proto3" json:"days,omitempty"`
+	Targets       *MealPlanTargets       `protobuf:"bytes,4,opt,name=targets,proto3" json:"targets,omitempty"` // set when the plan is generated
+	Notes         []string               `protobuf:"bytes,5,rep,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MealPlan) Reset() {
+	*x = MealPlan{}
+	mi := &file_proto_meal_plan_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealPlan) ProtoMessage() {}
+
+func (x *MealPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_meal_plan_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealPlan.ProtoReflect.Descriptor instead.
+func (*MealPlan) Descriptor() ([]byte, []int) {
+	return file_proto_meal_plan_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MealPlan) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *MealPlan) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *MealPlan) GetDays() []*MealPlanDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *MealPlan) GetTargets() *MealPlanTargets {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *MealPlan) GetNotes() []string {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+// Request/Response messages
+type GenerateMealPlanRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	UserId                int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartDate             string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                                        // optional, YYYY-MM-DD, defaults to today in the user's timezone
+	Days                  int32                  `protobuf:"varint,3,opt,name=days,proto3" json:"days,omitempty"`                                                                  // optional, 1-14 (default 7)
+	MealsPerDay           int32                  `protobuf:"varint,4,opt,name=meals_per_day,json=mealsPerDay,proto3" json:"meals_per_day,omitempty"`                               // optional, 1-6 (default chosen from the user's goals)
+	NonInflammatoryOnly   bool                   `protobuf:"varint,5,opt,name=non_inflammatory_only,json=nonInflammatoryOnly,proto3" json:"non_inflammatory_only,omitempty"`       // plan with non-inflammatory foods where possible
+	FavorAntiInflammatory bool                   `protobuf:"varint,6,opt,name=favor_anti_inflammatory,json=favorAntiInflammatory,proto3" json:"favor_anti_inflammatory,omitempty"` // rotate foods that lower the inflammation score first
+	FavorGutHealth        bool                   `protobuf:"varint,7,opt,name=favor_gut_health,json=favorGutHealth,proto3" json:"favor_gut_health,omitempty"`                      // rotate probiotic and prebiotic foods first
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GenerateMealPlanRequest) Reset() {
+	*x = GenerateMealPlanRequest{}
+	mi := &file_proto_meal_plan_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateMealPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateMealPlanRequest) ProtoMessage() {}
+
+func (x *GenerateMealPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_meal_plan_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateMealPlanRequest.ProtoReflect.Descriptor instead.
+func (*GenerateMealPlanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_meal_plan_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GenerateMealPlanRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GenerateMealPlanRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GenerateMealPlanRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GenerateMealPlanRequest) GetMealsPerDay() int32 {
+	if x != nil {
+		return x.MealsPerDay
+	}
+	return 0
+}
+
+func (x *GenerateMealPlanRequest) GetNonInflammatoryOnly() bool {
+	if x != nil {
+		return x.NonInflammatoryOnly
+	}
+	return false
+}
+
+func (x *GenerateMealPlanRequest) GetFavorAntiInflammatory() bool {
+	if x != nil {
+		return x.FavorAntiInflammatory
+	}
+	return false
+}
+
+func (x *GenerateMealPlanRequest) GetFavorGutHealth() bool {
+	if x != nil {
+		return x.FavorGutHealth
+	}
+	return false
+}
+
+type GetMealPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // optional, YYYY-MM-DD, defaults to today in the user's timezone
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // optional, YYYY-MM-DD, defaults to six days after start_date
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMealPlanRequest) Reset() {
+	*x = GetMealPlanRequest{}
+	mi := &file_proto_meal_plan_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMealPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMealPlanRequest) ProtoMessage() {}
+
+func (x *GetMealPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_meal_plan_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMealPlanRequest.ProtoReflect.Descriptor instead.
+func (*GetMealPlanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_meal_plan_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetMealPlanRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetMealPlanRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetMealPlanRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type MealPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          *MealPlan              `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MealPlanResponse) Reset() {
+	*x = MealPlanResponse{}
+	mi := &file_proto_meal_plan_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealPlanResponse) ProtoMessage() {}
+
+func (x *MealPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_meal_plan_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealPlanResponse.ProtoReflect.Descriptor instead.
+func (*MealPlanResponse) Descriptor() ([]byte, []int) {
+	return file_proto_meal_plan_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MealPlanResponse) GetPlan() *MealPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *MealPlanResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_proto_meal_plan_proto protoreflect.FileDescriptor
+
+const file_proto_meal_plan_proto_rawDesc = "" +
+	"\n" +
+	"\x15proto/meal_plan.proto\x12\x04user\"\x95\x02\n" +
+	"\vPlannedFood\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\x05R\aentryId\x12\x17\n" +
+	"\afood_id\x18\x02 \x01(\x05R\x06foodId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12#\n" +
+	"\rserving_units\x18\x04 \x01(\tR\fservingUnits\x12\x1a\n" +
+	"\bservings\x18\x05 \x01(\x01R\bservings\x12\x1a\n" +
+	"\bcalories\x18\x06 \x01(\x01R\bcalories\x12#\n" +
+	"\rprotein_grams\x18\a \x01(\x01R\fproteinGrams\x12\x1f\n" +
+	"\vcarbs_grams\x18\b \x01(\x01R\n" +
+	"carbsGrams\x12\x1b\n" +
+	"\tfat_grams\x18\t \x01(\x01R\bfatGrams\"\xd6\x01\n" +
+	"\vPlannedMeal\x12\x1f\n" +
+	"\vmeal_number\x18\x01 \x01(\x05R\n" +
+	"mealNumber\x12'\n" +
+	"\x05foods\x18\x02 \x03(\v2\x11.user.PlannedFoodR\x05foods\x12\x1a\n" +
+	"\bcalories\x18\x03 \x01(\x01R\bcalories\x12#\n" +
+	"\rprotein_grams\x18\x04 \x01(\x01R\fproteinGrams\x12\x1f\n" +
+	"\vcarbs_grams\x18\x05 \x01(\x01R\n" +
+	"carbsGrams\x12\x1b\n" +
+	"\tfat_grams\x18\x06 \x01(\x01R\bfatGrams\"\xc9\x01\n" +
+	"\vMealPlanDay\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12'\n" +
+	"\x05meals\x18\x02 \x03(\v2\x11.user.PlannedMealR\x05meals\x12\x1a\n" +
+	"\bcalories\x18\x03 \x01(\x01R\bcalories\x12#\n" +
+	"\rprotein_grams\x18\x04 \x01(\x01R\fproteinGrams\x12\x1f\n" +
+	"\vcarbs_grams\x18\x05 \x01(\x01R\n" +
+	"carbsGrams\x12\x1b\n" +
+	"\tfat_grams\x18\x06 \x01(\x01R\bfatGrams\"\x90\x01\n" +
+	"\x0fMealPlanTargets\x12\x1a\n" +
+	"\bcalories\x18\x01 \x01(\x01R\bcalories\x12#\n" +
+	"\rprotein_grams\x18\x02 \x01(\x01R\fproteinGrams\x12\x1f\n" +
+	"\vcarbs_grams\x18\x03 \x01(\x01R\n" +
+	"carbsGrams\x12\x1b\n" +
+	"\tfat_grams\x18\x04 \x01(\x01R\bfatGrams\"\xb2\x01\n" +
+	"\bMealPlan\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12%\n" +
+	"\x04days\x18\x03 \x03(\v2\x11.user.MealPlanDayR\x04days\x12/\n" +
+	"\atargets\x18\x04 \x01(\v2\x15.user.MealPlanTargetsR\atargets\x12\x14\n" +
+	"\x05notes\x18\x05 \x03(\tR\x05notes\"\x9f\x02\n" +
+	"\x17GenerateMealPlanRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x12\n" +
+	"\x04days\x18\x03 \x01(\x05R\x04days\x12\"\n" +
+	"\rmeals_per_day\x18\x04 \x01(\x05R\vmealsPerDay\x122\n" +
+	"\x15non_inflammatory_only\x18\x05 \x01(\bR\x13nonInflammatoryOnly\x126\n" +
+	"\x17favor_anti_inflammatory\x18\x06 \x01(\bR\x15favorAntiInflammatory\x12(\n" +
+	"\x10favor_gut_health\x18\a \x01(\bR\x0efavorGutHealth\"g\n" +
+	"\x12GetMealPlanRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\"L\n" +
+	"\x10MealPlanResponse\x12\"\n" +
+	"\x04plan\x18\x01 \x01(\v2\x0e.user.MealPlanR\x04plan\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error2\x9d\x01\n" +
+	"\x0fMealPlanService\x12I\n" +
+	"\x10GenerateMealPlan\x12\x1d.user.GenerateMealPlanRequest\x1a\x16.user.MealPlanResponse\x12?\n" +
+	"\vGetMealPlan\x12\x18.user.GetMealPlanRequest\x1a\x16.user.MealPlanResponseB\tZ\a./protob\x06proto3"
+
+var (
+	file_proto_meal_plan_proto_rawDescOnce sync.Once
+	file_proto_meal_plan_proto_rawDescData []byte
+)
+
+func file_proto_meal_plan_proto_rawDescGZIP() []byte {
+	file_proto_meal_plan_proto_rawDescOnce.Do(func() {
+		file_proto_meal_plan_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_meal_plan_proto_rawDesc), len(file_proto_meal_plan_proto_rawDesc)))
+	})
+	return file_proto_meal_plan_proto_rawDescData
+}
+
+var file_proto_meal_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_meal_plan_proto_goTypes = []any{
+	(*PlannedFood)(nil),             // 0: user.PlannedFood
+	(*PlannedMeal)(nil),             // 1: user.PlannedMeal
+	(*MealPlanDay)(nil),             // 2: user.MealPlanDay
+	(*MealPlanTargets)(nil),         // 3: user.MealPlanTargets
+	(*MealPlan)(nil),                // 4: user.MealPlan
+	(*GenerateMealPlanRequest)(nil), // 5: user.GenerateMealPlanRequest
+	(*GetMealPlanRequest)(nil),      // 6: user.GetMealPlanRequest
+	(*MealPlanResponse)(nil),        // 7: user.MealPlanResponse
+}
+var file_proto_meal_plan_proto_depIdxs = []int32{
+	0, // 0: user.PlannedMeal.foods:type_name -> user.PlannedFood
+	1, // 1: user.MealPlanDay.meals:type_name -> user.PlannedMeal
+	2, // 2: user.MealPlan.days:type_name -> user.MealPlanDay
+	3, // 3: user.MealPlan.targets:type_name -> user.MealPlanTargets
+	4, // 4: user.MealPlanResponse.plan:type_name -> user.MealPlan
+	5, // 5: user.MealPlanService.GenerateMealPlan:input_type -> user.GenerateMealPlanRequest
+	6, // 6: user.MealPlanService.GetMealPlan:input_type -> user.GetMealPlanRequest
+	7, // 7: user.MealPlanService.GenerateMealPlan:output_type -> user.MealPlanResponse
+	7, // 8: user.MealPlanService.GetMealPlan:output_type -> user.MealPlanResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_proto_meal_plan_proto_init() }
+func file_proto_meal_plan_proto_init() {
+	if File_proto_meal_plan_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_meal_plan_proto_rawDesc), len(file_proto_meal_plan_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_meal_plan_proto_goTypes,
+		DependencyIndexes: file_proto_meal_plan_proto_depIdxs,
+		MessageInfos:      file_proto_meal_plan_proto_msgTypes,
+	}.Build()
+	File_proto_meal_plan_proto = out.File
+	file_proto_meal_plan_proto_goTypes = nil
+	file_proto_meal_plan_proto_depIdxs = nil
+}
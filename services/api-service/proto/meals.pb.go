@@ -0,0 +1,1055 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        v5.29.3
+// source: proto/meals.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// An amount of a food in a recipe
+type MealIngredient struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FoodId        int32                  `protobuf:"varint,1,opt,name=food_id,json=foodId,proto3" json:"food_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Unit          string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	Notes         string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	Id            int32                  `protobuf:"varint,6,opt,name=id,proto3" json:"id,omitempty"` // MEAL_INGREDIENTS id, used to substitute the ingredient
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MealIngredient) Reset() {
+	*x = MealIngredient{}
+	mi := &file_proto_meals_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealIngredient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealIngredient) ProtoMessage() {}
+
+func (x *MealIngredient) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_meals_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealIngredient.ProtoReflect.Descriptor instead.
+func (*MealIngredient) Descriptor() ([]byte, []int) {
+	return file_proto_meals_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MealIngredient) GetFoodId() int32 {
+	if x != nil {
+		return x.FoodId
+	}
+	return 0
+}
+
+func (x *MealIngredient) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MealIngredient) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *MealIngredient) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *MealIngredient) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *MealIngredient) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// A meal with its ingredients scaled to a number of servings. Nutrition is
+// for all servings together.
+type ScaledMeal struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MealId         int32                  `protobuf:"varint,1,opt,name=meal_id,json=mealId,proto3" json:"meal_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RecipeServings int32                  `protobuf:"varint,3,opt,name=recipe_servings,json=recipeServings,proto3" json:"recipe_servings,omitempty"` // servings the stored ingredient quantities make
+	Servings       int32                  `protobuf:"varint,4,opt,name=servings,proto3" json:"servings,omitempty"`
+	PrepTime       int32                  `protobuf:"varint,5,opt,name=prep_time,json=prepTime,proto3" json:"prep_time,omitempty"` // minutes
+	Ingredients    []*MealIngredient      `protobuf:"bytes,6,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	Calories       float64                `protobuf:"fixed64,7,opt,name=calories,proto3" json:"calories,omitempty"`
+	ProteinGrams   float64                `protobuf:"fixed64,8,opt,name=protein_grams,json=proteinGrams,proto3" json:"protein_grams,omitempty"`
+	CarbsGrams     float64                `protobuf:"fixed64,9,opt,name=carbs_grams,json=carbsGrams,proto3" json:"carbs_grams,omitempty"`
+	FatGrams       float64                `protobuf:"fixed64,10,opt,name=fat_grams,json=fatGrams,proto3" json:"fat_grams,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ScaledMeal) Reset() {
+	*x = ScaledMeal{}
+	mi := &file_proto_meals_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScaledMeal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaledMeal) ProtoMessage() {}
+
+func (x *ScaledMeal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_meals_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaledMeal.ProtoReflect.Descriptor instead.
+func (*ScaledMeal) Descriptor() ([]byte, []int) {
+	return file_proto_meals_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScaledMeal) GetMealId() int32 {
+	if x != nil {
+		return x.MealId
+	}
+	return 0
+}
+
+func (x *ScaledMeal) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScaledMeal) GetRecipeServings() int32 {
+	if x != nil {
+		return x.RecipeServings
+	}
+	return 0
+}
+
+func (x *ScaledMeal) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *ScaledMeal) GetPrepTime() int32 {
+	if x != nil {
+		return x.PrepTime
+	}
+	return 0
+}
+
+func (x *ScaledMeal) GetIngredients() []*MealIngredient {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+func (x *ScaledMeal) GetCalories() float64 {
+	if x != nil {
+		return x.Calories
+	}
+	return 0
+}
+
+func (x *ScaledMeal) GetProteinGrams() float64 {
+	if x != nil {
+		return x.ProteinGrams
+	}
+	return 0
+}
+
+func (x *ScaledMeal) GetCarbsGrams() float64 {
+	if x != nil {
+		return x.CarbsGrams
+	}
+	return 0
+}
+
+func (x *ScaledMeal) GetFatGrams() float64 {
+	if x != nil {
+		return x.FatGrams
+	}
+	return 0
+}
+
+// Preparation instructions as stored and rendered to sanitized HTML. raw is
+// for editing only; html is the only form safe to insert into a page.
+type PrepInstructions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // MARKDOWN or HTML
+	Raw           string                 `protobuf:"bytes,2,opt,name=raw,proto3" json:"raw,omitempty"`
+	Html          string                 `protobuf:"bytes,3,opt,name=html,proto3" json:"html,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrepInstructions) Reset() {
+	*x = PrepInstructions{}
+	mi := &file_proto_meals_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrepInstructions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepInstructions) ProtoMessage() {}
+
+func (x *PrepInstructions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_meals_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepInstructions.ProtoReflect.Descriptor instead.
+func (*PrepInstructions) Descriptor() ([]byte, []int) {
+	return file_proto_meals_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PrepInstructions) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *PrepInstructions) GetRaw() string {
+	if x != nil {
+		return x.Raw
+	}
+	return ""
+}
+
+func (x *PrepInstructions) GetHtml() string {
+	if x != nil {
+		return x.Html
+	}
+	return ""
+}
+
+// A meal as stored, with nutrition per serving
+type Meal struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Servings         int32                  `protobuf:"varint,4,opt,name=servings,proto3" json:"servings,omitempty"`                 // servings the ingredient quantities make
+	PrepTime         int32                  `protobuf:"varint,5,opt,name=prep_time,json=prepTime,proto3" json:"prep_time,omitempty"` // minutes
+	Ingredients      []*MealIngredient      `protobuf:"bytes,6,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	Calories         float64                `protobuf:"fixed64,7,opt,name=calories,proto3" json:"calories,omitempty"`
+	ProteinGrams     float64                `protobuf:"fixed64,8,opt,name=protein_grams,json=proteinGrams,proto3" json:"protein_grams,omitempty"`
+	CarbsGrams       float64                `protobuf:"fixed64,9,opt,name=carbs_grams,json=carbsGrams,proto3" json:"carbs_grams,omitempty"`
+	FatGrams         float64                `protobuf:"fixed64,10,opt,name=fat_grams,json=fatGrams,proto3" json:"fat_grams,omitempty"`
+	PrepInstructions *PrepInstructions      `protobuf:"bytes,11,opt,name=prep_instructions,json=prepInstructions,proto3" json:"prep_instructions,omitempty"` // unset when the meal has none
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Meal) Reset() {
+	*x = Meal{}
+	mi := &file_proto_meals_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Meal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Meal) ProtoMessage() {}
+
+func (x *Meal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_meals_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Meal.ProtoReflect.Descriptor instead.
+func (*Meal) Descriptor() ([]byte, []int) {
+	return file_proto_meals_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Meal) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Meal) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Meal) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Meal) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *Meal) GetPrepTime() int32 {
+	if x != nil {
+		return x.PrepTime
+	}
+	return 0
+}
+
+func (x *Meal) GetIngredients() []*MealIngredient {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+func (x *Meal) GetCalories() float64 {
+	if x != nil {
+		return x.Calories
+	}
+	return 0
+}
+
+func (x *Meal) GetProteinGrams() float64 {
+	if x != nil {
+		return x.ProteinGrams
+	}
+	return 0
+}
+
+func (x *Meal) GetCarbsGrams() float64 {
+	if x != nil {
+		return x.CarbsGrams
+	}
+	return 0
+}
+
+func (x *Meal) GetFatGrams() float64 {
+	if x != nil {
+		return x.FatGrams
+	}
+	return 0
+}
+
+func (x *Meal) GetPrepInstructions() *PrepInstructions {
+	if x != nil {
+		return x.PrepInstructions
+	}
+	return nil
+}
+
+// A planned USER_MEALS entry eating part of a batch
+type BatchEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       int32                  `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	MealNumber    int32                  `protobuf:"varint,3,opt,name=meal_number,json=mealNumber,proto3" json:"meal_number,omitempty"`
+	Servings      float64                `protobuf:"fixed64,4,opt,name=servings,proto3" json:"servings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchEntry) Reset() {
+	*x = BatchEntry{}
+	mi := &file_proto_meals_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchEntry) ProtoMessage() {}
+
+func (x *BatchEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_meals_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchEntry.ProtoReflect.Descriptor instead.
+func (*BatchEntry) Descriptor() ([]byte, []int) {
+	return file_proto_meals_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchEntry) GetEntryId() int32 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+func (x *BatchEntry) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *BatchEntry) GetMealNumber() int32 {
+	if x != nil {
+		return x.MealNumber
+	}
+	return 0
+}
+
+func (x *BatchEntry) GetServings() float64 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+// Request/Response messages
+type ScaleMealRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MealId        int32                  `protobuf:"varint,1,opt,name=meal_id,json=mealId,proto3" json:"meal_id,omitempty"`
+	Servings      int32                  `protobuf:"varint,2,opt,name=servings,proto3" json:"servings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScaleMealRequest) Reset() {
+	*x = ScaleMealRequest{}
+	mi := &file_proto_meals_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScaleMealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleMealRequest) ProtoMessage() {}
+
+func (x *ScaleMealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_meals_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaleMealRequest.ProtoReflect.Descriptor instead.
+func (*ScaleMealRequest) Descriptor() ([]byte, []int) {
+	return file_proto_meals_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ScaleMealRequest) GetMealId() int32 {
+	if x != nil {
+		return x.MealId
+	}
+	return 0
+}
+
+func (x *ScaleMealRequest) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+type ScaledMealResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meal          *ScaledMeal            `protobuf:"bytes,1,opt,name=meal,proto3" json:"meal,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScaledMealResponse) Reset() {
+	*x = ScaledMealResponse{}
+	mi := &file_proto_meals_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScaledMealResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaledMealResponse) ProtoMessage() {}
+
+func (x *ScaledMealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_meals_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaledMealResponse.ProtoReflect.Descriptor instead.
+func (*ScaledMealResponse) Descriptor() ([]byte, []int) {
+	return file_proto_meals_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ScaledMealResponse) GetMeal() *ScaledMeal {
+	if x != nil {
+		return x.Meal
+	}
+	return nil
+}
+
+func (x *ScaledMealResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type PlanBatchCookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MealId        int32                  `protobuf:"varint,2,opt,name=meal_id,json=mealId,proto3" json:"meal_id,omitempty"`
+	StartDate     string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // optional, defaults to today in the user's timezone
+	Days          int32                  `protobuf:"varint,4,opt,name=days,proto3" json:"days,omitempty"`
+	MealNumber    int32                  `protobuf:"varint,5,opt,name=meal_number,json=mealNumber,proto3" json:"meal_number,omitempty"`
+	Servings      int32                  `protobuf:"varint,6,opt,name=servings,proto3" json:"servings,omitempty"` // optional batch size, defaults to one serving per day
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanBatchCookRequest) Reset() {
+	*x = PlanBatchCookRequest{}
+	mi := &file_proto_meals_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanBatchCookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanBatchCookRequest) ProtoMessage() {}
+
+func (x *PlanBatchCookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_meals_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanBatchCookRequest.ProtoReflect.Descriptor instead.
+func (*PlanBatchCookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_meals_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PlanBatchCookRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PlanBatchCookRequest) GetMealId() int32 {
+	if x != nil {
+		return x.MealId
+	}
+	return 0
+}
+
+func (x *PlanBatchCookRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *PlanBatchCookRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *PlanBatchCookRequest) GetMealNumber() int32 {
+	if x != nil {
+		return x.MealNumber
+	}
+	return 0
+}
+
+func (x *PlanBatchCookRequest) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+type PlanBatchCookResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Batch            *ScaledMeal            `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	Entries          []*BatchEntry          `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	PrepMinutesSaved int32                  `protobuf:"varint,3,opt,name=prep_minutes_saved,json=prepMinutesSaved,proto3" json:"prep_minutes_saved,omitempty"`
+	Error            string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PlanBatchCookResponse) Reset() {
+	*x = PlanBatchCookResponse{}
+	mi := &file_proto_meals_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanBatchCookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanBatchCookResponse) ProtoMessage() {}
+
+func (x *PlanBatchCookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_meals_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanBatchCookResponse.ProtoReflect.Descriptor instead.
+func (*PlanBatchCookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_meals_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PlanBatchCookResponse) GetBatch() *ScaledMeal {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+func (x *PlanBatchCookResponse) GetEntries() []*BatchEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *PlanBatchCookResponse) GetPrepMinutesSaved() int32 {
+	if x != nil {
+		return x.PrepMinutesSaved
+	}
+	return 0
+}
+
+func (x *PlanBatchCookResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetMealRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MealId        int32                  `protobuf:"varint,1,opt,name=meal_id,json=mealId,proto3" json:"meal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMealRequest) Reset() {
+	*x = GetMealRequest{}
+	mi := &file_proto_meals_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMealRequest) ProtoMessage() {}
+
+func (x *GetMealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_meals_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMealRequest.ProtoReflect.Descriptor instead.
+func (*GetMealRequest) Descriptor() ([]byte, []int) {
+	return file_proto_meals_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetMealRequest) GetMealId() int32 {
+	if x != nil {
+		return x.MealId
+	}
+	return 0
+}
+
+type UpdateMealInstructionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MealId        int32                  `protobuf:"varint,1,opt,name=meal_id,json=mealId,proto3" json:"meal_id,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // MARKDOWN or HTML
+	Raw           string                 `protobuf:"bytes,3,opt,name=raw,proto3" json:"raw,omitempty"`       // empty clears the instructions
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMealInstructionsRequest) Reset() {
+	*x = UpdateMealInstructionsRequest{}
+	mi := &file_proto_meals_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMealInstructionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMealInstructionsRequest) ProtoMessage() {}
+
+func (x *UpdateMealInstructionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_meals_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMealInstructionsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMealInstructionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_meals_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateMealInstructionsRequest) GetMealId() int32 {
+	if x != nil {
+		return x.MealId
+	}
+	return 0
+}
+
+func (x *UpdateMealInstructionsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *UpdateMealInstructionsRequest) GetRaw() string {
+	if x != nil {
+		return x.Raw
+	}
+	return ""
+}
+
+type MealResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meal          *Meal                  `protobuf:"bytes,1,opt,name=meal,proto3" json:"meal,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MealResponse) Reset() {
+	*x = MealResponse{}
+	mi := &file_proto_meals_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealResponse) ProtoMessage() {}
+
+func (x *MealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_meals_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealResponse.ProtoReflect.Descriptor instead.
+func (*MealResponse) Descriptor() ([]byte, []int) {
+	return file_proto_meals_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MealResponse) GetMeal() *Meal {
+	if x != nil {
+		return x.Meal
+	}
+	return nil
+}
+
+func (x *MealResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_proto_meals_proto protoreflect.FileDescriptor
+
+const file_proto_meals_proto_rawDesc = "" +
+	"\n" +
+	"\x11proto/meals.proto\x12\x04user\"\x93\x01\n" +
+	"\x0eMealIngredient\x12\x17\n" +
+	"\afood_id\x18\x01 \x01(\x05R\x06foodId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x01R\bquantity\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\x12\x14\n" +
+	"\x05notes\x18\x05 \x01(\tR\x05notes\x12\x0e\n" +
+	"\x02id\x18\x06 \x01(\x05R\x02id\"\xd2\x02\n" +
+	"\n" +
+	"ScaledMeal\x12\x17\n" +
+	"\ameal_id\x18\x01 \x01(\x05R\x06mealId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12'\n" +
+	"\x0frecipe_servings\x18\x03 \x01(\x05R\x0erecipeServings\x12\x1a\n" +
+	"\bservings\x18\x04 \x01(\x05R\bservings\x12\x1b\n" +
+	"\tprep_time\x18\x05 \x01(\x05R\bprepTime\x126\n" +
+	"\vingredients\x18\x06 \x03(\v2\x14.user.MealIngredientR\vingredients\x12\x1a\n" +
+	"\bcalories\x18\a \x01(\x01R\bcalories\x12#\n" +
+	"\rprotein_grams\x18\b \x01(\x01R\fproteinGrams\x12\x1f\n" +
+	"\vcarbs_grams\x18\t \x01(\x01R\n" +
+	"carbsGrams\x12\x1b\n" +
+	"\tfat_grams\x18\n" +
+	" \x01(\x01R\bfatGrams\"P\n" +
+	"\x10PrepInstructions\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x10\n" +
+	"\x03raw\x18\x02 \x01(\tR\x03raw\x12\x12\n" +
+	"\x04html\x18\x03 \x01(\tR\x04html\"\x81\x03\n" +
+	"\x04Meal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bservings\x18\x04 \x01(\x05R\bservings\x12\x1b\n" +
+	"\tprep_time\x18\x05 \x01(\x05R\bprepTime\x126\n" +
+	"\vingredients\x18\x06 \x03(\v2\x14.user.MealIngredientR\vingredients\x12\x1a\n" +
+	"\bcalories\x18\a \x01(\x01R\bcalories\x12#\n" +
+	"\rprotein_grams\x18\b \x01(\x01R\fproteinGrams\x12\x1f\n" +
+	"\vcarbs_grams\x18\t \x01(\x01R\n" +
+	"carbsGrams\x12\x1b\n" +
+	"\tfat_grams\x18\n" +
+	" \x01(\x01R\bfatGrams\x12C\n" +
+	"\x11prep_instructions\x18\v \x01(\v2\x16.user.PrepInstructionsR\x10prepInstructions\"x\n" +
+	"\n" +
+	"BatchEntry\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\x05R\aentryId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1f\n" +
+	"\vmeal_number\x18\x03 \x01(\x05R\n" +
+	"mealNumber\x12\x1a\n" +
+	"\bservings\x18\x04 \x01(\x01R\bservings\"G\n" +
+	"\x10ScaleMealRequest\x12\x17\n" +
+	"\ameal_id\x18\x01 \x01(\x05R\x06mealId\x12\x1a\n" +
+	"\bservings\x18\x02 \x01(\x05R\bservings\"P\n" +
+	"\x12ScaledMealResponse\x12$\n" +
+	"\x04meal\x18\x01 \x01(\v2\x10.user.ScaledMealR\x04meal\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xb8\x01\n" +
+	"\x14PlanBatchCookRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x17\n" +
+	"\ameal_id\x18\x02 \x01(\x05R\x06mealId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x12\n" +
+	"\x04days\x18\x04 \x01(\x05R\x04days\x12\x1f\n" +
+	"\vmeal_number\x18\x05 \x01(\x05R\n" +
+	"mealNumber\x12\x1a\n" +
+	"\bservings\x18\x06 \x01(\x05R\bservings\"\xaf\x01\n" +
+	"\x15PlanBatchCookResponse\x12&\n" +
+	"\x05batch\x18\x01 \x01(\v2\x10.user.ScaledMealR\x05batch\x12*\n" +
+	"\aentries\x18\x02 \x03(\v2\x10.user.BatchEntryR\aentries\x12,\n" +
+	"\x12prep_minutes_saved\x18\x03 \x01(\x05R\x10prepMinutesSaved\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\")\n" +
+	"\x0eGetMealRequest\x12\x17\n" +
+	"\ameal_id\x18\x01 \x01(\x05R\x06mealId\"b\n" +
+	"\x1dUpdateMealInstructionsRequest\x12\x17\n" +
+	"\ameal_id\x18\x01 \x01(\x05R\x06mealId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x10\n" +
+	"\x03raw\x18\x03 \x01(\tR\x03raw\"D\n" +
+	"\fMealResponse\x12\x1e\n" +
+	"\x04meal\x18\x01 \x01(\v2\n" +
+	".user.MealR\x04meal\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error2\x9e\x02\n" +
+	"\vMealService\x12=\n" +
+	"\tScaleMeal\x12\x16.user.ScaleMealRequest\x1a\x18.user.ScaledMealResponse\x12H\n" +
+	"\rPlanBatchCook\x12\x1a.user.PlanBatchCookRequest\x1a\x1b.user.PlanBatchCookResponse\x123\n" +
+	"\aGetMeal\x12\x14.user.GetMealRequest\x1a\x12.user.MealResponse\x12Q\n" +
+	"\x16UpdateMealInstructions\x12#.user.UpdateMealInstructionsRequest\x1a\x12.user.MealResponseB\tZ\a./protob\x06proto3"
+
+var (
+	file_proto_meals_proto_rawDescOnce sync.Once
+	file_proto_meals_proto_rawDescData []byte
+)
+
+func file_proto_meals_proto_rawDescGZIP() []byte {
+	file_proto_meals_proto_rawDescOnce.Do(func() {
+		file_proto_meals_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_meals_proto_rawDesc), len(file_proto_meals_proto_rawDesc)))
+	})
+	return file_proto_meals_proto_rawDescData
+}
+
+var file_proto_meals_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_proto_meals_proto_goTypes = []any{
+	(*MealIngredient)(nil),                // 0: user.MealIngredient
+	(*ScaledMeal)(nil),                    // 1: user.ScaledMeal
+	(*PrepInstructions)(nil),              // 2: user.PrepInstructions
+	(*Meal)(nil),                          // 3: user.Meal
+	(*BatchEntry)(nil),                    // 4: user.BatchEntry
+	(*ScaleMealRequest)(nil),              // 5: user.ScaleMealRequest
+	(*ScaledMealResponse)(nil),            // 6: user.ScaledMealResponse
+	(*PlanBatchCookRequest)(nil),          // 7: user.PlanBatchCookRequest
+	(*PlanBatchCookResponse)(nil),         // 8: user.PlanBatchCookResponse
+	(*GetMealRequest)(nil),                // 9: user.GetMealRequest
+	(*UpdateMealInstructionsRequest)(nil), // 10: user.UpdateMealInstructionsRequest
+	(*MealResponse)(nil),                  // 11: user.MealResponse
+}
+var file_proto_meals_proto_depIdxs = []int32{
+	0,  // 0: user.ScaledMeal.ingredients:type_name -> user.MealIngredient
+	0,  // 1: user.Meal.ingredients:type_name -> user.MealIngredient
+	2,  // 2: user.Meal.prep_instructions:type_name -> user.PrepInstructions
+	1,  // 3: user.ScaledMealResponse.meal:type_name -> user.ScaledMeal
+	1,  // 4: user.PlanBatchCookResponse.batch:type_name -> user.ScaledMeal
+	4,  // 5: user.PlanBatchCookResponse.entries:type_name -> user.BatchEntry
+	3,  // 6: user.MealResponse.meal:type_name -> user.Meal
+	5,  // 7: user.MealService.ScaleMeal:input_type -> user.ScaleMealRequest
+	7,  // 8: user.MealService.PlanBatchCook:input_type -> user.PlanBatchCookRequest
+	9,  // 9: user.MealService.GetMeal:input_type -> user.GetMealRequest
+	10, // 10: user.MealService.UpdateMealInstructions:input_type -> user.UpdateMealInstructionsRequest
+	6,  // 11: user.MealService.ScaleMeal:output_type -> user.ScaledMealResponse
+	8,  // 12: user.MealService.PlanBatchCook:output_type -> user.PlanBatchCookResponse
+	11, // 13: user.MealService.GetMeal:output_type -> user.MealResponse
+	11, // 14: user.MealService.UpdateMealInstructions:output_type -> user.MealResponse
+	11, // [11:15] is the sub-list for method output_type
+	7,  // [7:11] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_proto_meals_proto_init() }
+func file_proto_meals_proto_init() {
+	if File_proto_meals_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_meals_proto_rawDesc), len(file_proto_meals_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_meals_proto_goTypes,
+		DependencyIndexes: file_proto_meals_proto_depIdxs,
+		MessageInfos:      file_proto_meals_proto_msgTypes,
+	}.Build()
+	File_proto_meals_proto = out.File
+	file_proto_meals_proto_goTypes = nil
+	file_proto_meals_proto_depIdxs = nil
+}
@@ -0,0 +1,507 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        v5.29.3
+// source: proto/progress.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Average weight logged on one day and the smoothed trend on that day
+type WeightTrendPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD in the user's timezone
+	WeightKg      float64                `protobuf:"fixed64,2,opt,name=weight_kg,json=weightKg,proto3" json:"weight_kg,omitempty"`
+	TrendKg       float64                `protobuf:"fixed64,3,opt,name=trend_kg,json=trendKg,proto3" json:"trend_kg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeightTrendPoint) Reset() {
+	*x = WeightTrendPoint{}
+	mi := &file_proto_progress_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeightTrendPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeightTrendPoint) ProtoMessage() {}
+
+func (x *WeightTrendPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_progress_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeightTrendPoint.ProtoReflect.Descriptor instead.
+func (*WeightTrendPoint) Descriptor() ([]byte, []int) {
+	return file_proto_progress_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WeightTrendPoint) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *WeightTrendPoint) GetWeightKg() float64 {
+	if x != nil {
+		return x.WeightKg
+	}
+	return 0
+}
+
+func (x *WeightTrendPoint) GetTrendKg() float64 {
+	if x != nil {
+		return x.TrendKg
+	}
+	return 0
+}
+
+// Energy balance implied by the weight trend, compared against logged intake
+type EnergyBalance struct {
+	state                        protoimpl.MessageState `protogen:"open.v1"`
+	StartDate                    string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`     // YYYY-MM-DD, first day of the window
+	EndDate                      string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`           // YYYY-MM-DD, last day of the window
+	IntakeDays                   int32                  `protobuf:"varint,3,opt,name=intake_days,json=intakeDays,proto3" json:"intake_days,omitempty"` // days in the window with diary entries
+	AverageIntakeCalories        float64                `protobuf:"fixed64,4,opt,name=average_intake_calories,json=averageIntakeCalories,proto3" json:"average_intake_calories,omitempty"`
+	DailyBalanceCalories         float64                `protobuf:"fixed64,5,opt,name=daily_balance_calories,json=dailyBalanceCalories,proto3" json:"daily_balance_calories,omitempty"` // negative for a deficit
+	EstimatedMaintenanceCalories float64                `protobuf:"fixed64,6,opt,name=estimated_maintenance_calories,json=estimatedMaintenanceCalories,proto3" json:"estimated_maintenance_calories,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *EnergyBalance) Reset() {
+	*x = EnergyBalance{}
+	mi := &file_proto_progress_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnergyBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnergyBalance) ProtoMessage() {}
+
+func (x *EnergyBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_progress_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnergyBalance.ProtoReflect.Descriptor instead.
+func (*EnergyBalance) Descriptor() ([]byte, []int) {
+	return file_proto_progress_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EnergyBalance) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *EnergyBalance) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *EnergyBalance) GetIntakeDays() int32 {
+	if x != nil {
+		return x.IntakeDays
+	}
+	return 0
+}
+
+func (x *EnergyBalance) GetAverageIntakeCalories() float64 {
+	if x != nil {
+		return x.AverageIntakeCalories
+	}
+	return 0
+}
+
+func (x *EnergyBalance) GetDailyBalanceCalories() float64 {
+	if x != nil {
+		return x.DailyBalanceCalories
+	}
+	return 0
+}
+
+func (x *EnergyBalance) GetEstimatedMaintenanceCalories() float64 {
+	if x != nil {
+		return x.EstimatedMaintenanceCalories
+	}
+	return 0
+}
+
+// Smoothed weight trend, rate of change and plateau state
+type WeightProgress struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StartDate      string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate        string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Timezone       string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	WeighIns       int32                  `protobuf:"varint,4,opt,name=weigh_ins,json=weighIns,proto3" json:"weigh_ins,omitempty"` // days with at least one weight logged
+	LatestWeightKg float64                `protobuf:"fixed64,5,opt,name=latest_weight_kg,json=latestWeightKg,proto3" json:"latest_weight_kg,omitempty"`
+	TrendWeightKg  float64                `protobuf:"fixed64,6,opt,name=trend_weight_kg,json=trendWeightKg,proto3" json:"trend_weight_kg,omitempty"`
+	WeeklyRateKg   *float64               `protobuf:"fixed64,7,opt,name=weekly_rate_kg,json=weeklyRateKg,proto3,oneof" json:"weekly_rate_kg,omitempty"` // unset until there is enough data
+	Plateau        bool                   `protobuf:"varint,8,opt,name=plateau,proto3" json:"plateau,omitempty"`
+	PlateauDays    int32                  `protobuf:"varint,9,opt,name=plateau_days,json=plateauDays,proto3" json:"plateau_days,omitempty"`
+	EnergyBalance  *EnergyBalance         `protobuf:"bytes,10,opt,name=energy_balance,json=energyBalance,proto3" json:"energy_balance,omitempty"` // unset without a rate or logged intake
+	Points         []*WeightTrendPoint    `protobuf:"bytes,11,rep,name=points,proto3" json:"points,omitempty"`
+	Notes          []string               `protobuf:"bytes,12,rep,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WeightProgress) Reset() {
+	*x = WeightProgress{}
+	mi := &file_proto_progress_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeightProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeightProgress) ProtoMessage() {}
+
+func (x *WeightProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_progress_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeightProgress.ProtoReflect.Descriptor instead.
+func (*WeightProgress) Descriptor() ([]byte, []int) {
+	return file_proto_progress_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WeightProgress) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *WeightProgress) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *WeightProgress) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *WeightProgress) GetWeighIns() int32 {
+	if x != nil {
+		return x.WeighIns
+	}
+	return 0
+}
+
+func (x *WeightProgress) GetLatestWeightKg() float64 {
+	if x != nil {
+		return x.LatestWeightKg
+	}
+	return 0
+}
+
+func (x *WeightProgress) GetTrendWeightKg() float64 {
+	if x != nil {
+		return x.TrendWeightKg
+	}
+	return 0
+}
+
+func (x *WeightProgress) GetWeeklyRateKg() float64 {
+	if x != nil && x.WeeklyRateKg != nil {
+		return *x.WeeklyRateKg
+	}
+	return 0
+}
+
+func (x *WeightProgress) GetPlateau() bool {
+	if x != nil {
+		return x.Plateau
+	}
+	return false
+}
+
+func (x *WeightProgress) GetPlateauDays() int32 {
+	if x != nil {
+		return x.PlateauDays
+	}
+	return 0
+}
+
+func (x *WeightProgress) GetEnergyBalance() *EnergyBalance {
+	if x != nil {
+		return x.EnergyBalance
+	}
+	return nil
+}
+
+func (x *WeightProgress) GetPoints() []*WeightTrendPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *WeightProgress) GetNotes() []string {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+// Request/Response messages
+type GetWeightProgressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"` // optional, days ending today in the user's timezone (default 90)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWeightProgressRequest) Reset() {
+	*x = GetWeightProgressRequest{}
+	mi := &file_proto_progress_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWeightProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWeightProgressRequest) ProtoMessage() {}
+
+func (x *GetWeightProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_progress_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWeightProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetWeightProgressRequest) Descriptor() ([]byte, []int) {
+	return file_proto_progress_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetWeightProgressRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetWeightProgressRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type GetWeightProgressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Progress      *WeightProgress        `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWeightProgressResponse) Reset() {
+	*x = GetWeightProgressResponse{}
+	mi := &file_proto_progress_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWeightProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWeightProgressResponse) ProtoMessage() {}
+
+func (x *GetWeightProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_progress_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWeightProgressResponse.ProtoReflect.Descriptor instead.
+func (*GetWeightProgressResponse) Descriptor() ([]byte, []int) {
+	return file_proto_progress_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetWeightProgressResponse) GetProgress() *WeightProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *GetWeightProgressResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_proto_progress_proto protoreflect.FileDescriptor
+
+const file_proto_progress_proto_rawDesc = "" +
+	"\n" +
+	"\x14proto/progress.proto\x12\x04user\"^\n" +
+	"\x10WeightTrendPoint\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1b\n" +
+	"\tweight_kg\x18\x02 \x01(\x01R\bweightKg\x12\x19\n" +
+	"\btrend_kg\x18\x03 \x01(\x01R\atrendKg\"\x9e\x02\n" +
+	"\rEnergyBalance\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12\x1f\n" +
+	"\vintake_days\x18\x03 \x01(\x05R\n" +
+	"intakeDays\x126\n" +
+	"\x17average_intake_calories\x18\x04 \x01(\x01R\x15averageIntakeCalories\x124\n" +
+	"\x16daily_balance_calories\x18\x05 \x01(\x01R\x14dailyBalanceCalories\x12D\n" +
+	"\x1eestimated_maintenance_calories\x18\x06 \x01(\x01R\x1cestimatedMaintenanceCalories\"\xd2\x03\n" +
+	"\x0eWeightProgress\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x12\x1b\n" +
+	"\tweigh_ins\x18\x04 \x01(\x05R\bweighIns\x12(\n" +
+	"\x10latest_weight_kg\x18\x05 \x01(\x01R\x0elatestWeightKg\x12&\n" +
+	"\x0ftrend_weight_kg\x18\x06 \x01(\x01R\rtrendWeightKg\x12)\n" +
+	"\x0eweekly_rate_kg\x18\a \x01(\x01H\x00R\fweeklyRateKg\x88\x01\x01\x12\x18\n" +
+	"\aplateau\x18\b \x01(\bR\aplateau\x12!\n" +
+	"\fplateau_days\x18\t \x01(\x05R\vplateauDays\x12:\n" +
+	"\x0eenergy_balance\x18\n" +
+	" \x01(\v2\x13.user.EnergyBalanceR\renergyBalance\x12.\n" +
+	"\x06points\x18\v \x03(\v2\x16.user.WeightTrendPointR\x06points\x12\x14\n" +
+	"\x05notes\x18\f \x03(\tR\x05notesB\x11\n" +
+	"\x0f_weekly_rate_kg\"G\n" +
+	"\x18GetWeightProgressRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\"c\n" +
+	"\x19GetWeightProgressResponse\x120\n" +
+	"\bprogress\x18\x01 \x01(\v2\x14.user.WeightProgressR\bprogress\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error2g\n" +
+	"\x0fProgressService\x12T\n" +
+	"\x11GetWeightProgress\x12\x1e.user.GetWeightProgressRequest\x1a\x1f.user.GetWeightProgressResponseB\tZ\a./protob\x06proto3"
+
+var (
+	file_proto_progress_proto_rawDescOnce sync.Once
+	file_proto_progress_proto_rawDescData []byte
+)
+
+func file_proto_progress_proto_rawDescGZIP() []byte {
+	file_proto_progress_proto_rawDescOnce.Do(func() {
+		file_proto_progress_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_progress_proto_rawDesc), len(file_proto_progress_proto_rawDesc)))
+	})
+	return file_proto_progress_proto_rawDescData
+}
+
+var file_proto_progress_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_progress_proto_goTypes = []any{
+	(*WeightTrendPoint)(nil),          // 0: user.WeightTrendPoint
+	(*EnergyBalance)(nil),             // 1: user.EnergyBalance
+	(*WeightProgress)(nil),            // 2: user.WeightProgress
+	(*GetWeightProgressRequest)(nil),  // 3: user.GetWeightProgressRequest
+	(*GetWeightProgressResponse)(nil), // 4: user.GetWeightProgressResponse
+}
+var file_proto_progress_proto_depIdxs = []int32{
+	1, // 0: user.WeightProgress.energy_balance:type_name -> user.EnergyBalance
+	0, // 1: user.WeightProgress.points:type_name -> user.WeightTrendPoint
+	2, // 2: user.GetWeightProgressResponse.progress:type_name -> user.WeightProgress
+	3, // 3: user.ProgressService.GetWeightProgress:input_type -> user.GetWeightProgressRequest
+	4, // 4: user.ProgressService.GetWeightProgress:output_type -> user.GetWeightProgressResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_proto_progress_proto_init() }
+func file_proto_progress_proto_init() {
+	if File_proto_progress_proto != nil {
+		return
+	}
+	file_proto_progress_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_progress_proto_rawDesc), len(file_proto_progress_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_progress_proto_goTypes,
+		DependencyIndexes: file_proto_progress_proto_depIdxs,
+		MessageInfos:      file_proto_progress_proto_msgTypes,
+	}.Build()
+	File_proto_progress_proto = out.File
+	file_proto_progress_proto_goTypes = nil
+	file_proto_progress_proto_depIdxs = nil
+}
@@ -0,0 +1,125 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: proto/progress.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ProgressService_GetWeightProgress_FullMethodName = "/user.ProgressService/GetWeightProgress"
+)
+
+// ProgressServiceClient is the client API for ProgressService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Progress analytics gRPC definitions
+type ProgressServiceClient interface {
+	GetWeightProgress(ctx context.Context, in *GetWeightProgressRequest, opts ...grpc.CallOption) (*GetWeightProgressResponse, error)
+}
+
+type progressServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProgressServiceClient(cc grpc.ClientConnInterface) ProgressServiceClient {
+	return &progressServiceClient{cc}
+}
+
+func (c *progressServiceClient) GetWeightProgress(ctx context.Context, in *GetWeightProgressRequest, opts ...grpc.CallOption) (*GetWeightProgressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWeightProgressResponse)
+	err := c.cc.Invoke(ctx, ProgressService_GetWeightProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProgressServiceServer is the server API for ProgressService service.
+// All implementations must embed UnimplementedProgressServiceServer
+// for forward compatibility.
+//
+// Progress analytics gRPC definitions
+type ProgressServiceServer interface {
+	GetWeightProgress(context.Context, *GetWeightProgressRequest) (*GetWeightProgressResponse, error)
+	mustEmbedUnimplementedProgressServiceServer()
+}
+
+// UnimplementedProgressServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProgressServiceServer struct{}
+
+func (UnimplementedProgressServiceServer) GetWeightProgress(context.Context, *GetWeightProgressRequest) (*GetWeightProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWeightProgress not implemented")
+}
+func (UnimplementedProgressServiceServer) mustEmbedUnimplementedProgressServiceServer() {}
+func (UnimplementedProgressServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeProgressServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProgressServiceServer will
+// result in compilation errors.
+type UnsafeProgressServiceServer interface {
+	mustEmbedUnimplementedProgressServiceServer()
+}
+
+func RegisterProgressServiceServer(s grpc.ServiceRegistrar, srv ProgressServiceServer) {
+	// If the following call pancis, it indicates UnimplementedProgressServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProgressService_ServiceDesc, srv)
+}
+
+func _ProgressService_GetWeightProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWeightProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgressServiceServer).GetWeightProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProgressService_GetWeightProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgressServiceServer).GetWeightProgress(ctx, req.(*GetWeightProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProgressService_ServiceDesc is the grpc.ServiceDesc for ProgressService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProgressService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.ProgressService",
+	HandlerType: (*ProgressServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetWeightProgress",
+			Handler:    _ProgressService_GetWeightProgress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/progress.proto",
+}
@@ -0,0 +1,125 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: proto/shopping_list.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ShoppingListService_GetShoppingList_FullMethodName = "/user.ShoppingListService/GetShoppingList"
+)
+
+// ShoppingListServiceClient is the client API for ShoppingListService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Shopping list gRPC definitions
+type ShoppingListServiceClient interface {
+	GetShoppingList(ctx context.Context, in *GetShoppingListRequest, opts ...grpc.CallOption) (*ShoppingListResponse, error)
+}
+
+type shoppingListServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewShoppingListServiceClient(cc grpc.ClientConnInterface) ShoppingListServiceClient {
+	return &shoppingListServiceClient{cc}
+}
+
+func (c *shoppingListServiceClient) GetShoppingList(ctx context.Context, in *GetShoppingListRequest, opts ...grpc.CallOption) (*ShoppingListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShoppingListResponse)
+	err := c.cc.Invoke(ctx, ShoppingListService_GetShoppingList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ShoppingListServiceServer is the server API for ShoppingListService service.
+// All implementations must embed UnimplementedShoppingListServiceServer
+// for forward compatibility.
+//
+// Shopping list gRPC definitions
+type ShoppingListServiceServer interface {
+	GetShoppingList(context.Context, *GetShoppingListRequest) (*ShoppingListResponse, error)
+	mustEmbedUnimplementedShoppingListServiceServer()
+}
+
+// UnimplementedShoppingListServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedShoppingListServiceServer struct{}
+
+func (UnimplementedShoppingListServiceServer) GetShoppingList(context.Context, *GetShoppingListRequest) (*ShoppingListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShoppingList not implemented")
+}
+func (UnimplementedShoppingListServiceServer) mustEmbedUnimplementedShoppingListServiceServer() {}
+func (UnimplementedShoppingListServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeShoppingListServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ShoppingListServiceServer will
+// result in compilation errors.
+type UnsafeShoppingListServiceServer interface {
+	mustEmbedUnimplementedShoppingListServiceServer()
+}
+
+func RegisterShoppingListServiceServer(s grpc.ServiceRegistrar, srv ShoppingListServiceServer) {
+	// If the following call pancis, it indicates UnimplementedShoppingListServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ShoppingListService_ServiceDesc, srv)
+}
+
+func _ShoppingListService_GetShoppingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShoppingListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShoppingListServiceServer).GetShoppingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShoppingListService_GetShoppingList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShoppingListServiceServer).GetShoppingList(ctx, req.(*GetShoppingListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ShoppingListService_ServiceDesc is the grpc.ServiceDesc for ShoppingListService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ShoppingListService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.ShoppingListService",
+	HandlerType: (*ShoppingListServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetShoppingList",
+			Handler:    _ShoppingListService_GetShoppingList_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/shopping_list.proto",
+}
@@ -40,6 +40,10 @@ type User struct {
 	LastActive    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=last_active,json=lastActive,proto3" json:"last_active,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	HeightCm      float64                `protobuf:"fixed64,16,opt,name=height_cm,json=heightCm,proto3" json:"height_cm,omitempty"`
+	WeightKg      float64                `protobuf:"fixed64,17,opt,name=weight_kg,json=weightKg,proto3" json:"weight_kg,omitempty"`
+	BirthDate     string                 `protobuf:"bytes,18,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`             // YYYY-MM-DD
+	ActivityLevel string                 `protobuf:"bytes,19,opt,name=activity_level,json=activityLevel,proto3" json:"activity_level,omitempty"` // SEDENTARY, LIGHT, MODERATE, ACTIVE, VERY_ACTIVE
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -179,6 +183,34 @@ func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *User) GetHeightCm() float64 {
+	if x != nil {
+		return x.HeightCm
+	}
+	return 0
+}
+
+func (x *User) GetWeightKg() float64 {
+	if x != nil {
+		return x.WeightKg
+	}
+	return 0
+}
+
+func (x *User) GetBirthDate() string {
+	if x != nil {
+		return x.BirthDate
+	}
+	return ""
+}
+
+func (x *User) GetActivityLevel() string {
+	if x != nil {
+		return x.ActivityLevel
+	}
+	return ""
+}
+
 // Request/Response messages
 type CreateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -194,6 +226,10 @@ type CreateUserRequest struct {
 	Locale        string                 `protobuf:"bytes,10,opt,name=locale,proto3" json:"locale,omitempty"`
 	Timezone      string                 `protobuf:"bytes,11,opt,name=timezone,proto3" json:"timezone,omitempty"`
 	UtcOffset     int32                  `protobuf:"varint,12,opt,name=utc_offset,json=utcOffset,proto3" json:"utc_offset,omitempty"`
+	HeightCm      float64                `protobuf:"fixed64,13,opt,name=height_cm,json=heightCm,proto3" json:"height_cm,omitempty"`
+	WeightKg      float64                `protobuf:"fixed64,14,opt,name=weight_kg,json=weightKg,proto3" json:"weight_kg,omitempty"`
+	BirthDate     string                 `protobuf:"bytes,15,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"` // YYYY-MM-DD
+	ActivityLevel string                 `protobuf:"bytes,16,opt,name=activity_level,json=activityLevel,proto3" json:"activity_level,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -312,6 +348,34 @@ func (x *CreateUserRequest) GetUtcOffset() int32 {
 	return 0
 }
 
+func (x *CreateUserRequest) GetHeightCm() float64 {
+	if x != nil {
+		return x.HeightCm
+	}
+	return 0
+}
+
+func (x *CreateUserRequest) GetWeightKg() float64 {
+	if x != nil {
+		return x.WeightKg
+	}
+	return 0
+}
+
+func (x *CreateUserRequest) GetBirthDate() string {
+	if x != nil {
+		return x.BirthDate
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetActivityLevel() string {
+	if x != nil {
+		return x.ActivityLevel
+	}
+	return ""
+}
+
 type CreateUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -562,6 +626,10 @@ type UpdateUserRequest struct {
 	Locale        string                 `protobuf:"bytes,10,opt,name=locale,proto3" json:"locale,omitempty"`
 	Timezone      string                 `protobuf:"bytes,11,opt,name=timezone,proto3" json:"timezone,omitempty"`
 	UtcOffset     int32                  `protobuf:"varint,12,opt,name=utc_offset,json=utcOffset,proto3" json:"utc_offset,omitempty"`
+	HeightCm      float64                `protobuf:"fixed64,13,opt,name=height_cm,json=heightCm,proto3" json:"height_cm,omitempty"`
+	WeightKg      float64                `protobuf:"fixed64,14,opt,name=weight_kg,json=weightKg,proto3" json:"weight_kg,omitempty"`
+	BirthDate     string                 `protobuf:"bytes,15,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"` // YYYY-MM-DD
+	ActivityLevel string                 `protobuf:"bytes,16,opt,name=activity_level,json=activityLevel,proto3" json:"activity_level,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -680,6 +748,34 @@ func (x *UpdateUserRequest) GetUtcOffset() int32 {
 	return 0
 }
 
+func (x *UpdateUserRequest) GetHeightCm() float64 {
+	if x != nil {
+		return x.HeightCm
+	}
+	return 0
+}
+
+func (x *UpdateUserRequest) GetWeightKg() float64 {
+	if x != nil {
+		return x.WeightKg
+	}
+	return 0
+}
+
+func (x *UpdateUserRequest) GetBirthDate() string {
+	if x != nil {
+		return x.BirthDate
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetActivityLevel() string {
+	if x != nil {
+		return x.ActivityLevel
+	}
+	return ""
+}
+
 type UpdateUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -954,6 +1050,10 @@ type UpsertUserRequest struct {
 	Locale        string                 `protobuf:"bytes,10,opt,name=locale,proto3" json:"locale,omitempty"`
 	Timezone      string                 `protobuf:"bytes,11,opt,name=timezone,proto3" json:"timezone,omitempty"`
 	UtcOffset     int32                  `protobuf:"varint,12,opt,name=utc_offset,json=utcOffset,proto3" json:"utc_offset,omitempty"`
+	HeightCm      float64                `protobuf:"fixed64,13,opt,name=height_cm,json=heightCm,proto3" json:"height_cm,omitempty"`
+	WeightKg      float64                `protobuf:"fixed64,14,opt,name=weight_kg,json=weightKg,proto3" json:"weight_kg,omitempty"`
+	BirthDate     string                 `protobuf:"bytes,15,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"` // YYYY-MM-DD
+	ActivityLevel string                 `protobuf:"bytes,16,opt,name=activity_level,json=activityLevel,proto3" json:"activity_level,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1072,6 +1172,34 @@ func (x *UpsertUserRequest) GetUtcOffset() int32 {
 	return 0
 }
 
+func (x *UpsertUserRequest) GetHeightCm() float64 {
+	if x != nil {
+		return x.HeightCm
+	}
+	return 0
+}
+
+func (x *UpsertUserRequest) GetWeightKg() float64 {
+	if x != nil {
+		return x.WeightKg
+	}
+	return 0
+}
+
+func (x *UpsertUserRequest) GetBirthDate() string {
+	if x != nil {
+		return x.BirthDate
+	}
+	return ""
+}
+
+func (x *UpsertUserRequest) GetActivityLevel() string {
+	if x != nil {
+		return x.ActivityLevel
+	}
+	return ""
+}
+
 type UpsertUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -1128,7 +1256,7 @@ var File_proto_user_proto protoreflect.FileDescriptor
 
 const file_proto_user_proto_rawDesc = "" +
 	"\n" +
-	"\x10proto/user.proto\x12\x04user\x1a\x1fgoogle/protobuf/timestamp.proto\"\x83\x04\n" +
+	"\x10proto/user.proto\x12\x04user\x1a\x1fgoogle/protobuf/timestamp.proto\"\x83\x05\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
 	"\tfull_name\x18\x02 \x01(\tR\bfullName\x12\x14\n" +
@@ -1150,7 +1278,12 @@ const file_proto_user_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe9\x02\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1b\n" +
+	"\theight_cm\x18\x10 \x01(\x01R\bheightCm\x12\x1b\n" +
+	"\tweight_kg\x18\x11 \x01(\x01R\bweightKg\x12\x1d\n" +
+	"\n" +
+	"birth_date\x18\x12 \x01(\tR\tbirthDate\x12%\n" +
+	"\x0eactivity_level\x18\x13 \x01(\tR\ractivityLevel\"\xe9\x03\n" +
 	"\x11CreateUserRequest\x12\x1b\n" +
 	"\tfull_name\x18\x01 \x01(\tR\bfullName\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
@@ -1166,7 +1299,12 @@ const file_proto_user_proto_rawDesc = "" +
 	" \x01(\tR\x06locale\x12\x1a\n" +
 	"\btimezone\x18\v \x01(\tR\btimezone\x12\x1d\n" +
 	"\n" +
-	"utc_offset\x18\f \x01(\x05R\tutcOffset\"J\n" +
+	"utc_offset\x18\f \x01(\x05R\tutcOffset\x12\x1b\n" +
+	"\theight_cm\x18\r \x01(\x01R\bheightCm\x12\x1b\n" +
+	"\tweight_kg\x18\x0e \x01(\x01R\bweightKg\x12\x1d\n" +
+	"\n" +
+	"birth_date\x18\x0f \x01(\tR\tbirthDate\x12%\n" +
+	"\x0eactivity_level\x18\x10 \x01(\tR\ractivityLevel\"J\n" +
 	"\x12CreateUserResponse\x12\x1e\n" +
 	"\x04user\x18\x01 \x01(\v2\n" +
 	".user.UserR\x04user\x12\x14\n" +
@@ -1181,7 +1319,7 @@ const file_proto_user_proto_rawDesc = "" +
 	"\x13GetAllUsersResponse\x12 \n" +
 	"\x05users\x18\x01 \x03(\v2\n" +
 	".user.UserR\x05users\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\"\xe3\x02\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xe3\x03\n" +
 	"\x11UpdateUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
 	"\tfull_name\x18\x02 \x01(\tR\bfullName\x12\x1a\n" +
@@ -1197,7 +1335,12 @@ const file_proto_user_proto_rawDesc = "" +
 	" \x01(\tR\x06locale\x12\x1a\n" +
 	"\btimezone\x18\v \x01(\tR\btimezone\x12\x1d\n" +
 	"\n" +
-	"utc_offset\x18\f \x01(\x05R\tutcOffset\"J\n" +
+	"utc_offset\x18\f \x01(\x05R\tutcOffset\x12\x1b\n" +
+	"\theight_cm\x18\r \x01(\x01R\bheightCm\x12\x1b\n" +
+	"\tweight_kg\x18\x0e \x01(\x01R\bweightKg\x12\x1d\n" +
+	"\n" +
+	"birth_date\x18\x0f \x01(\tR\tbirthDate\x12%\n" +
+	"\x0eactivity_level\x18\x10 \x01(\tR\ractivityLevel\"J\n" +
 	"\x12UpdateUserResponse\x12\x1e\n" +
 	"\x04user\x18\x01 \x01(\v2\n" +
 	".user.UserR\x04user\x12\x14\n" +
@@ -1214,7 +1357,7 @@ const file_proto_user_proto_rawDesc = "" +
 	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x1e\n" +
 	"\x04user\x18\x02 \x01(\v2\n" +
 	".user.UserR\x04user\x12\x14\n" +
-	"\x05error\x18\x03 \x01(\tR\x05error\"\xe9\x02\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\xe9\x03\n" +
 	"\x11UpsertUserRequest\x12\x1b\n" +
 	"\tfull_name\x18\x01 \x01(\tR\bfullName\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
@@ -1230,7 +1373,12 @@ const file_proto_user_proto_rawDesc = "" +
 	" \x01(\tR\x06locale\x12\x1a\n" +
 	"\btimezone\x18\v \x01(\tR\btimezone\x12\x1d\n" +
 	"\n" +
-	"utc_offset\x18\f \x01(\x05R\tutcOffset\"J\n" +
+	"utc_offset\x18\f \x01(\x05R\tutcOffset\x12\x1b\n" +
+	"\theight_cm\x18\r \x01(\x01R\bheightCm\x12\x1b\n" +
+	"\tweight_kg\x18\x0e \x01(\x01R\bweightKg\x12\x1d\n" +
+	"\n" +
+	"birth_date\x18\x0f \x01(\tR\tbirthDate\x12%\n" +
+	"\x0eactivity_level\x18\x10 \x01(\tR\ractivityLevel\"J\n" +
 	"\x12UpsertUserResponse\x12\x1e\n" +
 	"\x04user\x18\x01 \x01(\v2\n" +
 	".user.UserR\x04user\x12\x14\n" +
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// userIDHeader tells HTTP microservices which authenticated user made the request
+const userIDHeader = "X-User-ID"
+
+// serviceProxy forwards authenticated requests to an HTTP microservice,
+// stripping the /api prefix and passing the JWT user ID in userIDHeader
+func serviceProxy(serviceURL, name string) gin.HandlerFunc {
+	target, err := url.Parse(serviceURL)
+	if err != nil {
+		log.Fatalf("Invalid %s URL %q: %v", name, serviceURL, err)
+	}
+
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	director := proxy.Director
+	proxy.Director = func(req *http.Request) {
+		director(req)
+		req.Host = target.Host
+	}
+	proxy.ErrorHandler = func(w http.ResponseWriter, req *http.Request, err error) {
+		log.Printf("Error proxying to %s: %v", name, err)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(ErrorResponse{Error: name + " unavailable"})
+	}
+
+	return func(c *gin.Context) {
+		req := c.Request.Clone(c.Request.Context())
+		req.URL.Path = strings.TrimPrefix(req.URL.Path, "/api")
+		req.URL.RawPath = ""
+		// Never trust a client-supplied user ID
+		req.Header.Del(userIDHeader)
+		req.Header.Set(userIDHeader, strconv.Itoa(c.GetInt("user_id")))
+
+		proxy.ServeHTTP(c.Writer, req)
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TestServiceProxy checks that proxied requests lose the /api prefix and
+// carry the JWT user ID instead of any client-supplied one
+func TestServiceProxy(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var gotPath, gotQuery, gotUserID string
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotQuery = r.URL.RawQuery
+		gotUserID = r.Header.Get(userIDHeader)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer backend.Close()
+
+	r := gin.New()
+	r.POST("/api/users/me/goals", func(c *gin.Context) { c.Set("user_id", 7) }, serviceProxy(backend.URL, "Survey service"))
+
+	// ReverseProxy needs a CloseNotifier, which httptest.ResponseRecorder lacks
+	gateway := httptest.NewServer(r)
+	defer gateway.Close()
+
+	req, _ := http.NewRequest("POST", gateway.URL+"/api/users/me/goals?verbose=1", nil)
+	req.Header.Set(userIDHeader, "1")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected status 201, got %d", resp.StatusCode)
+	}
+	if gotPath != "/users/me/goals" {
+		t.Errorf("Expected path /users/me/goals, got %s", gotPath)
+	}
+	if gotQuery != "verbose=1" {
+		t.Errorf("Expected query verbose=1, got %s", gotQuery)
+	}
+	if gotUserID != "7" {
+		t.Errorf("Expected %s 7, got %s", userIDHeader, gotUserID)
+	}
+}
+
+// TestServiceProxyUnavailable checks that an unreachable service yields a JSON error
+func TestServiceProxyUnavailable(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	r := gin.New()
+	r.GET("/api/goals", serviceProxy("http://127.0.0.1:1", "Survey service"))
+
+	gateway := httptest.NewServer(r)
+	defer gateway.Close()
+
+	resp, err := http.Get(gateway.URL + "/api/goals")
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("Expected status 500, got %d", resp.StatusCode)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != "{\"error\":\"Survey service unavailable\"}\n" {
+		t.Errorf("Unexpected body %q", body)
+	}
+}
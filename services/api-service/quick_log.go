@@ -0,0 +1,361 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	pb "api-service/proto"
+	"github.com/gin-gonic/gin"
+)
+
+// FavoriteRequest defines the request payload for adding a favorite.
+// Exactly one of foodId or mealId is set.
+type FavoriteRequest struct {
+	FoodID   int32   `json:"foodId" example:"12"`
+	MealID   int32   `json:"mealId" example:"0"`
+	Servings float64 `json:"servings" example:"1.5"`
+}
+
+// FavoriteResponse defines a favorite food or meal with calories scaled by servings
+type FavoriteResponse struct {
+	ID        int32     `json:"id" example:"5"`
+	FoodID    int32     `json:"foodId,omitempty" example:"12"`
+	MealID    int32     `json:"mealId,omitempty" example:"0"`
+	Name      string    `json:"name" example:"Greek Yogurt - Plain"`
+	Servings  float64   `json:"servings" example:"1.5"`
+	Calories  float64   `json:"calories" example:"195"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// RecentItemResponse defines a food or meal from the diary history with the
+// servings it was last logged with
+type RecentItemResponse struct {
+	FoodID      int32   `json:"foodId,omitempty" example:"12"`
+	MealID      int32   `json:"mealId,omitempty" example:"0"`
+	Name        string  `json:"name" example:"Greek Yogurt - Plain"`
+	Servings    float64 `json:"servings" example:"1.5"`
+	Calories    float64 `json:"calories" example:"195"`
+	LastLogged  string  `json:"lastLogged" example:"2025-03-09"`
+	TimesLogged int32   `json:"timesLogged" example:"9"`
+	Favorite    bool    `json:"favorite" example:"true"`
+}
+
+// CopyDiaryRequest defines the request payload for copying diary entries.
+// Without mealNumber the whole day is copied.
+type CopyDiaryRequest struct {
+	FromDate     string `json:"fromDate" binding:"required" example:"2025-03-08"`
+	ToDate       string `json:"toDate" example:"2025-03-09"`
+	MealNumber   int32  `json:"mealNumber" example:"1"`
+	ToMealNumber int32  `json:"toMealNumber" example:"0"`
+}
+
+// CopyDiaryResponse defines the diary of the target date after a copy
+type CopyDiaryResponse struct {
+	Date    string               `json:"date" example:"2025-03-09"`
+	Copied  int32                `json:"copied" example:"3"`
+	Entries []DiaryEntryResponse `json:"entries"`
+}
+
+// listFavoritesHandler godoc
+// @Summary      List Favorites
+// @Description  List the authenticated user's favorite foods and meals by name
+// @Tags         diary
+// @Produce      json
+// @Security     Bearer
+// @Success      200  {array}   FavoriteResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/diary/favorites [get]
+func listFavoritesHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Diary service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewDiaryServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.ListFavorites(ctx, &pb.ListFavoritesRequest{
+			UserId: int32(c.GetInt("user_id")),
+		})
+		if err != nil {
+			log.Printf("Error calling ListFavorites: %v", err)
+			c.JSON(500, gin.H{"error": "Diary service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to list favorites")
+			return
+		}
+
+		favorites := make([]FavoriteResponse, len(resp.Favorites))
+		for i, favorite := range resp.Favorites {
+			favorites[i] = toFavoriteResponse(favorite)
+		}
+
+		c.JSON(200, favorites)
+	}
+}
+
+// addFavoriteHandler godoc
+// @Summary      Add Favorite
+// @Description  Mark a food or meal as a favorite for quick logging. Servings (default 1) is what the favorite is usually logged with; adding a favorite again updates it.
+// @Tags         diary
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        request  body      FavoriteRequest  true  "Favorite food or meal"
+// @Success      201      {object}  FavoriteResponse
+// @Failure      400      {object}  ErrorResponse
+// @Failure      401      {object}  ErrorResponse
+// @Failure      404      {object}  ErrorResponse
+// @Failure      500      {object}  ErrorResponse
+// @Router       /api/diary/favorites [post]
+func addFavoriteHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req FavoriteRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(400, gin.H{"error": "Invalid request payload"})
+			return
+		}
+
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Diary service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewDiaryServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.AddFavorite(ctx, &pb.AddFavoriteRequest{
+			UserId:   int32(c.GetInt("user_id")),
+			FoodId:   req.FoodID,
+			MealId:   req.MealID,
+			Servings: req.Servings,
+		})
+		if err != nil {
+			log.Printf("Error calling AddFavorite: %v", err)
+			c.JSON(500, gin.H{"error": "Diary service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to add favorite")
+			return
+		}
+
+		c.JSON(201, toFavoriteResponse(resp.Favorite))
+	}
+}
+
+// removeFavoriteHandler godoc
+// @Summary      Remove Favorite
+// @Description  Remove one of the authenticated user's favorites
+// @Tags         diary
+// @Produce      json
+// @Security     Bearer
+// @Param        id   path      int  true  "Favorite ID"
+// @Success      200  {object}  MessageResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/diary/favorites/{id} [delete]
+func removeFavoriteHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(400, gin.H{"error": "Invalid favorite ID"})
+			return
+		}
+
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Diary service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewDiaryServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.RemoveFavorite(ctx, &pb.RemoveFavoriteRequest{
+			Id:     int32(id),
+			UserId: int32(c.GetInt("user_id")),
+		})
+		if err != nil {
+			log.Printf("Error calling RemoveFavorite: %v", err)
+			c.JSON(500, gin.H{"error": "Diary service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to remove favorite")
+			return
+		}
+
+		c.JSON(200, MessageResponse{Message: resp.Message})
+	}
+}
+
+// listRecentItemsHandler godoc
+// @Summary      List Recent Items
+// @Description  List the foods and meals the authenticated user logged in the last 30 days, most recently logged first, with the servings each was last logged with and how often it was logged. Log one again with POST /api/diary.
+// @Tags         diary
+// @Produce      json
+// @Security     Bearer
+// @Param        limit  query     int  false  "Number of items (default 20, max 50)"
+// @Success      200    {array}   RecentItemResponse
+// @Failure      400    {object}  ErrorResponse
+// @Failure      401    {object}  ErrorResponse
+// @Failure      404    {object}  ErrorResponse
+// @Failure      500    {object}  ErrorResponse
+// @Router       /api/diary/recent [get]
+func listRecentItemsHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var limit int
+		if value := c.Query("limit"); value != "" {
+			var err error
+			limit, err = strconv.Atoi(value)
+			if err != nil || limit <= 0 {
+				c.JSON(400, gin.H{"error": "limit must be a positive integer"})
+				return
+			}
+		}
+
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Diary service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewDiaryServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.ListRecentItems(ctx, &pb.ListRecentItemsRequest{
+			UserId: int32(c.GetInt("user_id")),
+			Limit:  int32(limit),
+		})
+		if err != nil {
+			log.Printf("Error calling ListRecentItems: %v", err)
+			c.JSON(500, gin.H{"error": "Diary service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to list recent items")
+			return
+		}
+
+		items := make([]RecentItemResponse, len(resp.Items))
+		for i, item := range resp.Items {
+			items[i] = RecentItemResponse{
+				FoodID:      item.FoodId,
+				MealID:      item.MealId,
+				Name:        item.Name,
+				Servings:    item.Servings,
+				Calories:    item.Calories,
+				LastLogged:  item.LastLogged,
+				TimesLogged: item.TimesLogged,
+				Favorite:    item.Favorite,
+			}
+		}
+
+		c.JSON(200, items)
+	}
+}
+
+// copyDiaryHandler godoc
+// @Summary      Copy Diary Entries
+// @Description  Copy a day's diary entries, or those of one meal slot (mealNumber), onto another date. toDate defaults to today in the user's timezone; toMealNumber moves a copied meal slot into another slot. Entries already on the target date are kept. Returns the target date's diary.
+// @Tags         diary
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        request  body      CopyDiaryRequest  true  "Source and target of the copy"
+// @Success      201      {object}  CopyDiaryResponse
+// @Failure      400      {object}  ErrorResponse
+// @Failure      401      {object}  ErrorResponse
+// @Failure      404      {object}  ErrorResponse
+// @Failure      500      {object}  ErrorResponse
+// @Router       /api/diary/copy [post]
+func copyDiaryHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req CopyDiaryRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(400, gin.H{"error": "Invalid request payload"})
+			return
+		}
+
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Diary service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewDiaryServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.CopyDiaryEntries(ctx, &pb.CopyDiaryEntriesRequest{
+			UserId:       int32(c.GetInt("user_id")),
+			FromDate:     req.FromDate,
+			ToDate:       req.ToDate,
+			MealNumber:   req.MealNumber,
+			ToMealNumber: req.ToMealNumber,
+		})
+		if err != nil {
+			log.Printf("Error calling CopyDiaryEntries: %v", err)
+			c.JSON(500, gin.H{"error": "Diary service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to copy diary entries")
+			return
+		}
+
+		copied := CopyDiaryResponse{
+			Date:    resp.Date,
+			Copied:  resp.Copied,
+			Entries: make([]DiaryEntryResponse, len(resp.Entries)),
+		}
+		for i, entry := range resp.Entries {
+			copied.Entries[i] = toDiaryEntryResponse(entry)
+		}
+
+		c.JSON(201, copied)
+	}
+}
+
+// toFavoriteResponse converts a proto favorite to its JSON representation
+func toFavoriteResponse(favorite *pb.Favorite) FavoriteResponse {
+	return FavoriteResponse{
+		ID:        favorite.Id,
+		FoodID:    favorite.FoodId,
+		MealID:    favorite.MealId,
+		Name:      favorite.Name,
+		Servings:  favorite.Servings,
+		Calories:  favorite.Calories,
+		CreatedAt: favorite.CreatedAt.AsTime(),
+	}
+}
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	pb "api-service/proto"
+	"github.com/gin-gonic/gin"
+)
+
+// NutritionPeriodResponse defines nutrition consumed during one reporting period
+type NutritionPeriodResponse struct {
+	PeriodStart          string  `json:"periodStart" example:"2025-03-10"`
+	PeriodEnd            string  `json:"periodEnd" example:"2025-03-16"`
+	DaysLogged           int32   `json:"daysLogged" example:"5"`
+	Calories             float64 `json:"calories" example:"9500"`
+	ProteinGrams         float64 `json:"proteinGrams" example:"610"`
+	CarbsGrams           float64 `json:"carbsGrams" example:"980"`
+	FatGrams             float64 `json:"fatGrams" example:"320"`
+	NonInflammatoryFoods int32   `json:"nonInflammatoryFoods" example:"6"`
+	ProbioticFoods       int32   `json:"probioticFoods" example:"1"`
+	PrebioticFoods       int32   `json:"prebioticFoods" example:"2"`
+	FiberGrams           float64 `json:"fiberGrams" example:"150"`
+	SugarGrams           float64 `json:"sugarGrams" example:"210"`
+	SodiumMg             float64 `json:"sodiumMg" example:"11200"`
+	PotassiumMg          float64 `json:"potassiumMg" example:"14500"`
+	IronMg               float64 `json:"ironMg" example:"72"`
+	CalciumMg            float64 `json:"calciumMg" example:"4800"`
+	VitaminDMcg          float64 `json:"vitaminDMcg" example:"45"`
+	Omega3Grams          float64 `json:"omega3Grams" example:"6.5"`
+	UntrackedItems       int32   `json:"untrackedItems" example:"1"`
+	CaloriesBurned       float64 `json:"caloriesBurned" example:"1450"`
+	NetCalories          float64 `json:"netCalories" example:"8050"`
+	// Meal ingredients left out because their unit does not convert to the food's serving unit
+	UnconvertedIngredients int32 `json:"unconvertedIngredients" example:"0"`
+}
+
+// NutrientGapResponse compares the daily intake of one micronutrient with the
+// reference intake for the user's sex and age
+type NutrientGapResponse struct {
+	Nutrient           string   `json:"nutrient" example:"iron"`
+	Unit               string   `json:"unit" example:"mg"`
+	Kind               string   `json:"kind" example:"MINIMUM"`
+	ReferenceIntake    float64  `json:"referenceIntake" example:"18"`
+	AverageIntake      float64  `json:"averageIntake" example:"14.4"`
+	PercentOfReference float64  `json:"percentOfReference" example:"80"`
+	FlaggedDates       []string `json:"flaggedDates" example:"2025-03-10,2025-03-12"`
+}
+
+// NutritionReportResponse defines a nutrition summary grouped by day, week or month
+type NutritionReportResponse struct {
+	Granularity  string                    `json:"granularity" example:"week"`
+	StartDate    string                    `json:"startDate" example:"2025-02-17"`
+	EndDate      string                    `json:"endDate" example:"2025-03-12"`
+	Timezone     string                    `json:"timezone" example:"America/New_York"`
+	Periods      []NutritionPeriodResponse `json:"periods"`
+	NutrientGaps []NutrientGapResponse     `json:"nutrientGaps"`
+	Notes        []string                  `json:"notes"`
+}
+
+// nutritionReportHandler godoc
+// @Summary      Nutrition Report
+// @Description  Summarize consumed calories, calories burned in workouts, net calories (consumed minus burned), macros, micronutrients and non-inflammatory/probiotic/prebiotic food counts per day, week (Monday start) or month. Day boundaries follow the user's timezone; the range defaults to the last 7 days, 4 weeks or 3 months. nutrientGaps compares every logged day with the reference intakes for the user's sex and age and flags days below a minimum (fiber, potassium, iron, calcium, vitamin D, omega-3) or above a limit (sodium); it is skipped with a note when sex or birth date is missing. Meal ingredients are converted to their food's serving unit; any in a unit that cannot be converted (e.g. pieces of a food measured in grams) are left out, counted in unconvertedIngredients and noted.
+// @Tags         reports
+// @Produce      json
+// @Security     Bearer
+// @Param        granularity  query     string  false  "day, week or month"  Enums(day, week, month)
+// @Param        startDate    query     string  false  "First day (YYYY-MM-DD)"
+// @Param        endDate      query     string  false  "Last day (YYYY-MM-DD), defaults to today"
+// @Success      200          {object}  NutritionReportResponse
+// @Failure      400          {object}  ErrorResponse
+// @Failure      401          {object}  ErrorResponse
+// @Failure      500          {object}  ErrorResponse
+// @Router       /api/reports/nutrition [get]
+func nutritionReportHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Reporting service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewNutritionServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.NutritionReport(ctx, &pb.NutritionReportRequest{
+			UserId:      int32(c.GetInt("user_id")),
+			Granularity: c.Query("granularity"),
+			StartDate:   c.Query("startDate"),
+			EndDate:     c.Query("endDate"),
+		})
+		if err != nil {
+			log.Printf("Error calling NutritionReport: %v", err)
+			c.JSON(500, gin.H{"error": "Reporting service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to build nutrition report")
+			return
+		}
+
+		report := NutritionReportResponse{
+			Granularity:  resp.Granularity,
+			StartDate:    resp.StartDate,
+			EndDate:      resp.EndDate,
+			Timezone:     resp.Timezone,
+			Periods:      make([]NutritionPeriodResponse, len(resp.Periods)),
+			NutrientGaps: make([]NutrientGapResponse, len(resp.NutrientGaps)),
+			Notes:        nonNilStrings(resp.Notes),
+		}
+		for i, period := range resp.Periods {
+			report.Periods[i] = NutritionPeriodResponse{
+				PeriodStart:            period.PeriodStart,
+				PeriodEnd:              period.PeriodEnd,
+				DaysLogged:             period.DaysLogged,
+				Calories:               period.Calories,
+				ProteinGrams:           period.ProteinGrams,
+				CarbsGrams:             period.CarbsGrams,
+				FatGrams:               period.FatGrams,
+				NonInflammatoryFoods:   period.NonInflammatoryFoods,
+				ProbioticFoods:         period.ProbioticFoods,
+				PrebioticFoods:         period.PrebioticFoods,
+				FiberGrams:             period.FiberGrams,
+				SugarGrams:             period.SugarGrams,
+				SodiumMg:               period.SodiumMg,
+				PotassiumMg:            period.PotassiumMg,
+				IronMg:                 period.IronMg,
+				CalciumMg:              period.CalciumMg,
+				VitaminDMcg:            period.VitaminDMcg,
+				Omega3Grams:            period.Omega3Grams,
+				UntrackedItems:         period.UntrackedItems,
+				CaloriesBurned:         period.CaloriesBurned,
+				NetCalories:            period.NetCalories,
+				UnconvertedIngredients: period.UnconvertedIngredients,
+			}
+		}
+		for i, gap := range resp.NutrientGaps {
+			report.NutrientGaps[i] = NutrientGapResponse{
+				Nutrient:           gap.Nutrient,
+				Unit:               gap.Unit,
+				Kind:               gap.Kind,
+				ReferenceIntake:    gap.ReferenceIntake,
+				AverageIntake:      gap.AverageIntake,
+				PercentOfReference: gap.PercentOfReference,
+				FlaggedDates:       nonNilStrings(gap.FlaggedDates),
+			}
+		}
+
+		c.JSON(200, report)
+	}
+}
+
+// MealScoresResponse defines the health scores of one meal
+type MealScoresResponse struct {
+	MealNumber        int32   `json:"mealNumber" example:"1"`
+	Calories          float64 `json:"calories" example:"520"`
+	InflammationScore float64 `json:"inflammationScore" example:"12"`
+	GutHealthScore    float64 `json:"gutHealthScore" example:"100"`
+}
+
+// DayScoresResponse defines the health scores of one logged day and its meals
+type DayScoresResponse struct {
+	Date              string               `json:"date" example:"2025-03-10"`
+	Calories          float64              `json:"calories" example:"2150"`
+	InflammationScore float64              `json:"inflammationScore" example:"18"`
+	GutHealthScore    float64              `json:"gutHealthScore" example:"75"`
+	ProbioticServings float64              `json:"probioticServings" example:"1"`
+	PrebioticServings float64              `json:"prebioticServings" example:"1"`
+	Meals             []MealScoresResponse `json:"meals"`
+}
+
+// ScoreTrendResponse defines the weekly change of a score
+type ScoreTrendResponse struct {
+	PointsPerWeek float64 `json:"pointsPerWeek" example:"-3.5"`
+	Direction     string  `json:"direction" example:"improving"`
+}
+
+// HealthScoresResponse defines daily inflammation and gut-health scores with their trends
+type HealthScoresResponse struct {
+	StartDate                string              `json:"startDate" example:"2025-02-13"`
+	EndDate                  string              `json:"endDate" example:"2025-03-12"`
+	Days                     []DayScoresResponse `json:"days"`
+	AverageInflammationScore float64             `json:"averageInflammationScore" example:"21"`
+	AverageGutHealthScore    float64             `json:"averageGutHealthScore" example:"68"`
+	InflammationTrend        *ScoreTrendResponse `json:"inflammationTrend,omitempty"`
+	GutHealthTrend           *ScoreTrendResponse `json:"gutHealthTrend,omitempty"`
+	Notes                    []string            `json:"notes"`
+}
+
+// healthScoresHandler godoc
+// @Summary      Health Scores
+// @Description  Score each logged day and meal for inflammation and gut health from the catalog foods eaten, weighted by portion. The inflammation score is the percentage of calories from foods not flagged non-inflammatory (nightshades always count), so 0 is best. The gut-health score gives up to 50 points for 1 probiotic serving and 50 for 2 prebiotic servings a day, so 100 is best; a meal is measured against its share of the day. Trends are the least-squares change per week over logged days and are omitted with fewer than two. Meals without ingredients and quick-add calories are not scored, and ingredients whose unit does not convert to their food's serving unit are left out and noted. The range defaults to the last 28 days.
+// @Tags         reports
+// @Produce      json
+// @Security     Bearer
+// @Param        startDate  query     string  false  "First day (YYYY-MM-DD), defaults to 27 days before endDate"
+// @Param        endDate    query     string  false  "Last day (YYYY-MM-DD), defaults to today"
+// @Success      200        {object}  HealthScoresResponse
+// @Failure      400        {object}  ErrorResponse
+// @Failure      401        {object}  ErrorResponse
+// @Failure      500        {object}  ErrorResponse
+// @Router       /api/reports/health-scores [get]
+func healthScoresHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Reporting service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewNutritionServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.HealthScores(ctx, &pb.HealthScoresRequest{
+			UserId:    int32(c.GetInt("user_id")),
+			StartDate: c.Query("startDate"),
+			EndDate:   c.Query("endDate"),
+		})
+		if err != nil {
+			log.Printf("Error calling HealthScores: %v", err)
+			c.JSON(500, gin.H{"error": "Reporting service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to build health scores")
+			return
+		}
+
+		scores := HealthScoresResponse{
+			StartDate:                resp.StartDate,
+			EndDate:                  resp.EndDate,
+			Days:                     make([]DayScoresResponse, len(resp.Days)),
+			AverageInflammationScore: resp.AverageInflammationScore,
+			AverageGutHealthScore:    resp.AverageGutHealthScore,
+			InflammationTrend:        toScoreTrendResponse(resp.InflammationTrend),
+			GutHealthTrend:           toScoreTrendResponse(resp.GutHealthTrend),
+			Notes:                    nonNilStrings(resp.Notes),
+		}
+		for i, day := range resp.Days {
+			scores.Days[i] = DayScoresResponse{
+				Date:              day.Date,
+				Calories:          day.Calories,
+				InflammationScore: day.InflammationScore,
+				GutHealthScore:    day.GutHealthScore,
+				ProbioticServings: day.ProbioticServings,
+				PrebioticServings: day.PrebioticServings,
+				Meals:             make([]MealScoresResponse, len(day.Meals)),
+			}
+			for j, meal := range day.Meals {
+				scores.Days[i].Meals[j] = MealScoresResponse{
+					MealNumber:        meal.MealNumber,
+					Calories:          meal.Calories,
+					InflammationScore: meal.InflammationScore,
+					GutHealthScore:    meal.GutHealthScore,
+				}
+			}
+		}
+
+		c.JSON(200, scores)
+	}
+}
+
+func toScoreTrendResponse(trend *pb.ScoreTrend) *ScoreTrendResponse {
+	if trend == nil {
+		return nil
+	}
+	return &ScoreTrendResponse{PointsPerWeek: trend.PointsPerWeek, Direction: trend.Direction}
+}
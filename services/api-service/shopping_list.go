@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	pb "api-service/proto"
+	"github.com/gin-gonic/gin"
+)
+
+// ShoppingItemResponse defines a food to buy in its purchasing unit
+type ShoppingItemResponse struct {
+	FoodID   int32   `json:"foodId" example:"1"`
+	Name     string  `json:"name" example:"Salmon - Wild Atlantic"`
+	Quantity float64 `json:"quantity" example:"1.25"`
+	Unit     string  `json:"unit" example:"POUNDS"`
+}
+
+// ShoppingAisleResponse defines the items of one food category
+type ShoppingAisleResponse struct {
+	Category string                 `json:"category" example:"FISH"`
+	Name     string                 `json:"name" example:"Seafood"`
+	Items    []ShoppingItemResponse `json:"items"`
+}
+
+// ShoppingListResponse defines a grocery list for a date range
+type ShoppingListResponse struct {
+	StartDate string                  `json:"startDate" example:"2025-06-02"`
+	EndDate   string                  `json:"endDate" example:"2025-06-08"`
+	Aisles    []ShoppingAisleResponse `json:"aisles"`
+	Notes     []string                `json:"notes"`
+}
+
+// shoppingListContentTypes maps the document formats to their content types
+var shoppingListContentTypes = map[string]string{
+	"markdown": "text/markdown; charset=utf-8",
+	"text":     "text/plain; charset=utf-8",
+}
+
+// shoppingListHandler godoc
+// @Summary      Shopping List
+// @Description  Build one grocery list from the authenticated user's planned meals. Meal ingredients and single planned foods are added up per food, converted to one purchasing unit per food (ounces up to a pound, then pounds; teaspoons, tablespoons or cups; whole pieces) and rounded up, then grouped into aisles by food category. The range defaults to the week starting today and may cover up to 31 days. format=markdown returns a Markdown checklist and format=text plain text.
+// @Tags         meal-plan
+// @Produce      json
+// @Produce      plain
+// @Produce      text/markdown
+// @Security     Bearer
+// @Param        startDate  query     string  false  "First day (YYYY-MM-DD)"
+// @Param        endDate    query     string  false  "Last day (YYYY-MM-DD)"
+// @Param        format     query     string  false  "json (default), markdown or text"
+// @Success      200        {object}  ShoppingListResponse
+// @Failure      400        {object}  ErrorResponse
+// @Failure      401        {object}  ErrorResponse
+// @Failure      500        {object}  ErrorResponse
+// @Router       /api/shopping-list [get]
+func shoppingListHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Shopping list service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewShoppingListServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.GetShoppingList(ctx, &pb.GetShoppingListRequest{
+			UserId:    int32(c.GetInt("user_id")),
+			StartDate: c.Query("startDate"),
+			EndDate:   c.Query("endDate"),
+			Format:    c.Query("format"),
+		})
+		if err != nil {
+			log.Printf("Error calling GetShoppingList: %v", err)
+			c.JSON(500, gin.H{"error": "Shopping list service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to get shopping list")
+			return
+		}
+
+		if contentType, ok := shoppingListContentTypes[c.Query("format")]; ok {
+			c.Data(200, contentType, []byte(resp.Document))
+			return
+		}
+
+		list := ShoppingListResponse{
+			StartDate: resp.List.StartDate,
+			EndDate:   resp.List.EndDate,
+			Aisles:    make([]ShoppingAisleResponse, len(resp.List.Aisles)),
+			Notes:     nonNilStrings(resp.List.Notes),
+		}
+		for i, aisle := range resp.List.Aisles {
+			items := make([]ShoppingItemResponse, len(aisle.Items))
+			for j, item := range aisle.Items {
+				items[j] = ShoppingItemResponse{
+					FoodID:   item.FoodId,
+					Name:     item.Name,
+					Quantity: item.Quantity,
+					Unit:     item.Unit,
+				}
+			}
+			list.Aisles[i] = ShoppingAisleResponse{Category: aisle.Category, Name: aisle.Name, Items: items}
+		}
+
+		c.JSON(200, list)
+	}
+}
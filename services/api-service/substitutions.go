@@ -0,0 +1,267 @@
+package main
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	pb "api-service/proto"
+	"github.com/gin-gonic/gin"
+)
+
+// SubstituteResponse defines a food sized to replace an amount of another food.
+// distance is 0 for a perfect macro match and grows as the nutrition drifts apart.
+type SubstituteResponse struct {
+	FoodID            int32   `json:"foodId" example:"1"`
+	Name              string  `json:"name" example:"Salmon - Wild Atlantic"`
+	Category          string  `json:"category" example:"FISH"`
+	ServingUnits      string  `json:"servingUnits" example:"OUNCES"`
+	Servings          float64 `json:"servings" example:"4"`
+	Calories          float64 `json:"calories" example:"620"`
+	ProteinGrams      float64 `json:"proteinGrams" example:"88"`
+	CarbsGrams        float64 `json:"carbsGrams" example:"0"`
+	FatGrams          float64 `json:"fatGrams" example:"28"`
+	IsNonInflammatory bool    `json:"isNonInflammatory" example:"true"`
+	Liked             bool    `json:"liked" example:"false"`
+	Distance          float64 `json:"distance" example:"0.302"`
+	SameCategory      bool    `json:"sameCategory" example:"false"`
+}
+
+// SubstitutesResponse defines the original amount and its ranked substitutes
+type SubstitutesResponse struct {
+	Original    SubstituteResponse   `json:"original"`
+	Substitutes []SubstituteResponse `json:"substitutes"`
+}
+
+// ApplySubstituteRequest defines the request payload for swapping the food of an
+// entry. ingredientId picks the ingredient to replace when the entry logs a meal.
+type ApplySubstituteRequest struct {
+	FoodID              int32 `json:"foodId" binding:"required" example:"1"`
+	IngredientID        int32 `json:"ingredientId,omitempty" example:"90"`
+	NonInflammatoryOnly bool  `json:"nonInflammatoryOnly" example:"false"`
+}
+
+// MealFoodResponse defines one entry of a meal with nutrition scaled by servings
+type MealFoodResponse struct {
+	EntryID      int32   `json:"entryId" example:"40"`
+	FoodID       int32   `json:"foodId,omitempty" example:"1"`
+	MealID       int32   `json:"mealId,omitempty" example:"12"`
+	Name         string  `json:"name" example:"Salmon - Wild Atlantic"`
+	Servings     float64 `json:"servings" example:"4"`
+	Calories     float64 `json:"calories" example:"620"`
+	ProteinGrams float64 `json:"proteinGrams" example:"88"`
+	CarbsGrams   float64 `json:"carbsGrams" example:"0"`
+	FatGrams     float64 `json:"fatGrams" example:"28"`
+}
+
+// SwappedMealResponse defines the meal an entry belongs to after a swap
+type SwappedMealResponse struct {
+	Date       string             `json:"date" example:"2025-06-02"`
+	MealNumber int32              `json:"mealNumber" example:"1"`
+	IsPlanned  bool               `json:"isPlanned" example:"true"`
+	Foods      []MealFoodResponse `json:"foods"`
+	Totals     DiaryTotals        `json:"totals"`
+}
+
+// suggestSubstitutesHandler godoc
+// @Summary      Suggest Substitutes
+// @Description  Rank the foods the authenticated user may eat that can replace an amount of a catalog food. Candidates come from the same or a compatible category (meat, fish and legumes; grains and legumes; dairy and dairy alternatives; vegetables and nightshades; fats and oils; nuts and seeds) and skip disliked foods, allergens and diet exclusions. Each is sized to the same amount when the units convert (mass, volume) or to the same calories otherwise, and ranked by macro distance. A non-inflammatory food is only replaced by non-inflammatory foods.
+// @Tags         foods
+// @Produce      json
+// @Security     Bearer
+// @Param        foodId               path      int     true   "Food ID"
+// @Param        quantity             query     number  false  "Amount to replace (default 1)"
+// @Param        unit                 query     string  false  "Unit of the amount (GRAMS, OUNCES, TSP, TBSP, CUPS, PIECES; default the food's serving unit)"
+// @Param        nonInflammatoryOnly  query     bool    false  "Only suggest non-inflammatory foods"
+// @Param        limit                query     int     false  "Number of substitutes (default 5, max 20)"
+// @Success      200                  {object}  SubstitutesResponse
+// @Failure      400                  {object}  ErrorResponse
+// @Failure      401                  {object}  ErrorResponse
+// @Failure      404                  {object}  ErrorResponse
+// @Failure      500                  {object}  ErrorResponse
+// @Router       /api/users/me/foods/{foodId}/substitutes [get]
+func suggestSubstitutesHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		foodID, err := strconv.Atoi(c.Param("foodId"))
+		if err != nil {
+			c.JSON(400, gin.H{"error": "Invalid food ID"})
+			return
+		}
+
+		var quantity float64
+		if value := c.Query("quantity"); value != "" {
+			quantity, err = strconv.ParseFloat(value, 64)
+			if err != nil || quantity <= 0 {
+				c.JSON(400, gin.H{"error": "quantity must be a positive number"})
+				return
+			}
+		}
+
+		nonInflammatoryOnly := false
+		if value := c.Query("nonInflammatoryOnly"); value != "" {
+			nonInflammatoryOnly, err = strconv.ParseBool(value)
+			if err != nil {
+				c.JSON(400, gin.H{"error": "nonInflammatoryOnly must be true or false"})
+				return
+			}
+		}
+
+		var limit int
+		if value := c.Query("limit"); value != "" {
+			limit, err = strconv.Atoi(value)
+			if err != nil || limit <= 0 {
+				c.JSON(400, gin.H{"error": "limit must be a positive integer"})
+				return
+			}
+		}
+
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Substitution service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewSubstitutionServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.SuggestSubstitutes(ctx, &pb.SuggestSubstitutesRequest{
+			UserId:              int32(c.GetInt("user_id")),
+			FoodId:              int32(foodID),
+			Quantity:            quantity,
+			Unit:                c.Query("unit"),
+			NonInflammatoryOnly: nonInflammatoryOnly,
+			Limit:               int32(limit),
+		})
+		if err != nil {
+			log.Printf("Error calling SuggestSubstitutes: %v", err)
+			c.JSON(500, gin.H{"error": "Substitution service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to suggest substitutes")
+			return
+		}
+
+		result := SubstitutesResponse{
+			Original:    toSubstituteResponse(resp.Original),
+			Substitutes: make([]SubstituteResponse, len(resp.Substitutes)),
+		}
+		for i, s := range resp.Substitutes {
+			result.Substitutes[i] = toSubstituteResponse(s)
+		}
+
+		c.JSON(200, result)
+	}
+}
+
+// applySubstituteHandler godoc
+// @Summary      Apply Substitute
+// @Description  Swap the food of a single-food diary or meal plan entry, or one ingredient (ingredientId) of a meal entry, for a substitute. The substitute must be one the food could be replaced with (see Suggest Substitutes) and is sized the same way; a meal ingredient is stored in the substitute's serving unit and the meal's totals are recomputed. A meal also used by other entries or favorites is copied for this entry, whose mealId then changes. Returns the entry's meal with recomputed totals.
+// @Tags         diary
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id       path      int                     true  "Diary or meal plan entry ID"
+// @Param        request  body      ApplySubstituteRequest  true  "Substitute"
+// @Success      200      {object}  SwappedMealResponse
+// @Failure      400      {object}  ErrorResponse
+// @Failure      401      {object}  ErrorResponse
+// @Failure      404      {object}  ErrorResponse
+// @Failure      500      {object}  ErrorResponse
+// @Router       /api/diary/{id}/substitute [post]
+func applySubstituteHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(400, gin.H{"error": "Invalid entry ID"})
+			return
+		}
+
+		var req ApplySubstituteRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(400, gin.H{"error": "Invalid request payload"})
+			return
+		}
+
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Substitution service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewSubstitutionServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.ApplySubstitute(ctx, &pb.ApplySubstituteRequest{
+			UserId:              int32(c.GetInt("user_id")),
+			EntryId:             int32(id),
+			FoodId:              req.FoodID,
+			IngredientId:        req.IngredientID,
+			NonInflammatoryOnly: req.NonInflammatoryOnly,
+		})
+		if err != nil {
+			log.Printf("Error calling ApplySubstitute: %v", err)
+			c.JSON(500, gin.H{"error": "Substitution service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to apply substitute")
+			return
+		}
+
+		meal := SwappedMealResponse{
+			Date:       resp.Meal.Date,
+			MealNumber: resp.Meal.MealNumber,
+			IsPlanned:  resp.Meal.IsPlanned,
+			Foods:      make([]MealFoodResponse, len(resp.Meal.Foods)),
+			Totals: DiaryTotals{
+				Calories:     resp.Meal.Calories,
+				ProteinGrams: resp.Meal.ProteinGrams,
+				CarbsGrams:   resp.Meal.CarbsGrams,
+				FatGrams:     resp.Meal.FatGrams,
+			},
+		}
+		for i, f := range resp.Meal.Foods {
+			meal.Foods[i] = MealFoodResponse{
+				EntryID:      f.EntryId,
+				FoodID:       f.FoodId,
+				MealID:       f.MealId,
+				Name:         f.Name,
+				Servings:     f.Servings,
+				Calories:     f.Calories,
+				ProteinGrams: f.ProteinGrams,
+				CarbsGrams:   f.CarbsGrams,
+				FatGrams:     f.FatGrams,
+			}
+		}
+
+		c.JSON(200, meal)
+	}
+}
+
+// toSubstituteResponse converts a protobuf substitute into its JSON response
+func toSubstituteResponse(s *pb.SubstituteFood) SubstituteResponse {
+	return SubstituteResponse{
+		FoodID:            s.FoodId,
+		Name:              s.Name,
+		Category:          s.Category,
+		ServingUnits:      s.ServingUnits,
+		Servings:          s.Servings,
+		Calories:          s.Calories,
+		ProteinGrams:      s.ProteinGrams,
+		CarbsGrams:        s.CarbsGrams,
+		FatGrams:          s.FatGrams,
+		IsNonInflammatory: s.IsNonInflammatory,
+		Liked:             s.Liked,
+		Distance:          s.Distance,
+		SameCategory:      s.SameCategory,
+	}
+}
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Survey routes are served by survey-service. The handlers below forward to
+// the service proxy; they exist so each route is documented in Swagger.
+
+// SurveyRequest defines the payload for creating or updating a survey
+type SurveyRequest struct {
+	Slug        string  `json:"slug" example:"activity-level" maxLength:"50"`
+	Title       string  `json:"title" example:"Activity Level Assessment" maxLength:"100"`
+	Description *string `json:"description,omitempty" example:"How active you are at work and in your free time" maxLength:"500"`
+}
+
+// SurveyResponse defines a survey; currentVersion is omitted until questions are published
+type SurveyResponse struct {
+	ID             int       `json:"id" example:"1"`
+	Slug           string    `json:"slug" example:"activity-level"`
+	Title          string    `json:"title" example:"Activity Level Assessment"`
+	Description    *string   `json:"description,omitempty" example:"How active you are at work and in your free time"`
+	CurrentVersion *int      `json:"currentVersion,omitempty" example:"1"`
+	CreatedAt      time.Time `json:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
+
+// SurveyDetailResponse defines a survey with the questions of its current version
+type SurveyDetailResponse struct {
+	SurveyResponse
+	Questions []SurveyQuestionResponse `json:"questions"`
+}
+
+// QuestionOptionResponse defines a choice of a choice question
+type QuestionOptionResponse struct {
+	Value string `json:"value" example:"YES" maxLength:"50"`
+	Label string `json:"label" example:"Yes" maxLength:"100"`
+}
+
+// ShowIfResponse makes a question conditional on an earlier choice question
+type ShowIfResponse struct {
+	QuestionKey string   `json:"questionKey" example:"exercises_regularly"`
+	Values      []string `json:"values" example:"YES"`
+}
+
+// SurveyQuestionRequest defines a question of a new survey version
+type SurveyQuestionRequest struct {
+	Key       string                   `json:"key" example:"exercise_days_per_week" maxLength:"50"`
+	Type      string                   `json:"type" example:"NUMERIC" enums:"SINGLE_CHOICE,MULTI_CHOICE,NUMERIC,FREE_TEXT,SCALE"`
+	Prompt    string                   `json:"prompt" example:"How many days a week do you exercise?" maxLength:"255"`
+	Required  *bool                    `json:"required,omitempty" example:"true"`
+	MinValue  *float64                 `json:"minValue,omitempty" example:"1"`
+	MaxValue  *float64                 `json:"maxValue,omitempty" example:"7"`
+	MaxLength *int                     `json:"maxLength,omitempty" example:"500"`
+	Options   []QuestionOptionResponse `json:"options,omitempty"`
+	ShowIf    *ShowIfResponse          `json:"showIf,omitempty"`
+}
+
+// SurveyQuestionResponse defines a question of a survey version
+type SurveyQuestionResponse struct {
+	ID       int `json:"id" example:"3"`
+	Position int `json:"position" example:"3"`
+	SurveyQuestionRequest
+}
+
+// SurveyVersionRequest defines the payload for publishing a new survey version
+type SurveyVersionRequest struct {
+	Questions []SurveyQuestionRequest `json:"questions"`
+}
+
+// SurveyVersionResponse defines an immutable set of survey questions
+type SurveyVersionResponse struct {
+	SurveyID  int                      `json:"surveyId" example:"1"`
+	Version   int                      `json:"version" example:"1"`
+	CreatedAt time.Time                `json:"createdAt"`
+	Questions []SurveyQuestionResponse `json:"questions"`
+}
+
+// SurveyAnswersRequest defines the payload for submitting a survey
+type SurveyAnswersRequest struct {
+	Version *int                   `json:"version,omitempty" example:"1"`
+	Answers map[string]interface{} `json:"answers"`
+}
+
+// SurveyAnswersResponse defines a submitted survey response
+type SurveyAnswersResponse struct {
+	ID          int                    `json:"id" example:"12"`
+	SurveyID    int                    `json:"surveyId" example:"1"`
+	Version     int                    `json:"version" example:"1"`
+	SubmittedAt time.Time              `json:"submittedAt"`
+	Answers     map[string]interface{} `json:"answers"`
+}
+
+// SurveyValidationResponse is returned when answers break the survey's rules
+type SurveyValidationResponse struct {
+	Error      string              `json:"error" example:"survey response is invalid"`
+	Violations []ViolationResponse `json:"violations"`
+}
+
+// listSurveysHandler godoc
+// @Summary      List Surveys
+// @Tags         surveys
+// @Produce      json
+// @Security     Bearer
+// @Success      200  {array}   SurveyResponse
+// @Failure      401  {object}  ErrorResponse
+// @Router       /api/surveys [get]
+func listSurveysHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// createSurveyHandler godoc
+// @Summary      Create Survey
+// @Description  Create a survey without questions; publish questions with POST /api/surveys/{id}/versions. Admins only.
+// @Tags         surveys
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        survey  body      SurveyRequest  true  "Survey"
+// @Success      201     {object}  SurveyResponse
+// @Failure      400     {object}  ErrorResponse
+// @Failure      401     {object}  ErrorResponse
+// @Failure      403     {object}  ErrorResponse
+// @Failure      409     {object}  ErrorResponse  "Slug already exists"
+// @Router       /api/surveys [post]
+func createSurveyHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// getSurveyHandler godoc
+// @Summary      Get Survey
+// @Description  Get a survey with the questions of its current version
+// @Tags         surveys
+// @Produce      json
+// @Security     Bearer
+// @Param        id   path      int  true  "Survey ID"
+// @Success      200  {object}  SurveyDetailResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /api/surveys/{id} [get]
+func getSurveyHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// updateSurveyHandler godoc
+// @Summary      Update Survey
+// @Description  Update a survey's slug, title and description; questions are changed by publishing a new version. Admins only.
+// @Tags         surveys
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id      path      int            true  "Survey ID"
+// @Param        survey  body      SurveyRequest  true  "Survey"
+// @Success      200     {object}  SurveyResponse
+// @Failure      400     {object}  ErrorResponse
+// @Failure      401     {object}  ErrorResponse
+// @Failure      403     {object}  ErrorResponse
+// @Failure      404     {object}  ErrorResponse
+// @Failure      409     {object}  ErrorResponse  "Slug already exists"
+// @Router       /api/surveys/{id} [put]
+func updateSurveyHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// deleteSurveyHandler godoc
+// @Summary      Delete Survey
+// @Description  Delete a survey and its versions. Surveys that users have answered cannot be deleted. Admins only.
+// @Tags         surveys
+// @Security     Bearer
+// @Param        id  path  int  true  "Survey ID"
+// @Success      204
+// @Failure      401  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse  "Survey has responses"
+// @Router       /api/surveys/{id} [delete]
+func deleteSurveyHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// createSurveyVersionHandler godoc
+// @Summary      Publish Survey Version
+// @Description  Publish a new immutable version of a survey's questions, which becomes the current version. Choice questions need at least two options; NUMERIC questions may set minValue and maxValue; SCALE questions need whole-number bounds with 2 to 11 steps; FREE_TEXT answers are limited to maxLength (default 2000) characters. showIf branches on an earlier choice question: the question is only asked when that answer includes one of the listed values. Questions are required unless required is false. Admins only.
+// @Tags         surveys
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id       path      int                   true  "Survey ID"
+// @Param        version  body      SurveyVersionRequest  true  "Questions"
+// @Success      201      {object}  SurveyVersionResponse
+// @Failure      400      {object}  ErrorResponse
+// @Failure      401      {object}  ErrorResponse
+// @Failure      403      {object}  ErrorResponse
+// @Failure      404      {object}  ErrorResponse
+// @Router       /api/surveys/{id}/versions [post]
+func createSurveyVersionHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// getSurveyVersionHandler godoc
+// @Summary      Get Survey Version
+// @Tags         surveys
+// @Produce      json
+// @Security     Bearer
+// @Param        id       path      int  true  "Survey ID"
+// @Param        version  path      int  true  "Version number"
+// @Success      200      {object}  SurveyVersionResponse
+// @Failure      401      {object}  ErrorResponse
+// @Failure      404      {object}  ErrorResponse
+// @Router       /api/surveys/{id}/versions/{version} [get]
+func getSurveyVersionHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// submitSurveyResponseHandler godoc
+// @Summary      Submit Survey
+// @Description  Submit the authenticated user's answers, keyed by question key, to the current version (or the given version). Answers are an option value for SINGLE_CHOICE, a list of option values for MULTI_CHOICE, a number for NUMERIC and SCALE, and text for FREE_TEXT. Questions hidden by showIf must be left out. Every rejected answer is reported as a violation.
+// @Tags         surveys
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        surveyId  path      int                   true  "Survey ID"
+// @Param        response  body      SurveyAnswersRequest  true  "Answers"
+// @Success      201       {object}  SurveyAnswersResponse
+// @Failure      400       {object}  ErrorResponse
+// @Failure      401       {object}  ErrorResponse
+// @Failure      404       {object}  ErrorResponse  "Survey version not found"
+// @Failure      422       {object}  SurveyValidationResponse  "Invalid answers"
+// @Router       /api/users/me/surveys/{surveyId}/responses [post]
+func submitSurveyResponseHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// getLatestSurveyResponseHandler godoc
+// @Summary      My Latest Survey Response
+// @Description  Get the authenticated user's most recent response to a survey, in any version
+// @Tags         surveys
+// @Produce      json
+// @Security     Bearer
+// @Param        surveyId  path      int  true  "Survey ID"
+// @Success      200       {object}  SurveyAnswersResponse
+// @Failure      401       {object}  ErrorResponse
+// @Failure      404       {object}  ErrorResponse  "No response yet"
+// @Router       /api/users/me/surveys/{surveyId}/responses/latest [get]
+func getLatestSurveyResponseHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	pb "api-service/proto"
+	"github.com/gin-gonic/gin"
+)
+
+// NutritionTargetsResponse defines a user's daily calorie and macro targets
+type NutritionTargetsResponse struct {
+	BMR                      float64  `json:"bmr" example:"1300"`
+	TDEE                     float64  `json:"tdee" example:"1788"`
+	CalorieAdjustmentPercent float64  `json:"calorieAdjustmentPercent" example:"-20"`
+	Calories                 float64  `json:"calories" example:"1430"`
+	ProteinGrams             float64  `json:"proteinGrams" example:"132"`
+	CarbsGrams               float64  `json:"carbsGrams" example:"117"`
+	FatGrams                 float64  `json:"fatGrams" example:"48"`
+	ActivityLevel            string   `json:"activityLevel" example:"LIGHT"`
+	Goals                    []string `json:"goals" example:"Weight/Lose,Strength/Gain"`
+	Notes                    []string `json:"notes"`
+	CyclePhase               string   `json:"cyclePhase,omitempty" example:"LUTEAL"`
+}
+
+// nutritionTargetsHandler godoc
+// @Summary      Nutrition Targets
+// @Description  Daily calorie and macro targets. BMR uses Mifflin-St Jeor from the user's height, weight, age and biological sex, scaled by activity level and adjusted for the user's Weight and Appearance goals. For users who opted in to cycle tracking, today's cycle phase is returned and applied (calories +5% as carbohydrate in the luteal phase).
+// @Tags         nutrition
+// @Produce      json
+// @Security     Bearer
+// @Success      200  {object}  NutritionTargetsResponse
+// @Failure      400  {object}  ErrorResponse  "Profile is missing height, weight, birth date or sex"
+// @Failure      401  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /api/nutrition/targets [get]
+func nutritionTargetsHandler(dbGatewayAddr string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		conn, err := dialService(dbGatewayAddr)
+		if err != nil {
+			log.Printf("Failed to connect to DB gateway: %v", err)
+			c.JSON(500, gin.H{"error": "Nutrition service unavailable"})
+			return
+		}
+		defer conn.Close()
+
+		client := pb.NewNutritionServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+		defer cancel()
+
+		resp, err := client.GetNutritionTargets(ctx, &pb.GetNutritionTargetsRequest{
+			UserId: int32(c.GetInt("user_id")),
+		})
+		if err != nil {
+			log.Printf("Error calling GetNutritionTargets: %v", err)
+			c.JSON(500, gin.H{"error": "Nutrition service unavailable"})
+			return
+		}
+		if resp.Error != "" {
+			respondServiceError(c, resp.Error, "Failed to calculate nutrition targets")
+			return
+		}
+
+		targets := resp.Targets
+		c.JSON(200, NutritionTargetsResponse{
+			BMR:                      targets.Bmr,
+			TDEE:                     targets.Tdee,
+			CalorieAdjustmentPercent: targets.CalorieAdjustmentPercent,
+			Calories:                 targets.Calories,
+			ProteinGrams:             targets.ProteinGrams,
+			CarbsGrams:               targets.CarbsGrams,
+			FatGrams:                 targets.FatGrams,
+			ActivityLevel:            targets.ActivityLevel,
+			Goals:                    targets.Goals,
+			Notes:                    targets.Notes,
+			CyclePhase:               targets.CyclePhase,
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package main
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Exercise and workout routes are served by workout-service. The handlers
+// below forward to the service proxy; they exist so each route is documented in Swagger.
+
+// ExerciseRequest defines the payload for creating a custom exercise
+type ExerciseRequest struct {
+	Name         string `json:"name" example:"Trail Running" maxLength:"100"`
+	ExerciseType string `json:"exerciseType" example:"ENDURANCE" enums:"STRENGTH,ENDURANCE"`
+}
+
+// ExerciseResponse defines a built-in or custom exercise
+type ExerciseResponse struct {
+	ID           int    `json:"id" example:"1"`
+	Name         string `json:"name" example:"Back Squat"`
+	ExerciseType string `json:"exerciseType" example:"STRENGTH"`
+	Custom       bool   `json:"custom" example:"false"`
+}
+
+// WorkoutSetRequest defines a set of a workout. Strength sets need reps and
+// may have a weight; endurance sets need a duration and may have a distance
+// and elevation gain.
+type WorkoutSetRequest struct {
+	ExerciseID          int      `json:"exerciseId" example:"1"`
+	Reps                *int     `json:"reps,omitempty" example:"5" minimum:"1" maximum:"1000"`
+	WeightKg            *float64 `json:"weightKg,omitempty" example:"100" minimum:"0" maximum:"1000"`
+	DurationSeconds     *int     `json:"durationSeconds,omitempty" example:"1500" minimum:"1" maximum:"86400"`
+	DistanceMeters      *float64 `json:"distanceMeters,omitempty" example:"5000"`
+	ElevationGainMeters *float64 `json:"elevationGainMeters,omitempty" example:"45" minimum:"0" maximum:"20000"`
+	AvgHeartRate        *int     `json:"avgHeartRate,omitempty" example:"152" minimum:"30" maximum:"250"`
+	MaxHeartRate        *int     `json:"maxHeartRate,omitempty" example:"171" minimum:"30" maximum:"250"`
+}
+
+// WorkoutRequest defines the payload for logging or replacing a workout
+type WorkoutRequest struct {
+	StartedAt       *time.Time          `json:"startedAt,omitempty" example:"2025-03-10T18:00:00Z"`
+	DurationMinutes *int                `json:"durationMinutes,omitempty" example:"60" minimum:"1" maximum:"1440"`
+	Notes           *string             `json:"notes,omitempty" example:"Leg day" maxLength:"500"`
+	CaloriesBurned  *float64            `json:"caloriesBurned,omitempty" example:"420" minimum:"0" maximum:"10000"`
+	Sets            []WorkoutSetRequest `json:"sets"`
+}
+
+// WorkoutSetResponse defines a logged set with its derived pace or estimated one-rep max
+type WorkoutSetResponse struct {
+	ID                   int      `json:"id" example:"11"`
+	ExerciseID           int      `json:"exerciseId" example:"1"`
+	ExerciseName         string   `json:"exerciseName" example:"Back Squat"`
+	ExerciseType         string   `json:"exerciseType" example:"STRENGTH"`
+	SetNumber            int      `json:"setNumber" example:"1"`
+	Reps                 *int     `json:"reps,omitempty" example:"5"`
+	WeightKg             *float64 `json:"weightKg,omitempty" example:"100"`
+	DurationSeconds      *int     `json:"durationSeconds,omitempty" example:"1500"`
+	DistanceMeters       *float64 `json:"distanceMeters,omitempty" example:"5000"`
+	ElevationGainMeters  *float64 `json:"elevationGainMeters,omitempty" example:"45"`
+	AvgHeartRate         *int     `json:"avgHeartRate,omitempty" example:"152"`
+	MaxHeartRate         *int     `json:"maxHeartRate,omitempty" example:"171"`
+	PaceSecondsPerKm     *float64 `json:"paceSecondsPerKm,omitempty" example:"300"`
+	EstimatedOneRepMaxKg *float64 `json:"estimatedOneRepMaxKg,omitempty" example:"116.7"`
+}
+
+// WorkoutResponse defines a training session and its sets
+type WorkoutResponse struct {
+	ID              int                  `json:"id" example:"5"`
+	StartedAt       time.Time            `json:"startedAt"`
+	DurationMinutes *int                 `json:"durationMinutes,omitempty" example:"60"`
+	Notes           *string              `json:"notes,omitempty" example:"Leg day"`
+	CaloriesBurned  *float64             `json:"caloriesBurned,omitempty" example:"420"`
+	Source          string               `json:"source" example:"MANUAL" enums:"MANUAL,GPX,TCX,FIT"`
+	Sets            []WorkoutSetResponse `json:"sets"`
+	CreatedAt       time.Time            `json:"createdAt"`
+	UpdatedAt       time.Time            `json:"updatedAt"`
+}
+
+// HeartRateSampleResponse defines the heart rate at a point of a workout
+type HeartRateSampleResponse struct {
+	ElapsedSeconds int `json:"elapsedSeconds" example:"300"`
+	HeartRate      int `json:"heartRate" example:"148"`
+}
+
+// WorkoutHeartRateResponse defines the heart rate samples of a workout
+type WorkoutHeartRateResponse struct {
+	WorkoutID int                       `json:"workoutId" example:"5"`
+	Samples   []HeartRateSampleResponse `json:"samples"`
+}
+
+// PersonalRecordResponse defines the user's best set of an exercise by one measure
+type PersonalRecordResponse struct {
+	Record     string    `json:"record" example:"ESTIMATED_ONE_REP_MAX" enums:"ESTIMATED_ONE_REP_MAX,HEAVIEST_WEIGHT,MOST_REPS,BEST_SET_VOLUME,LONGEST_DISTANCE,LONGEST_DURATION,FASTEST_PACE"`
+	Value      float64   `json:"value" example:"116.7"`
+	Unit       string    `json:"unit" example:"kg"`
+	WorkoutID  int       `json:"workoutId" example:"5"`
+	SetNumber  int       `json:"setNumber" example:"1"`
+	AchievedAt time.Time `json:"achievedAt"`
+}
+
+// ExerciseRecordsResponse defines the personal records of an exercise
+type ExerciseRecordsResponse struct {
+	Exercise ExerciseResponse         `json:"exercise"`
+	Records  []PersonalRecordResponse `json:"records"`
+}
+
+// listExercisesHandler godoc
+// @Summary      List Exercises
+// @Description  List the built-in exercises and the authenticated user's custom exercises, by name
+// @Tags         workouts
+// @Produce      json
+// @Security     Bearer
+// @Param        type  query     string  false  "Only STRENGTH or ENDURANCE exercises"
+// @Success      200   {array}   ExerciseResponse
+// @Failure      400   {object}  ErrorResponse
+// @Failure      401   {object}  ErrorResponse
+// @Router       /api/exercises [get]
+func listExercisesHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// createExerciseHandler godoc
+// @Summary      Create Exercise
+// @Description  Add a custom exercise. The name must not match a built-in exercise or another of the user's own (case-insensitive).
+// @Tags         workouts
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        exercise  body      ExerciseRequest  true  "Exercise"
+// @Success      201       {object}  ExerciseResponse
+// @Failure      400       {object}  ErrorResponse
+// @Failure      401       {object}  ErrorResponse
+// @Failure      409       {object}  ErrorResponse
+// @Router       /api/exercises [post]
+func createExerciseHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// getExerciseRecordsHandler godoc
+// @Summary      Get Personal Records
+// @Description  The user's best sets of an exercise. Strength: estimated one-rep max (Epley, from sets of up to 12 reps), heaviest weight, most reps and best set volume. Endurance: longest distance, longest duration and fastest pace over at least 1 km. Ties keep the earliest set.
+// @Tags         workouts
+// @Produce      json
+// @Security     Bearer
+// @Param        id   path      int  true  "Exercise ID"
+// @Success      200  {object}  ExerciseRecordsResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /api/exercises/{id}/records [get]
+func getExerciseRecordsHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// listWorkoutsHandler godoc
+// @Summary      List Workouts
+// @Description  List the authenticated user's workouts with their sets, newest first
+// @Tags         workouts
+// @Produce      json
+// @Security     Bearer
+// @Param        from   query     string  false  "Only workouts started at or after this RFC 3339 time"
+// @Param        to     query     string  false  "Only workouts started before this RFC 3339 time"
+// @Param        limit  query     int     false  "Maximum number of workouts (1-200, default 50)"
+// @Success      200    {array}   WorkoutResponse
+// @Failure      400    {object}  ErrorResponse
+// @Failure      401    {object}  ErrorResponse
+// @Router       /api/workouts [get]
+func listWorkoutsHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// createWorkoutHandler godoc
+// @Summary      Log Workout
+// @Description  Log a training session with up to 100 sets, numbered in the order given. startedAt defaults to now and cannot be in the future.
+// @Tags         workouts
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        workout  body      WorkoutRequest  true  "Workout"
+// @Success      201      {object}  WorkoutResponse
+// @Failure      400      {object}  ErrorResponse
+// @Failure      401      {object}  ErrorResponse
+// @Router       /api/workouts [post]
+func createWorkoutHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// importWorkoutHandler godoc
+// @Summary      Import Activity File
+// @Description  Import a GPX, TCX or FIT file (up to 25 MB) as an endurance workout with one set holding its duration, distance, elevation gain and heart rate; heart rate samples are stored too. The exercise follows the file's sport (running, cycling, walking, hiking, swimming, rowing) unless exerciseId is given. Calories come from the file or are estimated from the exercise's MET value and the user's weight, and count toward the nutrition report's calories burned. A file that was already imported is rejected with 409.
+// @Tags         workouts
+// @Accept       multipart/form-data
+// @Produce      json
+// @Security     Bearer
+// @Param        file        formData  file    true   "GPX, TCX or FIT activity file"
+// @Param        exerciseId  query     int     false  "Endurance exercise to log the activity as"
+// @Success      201         {object}  WorkoutResponse
+// @Failure      400         {object}  ErrorResponse
+// @Failure      401         {object}  ErrorResponse
+// @Failure      409         {object}  ErrorResponse
+// @Failure      413         {object}  ErrorResponse
+// @Router       /api/workouts/import [post]
+func importWorkoutHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// getWorkoutHandler godoc
+// @Summary      Get Workout
+// @Tags         workouts
+// @Produce      json
+// @Security     Bearer
+// @Param        id   path      int  true  "Workout ID"
+// @Success      200  {object}  WorkoutResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /api/workouts/{id} [get]
+func getWorkoutHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// updateWorkoutHandler godoc
+// @Summary      Update Workout
+// @Description  Replace a workout and all of its sets; fields left out are cleared, except startedAt which is kept
+// @Tags         workouts
+// @Accept       json
+// @Produce      json
+// @Security     Bearer
+// @Param        id       path      int             true  "Workout ID"
+// @Param        workout  body      WorkoutRequest  true  "Workout"
+// @Success      200      {object}  WorkoutResponse
+// @Failure      400      {object}  ErrorResponse
+// @Failure      401      {object}  ErrorResponse
+// @Failure      404      {object}  ErrorResponse
+// @Router       /api/workouts/{id} [put]
+func updateWorkoutHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// deleteWorkoutHandler godoc
+// @Summary      Delete Workout
+// @Tags         workouts
+// @Security     Bearer
+// @Param        id  path  int  true  "Workout ID"
+// @Success      204
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /api/workouts/{id} [delete]
+func deleteWorkoutHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
+
+// getWorkoutHeartRateHandler godoc
+// @Summary      Get Workout Heart Rate
+// @Description  Heart rate samples of a workout imported from an activity file, at most one per second; empty for logged workouts
+// @Tags         workouts
+// @Produce      json
+// @Security     Bearer
+// @Param        id   path      int  true  "Workout ID"
+// @Success      200  {object}  WorkoutHeartRateResponse
+// @Failure      401  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /api/workouts/{id}/heart-rate [get]
+func getWorkoutHeartRateHandler(proxy gin.HandlerFunc) gin.HandlerFunc { return proxy }
@@ -0,0 +1,332 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Limits from the CHECK_INS table constraints
+const (
+	minRating      = 1
+	maxRating      = 5
+	maxSleepHours  = 24
+	maxNotesLength = 500
+)
+
+// List paging limits
+const (
+	defaultListLimit = 100
+	maxListLimit     = 500
+)
+
+// maxClockSkew tolerates client clocks running slightly ahead of the server
+const maxClockSkew = 5 * time.Minute
+
+// now returns the current time; tests replace it to pin the clock
+var now = time.Now
+
+// CheckIn is a body metrics and wellbeing entry from the CHECK_INS table
+type CheckIn struct {
+	ID             int       `db:"id" json:"id"`
+	RecordedAt     time.Time `db:"recorded_at" json:"recordedAt"`
+	WeightKg       *float64  `db:"weight_kg" json:"weightKg,omitempty"`
+	BodyFatPercent *float64  `db:"body_fat_percent" json:"bodyFatPercent,omitempty"`
+	WaistCm        *float64  `db:"waist_cm" json:"waistCm,omitempty"`
+	HipCm          *float64  `db:"hip_cm" json:"hipCm,omitempty"`
+	EnergyLevel    *int      `db:"energy_level" json:"energyLevel,omitempty"`
+	SleepHours     *float64  `db:"sleep_hours" json:"sleepHours,omitempty"`
+	Mood           *int      `db:"mood" json:"mood,omitempty"`
+	Notes          *string   `db:"notes" json:"notes,omitempty"`
+	CreatedAt      time.Time `db:"created_at" json:"createdAt"`
+	UpdatedAt      time.Time `db:"updated_at" json:"updatedAt"`
+}
+
+// CheckInRequest is the body for creating or replacing a check-in
+type CheckInRequest struct {
+	RecordedAt     *time.Time `json:"recordedAt"`
+	WeightKg       *float64   `json:"weightKg"`
+	BodyFatPercent *float64   `json:"bodyFatPercent"`
+	WaistCm        *float64   `json:"waistCm"`
+	HipCm          *float64   `json:"hipCm"`
+	EnergyLevel    *int       `json:"energyLevel"`
+	SleepHours     *float64   `json:"sleepHours"`
+	Mood           *int       `json:"mood"`
+	Notes          *string    `json:"notes"`
+}
+
+const checkInColumns = `id, recorded_at, weight_kg, body_fat_percent, waist_cm, hip_cm,
+	energy_level, sleep_hours, mood, notes, created_at, updated_at`
+
+// validate checks a check-in request against the CHECK_INS table constraints
+// and normalizes recordedAt to UTC
+func (req *CheckInRequest) validate() error {
+	if req.WeightKg == nil && req.BodyFatPercent == nil && req.WaistCm == nil && req.HipCm == nil &&
+		req.EnergyLevel == nil && req.SleepHours == nil && req.Mood == nil && req.Notes == nil {
+		return fmt.Errorf("at least one measurement is required")
+	}
+	if req.WeightKg != nil && *req.WeightKg <= 0 {
+		return fmt.Errorf("invalid weightKg: must be greater than 0")
+	}
+	if req.BodyFatPercent != nil && (*req.BodyFatPercent <= 0 || *req.BodyFatPercent >= 100) {
+		return fmt.Errorf("invalid bodyFatPercent: must be between 0 and 100")
+	}
+	if req.WaistCm != nil && *req.WaistCm <= 0 {
+		return fmt.Errorf("invalid waistCm: must be greater than 0")
+	}
+	if req.HipCm != nil && *req.HipCm <= 0 {
+		return fmt.Errorf("invalid hipCm: must be greater than 0")
+	}
+	if req.EnergyLevel != nil && (*req.EnergyLevel < minRating || *req.EnergyLevel > maxRating) {
+		return fmt.Errorf("invalid energyLevel: must be between %d and %d", minRating, maxRating)
+	}
+	if req.SleepHours != nil && (*req.SleepHours < 0 || *req.SleepHours > maxSleepHours) {
+		return fmt.Errorf("invalid sleepHours: must be between 0 and %d", maxSleepHours)
+	}
+	if req.Mood != nil && (*req.Mood < minRating || *req.Mood > maxRating) {
+		return fmt.Errorf("invalid mood: must be between %d and %d", minRating, maxRating)
+	}
+	if req.Notes != nil && len(*req.Notes) > maxNotesLength {
+		return fmt.Errorf("invalid notes: must be at most %d characters", maxNotesLength)
+	}
+	if req.RecordedAt != nil {
+		if req.RecordedAt.After(now().Add(maxClockSkew)) {
+			return fmt.Errorf("invalid recordedAt: cannot be in the future")
+		}
+		utc := req.RecordedAt.UTC()
+		req.RecordedAt = &utc
+	}
+	return nil
+}
+
+// parseTimeParam parses an optional RFC 3339 query parameter
+func parseTimeParam(r *http.Request, name string) (*time.Time, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: expected RFC 3339 timestamp", name)
+	}
+	utc := parsed.UTC()
+	return &utc, nil
+}
+
+func handleGetCheckIns(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := userIDFromRequest(r)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		from, err := parseTimeParam(r, "from")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		to, err := parseTimeParam(r, "to")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		limit := defaultListLimit
+		if value := r.URL.Query().Get("limit"); value != "" {
+			limit, err = strconv.Atoi(value)
+			if err != nil || limit < 1 || limit > maxListLimit {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid limit: must be between 1 and %d", maxListLimit))
+				return
+			}
+		}
+
+		query := `SELECT ` + checkInColumns + ` FROM CHECK_INS WHERE user_id = $1`
+		args := []interface{}{userID}
+		if from != nil {
+			args = append(args, *from)
+			query += fmt.Sprintf(" AND recorded_at >= $%d", len(args))
+		}
+		if to != nil {
+			args = append(args, *to)
+			query += fmt.Sprintf(" AND recorded_at < $%d", len(args))
+		}
+		args = append(args, limit)
+		query += fmt.Sprintf(" ORDER BY recorded_at DESC, id DESC LIMIT $%d", len(args))
+
+		checkIns := []CheckIn{}
+		if err := db.Select(&checkIns, query, args...); err != nil {
+			log.Printf("Failed to list check-ins: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to list check-ins")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, checkIns)
+	}
+}
+
+func handleCreateCheckIn(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := userIDFromRequest(r)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		var req CheckInRequest
+		if err := decodeJSON(r, &req); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if err := req.validate(); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if req.RecordedAt == nil {
+			recordedAt := now().UTC()
+			req.RecordedAt = &recordedAt
+		}
+
+		var checkIn CheckIn
+		err = db.Get(&checkIn, `
+			INSERT INTO CHECK_INS (user_id, recorded_at, weight_kg, body_fat_percent, waist_cm, hip_cm,
+			                       energy_level, sleep_hours, mood, notes)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			RETURNING `+checkInColumns,
+			userID, req.RecordedAt, req.WeightKg, req.BodyFatPercent, req.WaistCm, req.HipCm,
+			req.EnergyLevel, req.SleepHours, req.Mood, req.Notes)
+		if isForeignKeyViolation(err) {
+			writeError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		if err != nil {
+			log.Printf("Failed to create check-in: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to create check-in")
+			return
+		}
+
+		writeJSON(w, http.StatusCreated, checkIn)
+	}
+}
+
+func handleGetCheckIn(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := userIDFromRequest(r)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		id, err := pathID(r, "id")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Other users' check-ins are reported as not found rather than forbidden
+		var checkIn CheckIn
+		err = db.Get(&checkIn, `SELECT `+checkInColumns+` FROM CHECK_INS WHERE id = $1 AND user_id = $2`, id, userID)
+		if errors.Is(err, sql.ErrNoRows) {
+			writeError(w, http.StatusNotFound, "check-in not found")
+			return
+		}
+		if err != nil {
+			log.Printf("Failed to get check-in: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to get check-in")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, checkIn)
+	}
+}
+
+func handleUpdateCheckIn(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := userIDFromRequest(r)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		id, err := pathID(r, "id")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req CheckInRequest
+		if err := decodeJSON(r, &req); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if err := req.validate(); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// PUT replaces every measurement; recorded_at is kept when omitted
+		var checkIn CheckIn
+		err = db.Get(&checkIn, `
+			UPDATE CHECK_INS
+			SET recorded_at = COALESCE($3, recorded_at), weight_kg = $4, body_fat_percent = $5,
+			    waist_cm = $6, hip_cm = $7, energy_level = $8, sleep_hours = $9, mood = $10,
+			    notes = $11, updated_at = CURRENT_TIMESTAMP
+			WHERE id = $1 AND user_id = $2
+			RETURNING `+checkInColumns,
+			id, userID, req.RecordedAt, req.WeightKg, req.BodyFatPercent, req.WaistCm, req.HipCm,
+			req.EnergyLevel, req.SleepHours, req.Mood, req.Notes)
+		if errors.Is(err, sql.ErrNoRows) {
+			writeError(w, http.StatusNotFound, "check-in not found")
+			return
+		}
+		if err != nil {
+			log.Printf("Failed to update check-in: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to update check-in")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, checkIn)
+	}
+}
+
+func handleDeleteCheckIn(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := userIDFromRequest(r)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		id, err := pathID(r, "id")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		result, err := db.Exec(`DELETE FROM CHECK_INS WHERE id = $1 AND user_id = $2`, id, userID)
+		if err != nil {
+			log.Printf("Failed to delete check-in: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to delete check-in")
+			return
+		}
+		if rows, _ := result.RowsAffected(); rows == 0 {
+			writeError(w, http.StatusNotFound, "check-in not found")
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// isForeignKeyViolation reports whether err is a Postgres foreign key violation
+func isForeignKeyViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23503"
+}
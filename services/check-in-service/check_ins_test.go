@@ -0,0 +1,231 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var checkInRowColumns = []string{"id", "recorded_at", "weight_kg", "body_fat_percent", "waist_cm", "hip_cm",
+	"energy_level", "sleep_hours", "mood", "notes", "created_at", "updated_at"}
+
+func setupTestDB(t *testing.T) (*sqlx.DB, sqlmock.Sqlmock) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	return sqlx.NewDb(mockDB, "postgres"), mock
+}
+
+// serve routes a request through a router with the given pattern so path variables resolve
+func serve(pattern string, handler http.HandlerFunc, req *http.Request) *httptest.ResponseRecorder {
+	r := mux.NewRouter()
+	r.HandleFunc(pattern, handler)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	return rec
+}
+
+// pinNow fixes the clock for the duration of a test
+func pinNow(t *testing.T, at time.Time) {
+	original := now
+	now = func() time.Time { return at }
+	t.Cleanup(func() { now = original })
+}
+
+func TestHandleCreateCheckIn(t *testing.T) {
+	clock := time.Date(2025, 3, 10, 7, 30, 0, 0, time.UTC)
+	pinNow(t, clock)
+
+	tests := []struct {
+		name       string
+		userID     string
+		body       string
+		setupMock  func(mock sqlmock.Sqlmock)
+		wantStatus int
+		wantErr    string
+	}{
+		{
+			name:   "creates check-in recorded now",
+			userID: "7",
+			body:   `{"weightKg":72.4,"energyLevel":4,"sleepHours":7.5,"mood":3}`,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`INSERT INTO CHECK_INS`).
+					WithArgs(7, clock, 72.4, nil, nil, nil, 4, 7.5, 3, nil).
+					WillReturnRows(sqlmock.NewRows(checkInRowColumns).
+						AddRow(1, clock, "72.4", nil, nil, nil, 4, "7.5", 3, nil, clock, clock))
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:   "converts recordedAt to UTC",
+			userID: "7",
+			body:   `{"recordedAt":"2025-03-10T08:00:00+02:00","waistCm":80}`,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`INSERT INTO CHECK_INS`).
+					WithArgs(7, time.Date(2025, 3, 10, 6, 0, 0, 0, time.UTC), nil, nil, 80.0, nil, nil, nil, nil, nil).
+					WillReturnRows(sqlmock.NewRows(checkInRowColumns).
+						AddRow(2, clock, nil, nil, "80.0", nil, nil, nil, nil, nil, clock, clock))
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:   "unknown user",
+			userID: "7",
+			body:   `{"mood":5}`,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`INSERT INTO CHECK_INS`).
+					WillReturnError(&pq.Error{Code: "23503"})
+			},
+			wantStatus: http.StatusNotFound,
+			wantErr:    "user not found",
+		},
+		{name: "empty check-in", userID: "7", body: `{}`, wantStatus: http.StatusBadRequest, wantErr: "at least one measurement"},
+		{name: "negative weight", userID: "7", body: `{"weightKg":-1}`, wantStatus: http.StatusBadRequest, wantErr: "invalid weightKg"},
+		{name: "body fat out of range", userID: "7", body: `{"bodyFatPercent":100}`, wantStatus: http.StatusBadRequest, wantErr: "invalid bodyFatPercent"},
+		{name: "energy out of range", userID: "7", body: `{"energyLevel":6}`, wantStatus: http.StatusBadRequest, wantErr: "invalid energyLevel"},
+		{name: "mood out of range", userID: "7", body: `{"mood":0}`, wantStatus: http.StatusBadRequest, wantErr: "invalid mood"},
+		{name: "too much sleep", userID: "7", body: `{"sleepHours":25}`, wantStatus: http.StatusBadRequest, wantErr: "invalid sleepHours"},
+		{name: "notes too long", userID: "7", body: `{"notes":"` + strings.Repeat("x", 501) + `"}`, wantStatus: http.StatusBadRequest, wantErr: "invalid notes"},
+		{name: "future recordedAt", userID: "7", body: `{"mood":3,"recordedAt":"2025-03-10T08:00:00Z"}`, wantStatus: http.StatusBadRequest, wantErr: "cannot be in the future"},
+		{name: "unknown field", userID: "7", body: `{"mood":3,"userId":8}`, wantStatus: http.StatusBadRequest, wantErr: "invalid request body"},
+		{name: "missing user header", body: `{"mood":3}`, wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := setupTestDB(t)
+			defer db.Close()
+			if tt.setupMock != nil {
+				tt.setupMock(mock)
+			}
+
+			req := httptest.NewRequest("POST", "/check-ins", strings.NewReader(tt.body))
+			if tt.userID != "" {
+				req.Header.Set(userIDHeader, tt.userID)
+			}
+			rec := serve("/check-ins", handleCreateCheckIn(db), req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantErr != "" {
+				assert.Contains(t, rec.Body.String(), tt.wantErr)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestHandleGetCheckIns_FiltersByUserAndRange(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	recorded := time.Date(2025, 3, 2, 7, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`FROM CHECK_INS WHERE user_id = \$1 AND recorded_at >= \$2 AND recorded_at < \$3 ORDER BY recorded_at DESC, id DESC LIMIT \$4`).
+		WithArgs(7, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), 10).
+		WillReturnRows(sqlmock.NewRows(checkInRowColumns).
+			AddRow(3, recorded, "72.0", "24.5", nil, nil, nil, nil, nil, "after travel", recorded, recorded))
+
+	req := httptest.NewRequest("GET", "/check-ins?from=2025-03-01T00:00:00Z&to=2025-04-01T00:00:00Z&limit=10", nil)
+	req.Header.Set(userIDHeader, "7")
+	rec := serve("/check-ins", handleGetCheckIns(db), req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var checkIns []CheckIn
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &checkIns))
+	require.Len(t, checkIns, 1)
+	assert.Equal(t, 24.5, *checkIns[0].BodyFatPercent)
+	assert.Nil(t, checkIns[0].Mood)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHandleGetCheckIns_InvalidParams(t *testing.T) {
+	for _, query := range []string{"from=2025-03-01", "to=yesterday", "limit=0", "limit=501"} {
+		t.Run(query, func(t *testing.T) {
+			db, mock := setupTestDB(t)
+			defer db.Close()
+
+			req := httptest.NewRequest("GET", "/check-ins?"+query, nil)
+			req.Header.Set(userIDHeader, "7")
+			rec := serve("/check-ins", handleGetCheckIns(db), req)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestHandleGetCheckIn_OtherUsersCheckInNotFound(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM CHECK_INS WHERE id = \$1 AND user_id = \$2`).
+		WithArgs(3, 8).
+		WillReturnRows(sqlmock.NewRows(checkInRowColumns))
+
+	req := httptest.NewRequest("GET", "/check-ins/3", nil)
+	req.Header.Set(userIDHeader, "8")
+	rec := serve("/check-ins/{id}", handleGetCheckIn(db), req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHandleUpdateCheckIn_KeepsRecordedAtWhenOmitted(t *testing.T) {
+	pinNow(t, time.Date(2025, 3, 10, 7, 30, 0, 0, time.UTC))
+
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	recorded := time.Date(2025, 3, 2, 7, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`UPDATE CHECK_INS\s+SET recorded_at = COALESCE\(\$3, recorded_at\)`).
+		WithArgs(3, 7, nil, 71.8, nil, nil, nil, nil, nil, nil, nil).
+		WillReturnRows(sqlmock.NewRows(checkInRowColumns).
+			AddRow(3, recorded, "71.8", nil, nil, nil, nil, nil, nil, nil, recorded, recorded))
+
+	req := httptest.NewRequest("PUT", "/check-ins/3", strings.NewReader(`{"weightKg":71.8}`))
+	req.Header.Set(userIDHeader, "7")
+	rec := serve("/check-ins/{id}", handleUpdateCheckIn(db), req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var checkIn CheckIn
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &checkIn))
+	assert.True(t, recorded.Equal(checkIn.RecordedAt))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHandleDeleteCheckIn(t *testing.T) {
+	tests := []struct {
+		name       string
+		affected   int64
+		wantStatus int
+	}{
+		{name: "deletes own check-in", affected: 1, wantStatus: http.StatusNoContent},
+		{name: "other user's check-in", affected: 0, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := setupTestDB(t)
+			defer db.Close()
+
+			mock.ExpectExec(`DELETE FROM CHECK_INS WHERE id = \$1 AND user_id = \$2`).
+				WithArgs(3, 7).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			req := httptest.NewRequest("DELETE", "/check-ins/3", nil)
+			req.Header.Set(userIDHeader, "7")
+			rec := serve("/check-ins/{id}", handleDeleteCheckIn(db), req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -45,7 +45,7 @@ func main() {
 		w.Write([]byte("Check-in service is healthy"))
 	}).Methods("GET")
 
-	// Check-in endpoints
+	// Check-in endpoints (scoped to the user in the X-User-ID header)
 	r.HandleFunc("/check-ins", handleGetCheckIns(db)).Methods("GET")
 	r.HandleFunc("/check-ins", handleCreateCheckIn(db)).Methods("POST")
 	r.HandleFunc("/check-ins/{id}", handleGetCheckIn(db)).Methods("GET")
@@ -57,39 +57,3 @@ func main() {
 	log.Printf("Check-in service starting on port %s", port)
 	log.Fatal(http.ListenAndServe(":"+port, r))
 }
-
-// Handler functions (to be implemented)
-func handleGetCheckIns(db *sqlx.DB) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("Get check-ins endpoint"))
-	}
-}
-
-func handleCreateCheckIn(db *sqlx.DB) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("Create check-in endpoint"))
-	}
-}
-
-func handleGetCheckIn(db *sqlx.DB) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("Get check-in by ID endpoint"))
-	}
-}
-
-func handleUpdateCheckIn(db *sqlx.DB) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("Update check-in endpoint"))
-	}
-}
-
-func handleDeleteCheckIn(db *sqlx.DB) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("Delete check-in endpoint"))
-	}
-}
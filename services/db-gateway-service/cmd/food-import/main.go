@@ -0,0 +1,175 @@
+// Command food-import loads foods from a local USDA FoodData Central CSV
+// download or Open Food Facts JSONL dump into FOOD_CATALOG. Re-running it
+// updates the foods it imported before instead of duplicating them.
+//
+//	food-import -source usda -path ./FoodData_Central_csv_2024-10-31
+//	food-import -source off -path ./openfoodfacts-products.jsonl.gz
+package main
+
+import (
+	"compress/gzip"
+	"flag"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"db-gateway-service/internal/database"
+	"db-gateway-service/internal/foodimport"
+	meals "db-gateway-service/sql/meal-service"
+)
+
+func main() {
+	source := flag.String("source", "", "dump to import: usda (FoodData Central CSV directory) or off (Open Food Facts JSONL file, optionally gzipped)")
+	path := flag.String("path", "", "path of the unpacked download directory or the dump file")
+	dryRun := flag.Bool("dry-run", false, "read and normalize the dump without writing to the database")
+	batchSize := flag.Int("batch-size", 500, "foods written per transaction")
+	flag.Parse()
+
+	if *path == "" || (*source != "usda" && *source != "off") {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *batchSize < 1 {
+		log.Fatal("-batch-size must be at least 1")
+	}
+
+	var repo *meals.Repository
+	if !*dryRun {
+		// Environment variables - same as the gateway service
+		dbHost := os.Getenv("DB_HOST")
+		dbPort := getEnv("DB_PORT", "5432") // Non-sensitive default OK
+		dbUser := os.Getenv("DB_USER")
+		dbPassword := os.Getenv("DB_PASSWORD")
+		dbName := os.Getenv("DB_NAME")
+
+		if dbHost == "" || dbUser == "" || dbPassword == "" || dbName == "" {
+			log.Fatal("Required database environment variables not set: DB_HOST, DB_USER, DB_PASSWORD, DB_NAME")
+		}
+
+		dbPool, err := database.NewPool(&database.Config{
+			Host:     dbHost,
+			Port:     dbPort,
+			User:     dbUser,
+			Password: dbPassword,
+			Database: dbName,
+		})
+		if err != nil {
+			log.Fatalf("Failed to create database pool: %v", err)
+		}
+		defer dbPool.Close()
+		repo = meals.NewRepository(dbPool.GetDB())
+	}
+
+	var total meals.FoodImportResult
+	batch := make([]meals.ImportedFood, 0, *batchSize)
+	flush := func() error {
+		if repo == nil || len(batch) == 0 {
+			batch = batch[:0]
+			return nil
+		}
+		result, err := repo.UpsertImportedFoods(batch)
+		if err != nil {
+			return err
+		}
+		total.Inserted += result.Inserted
+		total.Updated += result.Updated
+		total.Barcodes += result.Barcodes
+		log.Printf("Imported %d foods (%d new, %d updated)", total.Inserted+total.Updated, total.Inserted, total.Updated)
+		batch = batch[:0]
+		return nil
+	}
+
+	normalized := 0
+	emit := func(food foodimport.Food) error {
+		normalized++
+		batch = append(batch, importedFood(food))
+		if len(batch) < *batchSize {
+			return nil
+		}
+		return flush()
+	}
+
+	var stats foodimport.Stats
+	var err error
+	switch *source {
+	case "usda":
+		stats, err = foodimport.ReadUSDA(*path, emit)
+	case "off":
+		stats, err = readOpenFoodFacts(*path, emit)
+	}
+	if err == nil {
+		err = flush()
+	}
+	if err != nil {
+		log.Fatalf("Food import failed: %v", err)
+	}
+
+	log.Printf("Read %d foods from %s, %d can be imported", stats.Read, *path, normalized)
+	reasons := make([]string, 0, len(stats.Skipped))
+	for reason := range stats.Skipped {
+		reasons = append(reasons, reason)
+	}
+	sort.Strings(reasons)
+	for _, reason := range reasons {
+		log.Printf("Skipped %d foods: %s", stats.Skipped[reason], reason)
+	}
+	if *dryRun {
+		log.Printf("Dry run: nothing was written")
+		return
+	}
+	log.Printf("Food import complete: %d new foods, %d updated, %d barcodes added", total.Inserted, total.Updated, total.Barcodes)
+}
+
+// readOpenFoodFacts opens a JSONL dump, decompressing it when it is gzipped
+func readOpenFoodFacts(path string, emit func(foodimport.Food) error) (foodimport.Stats, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return foodimport.Stats{}, err
+	}
+	defer file.Close()
+
+	var r io.Reader = file
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			return foodimport.Stats{}, err
+		}
+		defer gz.Close()
+		r = gz
+	}
+	return foodimport.ReadOpenFoodFacts(r, emit)
+}
+
+func importedFood(food foodimport.Food) meals.ImportedFood {
+	n := food.Nutrients
+	return meals.ImportedFood{
+		Source:       food.Source,
+		SourceID:     food.SourceID,
+		FoodName:     food.Name,
+		Category:     food.Category,
+		ServingUnits: food.ServingUnits,
+		Calories:     n.Calories,
+		ProteinGrams: n.ProteinGrams,
+		CarbsGrams:   n.CarbsGrams,
+		FatGrams:     n.FatGrams,
+		FiberGrams:   n.FiberGrams,
+		SugarGrams:   n.SugarGrams,
+		SodiumMg:     n.SodiumMg,
+		PotassiumMg:  n.PotassiumMg,
+		IronMg:       n.IronMg,
+		CalciumMg:    n.CalciumMg,
+		VitaminDMcg:  n.VitaminDMcg,
+		Omega3Grams:  n.Omega3Grams,
+		Barcode:      food.Barcode,
+	}
+}
+
+// getEnv gets environment variable with fallback (only for non-sensitive data)
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
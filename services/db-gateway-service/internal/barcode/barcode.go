@@ -0,0 +1,51 @@
+// Package barcode validates the GTIN barcodes printed on packaged foods
+// (EAN-8, UPC-A, EAN-13 and GTIN-14) and normalizes them to the form they
+// are stored in, so that a product scanned as UPC-A finds the food imported
+// under its EAN-13 code.
+package barcode
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Normalize validates a barcode's length and check digit and returns it in
+// its stored form: EAN-8 and EAN-13 codes as they are, UPC-A codes with the
+// leading zero of their EAN-13 form and GTIN-14 codes without a leading zero
+func Normalize(code string) (string, error) {
+	code = strings.TrimSpace(code)
+	if code == "" || strings.IndexFunc(code, func(r rune) bool { return r < '0' || r > '9' }) >= 0 {
+		return "", fmt.Errorf("invalid barcode %q: must be digits only", code)
+	}
+	switch len(code) {
+	case 8, 12, 13, 14:
+	default:
+		return "", fmt.Errorf("invalid barcode %q: must be 8, 12, 13 or 14 digits", code)
+	}
+	if CheckDigit(code[:len(code)-1]) != code[len(code)-1] {
+		return "", fmt.Errorf("invalid barcode %q: check digit does not match", code)
+	}
+
+	switch {
+	case len(code) == 12:
+		return "0" + code, nil
+	case len(code) == 14 && code[0] == '0':
+		return code[1:], nil
+	}
+	return code, nil
+}
+
+// CheckDigit returns the GS1 check digit of a barcode's other digits: from
+// the right, digits are weighted 3 and 1 in turn, and the check digit
+// rounds their sum up to a multiple of ten. Leading zeros do not change it.
+func CheckDigit(digits string) byte {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		weight := 1
+		if (len(digits)-1-i)%2 == 0 {
+			weight = 3
+		}
+		sum += int(digits[i]-'0') * weight
+	}
+	return byte('0' + (10-sum%10)%10)
+}
@@ -0,0 +1,47 @@
+package barcode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := map[string]string{
+		"96385074":       "96385074",
+		"3017620422003":  "3017620422003",
+		"012345678905":   "0012345678905",
+		"0012345678905":  "0012345678905",
+		"00012345678905": "0012345678905",
+		"10012345678902": "10012345678902",
+		" 5449000000996": "5449000000996",
+	}
+	for code, want := range tests {
+		got, err := Normalize(code)
+		assert.NoError(t, err, code)
+		assert.Equal(t, want, got, code)
+	}
+}
+
+func TestNormalize_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"":               "must be digits only",
+		"30176204220O3":  "must be digits only",
+		"3017-620422003": "must be digits only",
+		"123":            "must be 8, 12, 13 or 14 digits",
+		"123456789":      "must be 8, 12, 13 or 14 digits",
+		"3017620422004":  "check digit does not match",
+		"012345678900":   "check digit does not match",
+	}
+	for code, wantErr := range tests {
+		_, err := Normalize(code)
+		assert.ErrorContains(t, err, wantErr, code)
+	}
+}
+
+func TestCheckDigit(t *testing.T) {
+	assert.Equal(t, byte('3'), CheckDigit("301762042200"))
+	assert.Equal(t, byte('5'), CheckDigit("01234567890"))
+	assert.Equal(t, byte('5'), CheckDigit("001234567890"))
+	assert.Equal(t, byte('4'), CheckDigit("9638507"))
+}
@@ -0,0 +1,214 @@
+// Package cycle predicts menstrual cycle phases from logged period start dates
+// and describes how each phase adjusts daily targets and food suggestions.
+//
+// The cycle length is the average gap between the most recent logged period
+// starts; gaps outside the plausible range (usually a missed log) are left
+// out. Ovulation is placed 14 days before the next predicted period, since the
+// luteal phase varies far less than the follicular phase.
+package cycle
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"db-gateway-service/internal/micronutrients"
+	"db-gateway-service/internal/targets"
+)
+
+// Phases
+const (
+	Menstrual  = "MENSTRUAL"
+	Follicular = "FOLLICULAR"
+	Ovulatory  = "OVULATORY"
+	Luteal     = "LUTEAL"
+)
+
+// Cycle length settings, in days
+const (
+	DefaultCycleLength = 28
+	MinCycleLength     = 21
+	MaxCycleLength     = 45
+	PeriodLength       = 5
+
+	lutealLength = 14
+)
+
+// MaxHistory is the number of most recent cycles averaged for predictions
+const MaxHistory = 6
+
+// Symptoms that may be logged, stored as cycle_symptom_type
+var Symptoms = []string{
+	"CRAMPS", "BLOATING", "FATIGUE", "HEADACHE", "CRAVINGS",
+	"MOOD_CHANGES", "BREAST_TENDERNESS", "ACNE", "NAUSEA", "INSOMNIA",
+}
+
+// Prediction places a date within the user's cycle
+type Prediction struct {
+	Phase           string
+	CycleDay        int
+	CycleLength     int
+	CyclesUsed      int // 0 means DefaultCycleLength was assumed
+	LastPeriodStart time.Time
+	NextPeriodStart time.Time
+	OvulationDate   time.Time
+	// Projected is set when date is past the predicted next period, so the
+	// cycles since the last logged start were projected forward
+	Projected bool
+}
+
+// Adjustment is how a phase changes daily targets and food suggestions
+type Adjustment struct {
+	CalorieAdjustmentPercent float64
+	FocusNutrient            string // micronutrients constant, or empty
+	Note                     string
+}
+
+// adjustments are kept modest: resting energy use rises by roughly 2.5-10%
+// in the luteal phase, and menstruation is when iron losses occur
+var adjustments = map[string]Adjustment{
+	Menstrual: {
+		FocusNutrient: micronutrients.Iron,
+		Note:          "Menstrual phase: iron-rich foods help replace what is lost",
+	},
+	Follicular: {
+		Note: "Follicular phase: no adjustment",
+	},
+	Ovulatory: {
+		Note: "Ovulatory phase: no adjustment",
+	},
+	Luteal: {
+		CalorieAdjustmentPercent: 5,
+		FocusNutrient:            micronutrients.Fiber,
+		Note:                     "Luteal phase: resting energy use rises, so calories are 5% higher with the extra as carbohydrate; fiber-rich foods help with appetite",
+	},
+}
+
+// Eligible reports whether cycle tracking applies to a profile's biological
+// sex: FEMALE, or not set yet
+func Eligible(sex string) bool {
+	sex = strings.ToUpper(strings.TrimSpace(sex))
+	return sex == "" || sex == "FEMALE"
+}
+
+// ValidateSymptoms upper-cases symptoms and rejects unknown or repeated ones
+func ValidateSymptoms(symptoms []string) ([]string, error) {
+	known := make(map[string]bool, len(Symptoms))
+	for _, s := range Symptoms {
+		known[s] = true
+	}
+
+	result := make([]string, 0, len(symptoms))
+	seen := map[string]bool{}
+	for _, s := range symptoms {
+		s = strings.ToUpper(strings.TrimSpace(s))
+		if !known[s] {
+			return nil, fmt.Errorf("invalid symptom %q: must be one of %s", s, strings.Join(Symptoms, ", "))
+		}
+		if seen[s] {
+			return nil, fmt.Errorf("invalid symptoms: %q is listed twice", s)
+		}
+		seen[s] = true
+		result = append(result, s)
+	}
+	return result, nil
+}
+
+// CycleLength averages the gaps between the last MaxHistory+1 period starts.
+// It returns DefaultCycleLength and 0 cycles when no gap is plausible.
+func CycleLength(starts []time.Time) (int, int) {
+	sorted := append([]time.Time(nil), starts...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Before(sorted[j]) })
+	if len(sorted) > MaxHistory+1 {
+		sorted = sorted[len(sorted)-MaxHistory-1:]
+	}
+
+	total, cycles := 0, 0
+	for i := 1; i < len(sorted); i++ {
+		gap := days(sorted[i-1], sorted[i])
+		if gap < MinCycleLength || gap > MaxCycleLength {
+			continue
+		}
+		total += gap
+		cycles++
+	}
+	if cycles == 0 {
+		return DefaultCycleLength, 0
+	}
+	return int(math.Round(float64(total) / float64(cycles))), cycles
+}
+
+// Predict places date within the cycle from period starts logged on or before it
+func Predict(starts []time.Time, date time.Time) (*Prediction, error) {
+	var past []time.Time
+	for _, s := range starts {
+		if !s.After(date) {
+			past = append(past, s)
+		}
+	}
+	if len(past) == 0 {
+		return nil, fmt.Errorf("no period start logged on or before %s", date.Format("2006-01-02"))
+	}
+
+	length, cycles := CycleLength(past)
+	last := past[0]
+	for _, s := range past {
+		if s.After(last) {
+			last = s
+		}
+	}
+
+	p := &Prediction{CycleLength: length, CyclesUsed: cycles}
+	day := days(last, date) + 1
+	if day > length {
+		missed := (day - 1) / length
+		last = last.AddDate(0, 0, missed*length)
+		day -= missed * length
+		p.Projected = true
+	}
+
+	ovulationDay := length - lutealLength
+	p.CycleDay = day
+	p.LastPeriodStart = last
+	p.NextPeriodStart = last.AddDate(0, 0, length)
+	p.OvulationDate = last.AddDate(0, 0, ovulationDay-1)
+
+	switch {
+	case day <= PeriodLength:
+		p.Phase = Menstrual
+	case day < ovulationDay-1:
+		p.Phase = Follicular
+	case day <= ovulationDay+1:
+		p.Phase = Ovulatory
+	default:
+		p.Phase = Luteal
+	}
+
+	return p, nil
+}
+
+// AdjustmentFor returns the adjustment of a phase
+func AdjustmentFor(phase string) Adjustment {
+	return adjustments[phase]
+}
+
+// Adjust applies a phase's calorie adjustment to targets, taking the extra
+// calories as carbohydrate, and adds its note
+func Adjust(t *targets.Targets, phase string) {
+	adjustment := adjustments[phase]
+	if adjustment.CalorieAdjustmentPercent != 0 {
+		extra := math.Round(t.Calories * adjustment.CalorieAdjustmentPercent / 100)
+		t.Calories += extra
+		t.CarbsGrams += math.Round(extra / 4)
+	}
+	if adjustment.Note != "" {
+		t.Notes = append(t.Notes, adjustment.Note)
+	}
+}
+
+// days returns the whole days from a to b
+func days(a, b time.Time) int {
+	return int(math.Round(b.Sub(a).Hours() / 24))
+}
@@ -0,0 +1,107 @@
+package cycle
+
+import (
+	"testing"
+	"time"
+
+	"db-gateway-service/internal/targets"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(month time.Month, day int) time.Time {
+	return time.Date(2025, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCycleLength(t *testing.T) {
+	// 30 and 32 day cycles average to 31; the 60 day gap is a missed log
+	length, cycles := CycleLength([]time.Time{date(1, 1), date(1, 31), date(3, 4), date(5, 3)})
+	assert.Equal(t, 31, length)
+	assert.Equal(t, 2, cycles)
+
+	length, cycles = CycleLength([]time.Time{date(1, 1)})
+	assert.Equal(t, DefaultCycleLength, length)
+	assert.Equal(t, 0, cycles)
+}
+
+func TestPredict_Phases(t *testing.T) {
+	starts := []time.Time{date(1, 6), date(2, 3), date(3, 3)}
+
+	tests := []struct {
+		date  time.Time
+		phase string
+		day   int
+	}{
+		{date(3, 3), Menstrual, 1},
+		{date(3, 7), Menstrual, 5},
+		{date(3, 8), Follicular, 6},
+		{date(3, 16), Ovulatory, 14},
+		{date(3, 19), Luteal, 17},
+		{date(3, 30), Luteal, 28},
+	}
+	for _, tt := range tests {
+		p, err := Predict(starts, tt.date)
+		require.NoError(t, err)
+		assert.Equal(t, tt.phase, p.Phase, tt.date.Format("2006-01-02"))
+		assert.Equal(t, tt.day, p.CycleDay, tt.date.Format("2006-01-02"))
+	}
+
+	p, err := Predict(starts, date(3, 10))
+	require.NoError(t, err)
+	assert.Equal(t, 28, p.CycleLength)
+	assert.Equal(t, 2, p.CyclesUsed)
+	assert.Equal(t, date(3, 31), p.NextPeriodStart)
+	assert.Equal(t, date(3, 16), p.OvulationDate)
+	assert.False(t, p.Projected)
+
+	// Starts after the date are ignored
+	p, err = Predict(starts, date(2, 10))
+	require.NoError(t, err)
+	assert.Equal(t, date(2, 3), p.LastPeriodStart)
+}
+
+func TestPredict_ProjectsMissingStarts(t *testing.T) {
+	p, err := Predict([]time.Time{date(1, 6), date(2, 3)}, date(3, 5))
+	require.NoError(t, err)
+	assert.True(t, p.Projected)
+	assert.Equal(t, date(3, 3), p.LastPeriodStart)
+	assert.Equal(t, 3, p.CycleDay)
+	assert.Equal(t, Menstrual, p.Phase)
+
+	_, err = Predict([]time.Time{date(3, 3)}, date(2, 1))
+	assert.EqualError(t, err, "no period start logged on or before 2025-02-01")
+}
+
+func TestAdjust(t *testing.T) {
+	luteal := &targets.Targets{Calories: 2000, CarbsGrams: 200}
+	Adjust(luteal, Luteal)
+	assert.Equal(t, 2100.0, luteal.Calories)
+	assert.Equal(t, 225.0, luteal.CarbsGrams)
+	assert.Len(t, luteal.Notes, 1)
+
+	follicular := &targets.Targets{Calories: 2000, CarbsGrams: 200}
+	Adjust(follicular, Follicular)
+	assert.Equal(t, 2000.0, follicular.Calories)
+
+	assert.Equal(t, "iron", AdjustmentFor(Menstrual).FocusNutrient)
+}
+
+func TestEligible(t *testing.T) {
+	assert.True(t, Eligible("FEMALE"))
+	assert.True(t, Eligible(""))
+	assert.False(t, Eligible("MALE"))
+	assert.False(t, Eligible("OTHER"))
+}
+
+func TestValidateSymptoms(t *testing.T) {
+	symptoms, err := ValidateSymptoms([]string{"cramps", " Fatigue"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"CRAMPS", "FATIGUE"}, symptoms)
+
+	_, err = ValidateSymptoms([]string{"SNEEZING"})
+	assert.ErrorContains(t, err, `invalid symptom "SNEEZING"`)
+
+	_, err = ValidateSymptoms([]string{"ACNE", "acne"})
+	assert.EqualError(t, err, `invalid symptoms: "ACNE" is listed twice`)
+}
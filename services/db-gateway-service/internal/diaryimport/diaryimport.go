@@ -0,0 +1,560 @@
+// Package diaryimport reads food diary CSV exports from other trackers
+// (MyFitnessPal, Cronometer, Lose It! and similar per-food exports) and
+// matches their foods to catalog foods by name. Columns are found by header
+// name, so their order and any extra columns do not matter.
+package diaryimport
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Import limits
+const (
+	MaxRows = 20000
+
+	// MinScore is the name similarity a catalog food needs to match a row
+	MinScore = 0.75
+
+	// A match must log between MinServings and MaxServings servings of the
+	// catalog food to reach the row's calories; outside that range the name
+	// matched but the food did not
+	MinServings = 0.1
+	MaxServings = 20.0
+
+	// MaxFoodNameLength is the length of FOOD_CATALOG.food_name
+	MaxFoodNameLength = 255
+
+	// Rows logging servings outside minRowServings and maxRowServings (the
+	// range of USER_MEALS.servings) or a nutrition value above maxRowValue are
+	// export errors
+	minRowServings = 0.001
+	maxRowServings = 999
+	maxRowValue    = 9999
+)
+
+// Nutrition holds the totals a row logged
+type Nutrition struct {
+	Calories     float64
+	ProteinGrams float64
+	CarbsGrams   float64
+	FatGrams     float64
+	FiberGrams   *float64
+	SugarGrams   *float64
+	SodiumMg     *float64
+}
+
+// Row is a diary line of an export. Line is its line number in the file.
+type Row struct {
+	Line       int
+	Date       time.Time
+	MealNumber int
+	FoodName   string
+	Servings   float64
+	Nutrition  Nutrition
+}
+
+// PerServing returns the row's nutrition for one of its servings
+func (r Row) PerServing() Nutrition {
+	n := r.Nutrition
+	return Nutrition{
+		Calories:     n.Calories / r.Servings,
+		ProteinGrams: n.ProteinGrams / r.Servings,
+		CarbsGrams:   n.CarbsGrams / r.Servings,
+		FatGrams:     n.FatGrams / r.Servings,
+		FiberGrams:   divide(n.FiberGrams, r.Servings),
+		SugarGrams:   divide(n.SugarGrams, r.Servings),
+		SodiumMg:     divide(n.SodiumMg, r.Servings),
+	}
+}
+
+func divide(value *float64, by float64) *float64 {
+	if value == nil {
+		return nil
+	}
+	result := *value / by
+	return &result
+}
+
+// Skip is a line that could not be imported
+type Skip struct {
+	Line     int
+	FoodName string
+	Reason   string
+}
+
+// column names and the export headers they are read from, compared after
+// lowercasing and dropping units in parentheses
+const (
+	colDate     = "date"
+	colMeal     = "meal"
+	colFood     = "food"
+	colServings = "servings"
+	colCalories = "calories"
+	colProtein  = "protein"
+	colCarbs    = "carbs"
+	colFat      = "fat"
+	colFiber    = "fiber"
+	colSugar    = "sugar"
+	colSodium   = "sodium"
+)
+
+var headerAliases = map[string][]string{
+	colDate:     {"date", "day"},
+	colMeal:     {"meal", "meal name", "meal type", "group", "type"},
+	colFood:     {"food", "food name", "name", "description", "item"},
+	colServings: {"servings", "serving", "number of servings", "quantity", "amount", "qty"},
+	colCalories: {"calories", "energy", "kcal"},
+	colProtein:  {"protein"},
+	colCarbs:    {"carbs", "carbohydrates", "carbohydrate", "total carbs"},
+	colFat:      {"fat", "total fat"},
+	colFiber:    {"fiber", "fibre", "dietary fiber"},
+	colSugar:    {"sugar", "sugars"},
+	colSodium:   {"sodium"},
+}
+
+// requiredColumns must be present for a file to be imported
+var requiredColumns = []string{colDate, colMeal, colFood, colCalories}
+
+// dateLayouts are tried in order. Slashed dates are read month first, as
+// written by the US exports this is meant for.
+var dateLayouts = []string{
+	"2006-01-02",
+	"1/2/2006",
+	"2006/01/02",
+	"Jan 2, 2006",
+	"January 2, 2006",
+	"2 Jan 2006",
+	"2006-01-02 15:04:05",
+	time.RFC3339,
+}
+
+// mealNumbers maps export meal names to USER_MEALS meal numbers
+var mealNumbers = map[string]int{
+	"breakfast": 1,
+	"lunch":     2,
+	"dinner":    3,
+	"supper":    3,
+	"snack":     4,
+	"snacks":    4,
+}
+
+// Parse reads the rows of a diary export. Lines that cannot be imported are
+// returned as skips; an error means the file itself cannot be read.
+func Parse(r io.Reader) ([]Row, []Skip, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	text := strings.TrimPrefix(string(data), "\ufeff")
+
+	reader := csv.NewReader(strings.NewReader(text))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	firstLine, _, _ := strings.Cut(text, "\n")
+	if strings.Count(firstLine, ";") > strings.Count(firstLine, ",") {
+		reader.Comma = ';'
+	}
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil, fmt.Errorf("invalid CSV: the file is empty")
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid CSV: %v", err)
+	}
+	columns := findColumns(header)
+	for _, name := range requiredColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, nil, fmt.Errorf("invalid CSV: no %s column", name)
+		}
+	}
+
+	rows := []Row{}
+	skips := []Skip{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line, _ := reader.FieldPos(0)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid CSV: %v", err)
+		}
+		if len(rows)+len(skips) >= MaxRows {
+			return nil, nil, fmt.Errorf("invalid CSV: more than %d rows", MaxRows)
+		}
+		if isBlank(record) {
+			continue
+		}
+
+		row, reason := parseRow(record, columns)
+		row.Line = line
+		if reason != "" {
+			skips = append(skips, Skip{Line: line, FoodName: row.FoodName, Reason: reason})
+			continue
+		}
+		rows = append(rows, row)
+	}
+	return rows, skips, nil
+}
+
+// findColumns maps column names to their index in the header
+func findColumns(header []string) map[string]int {
+	columns := map[string]int{}
+	for i, title := range header {
+		title = normalizeHeader(title)
+		for name, aliases := range headerAliases {
+			if _, found := columns[name]; found {
+				continue
+			}
+			for _, alias := range aliases {
+				if title == alias {
+					columns[name] = i
+				}
+			}
+		}
+	}
+	return columns
+}
+
+// normalizeHeader lowercases a header and drops a unit in parentheses, so
+// that "Energy (kcal)" reads as "energy"
+func normalizeHeader(title string) string {
+	if i := strings.Index(title, "("); i >= 0 {
+		title = title[:i]
+	}
+	return strings.ToLower(strings.TrimSpace(title))
+}
+
+// parseRow reads one record; the reason is set when it cannot be imported
+func parseRow(record []string, columns map[string]int) (Row, string) {
+	field := func(name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	row := Row{FoodName: field(colFood), Servings: 1}
+	if row.FoodName == "" {
+		return row, "no food name"
+	}
+	if name := []rune(row.FoodName); len(name) > MaxFoodNameLength {
+		row.FoodName = string(name[:MaxFoodNameLength])
+	}
+
+	date, err := parseDate(field(colDate))
+	if err != nil {
+		return row, err.Error()
+	}
+	row.Date = date
+
+	meal, ok := MealNumber(field(colMeal))
+	if !ok {
+		return row, fmt.Sprintf("unknown meal %q", field(colMeal))
+	}
+	row.MealNumber = meal
+
+	if value := field(colServings); value != "" {
+		servings, ok := parseQuantity(value)
+		if !ok || servings < minRowServings || servings > maxRowServings {
+			return row, fmt.Sprintf("invalid servings %q", value)
+		}
+		row.Servings = servings
+	}
+
+	calories, ok := parseNumber(field(colCalories))
+	if !ok || calories == nil || *calories < 0 || *calories > maxRowValue {
+		return row, fmt.Sprintf("invalid calories %q", field(colCalories))
+	}
+	row.Nutrition.Calories = *calories
+
+	for _, v := range []struct {
+		column string
+		total  *float64
+	}{
+		{colProtein, &row.Nutrition.ProteinGrams},
+		{colCarbs, &row.Nutrition.CarbsGrams},
+		{colFat, &row.Nutrition.FatGrams},
+	} {
+		value, ok := parseNumber(field(v.column))
+		if !ok || (value != nil && (*value < 0 || *value > maxRowValue)) {
+			return row, fmt.Sprintf("invalid %s %q", v.column, field(v.column))
+		}
+		if value != nil {
+			*v.total = *value
+		}
+	}
+	for _, v := range []struct {
+		column string
+		total  **float64
+	}{
+		{colFiber, &row.Nutrition.FiberGrams},
+		{colSugar, &row.Nutrition.SugarGrams},
+		{colSodium, &row.Nutrition.SodiumMg},
+	} {
+		value, ok := parseNumber(field(v.column))
+		if !ok || (value != nil && (*value < 0 || *value > maxRowValue)) {
+			return row, fmt.Sprintf("invalid %s %q", v.column, field(v.column))
+		}
+		*v.total = value
+	}
+	return row, ""
+}
+
+// parseDate reads a date in any of the export date layouts
+func parseDate(value string) (time.Time, error) {
+	for _, layout := range dateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
+
+// MealNumber maps an export meal name ("Breakfast", "Snacks", "Meal 5") to a
+// meal number from 1 to 6
+func MealNumber(name string) (int, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if number, ok := mealNumbers[name]; ok {
+		return number, true
+	}
+	if strings.Contains(name, "snack") {
+		return mealNumbers["snack"], true
+	}
+	number, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(name, "meal")))
+	if err != nil || number < 1 || number > 6 {
+		return 0, false
+	}
+	return number, true
+}
+
+// parseNumber reads an optional number, ignoring thousands separators. An
+// empty value returns nil.
+func parseNumber(value string) (*float64, bool) {
+	value = strings.ReplaceAll(value, ",", "")
+	if value == "" {
+		return nil, true
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+		return nil, false
+	}
+	return &number, true
+}
+
+// parseQuantity reads the number at the start of a quantity such as "2",
+// "1.5 cup", "1/2 cup" or "1 1/2 slices"
+func parseQuantity(value string) (float64, bool) {
+	fields := strings.Fields(value)
+	total, ok := parseFraction(fields[0])
+	if !ok {
+		return 0, false
+	}
+	if len(fields) > 1 && strings.Contains(fields[1], "/") {
+		if fraction, ok := parseFraction(fields[1]); ok {
+			total += fraction
+		}
+	}
+	return total, true
+}
+
+func parseFraction(value string) (float64, bool) {
+	if numerator, denominator, found := strings.Cut(value, "/"); found {
+		n, err1 := strconv.ParseFloat(numerator, 64)
+		d, err2 := strconv.ParseFloat(denominator, 64)
+		if err1 != nil || err2 != nil || d == 0 {
+			return 0, false
+		}
+		return n / d, true
+	}
+	number, ok := parseNumber(value)
+	if !ok || number == nil {
+		return 0, false
+	}
+	return *number, true
+}
+
+func isBlank(record []string) bool {
+	for _, field := range record {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// Food is a catalog food a row can be matched to
+type Food struct {
+	ID       int
+	Name     string
+	Calories float64 // per serving
+}
+
+// Match is the catalog food a row was matched to and the servings of it
+// that give the row's calories
+type Match struct {
+	Index    int // position of the food in the order it was added
+	Food     Food
+	Score    float64
+	Servings float64
+}
+
+// Matcher matches rows to foods by name. Foods are indexed by token so that
+// a row is only scored against foods sharing a word with it, which keeps
+// matching fast against catalogs grown by bulk imports.
+type Matcher struct {
+	foods  []Food
+	tokens [][]string
+	index  map[string][]int // token to the indexes of the foods containing it
+}
+
+// NewMatcher creates a matcher over the given foods
+func NewMatcher(foods []Food) *Matcher {
+	m := &Matcher{index: map[string][]int{}}
+	for _, food := range foods {
+		m.Add(food)
+	}
+	return m
+}
+
+// Add adds a food, such as a custom food created for an unmatched row, and
+// returns its index
+func (m *Matcher) Add(food Food) int {
+	i := len(m.foods)
+	m.foods = append(m.foods, food)
+	m.tokens = append(m.tokens, Tokens(food.Name))
+	for _, token := range m.tokens[i] {
+		m.index[token] = append(m.index[token], i)
+	}
+	return i
+}
+
+// Match returns the food whose name is most similar to the row's, among those
+// scoring at least MinScore whose serving size fits the row's calories. Ties
+// go to the food added first.
+func (m *Matcher) Match(row Row) (Match, bool) {
+	rowTokens := Tokens(row.FoodName)
+
+	// Foods without a shared token score 0
+	shared := map[int]bool{}
+	for _, token := range rowTokens {
+		for _, i := range m.index[token] {
+			shared[i] = true
+		}
+	}
+	indexes := make([]int, 0, len(shared))
+	for i := range shared {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+
+	candidates := []Match{}
+	for _, i := range indexes {
+		food := m.foods[i]
+		score := Similarity(rowTokens, m.tokens[i])
+		if score < MinScore {
+			continue
+		}
+		servings, ok := matchServings(row, food)
+		if !ok {
+			continue
+		}
+		candidates = append(candidates, Match{Index: i, Food: food, Score: score, Servings: servings})
+	}
+	if len(candidates) == 0 {
+		return Match{}, false
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+	return candidates[0], true
+}
+
+// matchServings returns the servings of the food that give the row's
+// calories. Calorie-free foods keep the row's servings and only match
+// calorie-free rows.
+func matchServings(row Row, food Food) (float64, bool) {
+	if food.Calories <= 0 || row.Nutrition.Calories <= 0 {
+		if food.Calories > 0 || row.Nutrition.Calories > 0 {
+			return 0, false
+		}
+		return math.Round(row.Servings*1000) / 1000, true
+	}
+	servings := math.Round(row.Nutrition.Calories/food.Calories*1000) / 1000
+	if servings < MinServings || servings > MaxServings {
+		return 0, false
+	}
+	return servings, true
+}
+
+// stopWords carry no identity in food names: fillers, preparation and size
+// words and the serving units exports append to names
+var stopWords = map[string]bool{
+	"a": true, "and": true, "in": true, "of": true, "the": true, "with": true,
+	"raw": true, "fresh": true, "cooked": true, "small": true, "medium": true, "large": true,
+	"cup": true, "cups": true, "tbsp": true, "tsp": true, "oz": true, "g": true, "ea": true,
+	"serving": true, "servings": true, "piece": true, "pieces": true, "slice": true, "slices": true,
+}
+
+// Tokens splits a food name into lowercase words without stop words,
+// numbers or plural endings, so that "Bananas, Raw (2 medium)" gives [banana]
+func Tokens(name string) []string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	tokens := []string{}
+	seen := map[string]bool{}
+	for _, word := range words {
+		if stopWords[word] || strings.IndexFunc(word, unicode.IsLetter) < 0 {
+			continue
+		}
+		word = singular(word)
+		if !seen[word] {
+			seen[word] = true
+			tokens = append(tokens, word)
+		}
+	}
+	return tokens
+}
+
+// singular strips common English plural endings
+func singular(word string) string {
+	switch {
+	case len(word) > 4 && strings.HasSuffix(word, "ies"):
+		return strings.TrimSuffix(word, "ies") + "y"
+	case len(word) > 4 && strings.HasSuffix(word, "oes"):
+		return strings.TrimSuffix(word, "es")
+	case len(word) > 3 && strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss"):
+		return strings.TrimSuffix(word, "s")
+	}
+	return word
+}
+
+// Similarity is the Dice coefficient of two token sets: twice the shared
+// tokens over the total, 1 for the same words in any order
+func Similarity(a, b []string) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	set := map[string]bool{}
+	for _, token := range a {
+		set[token] = true
+	}
+	shared := 0
+	for _, token := range b {
+		if set[token] {
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(a)+len(b))
+}
@@ -0,0 +1,206 @@
+package diaryimport
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_MyFitnessPalStyle(t *testing.T) {
+	csv := "Date,Meal,Food Name,Servings,Calories,Fat (g),Carbohydrates (g),Protein (g),Fiber,Sodium (mg)\n" +
+		"2025-03-08,Breakfast,\"Banana, Raw\",2,210,0.8,54,2.6,6.2,2\n" +
+		"2025-03-08,Snacks,Almonds,1/2 cup,\"1,030\",90,38,38,,\n" +
+		"\n" +
+		"2025-03-08,Brunch,Pancakes,1,350,10,55,8,,\n" +
+		"03/09/2025,Dinner,,1,500,,,,,\n"
+
+	rows, skips, err := Parse(strings.NewReader(csv))
+
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, Row{
+		Line:       2,
+		Date:       time.Date(2025, 3, 8, 0, 0, 0, 0, time.UTC),
+		MealNumber: 1,
+		FoodName:   "Banana, Raw",
+		Servings:   2,
+		Nutrition: Nutrition{
+			Calories: 210, ProteinGrams: 2.6, CarbsGrams: 54, FatGrams: 0.8,
+			FiberGrams: floatPtr(6.2), SodiumMg: floatPtr(2),
+		},
+	}, rows[0])
+	assert.Equal(t, 4, rows[1].MealNumber)
+	assert.Equal(t, 0.5, rows[1].Servings)
+	assert.Equal(t, 1030.0, rows[1].Nutrition.Calories)
+	assert.Nil(t, rows[1].Nutrition.FiberGrams)
+
+	assert.Equal(t, []Skip{
+		{Line: 5, FoodName: "Pancakes", Reason: `unknown meal "Brunch"`},
+		{Line: 6, Reason: "no food name"},
+	}, skips)
+}
+
+func TestParse_CronometerStyle(t *testing.T) {
+	// Semicolon separated, with a byte order mark and units in the headers
+	csv := "\ufeffDay;Group;Food Name;Amount;Energy (kcal);Protein (g);Carbs (g);Fat (g);Sugars (g)\n" +
+		"Mar 8, 2025;Lunch;Chicken Breast;1.50 serving;248;46;0;5;0\n"
+
+	rows, skips, err := Parse(strings.NewReader(csv))
+
+	require.NoError(t, err)
+	assert.Empty(t, skips)
+	require.Len(t, rows, 1)
+	assert.Equal(t, time.Date(2025, 3, 8, 0, 0, 0, 0, time.UTC), rows[0].Date)
+	assert.Equal(t, 2, rows[0].MealNumber)
+	assert.Equal(t, 1.5, rows[0].Servings)
+	assert.Equal(t, 46.0, rows[0].Nutrition.ProteinGrams)
+	assert.Equal(t, 0.0, *rows[0].Nutrition.SugarGrams)
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		csv     string
+		wantErr string
+	}{
+		{name: "empty file", csv: "", wantErr: "the file is empty"},
+		{name: "meal summary export", csv: "Date,Meal,Calories,Fat (g)\n2025-03-08,Breakfast,400,10\n", wantErr: "no food column"},
+		{name: "unterminated quote", csv: "Date,Meal,Food,Calories\n2025-03-08,Lunch,\"Soup,100\n", wantErr: "invalid CSV"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := Parse(strings.NewReader(tt.csv))
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestParse_InvalidValues(t *testing.T) {
+	csv := "Date,Meal,Food,Servings,Calories,Protein\n" +
+		"2025-13-45,Lunch,Soup,1,100,5\n" +
+		"2025-03-08,Lunch,Soup,0,100,5\n" +
+		"2025-03-08,Lunch,Soup,1,lots,5\n" +
+		"2025-03-08,Lunch,Soup,1,100,-5\n"
+
+	rows, skips, err := Parse(strings.NewReader(csv))
+
+	require.NoError(t, err)
+	assert.Empty(t, rows)
+	reasons := make([]string, len(skips))
+	for i, skip := range skips {
+		reasons[i] = skip.Reason
+	}
+	assert.Equal(t, []string{
+		`invalid date "2025-13-45"`,
+		`invalid servings "0"`,
+		`invalid calories "lots"`,
+		`invalid protein "-5"`,
+	}, reasons)
+}
+
+func TestMealNumber(t *testing.T) {
+	tests := map[string]int{"Breakfast": 1, "lunch": 2, "Supper": 3, "Afternoon Snack": 4, "Meal 5": 5, "6": 6}
+	for name, want := range tests {
+		got, ok := MealNumber(name)
+		assert.True(t, ok, name)
+		assert.Equal(t, want, got, name)
+	}
+
+	for _, name := range []string{"Uncategorized", "Meal 7", ""} {
+		_, ok := MealNumber(name)
+		assert.False(t, ok, name)
+	}
+}
+
+func TestTokensAndSimilarity(t *testing.T) {
+	assert.Equal(t, []string{"banana"}, Tokens("Bananas, Raw (2 medium)"))
+	assert.Equal(t, []string{"strawberry"}, Tokens("Strawberries - 1 cup"))
+	assert.Equal(t, []string{"tomato"}, Tokens("Tomatoes"))
+
+	assert.Equal(t, 1.0, Similarity(Tokens("Salmon, Atlantic, Wild"), Tokens("Salmon - Wild Atlantic")))
+	assert.InDelta(t, 0.857, Similarity(Tokens("Chobani - Greek Yogurt, Plain"), Tokens("Greek Yogurt - Plain")), 0.001)
+	assert.InDelta(t, 0.667, Similarity(Tokens("Apple Juice"), Tokens("Apple - Medium")), 0.001)
+	assert.Equal(t, 0.0, Similarity(Tokens("2 cups"), Tokens("Rice")))
+}
+
+func TestMatcher_Match(t *testing.T) {
+	matcher := NewMatcher([]Food{
+		{ID: 11, Name: "Greek Yogurt - Plain", Calories: 130},
+		{ID: 49, Name: "Banana - Medium", Calories: 105},
+		{ID: 50, Name: "Banana Bread", Calories: 200},
+		{ID: 60, Name: "Black Coffee", Calories: 0},
+	})
+
+	tests := []struct {
+		name         string
+		row          Row
+		wantID       int
+		wantServings float64
+		wantMatch    bool
+	}{
+		{
+			name:         "servings follow the row's calories",
+			row:          Row{FoodName: "Bananas, raw", Servings: 1, Nutrition: Nutrition{Calories: 210}},
+			wantID:       49,
+			wantServings: 2,
+			wantMatch:    true,
+		},
+		{
+			name:         "brand prefix",
+			row:          Row{FoodName: "Chobani - Greek Yogurt, Plain", Servings: 1, Nutrition: Nutrition{Calories: 65}},
+			wantID:       11,
+			wantServings: 0.5,
+			wantMatch:    true,
+		},
+		{
+			name:         "calorie-free food keeps the row's servings",
+			row:          Row{FoodName: "Coffee, black", Servings: 2, Nutrition: Nutrition{Calories: 0}},
+			wantID:       60,
+			wantServings: 2,
+			wantMatch:    true,
+		},
+		{
+			name: "name matches but the calories do not",
+			row:  Row{FoodName: "Banana", Servings: 1, Nutrition: Nutrition{Calories: 5000}},
+		},
+		{
+			name: "no similar name",
+			row:  Row{FoodName: "Apple Juice", Servings: 1, Nutrition: Nutrition{Calories: 110}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, ok := matcher.Match(tt.row)
+			assert.Equal(t, tt.wantMatch, ok)
+			if tt.wantMatch {
+				assert.Equal(t, tt.wantID, match.Food.ID)
+				assert.Equal(t, tt.wantServings, match.Servings)
+			}
+		})
+	}
+}
+
+func TestMatcher_AddCustomFood(t *testing.T) {
+	matcher := NewMatcher(nil)
+	row := Row{FoodName: "Grandma's Lasagna", Servings: 1, Nutrition: Nutrition{Calories: 600}}
+
+	_, ok := matcher.Match(row)
+	assert.False(t, ok)
+
+	index := matcher.Add(Food{Name: row.FoodName, Calories: row.PerServing().Calories})
+	row.Servings, row.Nutrition.Calories = 2, 1200
+	match, ok := matcher.Match(row)
+
+	assert.True(t, ok)
+	assert.Equal(t, index, match.Index)
+	assert.Equal(t, 2.0, match.Servings)
+}
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
@@ -0,0 +1,273 @@
+// Package foodimport reads the USDA FoodData Central CSV download and the
+// Open Food Facts JSONL dump and normalizes their foods into catalog foods.
+// Both sources report nutrients per 100 g, or per 100 ml for drinks; catalog
+// foods are stored per ounce, or per cup for drinks, like the curated ones.
+package foodimport
+
+import (
+	"math"
+	"strings"
+	"unicode"
+
+	"db-gateway-service/internal/barcode"
+)
+
+// Food sources, mirroring the food_source_type enum
+const (
+	SourceUSDA          = "USDA"
+	SourceOpenFoodFacts = "OPEN_FOOD_FACTS"
+)
+
+// Serving units foods are normalized to
+const (
+	unitOunces = "OUNCES"
+	unitCups   = "CUPS"
+)
+
+// Grams in an ounce and milliliters in a cup
+const (
+	gramsPerOunce = 28.3495
+	mlPerCup      = 236.588
+)
+
+// Plausibility limits per 100 g: pure fat has 900 kcal
+const (
+	maxCaloriesPer100 = 950
+	maxGramsPer100    = 100
+)
+
+// maxNameLength is the length of FOOD_CATALOG.food_name
+const maxNameLength = 255
+
+// Nutrients holds nutrition values; optional ones are nil when not reported
+type Nutrients struct {
+	Calories     float64
+	ProteinGrams float64
+	CarbsGrams   float64
+	FatGrams     float64
+	FiberGrams   *float64
+	SugarGrams   *float64
+	SodiumMg     *float64
+	PotassiumMg  *float64
+	IronMg       *float64
+	CalciumMg    *float64
+	VitaminDMcg  *float64
+	Omega3Grams  *float64
+}
+
+// Food is a food normalized for FOOD_CATALOG, with nutrients per serving unit
+type Food struct {
+	Source       string
+	SourceID     string
+	Name         string
+	Category     string
+	ServingUnits string
+	Nutrients    Nutrients
+	Barcode      string // GTIN, "" when unknown
+}
+
+// Stats counts the foods read from a dump and why foods were skipped
+type Stats struct {
+	Read    int
+	Skipped map[string]int
+}
+
+func (s *Stats) skip(reason string) {
+	if s.Skipped == nil {
+		s.Skipped = map[string]int{}
+	}
+	s.Skipped[reason]++
+}
+
+// Skip reasons
+const (
+	skipNoName        = "no name"
+	skipNoEnergy      = "no energy value"
+	skipIncomplete    = "missing protein, carbs or fat"
+	skipImplausible   = "implausible nutrients"
+	skipNotFood       = "not a food"
+	skipInvalidRecord = "invalid record"
+)
+
+// source is a food as read from a dump, before normalization
+type source struct {
+	source   string
+	id       string
+	name     string
+	brand    string
+	category string
+	liquid   bool // nutrients are per 100 ml
+	barcode  string
+
+	calories, protein, carbs, fat *float64
+	optional                      Nutrients // per 100 g or ml, macros unused
+}
+
+// normalize converts a source food to a catalog food. It returns the skip
+// reason when the food cannot be imported.
+func normalize(s source) (Food, string) {
+	name := TitleCase(strings.TrimSpace(s.name))
+	if name == "" {
+		return Food{}, skipNoName
+	}
+	if brand := TitleCase(strings.TrimSpace(s.brand)); brand != "" && !strings.Contains(strings.ToLower(name), strings.ToLower(brand)) {
+		name += " - " + brand
+	}
+	if runes := []rune(name); len(runes) > maxNameLength {
+		name = string(runes[:maxNameLength])
+	}
+
+	if s.calories == nil {
+		return Food{}, skipNoEnergy
+	}
+	if s.protein == nil || s.carbs == nil || s.fat == nil {
+		return Food{}, skipIncomplete
+	}
+	if *s.calories < 0 || *s.calories > maxCaloriesPer100 {
+		return Food{}, skipImplausible
+	}
+	for _, grams := range []*float64{s.protein, s.carbs, s.fat, s.optional.FiberGrams, s.optional.SugarGrams, s.optional.Omega3Grams} {
+		if grams != nil && (*grams < 0 || *grams > maxGramsPer100) {
+			return Food{}, skipImplausible
+		}
+	}
+	for _, value := range []*float64{s.optional.SodiumMg, s.optional.PotassiumMg, s.optional.IronMg, s.optional.CalciumMg, s.optional.VitaminDMcg} {
+		if value != nil && *value < 0 {
+			return Food{}, skipImplausible
+		}
+	}
+
+	// Barcodes with a wrong check digit are typos in the source data
+	code, err := barcode.Normalize(s.barcode)
+	if err != nil {
+		code = ""
+	}
+
+	units, factor := unitOunces, gramsPerOunce/100
+	if s.liquid {
+		units, factor = unitCups, mlPerCup/100
+	}
+	return Food{
+		Source:       s.source,
+		SourceID:     s.id,
+		Name:         name,
+		Category:     Category(s.category),
+		ServingUnits: units,
+		Nutrients: Nutrients{
+			Calories:     round(*s.calories * factor),
+			ProteinGrams: round(*s.protein * factor),
+			CarbsGrams:   round(*s.carbs * factor),
+			FatGrams:     round(*s.fat * factor),
+			FiberGrams:   scale(s.optional.FiberGrams, factor),
+			SugarGrams:   scale(s.optional.SugarGrams, factor),
+			SodiumMg:     scale(s.optional.SodiumMg, factor),
+			PotassiumMg:  scale(s.optional.PotassiumMg, factor),
+			IronMg:       scale(s.optional.IronMg, factor),
+			CalciumMg:    scale(s.optional.CalciumMg, factor),
+			VitaminDMcg:  scale(s.optional.VitaminDMcg, factor),
+			Omega3Grams:  scale(s.optional.Omega3Grams, factor),
+		},
+		Barcode: code,
+	}, ""
+}
+
+// round rounds to the two decimals FOOD_CATALOG stores
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+func scale(value *float64, factor float64) *float64 {
+	if value == nil {
+		return nil
+	}
+	scaled := round(*value * factor)
+	return &scaled
+}
+
+// categoryKeywords maps words of source category labels to food categories,
+// checked in order so that "Fruits and Fruit Juices" is FRUIT rather than
+// BEVERAGE, "Nut and Seed Products" is NUTS and almond milk is not a nut
+var categoryKeywords = []struct {
+	category string
+	words    []string
+}{
+	{"FISH", []string{"fish", "finfish", "shellfish", "seafood", "salmon", "tuna", "shrimp"}},
+	{"MEAT", []string{"meat", "beef", "pork", "lamb", "veal", "game", "poultry", "chicken", "turkey", "sausage", "ham", "bacon"}},
+	{"DAIRY", []string{"dairy", "milk", "cheese", "yogurt", "yoghurt", "cream", "egg"}},
+	{"FRUIT", []string{"fruit", "berry", "apple", "banana", "citrus"}},
+	{"VEGETABLE", []string{"vegetable", "salad", "potato", "tomato"}},
+	{"LEGUMES", []string{"legume", "bean", "lentil", "chickpea", "hummus", "tofu"}},
+	{"NUTS", []string{"nut", "almond", "peanut", "walnut", "hazelnut", "cashew", "pistachio"}},
+	{"SEEDS", []string{"seed"}},
+	{"OIL", []string{"oil"}},
+	{"FAT", []string{"fat", "butter", "margarine"}},
+	{"SPICE_HERB", []string{"spice", "herb", "seasoning"}},
+	{"SWEETENER", []string{"sugar", "sweetener", "syrup", "honey"}},
+	{"SNACK", []string{"snack", "sweet", "candy", "chocolate", "confectionery", "chip", "cookies", "biscuit", "cracker"}},
+	{"CONDIMENT", []string{"condiment", "sauce", "dressing", "gravy", "ketchup", "mustard", "mayonnaise", "spread"}},
+	{"BEVERAGE", []string{"beverage", "drink", "juice", "water", "coffee", "tea", "soda"}},
+	{"GRAIN", []string{"grain", "cereal", "pasta", "bread", "baked", "rice", "oat", "flour"}},
+}
+
+// dairyAlternativeWords mark a dairy label as a plant-based substitute
+var dairyAlternativeWords = map[string]bool{
+	"alternative": true, "substitute": true, "plant": true, "vegan": true, "nondairy": true,
+	"almond": true, "oat": true, "soy": true, "coconut": true, "rice": true,
+}
+
+// Category maps a source category label, such as "Dairy and Egg Products" or
+// an Open Food Facts tag like "en:plant-based-milks", to a food category.
+// Labels that match nothing are OTHER.
+func Category(label string) string {
+	words := map[string]bool{}
+	for _, word := range strings.FieldsFunc(strings.ToLower(label), func(r rune) bool { return !unicode.IsLetter(r) }) {
+		words[word] = true
+		words[singular(word)] = true
+	}
+	delete(words, "en")
+
+	for _, entry := range categoryKeywords {
+		for _, keyword := range entry.words {
+			if !words[keyword] {
+				continue
+			}
+			if entry.category == "DAIRY" {
+				for word := range words {
+					if dairyAlternativeWords[word] {
+						return "DAIRY_ALTERNATIVE"
+					}
+				}
+			}
+			return entry.category
+		}
+	}
+	return "OTHER"
+}
+
+// singular strips common English plural endings
+func singular(word string) string {
+	switch {
+	case len(word) > 4 && strings.HasSuffix(word, "ies"):
+		return strings.TrimSuffix(word, "ies") + "y"
+	case len(word) > 4 && (strings.HasSuffix(word, "oes") || strings.HasSuffix(word, "ches") || strings.HasSuffix(word, "shes")):
+		return strings.TrimSuffix(word, "es")
+	case len(word) > 3 && strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss"):
+		return strings.TrimSuffix(word, "s")
+	}
+	return word
+}
+
+// TitleCase converts names written in capitals, as branded USDA foods are,
+// to title case. Names with any lowercase letter are kept as they are.
+func TitleCase(name string) string {
+	if strings.IndexFunc(name, unicode.IsLower) >= 0 {
+		return name
+	}
+	runes := []rune(name)
+	for i, r := range runes {
+		if i > 0 && (unicode.IsLetter(runes[i-1]) || runes[i-1] == '\'') {
+			runes[i] = unicode.ToLower(r)
+		}
+	}
+	return string(runes)
+}
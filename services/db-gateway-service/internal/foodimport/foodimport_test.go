@@ -0,0 +1,197 @@
+package foodimport
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+	return dir
+}
+
+func collect(foods *[]Food) func(Food) error {
+	return func(food Food) error {
+		*foods = append(*foods, food)
+		return nil
+	}
+}
+
+func TestReadUSDA(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"food_category.csv": "\"id\",\"code\",\"description\"\n" +
+			"\"9\",\"0900\",\"Fruits and Fruit Juices\"\n" +
+			"\"15\",\"1500\",\"Finfish and Shellfish Products\"\n",
+		"food.csv": "\"fdc_id\",\"data_type\",\"description\",\"food_category_id\",\"publication_date\"\n" +
+			"\"1102653\",\"sr_legacy_food\",\"Bananas, raw\",\"9\",\"2019-04-01\"\n" +
+			"\"1102700\",\"foundation_food\",\"Salmon, Atlantic, farmed, raw\",\"15\",\"2020-10-30\"\n" +
+			"\"2000001\",\"branded_food\",\"ORANGE JUICE\",\"\",\"2021-10-28\"\n" +
+			"\"3000001\",\"sample_food\",\"Bananas, sample 1\",\"9\",\"2019-04-01\"\n" +
+			"\"4000001\",\"sr_legacy_food\",\"Mystery mix\",\"\",\"2019-04-01\"\n",
+		"branded_food.csv": "\"fdc_id\",\"brand_owner\",\"brand_name\",\"gtin_upc\",\"serving_size\",\"serving_size_unit\",\"branded_food_category\"\n" +
+			"\"2000001\",\"Sunny Groves Inc.\",\"SUNNY GROVES\",\"012345678905\",\"240\",\"ml\",\"Fruit & Vegetable Juice, Nectars & Fruit Drinks\"\n",
+		"food_nutrient.csv": "\"id\",\"fdc_id\",\"nutrient_id\",\"amount\"\n" +
+			"\"1\",\"1102653\",\"1008\",\"89\"\n" +
+			"\"2\",\"1102653\",\"1003\",\"1.09\"\n" +
+			"\"3\",\"1102653\",\"1005\",\"22.8\"\n" +
+			"\"4\",\"1102653\",\"1004\",\"0.33\"\n" +
+			"\"5\",\"1102653\",\"1079\",\"2.6\"\n" +
+			"\"6\",\"1102653\",\"2000\",\"12.2\"\n" +
+			"\"7\",\"1102653\",\"1093\",\"1\"\n" +
+			"\"8\",\"1102653\",\"1092\",\"358\"\n" +
+			"\"9\",\"1102653\",\"1051\",\"74.9\"\n" +
+			"\"10\",\"1102700\",\"2048\",\"200\"\n" +
+			"\"11\",\"1102700\",\"1003\",\"20.3\"\n" +
+			"\"12\",\"1102700\",\"1050\",\"0\"\n" +
+			"\"13\",\"1102700\",\"1004\",\"13\"\n" +
+			"\"14\",\"1102700\",\"1404\",\"0.2\"\n" +
+			"\"15\",\"1102700\",\"1278\",\"0.6\"\n" +
+			"\"16\",\"1102700\",\"1272\",\"1.2\"\n" +
+			"\"17\",\"2000001\",\"1008\",\"46\"\n" +
+			"\"18\",\"2000001\",\"1003\",\"0.8\"\n" +
+			"\"19\",\"2000001\",\"1005\",\"10.4\"\n" +
+			"\"20\",\"2000001\",\"1004\",\"0\"\n" +
+			"\"21\",\"3000001\",\"1008\",\"89\"\n" +
+			"\"22\",\"4000001\",\"1008\",\"300\"\n",
+	})
+
+	var foods []Food
+	stats, err := ReadUSDA(dir, collect(&foods))
+
+	require.NoError(t, err)
+	assert.Equal(t, 5, stats.Read)
+	assert.Equal(t, map[string]int{skipNotFood: 1, skipIncomplete: 1}, stats.Skipped)
+	require.Len(t, foods, 3)
+
+	banana := foods[0]
+	assert.Equal(t, "USDA", banana.Source)
+	assert.Equal(t, "1102653", banana.SourceID)
+	assert.Equal(t, "Bananas, raw", banana.Name)
+	assert.Equal(t, "FRUIT", banana.Category)
+	assert.Equal(t, "OUNCES", banana.ServingUnits)
+	assert.Equal(t, 25.23, banana.Nutrients.Calories)
+	assert.Equal(t, 0.31, banana.Nutrients.ProteinGrams)
+	assert.Equal(t, 6.46, banana.Nutrients.CarbsGrams)
+	assert.Equal(t, 0.09, banana.Nutrients.FatGrams)
+	assert.Equal(t, 0.74, *banana.Nutrients.FiberGrams)
+	assert.Equal(t, 101.49, *banana.Nutrients.PotassiumMg)
+	assert.Nil(t, banana.Nutrients.IronMg)
+	assert.Empty(t, banana.Barcode)
+
+	salmon := foods[1]
+	assert.Equal(t, "FISH", salmon.Category)
+	assert.Equal(t, 56.7, salmon.Nutrients.Calories)
+	assert.Equal(t, 0.0, salmon.Nutrients.CarbsGrams)
+	assert.Equal(t, 0.57, *salmon.Nutrients.Omega3Grams)
+
+	juice := foods[2]
+	assert.Equal(t, "Orange Juice - Sunny Groves", juice.Name)
+	assert.Equal(t, "FRUIT", juice.Category)
+	assert.Equal(t, "CUPS", juice.ServingUnits)
+	assert.Equal(t, 108.83, juice.Nutrients.Calories)
+	assert.Equal(t, "0012345678905", juice.Barcode)
+}
+
+func TestReadUSDA_Errors(t *testing.T) {
+	t.Run("missing food file", func(t *testing.T) {
+		_, err := ReadUSDA(t.TempDir(), collect(new([]Food)))
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("missing column", func(t *testing.T) {
+		dir := writeFiles(t, map[string]string{
+			"food.csv":          "\"fdc_id\",\"description\"\n\"1\",\"Apple\"\n",
+			"food_nutrient.csv": "\"fdc_id\",\"nutrient_id\",\"amount\"\n",
+		})
+		_, err := ReadUSDA(dir, collect(new([]Food)))
+		assert.EqualError(t, err, "food.csv: no data_type column")
+	})
+
+	t.Run("emit error stops the import", func(t *testing.T) {
+		dir := writeFiles(t, map[string]string{
+			"food.csv": "\"fdc_id\",\"data_type\",\"description\",\"food_category_id\"\n\"1\",\"sr_legacy_food\",\"Apple\",\"\"\n",
+			"food_nutrient.csv": "\"fdc_id\",\"nutrient_id\",\"amount\"\n" +
+				"\"1\",\"1008\",\"52\"\n\"1\",\"1003\",\"0.3\"\n\"1\",\"1005\",\"13.8\"\n\"1\",\"1004\",\"0.2\"\n",
+		})
+		_, err := ReadUSDA(dir, func(Food) error { return errors.New("database is down") })
+		assert.EqualError(t, err, "database is down")
+	})
+}
+
+func TestReadOpenFoodFacts(t *testing.T) {
+	dump := `{"code":"3017620422003","product_name":"Nutella","brands":"Ferrero,Nutella","quantity":"400 g","categories_tags":["en:breakfasts","en:spreads","en:sweet-spreads","en:hazelnut-spreads"],"nutriments":{"energy-kcal_100g":539,"proteins_100g":6.3,"carbohydrates_100g":57.5,"fat_100g":30.9,"sugars_100g":56.3,"sodium_100g":0.0428}}
+{"code":"5449000000997","product_name":"","product_name_en":"Coca-Cola","brands":"Coca-Cola","quantity":"330 ml","categories_tags":["en:beverages","en:sodas"],"nutriments":{"energy_100g":"180","proteins_100g":"0","carbohydrates_100g":"10.6","fat_100g":"0"}}
+
+not json
+{"code":"","product_name":"No code"}
+{"code":"0000000000017","product_name":"Vitamin water","nutriments":{"proteins_100g":0}}
+{"code":"0000000000024","product_name":"Broken label","nutriments":{"energy-kcal_100g":5000,"proteins_100g":1,"carbohydrates_100g":1,"fat_100g":1}}
+{"code":"0000000000031","nutriments":{"energy-kcal_100g":100}}
+`
+
+	var foods []Food
+	stats, err := ReadOpenFoodFacts(strings.NewReader(dump), collect(&foods))
+
+	require.NoError(t, err)
+	assert.Equal(t, 7, stats.Read)
+	assert.Equal(t, map[string]int{skipInvalidRecord: 2, skipNoEnergy: 1, skipImplausible: 1, skipNoName: 1}, stats.Skipped)
+	require.Len(t, foods, 2)
+
+	nutella := foods[0]
+	assert.Equal(t, "OPEN_FOOD_FACTS", nutella.Source)
+	assert.Equal(t, "3017620422003", nutella.SourceID)
+	assert.Equal(t, "Nutella - Ferrero", nutella.Name)
+	assert.Equal(t, "NUTS", nutella.Category)
+	assert.Equal(t, "OUNCES", nutella.ServingUnits)
+	assert.Equal(t, 152.8, nutella.Nutrients.Calories)
+	assert.Equal(t, 12.13, *nutella.Nutrients.SodiumMg)
+	assert.Equal(t, "3017620422003", nutella.Barcode)
+
+	cola := foods[1]
+	assert.Equal(t, "Coca-Cola", cola.Name)
+	assert.Equal(t, "5449000000997", cola.SourceID)
+	assert.Empty(t, cola.Barcode, "a wrong check digit drops the barcode")
+	assert.Equal(t, "BEVERAGE", cola.Category)
+	assert.Equal(t, "CUPS", cola.ServingUnits)
+	assert.Equal(t, 101.78, cola.Nutrients.Calories)
+	assert.Equal(t, 25.08, cola.Nutrients.CarbsGrams)
+}
+
+func TestCategory(t *testing.T) {
+	tests := map[string]string{
+		"Dairy and Egg Products":         "DAIRY",
+		"en:plant-based-milks":           "DAIRY_ALTERNATIVE",
+		"Almond Milk":                    "DAIRY_ALTERNATIVE",
+		"Fruits and Fruit Juices":        "FRUIT",
+		"Nut and Seed Products":          "NUTS",
+		"Beef Products":                  "MEAT",
+		"Finfish and Shellfish Products": "FISH",
+		"Legumes and Legume Products":    "LEGUMES",
+		"Cookies & Biscuits":             "SNACK",
+		"Cereal Grains and Pasta":        "GRAIN",
+		"Fats and Oils":                  "OIL",
+		"Spices and Herbs":               "SPICE_HERB",
+		"Soups, Sauces, and Gravies":     "CONDIMENT",
+		"en:waters":                      "BEVERAGE",
+		"Baby Foods":                     "OTHER",
+		"":                               "OTHER",
+	}
+	for label, want := range tests {
+		assert.Equal(t, want, Category(label), label)
+	}
+}
+
+func TestTitleCase(t *testing.T) {
+	assert.Equal(t, "Peanut Butter, Creamy", TitleCase("PEANUT BUTTER, CREAMY"))
+	assert.Equal(t, "Annie's Mac & Cheese", TitleCase("ANNIE'S MAC & CHEESE"))
+	assert.Equal(t, "Bananas, raw", TitleCase("Bananas, raw"))
+}
@@ -0,0 +1,138 @@
+package foodimport
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// maxLineBytes bounds one product of the Open Food Facts dump; a few products
+// with long ingredient lists and images run to several megabytes
+const maxLineBytes = 64 << 20
+
+// kJPerKcal converts energy reported only in kilojoules
+const kJPerKcal = 4.184
+
+// offLiquidQuantity matches product quantities measured by volume, such as
+// "1 L", "330 ml" or "12 fl oz"
+var offLiquidQuantity = regexp.MustCompile(`(?i)\d\s*(ml|cl|dl|l|fl\.?\s*oz)\b`)
+
+// offProduct holds the fields of an Open Food Facts product the importer uses
+type offProduct struct {
+	Code           string                     `json:"code"`
+	ProductName    string                     `json:"product_name"`
+	ProductNameEn  string                     `json:"product_name_en"`
+	Brands         string                     `json:"brands"`
+	CategoriesTags []string                   `json:"categories_tags"`
+	Quantity       string                     `json:"quantity"`
+	Nutriments     map[string]json.RawMessage `json:"nutriments"`
+}
+
+// ReadOpenFoodFacts reads an Open Food Facts JSONL dump, one product per
+// line, and passes each product that can be imported to emit in file order
+func ReadOpenFoodFacts(r io.Reader, emit func(Food) error) (Stats, error) {
+	var stats Stats
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 1<<20), maxLineBytes)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		stats.Read++
+
+		var product offProduct
+		if err := json.Unmarshal(line, &product); err != nil {
+			stats.skip(skipInvalidRecord)
+			continue
+		}
+		if strings.TrimSpace(product.Code) == "" {
+			stats.skip(skipInvalidRecord)
+			continue
+		}
+
+		normalized, reason := normalize(product.source())
+		if reason != "" {
+			stats.skip(reason)
+			continue
+		}
+		if err := emit(normalized); err != nil {
+			return stats, err
+		}
+	}
+	return stats, scanner.Err()
+}
+
+// source picks the values of a product. Categories tags run from the broadest
+// to the most specific, so the most specific tag that maps to a category wins.
+// Minerals and vitamin D are reported in grams.
+func (p *offProduct) source() source {
+	name := p.ProductName
+	if strings.TrimSpace(name) == "" {
+		name = p.ProductNameEn
+	}
+	brand, _, _ := strings.Cut(p.Brands, ",")
+
+	category := ""
+	for i := len(p.CategoriesTags) - 1; i >= 0; i-- {
+		if Category(p.CategoriesTags[i]) != "OTHER" {
+			category = p.CategoriesTags[i]
+			break
+		}
+	}
+
+	calories := p.nutriment("energy-kcal_100g", 1)
+	if calories == nil {
+		calories = p.nutriment("energy_100g", 1/kJPerKcal)
+	}
+	return source{
+		source:   SourceOpenFoodFacts,
+		id:       strings.TrimSpace(p.Code),
+		name:     name,
+		brand:    brand,
+		category: category,
+		liquid:   offLiquidQuantity.MatchString(p.Quantity),
+		barcode:  p.Code,
+		calories: calories,
+		protein:  p.nutriment("proteins_100g", 1),
+		carbs:    p.nutriment("carbohydrates_100g", 1),
+		fat:      p.nutriment("fat_100g", 1),
+		optional: Nutrients{
+			FiberGrams:  p.nutriment("fiber_100g", 1),
+			SugarGrams:  p.nutriment("sugars_100g", 1),
+			SodiumMg:    p.nutriment("sodium_100g", 1e3),
+			PotassiumMg: p.nutriment("potassium_100g", 1e3),
+			IronMg:      p.nutriment("iron_100g", 1e3),
+			CalciumMg:   p.nutriment("calcium_100g", 1e3),
+			VitaminDMcg: p.nutriment("vitamin-d_100g", 1e6),
+			Omega3Grams: p.nutriment("omega-3-fat_100g", 1),
+		},
+	}
+}
+
+// nutriment returns a nutriment multiplied by factor. Values are numbers in
+// most products and strings in some; anything else counts as not reported.
+func (p *offProduct) nutriment(key string, factor float64) *float64 {
+	raw, ok := p.Nutriments[key]
+	if !ok {
+		return nil
+	}
+	var value float64
+	if err := json.Unmarshal(raw, &value); err != nil {
+		var text string
+		if err := json.Unmarshal(raw, &text); err != nil {
+			return nil
+		}
+		value, err = strconv.ParseFloat(strings.TrimSpace(text), 64)
+		if err != nil {
+			return nil
+		}
+	}
+	value *= factor
+	return &value
+}
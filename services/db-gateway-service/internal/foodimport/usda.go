@@ -0,0 +1,273 @@
+package foodimport
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// FoodData Central nutrient IDs. Amounts are per 100 g (or 100 ml for
+// branded drinks); vitamin D is in micrograms and minerals in milligrams.
+const (
+	fdcProtein               = 1003
+	fdcFat                   = 1004
+	fdcCarbs                 = 1005
+	fdcEnergy                = 1008
+	fdcCarbsBySummation      = 1050
+	fdcSugarsNLEA            = 1063
+	fdcFiber                 = 1079
+	fdcCalcium               = 1087
+	fdcIron                  = 1089
+	fdcPotassium             = 1092
+	fdcSodium                = 1093
+	fdcVitaminD              = 1114
+	fdcDHA                   = 1272
+	fdcEPA                   = 1278
+	fdcDPA                   = 1280
+	fdcALA                   = 1404
+	fdcSugars                = 2000
+	fdcEnergyAtwaterGeneral  = 2047
+	fdcEnergyAtwaterSpecific = 2048
+)
+
+// fdcNutrients are the nutrient IDs kept while reading food_nutrient.csv
+var fdcNutrients = map[int]bool{
+	fdcProtein: true, fdcFat: true, fdcCarbs: true, fdcEnergy: true, fdcCarbsBySummation: true,
+	fdcSugarsNLEA: true, fdcFiber: true, fdcCalcium: true, fdcIron: true, fdcPotassium: true,
+	fdcSodium: true, fdcVitaminD: true, fdcDHA: true, fdcEPA: true, fdcDPA: true, fdcALA: true,
+	fdcSugars: true, fdcEnergyAtwaterGeneral: true, fdcEnergyAtwaterSpecific: true,
+}
+
+// fdcDataTypes are the food.csv data types that describe foods as eaten;
+// the others are lab samples and acquisitions
+var fdcDataTypes = map[string]bool{
+	"foundation_food":   true,
+	"sr_legacy_food":    true,
+	"survey_fndds_food": true,
+	"branded_food":      true,
+}
+
+// fdcLiquidUnits are branded_food.csv serving size units of drinks
+var fdcLiquidUnits = map[string]bool{"ml": true, "mlt": true}
+
+// fdcFood collects the rows of the download files that describe one food
+type fdcFood struct {
+	id        string
+	dataType  string
+	name      string
+	category  string
+	brand     string
+	barcode   string
+	liquid    bool
+	nutrients map[int]float64
+}
+
+// ReadUSDA reads a FoodData Central CSV download from the directory it was
+// unpacked to. food.csv and food_nutrient.csv are required; food_category.csv,
+// wweia_food_category.csv and branded_food.csv add categories, brands,
+// barcodes and serving size units. Each food that can be imported is passed
+// to emit in food.csv order.
+func ReadUSDA(dir string, emit func(Food) error) (Stats, error) {
+	var stats Stats
+
+	categories := map[string]string{}
+	err := eachRecord(filepath.Join(dir, "food_category.csv"), false, []string{"id", "description"}, func(v []string) error {
+		categories[v[0]] = v[1]
+		return nil
+	})
+	if err != nil {
+		return stats, err
+	}
+	surveyCategories := map[string]string{}
+	err = eachRecord(filepath.Join(dir, "wweia_food_category.csv"), false, []string{"wweia_food_category", "wweia_food_category_description"}, func(v []string) error {
+		surveyCategories[v[0]] = v[1]
+		return nil
+	})
+	if err != nil {
+		return stats, err
+	}
+
+	foods := map[string]*fdcFood{}
+	var order []string
+	err = eachRecord(filepath.Join(dir, "food.csv"), true, []string{"fdc_id", "data_type", "description", "food_category_id"}, func(v []string) error {
+		stats.Read++
+		if !fdcDataTypes[v[1]] {
+			stats.skip(skipNotFood)
+			return nil
+		}
+		category := categories[v[3]]
+		if v[1] == "survey_fndds_food" {
+			category = surveyCategories[v[3]]
+		}
+		foods[v[0]] = &fdcFood{id: v[0], dataType: v[1], name: v[2], category: category, nutrients: map[int]float64{}}
+		order = append(order, v[0])
+		return nil
+	})
+	if err != nil {
+		return stats, err
+	}
+
+	err = eachRecord(filepath.Join(dir, "branded_food.csv"), false, []string{"fdc_id", "brand_owner", "brand_name", "gtin_upc", "serving_size_unit", "branded_food_category"}, func(v []string) error {
+		food := foods[v[0]]
+		if food == nil {
+			return nil
+		}
+		food.brand = v[2]
+		if food.brand == "" {
+			food.brand = v[1]
+		}
+		food.barcode = v[3]
+		food.liquid = fdcLiquidUnits[strings.ToLower(v[4])]
+		food.category = v[5]
+		return nil
+	})
+	if err != nil {
+		return stats, err
+	}
+
+	err = eachRecord(filepath.Join(dir, "food_nutrient.csv"), true, []string{"fdc_id", "nutrient_id", "amount"}, func(v []string) error {
+		food := foods[v[0]]
+		if food == nil {
+			return nil
+		}
+		nutrientID, err := strconv.Atoi(v[1])
+		if err != nil || !fdcNutrients[nutrientID] {
+			return nil
+		}
+		amount, err := strconv.ParseFloat(v[2], 64)
+		if err != nil {
+			return nil
+		}
+		food.nutrients[nutrientID] = amount
+		return nil
+	})
+	if err != nil {
+		return stats, err
+	}
+
+	for _, id := range order {
+		food := foods[id]
+		normalized, reason := normalize(food.source())
+		if reason != "" {
+			stats.skip(reason)
+			continue
+		}
+		if err := emit(normalized); err != nil {
+			return stats, err
+		}
+	}
+	return stats, nil
+}
+
+// source picks the nutrient values of a food. Energy falls back to the
+// Atwater factors foundation foods are reported with, carbohydrate to the
+// sum of its parts, and omega-3 is the sum of ALA, EPA, DPA and DHA.
+func (f *fdcFood) source() source {
+	s := source{
+		source:   SourceUSDA,
+		id:       f.id,
+		name:     f.name,
+		category: f.category,
+		liquid:   f.liquid,
+		barcode:  f.barcode,
+		calories: f.first(fdcEnergy, fdcEnergyAtwaterSpecific, fdcEnergyAtwaterGeneral),
+		protein:  f.first(fdcProtein),
+		carbs:    f.first(fdcCarbs, fdcCarbsBySummation),
+		fat:      f.first(fdcFat),
+	}
+	if f.dataType == "branded_food" {
+		s.brand = f.brand
+	}
+	s.optional = Nutrients{
+		FiberGrams:  f.first(fdcFiber),
+		SugarGrams:  f.first(fdcSugars, fdcSugarsNLEA),
+		SodiumMg:    f.first(fdcSodium),
+		PotassiumMg: f.first(fdcPotassium),
+		IronMg:      f.first(fdcIron),
+		CalciumMg:   f.first(fdcCalcium),
+		VitaminDMcg: f.first(fdcVitaminD),
+	}
+	var omega3 *float64
+	for _, id := range []int{fdcALA, fdcEPA, fdcDPA, fdcDHA} {
+		if amount, ok := f.nutrients[id]; ok {
+			if omega3 == nil {
+				omega3 = new(float64)
+			}
+			*omega3 += amount
+		}
+	}
+	s.optional.Omega3Grams = omega3
+	return s
+}
+
+// first returns the amount of the first of the nutrients the food reports
+func (f *fdcFood) first(ids ...int) *float64 {
+	for _, id := range ids {
+		if amount, ok := f.nutrients[id]; ok {
+			return &amount
+		}
+	}
+	return nil
+}
+
+// eachRecord calls fn with the values of the named columns for each record of
+// a CSV file. A missing optional file is skipped; a missing column is an
+// error only for required files, and reads as "" otherwise.
+func eachRecord(path string, required bool, columns []string, fn func(values []string) error) error {
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) && !required {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("%s: %v", filepath.Base(path), err)
+	}
+	positions := map[string]int{}
+	for i, name := range header {
+		positions[strings.TrimPrefix(strings.TrimSpace(name), "\ufeff")] = i
+	}
+	indexes := make([]int, len(columns))
+	for i, name := range columns {
+		index, ok := positions[name]
+		if !ok && required {
+			return fmt.Errorf("%s: no %s column", filepath.Base(path), name)
+		}
+		if !ok {
+			index = -1
+		}
+		indexes[i] = index
+	}
+
+	values := make([]string, len(columns))
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %v", filepath.Base(path), err)
+		}
+		for i, index := range indexes {
+			values[i] = ""
+			if index >= 0 && index < len(record) {
+				values[i] = strings.TrimSpace(record[index])
+			}
+		}
+		if err := fn(values); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,126 @@
+// Package foodprefs turns a user's diet restrictions and allergies into the
+// food categories and allergens to exclude from the catalog, so every feature
+// that suggests foods applies the same rules.
+package foodprefs
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Food preferences, mirroring the food_preference_type enum
+const (
+	Like    = "LIKE"
+	Dislike = "DISLIKE"
+)
+
+// Allergens mirrors the allergen_type enum (the major food allergens)
+var Allergens = []string{"MILK", "EGG", "FISH", "SHELLFISH", "TREE_NUTS", "PEANUTS", "WHEAT", "SOY", "SESAME"}
+
+// DietRestrictions mirrors the diet_restriction_type enum
+var DietRestrictions = []string{"VEGETARIAN", "PESCATARIAN", "VEGAN", "DAIRY_FREE", "NIGHTSHADE_FREE"}
+
+// FoodCategories mirrors the food_category_type enum
+var FoodCategories = []string{
+	"MEAT", "FISH", "GRAIN", "VEGETABLE", "FRUIT", "DAIRY", "DAIRY_ALTERNATIVE",
+	"FAT", "NIGHTSHADES", "OIL", "SPICE_HERB", "SWEETENER", "CONDIMENT", "SNACK",
+	"BEVERAGE", "LEGUMES", "NUTS", "SEEDS", "OTHER",
+}
+
+// dietRule lists what a diet restriction excludes. Dairy is matched by the
+// MILK allergen rather than the DAIRY category, which also holds eggs and
+// plant milks.
+type dietRule struct {
+	categories []string
+	allergens  []string
+}
+
+var dietRules = map[string]dietRule{
+	"VEGETARIAN":      {categories: []string{"MEAT", "FISH"}},
+	"PESCATARIAN":     {categories: []string{"MEAT"}},
+	"VEGAN":           {categories: []string{"MEAT", "FISH"}, allergens: []string{"MILK", "EGG"}},
+	"DAIRY_FREE":      {allergens: []string{"MILK"}},
+	"NIGHTSHADE_FREE": {categories: []string{"NIGHTSHADES"}},
+}
+
+// Exclusions are the food categories and allergens hidden from a user
+type Exclusions struct {
+	Categories []string
+	Allergens  []string
+}
+
+// ExclusionsFor combines the exclusions of diet restrictions and allergies
+func ExclusionsFor(dietRestrictions, allergies []string) Exclusions {
+	categories := map[string]bool{}
+	allergens := map[string]bool{}
+	for _, restriction := range dietRestrictions {
+		rule := dietRules[restriction]
+		for _, c := range rule.categories {
+			categories[c] = true
+		}
+		for _, a := range rule.allergens {
+			allergens[a] = true
+		}
+	}
+	for _, a := range allergies {
+		allergens[a] = true
+	}
+	return Exclusions{Categories: sortedKeys(categories), Allergens: sortedKeys(allergens)}
+}
+
+// NormalizeAllergies validates allergens, removing duplicates and sorting them
+func NormalizeAllergies(values []string) ([]string, error) {
+	return normalize(values, Allergens, "allergen")
+}
+
+// NormalizeDietRestrictions validates diet restrictions, removing duplicates and sorting them
+func NormalizeDietRestrictions(values []string) ([]string, error) {
+	return normalize(values, DietRestrictions, "diet restriction")
+}
+
+// ValidateFoodCategory checks a category against the food_category_type enum
+func ValidateFoodCategory(category string) error {
+	if !contains(FoodCategories, category) {
+		return fmt.Errorf("invalid category %q: must be one of %s", category, strings.Join(FoodCategories, ", "))
+	}
+	return nil
+}
+
+// ValidatePreference checks a preference against the food_preference_type enum
+func ValidatePreference(preference string) error {
+	if preference != Like && preference != Dislike {
+		return fmt.Errorf("invalid preference %q: must be LIKE or DISLIKE", preference)
+	}
+	return nil
+}
+
+func normalize(values, allowed []string, name string) ([]string, error) {
+	set := map[string]bool{}
+	for _, v := range values {
+		v = strings.ToUpper(strings.TrimSpace(v))
+		if !contains(allowed, v) {
+			return nil, fmt.Errorf("invalid %s %q: must be one of %s", name, v, strings.Join(allowed, ", "))
+		}
+		set[v] = true
+	}
+	return sortedKeys(set), nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,57 @@
+package foodprefs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExclusionsFor(t *testing.T) {
+	tests := []struct {
+		name           string
+		diets          []string
+		allergies      []string
+		wantCategories []string
+		wantAllergens  []string
+	}{
+		{name: "no restrictions", wantCategories: []string{}, wantAllergens: []string{}},
+		{name: "vegetarian", diets: []string{"VEGETARIAN"}, wantCategories: []string{"FISH", "MEAT"}, wantAllergens: []string{}},
+		{name: "pescatarian keeps fish", diets: []string{"PESCATARIAN"}, wantCategories: []string{"MEAT"}, wantAllergens: []string{}},
+		{name: "dairy free matches milk, not eggs", diets: []string{"DAIRY_FREE"}, wantCategories: []string{}, wantAllergens: []string{"MILK"}},
+		{
+			name:           "vegan with allergies",
+			diets:          []string{"VEGAN", "NIGHTSHADE_FREE"},
+			allergies:      []string{"PEANUTS", "MILK"},
+			wantCategories: []string{"FISH", "MEAT", "NIGHTSHADES"},
+			wantAllergens:  []string{"EGG", "MILK", "PEANUTS"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exclusions := ExclusionsFor(tt.diets, tt.allergies)
+
+			assert.Equal(t, tt.wantCategories, exclusions.Categories)
+			assert.Equal(t, tt.wantAllergens, exclusions.Allergens)
+		})
+	}
+}
+
+func TestNormalizeAllergies(t *testing.T) {
+	allergies, err := NormalizeAllergies([]string{"peanuts", " EGG ", "PEANUTS"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"EGG", "PEANUTS"}, allergies)
+
+	_, err = NormalizeAllergies([]string{"GLUTEN"})
+	assert.ErrorContains(t, err, `invalid allergen "GLUTEN"`)
+
+	allergies, err = NormalizeAllergies(nil)
+	require.NoError(t, err)
+	assert.Empty(t, allergies)
+}
+
+func TestNormalizeDietRestrictions(t *testing.T) {
+	_, err := NormalizeDietRestrictions([]string{"KETO"})
+	assert.ErrorContains(t, err, `invalid diet restriction "KETO"`)
+}
@@ -0,0 +1,318 @@
+// Package foodtext turns typed meal descriptions such as "2 eggs and a cup of
+// brown rice" into diary items: a quantity, a serving unit and a food phrase
+// for each food, and ranks catalog foods for each phrase with a confidence
+// the user can review before logging.
+package foodtext
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"db-gateway-service/internal/diaryimport"
+	"db-gateway-service/internal/units"
+)
+
+// MinScore is the name similarity below which a food is not a candidate.
+// It is lower than a diary import's, which can also check calories, since a
+// typed "chicken" should still offer "Chicken Breast - Skinless".
+const MinScore = 0.5
+
+// Confidence factors for what the text leaves open
+const (
+	unitAssumed     = 0.7 // no unit for a food measured by weight or volume
+	unitMismatch    = 0.5 // a unit that does not convert to the food's
+	quantityAssumed = 0.8 // no quantity, 1 is assumed
+)
+
+// Item is one food of a description
+type Item struct {
+	Text      string  // the phrase as typed
+	Quantity  float64 // in Unit, or in servings when Unit is ""
+	Estimated bool    // no quantity was given and 1 is assumed
+	Unit      string  // serving unit, "" when none was given
+	Servings  bool    // the quantity was given in servings, as in "2 servings of oatmeal"
+	Food      string  // the food phrase
+}
+
+// unitWord maps a unit word to a serving unit and the size of the word in it
+type unitWord struct {
+	unit   string
+	factor float64
+}
+
+// mlPerTsp converts metric volumes, which have no serving unit, to teaspoons
+const mlPerTsp = 4.92892
+
+var unitWords = map[string]unitWord{
+	"g": {units.Grams, 1}, "gr": {units.Grams, 1}, "gram": {units.Grams, 1}, "grams": {units.Grams, 1},
+	"kg": {units.Grams, 1000}, "kilo": {units.Grams, 1000}, "kilos": {units.Grams, 1000},
+	"kilogram": {units.Grams, 1000}, "kilograms": {units.Grams, 1000},
+	"oz": {units.Ounces, 1}, "ounce": {units.Ounces, 1}, "ounces": {units.Ounces, 1},
+	"lb": {units.Ounces, 16}, "lbs": {units.Ounces, 16}, "pound": {units.Ounces, 16}, "pounds": {units.Ounces, 16},
+	"tsp": {units.Tsp, 1}, "tsps": {units.Tsp, 1}, "teaspoon": {units.Tsp, 1}, "teaspoons": {units.Tsp, 1},
+	"tbsp": {units.Tbsp, 1}, "tbsps": {units.Tbsp, 1}, "tbs": {units.Tbsp, 1},
+	"tablespoon": {units.Tbsp, 1}, "tablespoons": {units.Tbsp, 1},
+	"cup": {units.Cups, 1}, "cups": {units.Cups, 1},
+	"ml": {units.Tsp, 1 / mlPerTsp}, "milliliter": {units.Tsp, 1 / mlPerTsp}, "milliliters": {units.Tsp, 1 / mlPerTsp},
+	"millilitre": {units.Tsp, 1 / mlPerTsp}, "millilitres": {units.Tsp, 1 / mlPerTsp},
+	"l": {units.Tsp, 1000 / mlPerTsp}, "liter": {units.Tsp, 1000 / mlPerTsp}, "liters": {units.Tsp, 1000 / mlPerTsp},
+	"litre": {units.Tsp, 1000 / mlPerTsp}, "litres": {units.Tsp, 1000 / mlPerTsp},
+	"piece": {units.Pieces, 1}, "pieces": {units.Pieces, 1}, "pc": {units.Pieces, 1}, "pcs": {units.Pieces, 1},
+	"slice": {units.Pieces, 1}, "slices": {units.Pieces, 1}, "item": {units.Pieces, 1}, "items": {units.Pieces, 1},
+}
+
+// fluidOunceTsp is the size of "fl oz" in teaspoons
+const fluidOunceTsp = 6
+
+var servingWords = map[string]bool{"serving": true, "servings": true, "portion": true, "portions": true}
+
+var numberWords = map[string]float64{
+	"a": 1, "an": 1, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5, "six": 6,
+	"seven": 7, "eight": 8, "nine": 9, "ten": 10, "eleven": 11, "twelve": 12,
+	"half": 0.5, "quarter": 0.25, "dozen": 12, "couple": 2,
+}
+
+// fillerWords are dropped before a phrase's quantity, as in "I had 2 eggs"
+var fillerWords = map[string]bool{"i": true, "had": true, "ate": true, "just": true, "also": true, "then": true}
+
+var unicodeFractions = strings.NewReplacer(
+	"½", " 1/2", "⅓", " 1/3", "⅔", " 2/3", "¼", " 1/4", "¾", " 3/4", "⅛", " 1/8",
+)
+
+var (
+	// alwaysSplit separates foods however the next phrase starts
+	alwaysSplit = regexp.MustCompile(`[,;\n+]|\bwith\b|\bplus\b`)
+	// andSplit separates foods only before a quantity, so that "mac and
+	// cheese" stays one food while "eggs and a cup of rice" is two
+	andSplit = regexp.MustCompile(`\s(?:and|&)\s`)
+	// attachedUnit separates units written against their number, as in "200g"
+	attachedUnit = regexp.MustCompile(`(\d)([a-z])`)
+	number       = regexp.MustCompile(`^\d+(?:\.\d+)?$|^\.\d+$`)
+	fraction     = regexp.MustCompile(`^(\d+)/(\d+)$`)
+)
+
+// Parse splits a description into items. Phrases without a food, such as a
+// lone "2 cups", are left out.
+func Parse(text string) []Item {
+	text = attachedUnit.ReplaceAllString(strings.ToLower(unicodeFractions.Replace(text)), "$1 $2")
+	items := []Item{}
+	for _, part := range alwaysSplit.Split(text, -1) {
+		for _, phrase := range splitAnd(part) {
+			if item, ok := parsePhrase(phrase); ok {
+				items = append(items, item)
+			}
+		}
+	}
+	return items
+}
+
+// splitAnd splits a part at each "and" that is followed by a quantity,
+// except the one of "one and a half"
+func splitAnd(part string) []string {
+	phrases := []string{}
+	start := 0
+	for _, loc := range andSplit.FindAllStringIndex(part, -1) {
+		next := strings.Fields(part[loc[1]:])
+		if len(next) > 1 && (next[0] == "a" || next[0] == "an") && next[1] == "half" {
+			continue
+		}
+		if _, _, ok := quantity(next); ok {
+			phrases = append(phrases, part[start:loc[0]])
+			start = loc[1]
+		}
+	}
+	return append(phrases, part[start:])
+}
+
+func parsePhrase(phrase string) (Item, bool) {
+	item := Item{Text: strings.TrimSpace(phrase), Quantity: 1}
+	words := strings.Fields(phrase)
+	for len(words) > 0 && fillerWords[words[0]] {
+		words = words[1:]
+	}
+	if len(words) > 0 && words[0] == "some" {
+		words = words[1:]
+	}
+
+	amount, used, ok := quantity(words)
+	if ok {
+		item.Quantity = amount
+		words = words[used:]
+	} else {
+		item.Estimated = true
+	}
+
+	if len(words) > 0 {
+		if unit, ok := unitWords[words[0]]; ok {
+			item.Unit = unit.unit
+			item.Quantity *= unit.factor
+			words = words[1:]
+		} else if words[0] == "fl" && len(words) > 1 && (words[1] == "oz" || words[1] == "ounce" || words[1] == "ounces") {
+			item.Unit = units.Tsp
+			item.Quantity *= fluidOunceTsp
+			words = words[2:]
+		} else if servingWords[words[0]] {
+			item.Servings = true
+			words = words[1:]
+		}
+	}
+	if len(words) > 0 && words[0] == "of" {
+		words = words[1:]
+	}
+
+	item.Food = strings.Trim(strings.Join(words, " "), ".!?:-'\" ")
+	if item.Food == "" || item.Quantity <= 0 {
+		return Item{}, false
+	}
+	return item, true
+}
+
+// quantity reads the amount at the start of words: numbers, fractions and
+// mixed numbers ("1 1/2"), number words ("a", "two", "half a", "a dozen",
+// "a couple of") and "N and a half". It returns the number of words used.
+func quantity(words []string) (float64, int, bool) {
+	if len(words) == 0 {
+		return 0, 0, false
+	}
+
+	var amount float64
+	used := 0
+	if value, ok := numeric(words[0]); ok {
+		amount, used = value, 1
+		if len(words) > 1 && fraction.MatchString(words[1]) {
+			value, _ := numeric(words[1])
+			amount += value
+			used = 2
+		}
+	} else if value, ok := numberWords[words[0]]; ok {
+		amount, used = value, 1
+		// "a dozen", "a half", "a couple of"
+		if (words[0] == "a" || words[0] == "an") && len(words) > 1 {
+			if next, ok := numberWords[words[1]]; ok && next != 1 {
+				amount, used = next, 2
+			}
+		}
+	} else {
+		return 0, 0, false
+	}
+
+	rest := words[used:]
+	switch {
+	// "half a cup", "a couple of eggs"
+	case amount == 0.5 || amount == 0.25 || amount == 2 && words[used-1] == "couple":
+		for len(rest) > 0 && (rest[0] == "a" || rest[0] == "an" || rest[0] == "of") {
+			rest = rest[1:]
+			used++
+		}
+	// "one and a half cups"
+	case len(rest) >= 3 && rest[0] == "and" && (rest[1] == "a" || rest[1] == "an") && rest[2] == "half":
+		amount += 0.5
+		used += 3
+	}
+	return amount, used, true
+}
+
+func numeric(word string) (float64, bool) {
+	if m := fraction.FindStringSubmatch(word); m != nil {
+		n, _ := strconv.ParseFloat(m[1], 64)
+		d, _ := strconv.ParseFloat(m[2], 64)
+		if d == 0 {
+			return 0, false
+		}
+		return n / d, true
+	}
+	if !number.MatchString(word) {
+		return 0, false
+	}
+	value, err := strconv.ParseFloat(word, 64)
+	return value, err == nil
+}
+
+// Food is a catalog food an item can be resolved to
+type Food struct {
+	ID           int
+	Name         string
+	ServingUnits string
+	Calories     float64 // per serving unit
+}
+
+// Match is a catalog food for an item, with the servings of it the item
+// amounts to and how confident the match is, from 0 to 1
+type Match struct {
+	Food       Food
+	Score      float64 // name similarity
+	Servings   float64
+	Calories   float64
+	Confidence float64
+	Note       string // what was assumed, "" when nothing was
+}
+
+// Rank returns up to limit foods matching an item, most confident first.
+// Ties go to the food listed first.
+func Rank(item Item, foods []Food, limit int) []Match {
+	tokens := diaryimport.Tokens(item.Food)
+	matches := []Match{}
+	for _, food := range foods {
+		score := diaryimport.Similarity(tokens, diaryimport.Tokens(food.Name))
+		if score < MinScore {
+			continue
+		}
+		matches = append(matches, match(item, food, score))
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Confidence > matches[j].Confidence
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// match sizes an item in servings of a food. Quantities in a unit of the
+// food's dimension convert exactly. Otherwise pieces, as in "2 slices" of a
+// food measured in ounces, count as servings, a weight or volume of a food
+// counted in pieces is taken as one serving, and the confidence is lowered.
+func match(item Item, food Food, score float64) Match {
+	confidence := score
+	notes := []string{}
+
+	servings := item.Quantity
+	switch {
+	case item.Servings:
+	case item.Unit == "":
+		if food.ServingUnits != units.Pieces {
+			confidence *= unitAssumed
+			notes = append(notes, "no unit given, quantity taken as servings")
+		}
+	default:
+		converted, err := units.Convert(item.Quantity, item.Unit, food.ServingUnits)
+		switch {
+		case err == nil:
+			servings = converted
+		case item.Unit == units.Pieces:
+			confidence *= unitMismatch
+			notes = append(notes, "pieces do not convert to "+strings.ToLower(food.ServingUnits)+", quantity taken as servings")
+		default:
+			servings = 1
+			confidence *= unitMismatch
+			notes = append(notes, strings.ToLower(item.Unit)+" do not convert to "+strings.ToLower(food.ServingUnits)+", 1 serving assumed")
+		}
+	}
+	if item.Estimated {
+		confidence *= quantityAssumed
+		notes = append(notes, "no quantity given, 1 assumed")
+	}
+
+	servings = math.Round(servings*100) / 100
+	return Match{
+		Food:       food,
+		Score:      score,
+		Servings:   servings,
+		Calories:   math.Round(servings*food.Calories*10) / 10,
+		Confidence: math.Round(confidence*100) / 100,
+		Note:       strings.Join(notes, "; "),
+	}
+}
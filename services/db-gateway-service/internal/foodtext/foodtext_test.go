@@ -0,0 +1,166 @@
+package foodtext
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	items := Parse("2 eggs and a cup of brown rice")
+
+	assert.Equal(t, []Item{
+		{Text: "2 eggs", Quantity: 2, Food: "eggs"},
+		{Text: "a cup of brown rice", Quantity: 1, Unit: "CUPS", Food: "brown rice"},
+	}, items)
+}
+
+func TestParse_Quantities(t *testing.T) {
+	tests := []struct {
+		text     string
+		quantity float64
+		unit     string
+		food     string
+	}{
+		{"1/2 cup oats", 0.5, "CUPS", "oats"},
+		{"1 1/2 cups of milk", 1.5, "CUPS", "milk"},
+		{"1½ tbsp peanut butter", 1.5, "TBSP", "peanut butter"},
+		{"half a cup of blueberries", 0.5, "CUPS", "blueberries"},
+		{"a half cup of yogurt", 0.5, "CUPS", "yogurt"},
+		{"one and a half slices of toast", 1.5, "PIECES", "toast"},
+		{"three tablespoons olive oil", 3, "TBSP", "olive oil"},
+		{"200g chicken breast", 200, "GRAMS", "chicken breast"},
+		{"0.5 kg potatoes", 500, "GRAMS", "potatoes"},
+		{"a dozen almonds", 12, "", "almonds"},
+		{"a couple of bananas", 2, "", "bananas"},
+		{"1 lb ground beef", 16, "OUNCES", "ground beef"},
+		{"8 fl oz orange juice", 48, "TSP", "orange juice"},
+		{"I had 4 oz salmon.", 4, "OUNCES", "salmon"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			items := Parse(tt.text)
+			if assert.Len(t, items, 1) {
+				assert.InDelta(t, tt.quantity, items[0].Quantity, 0.001)
+				assert.Equal(t, tt.unit, items[0].Unit)
+				assert.Equal(t, tt.food, items[0].Food)
+				assert.False(t, items[0].Estimated)
+			}
+		})
+	}
+}
+
+func TestParse_Splitting(t *testing.T) {
+	foods := func(text string) []string {
+		names := []string{}
+		for _, item := range Parse(text) {
+			names = append(names, item.Food)
+		}
+		return names
+	}
+
+	assert.Equal(t, []string{"mac and cheese"}, foods("mac and cheese"))
+	assert.Equal(t, []string{"oatmeal", "honey", "coffee"}, foods("oatmeal with 1 tsp honey, coffee"))
+	assert.Equal(t, []string{"toast", "butter"}, foods("2 slices toast + 1 tbsp butter"))
+	assert.Equal(t, []string{"salt and pepper"}, foods("salt and pepper"))
+	assert.Empty(t, foods("2 cups"))
+	assert.Empty(t, foods(""))
+}
+
+func TestParse_Servings(t *testing.T) {
+	items := Parse("2 servings of oatmeal; some rice")
+
+	assert.Equal(t, []Item{
+		{Text: "2 servings of oatmeal", Quantity: 2, Servings: true, Food: "oatmeal"},
+		{Text: "some rice", Quantity: 1, Estimated: true, Food: "rice"},
+	}, items)
+}
+
+var catalog = []Food{
+	{ID: 7, Name: "Eggs - Large", ServingUnits: "PIECES", Calories: 72},
+	{ID: 8, Name: "Eggplant", ServingUnits: "CUPS", Calories: 20},
+	{ID: 31, Name: "Brown Rice - Cooked", ServingUnits: "CUPS", Calories: 216},
+	{ID: 32, Name: "White Rice - Cooked", ServingUnits: "CUPS", Calories: 205},
+	{ID: 40, Name: "Chicken Breast", ServingUnits: "OUNCES", Calories: 47},
+	{ID: 50, Name: "Whole Wheat Bread", ServingUnits: "OUNCES", Calories: 70},
+}
+
+func TestRank(t *testing.T) {
+	matches := Rank(Item{Quantity: 2, Food: "eggs"}, catalog, 3)
+	if assert.Len(t, matches, 1) {
+		assert.Equal(t, 7, matches[0].Food.ID)
+		assert.Equal(t, 2.0, matches[0].Servings)
+		assert.Equal(t, 144.0, matches[0].Calories)
+		assert.Equal(t, 1.0, matches[0].Confidence)
+		assert.Empty(t, matches[0].Note)
+	}
+
+	matches = Rank(Item{Quantity: 1, Unit: "CUPS", Food: "brown rice"}, catalog, 3)
+	if assert.Len(t, matches, 2) {
+		assert.Equal(t, 31, matches[0].Food.ID)
+		assert.Equal(t, 1.0, matches[0].Confidence)
+		assert.Equal(t, 32, matches[1].Food.ID, "the other rice is an alternative")
+		assert.Equal(t, 0.5, matches[1].Confidence)
+	}
+}
+
+func TestRank_Units(t *testing.T) {
+	tests := []struct {
+		name       string
+		item       Item
+		servings   float64
+		confidence float64
+		note       string
+	}{
+		{
+			name:       "grams convert to ounces",
+			item:       Item{Quantity: 170, Unit: "GRAMS", Food: "chicken breast"},
+			servings:   6,
+			confidence: 1,
+		},
+		{
+			name:       "no unit for a food measured by weight",
+			item:       Item{Quantity: 2, Food: "chicken breast"},
+			servings:   2,
+			confidence: 0.7,
+			note:       "no unit given, quantity taken as servings",
+		},
+		{
+			name:       "slices of a food measured by weight",
+			item:       Item{Quantity: 2, Unit: "PIECES", Food: "whole wheat bread"},
+			servings:   2,
+			confidence: 0.5,
+			note:       "pieces do not convert to ounces, quantity taken as servings",
+		},
+		{
+			name:       "volume of a food counted in pieces",
+			item:       Item{Quantity: 1, Unit: "CUPS", Food: "eggs"},
+			servings:   1,
+			confidence: 0.5,
+			note:       "cups do not convert to pieces, 1 serving assumed",
+		},
+		{
+			name:       "no quantity",
+			item:       Item{Quantity: 1, Estimated: true, Food: "eggs"},
+			servings:   1,
+			confidence: 0.8,
+			note:       "no quantity given, 1 assumed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches := Rank(tt.item, catalog, 1)
+			if assert.Len(t, matches, 1) {
+				assert.Equal(t, tt.servings, matches[0].Servings)
+				assert.Equal(t, tt.confidence, matches[0].Confidence)
+				assert.Equal(t, tt.note, matches[0].Note)
+			}
+		})
+	}
+}
+
+func TestRank_NoMatch(t *testing.T) {
+	assert.Empty(t, Rank(Item{Quantity: 1, Food: "kombucha"}, catalog, 3))
+}
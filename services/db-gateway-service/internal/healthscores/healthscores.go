@@ -0,0 +1,146 @@
+// Package healthscores rates meals and days by how inflammatory they are and
+// how well they feed the gut, from the FOOD_CATALOG flags of the foods eaten.
+//
+// The inflammation score is the percentage of calories from foods that are
+// not flagged non-inflammatory, with nightshades always counted as
+// inflammatory, so 0 is best. The gut-health score gives up to 50 points for
+// probiotic servings and 50 for prebiotic servings measured against a daily
+// goal, so 100 is best. A meal is measured against its share of the daily goal.
+package healthscores
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// Daily gut-health goals, in servings of the food's serving unit
+const (
+	ProbioticGoal = 1.0
+	PrebioticGoal = 2.0
+)
+
+// Trend directions
+const (
+	Improving = "improving"
+	Worsening = "worsening"
+	Steady    = "steady"
+)
+
+// steadyPointsPerWeek is the smallest weekly change reported as a direction
+const steadyPointsPerWeek = 1.0
+
+// Item is a portion of a catalog food that was eaten
+type Item struct {
+	Calories        float64
+	Servings        float64
+	Category        string
+	NonInflammatory bool
+	Probiotic       bool
+	Prebiotic       bool
+}
+
+// Scores rates a set of items. Inflammation is only meaningful when Calories
+// is above zero.
+type Scores struct {
+	Inflammation      float64
+	GutHealth         float64
+	Calories          float64
+	ProbioticServings float64
+	PrebioticServings float64
+}
+
+// Point is a day's score for trend fitting
+type Point struct {
+	Date  time.Time
+	Value float64
+}
+
+// Trend is the least-squares change of a score per week
+type Trend struct {
+	PointsPerWeek float64
+	Direction     string
+}
+
+// Inflammatory reports whether an item counts against the inflammation score
+func Inflammatory(item Item) bool {
+	return !item.NonInflammatory || strings.ToUpper(item.Category) == "NIGHTSHADES"
+}
+
+// Score rates items eaten against share of the daily gut-health goal
+// (1 for a whole day)
+func Score(items []Item, share float64) Scores {
+	var s Scores
+	inflammatoryCalories := 0.0
+	for _, item := range items {
+		s.Calories += item.Calories
+		if Inflammatory(item) {
+			inflammatoryCalories += item.Calories
+		}
+		if item.Probiotic {
+			s.ProbioticServings += item.Servings
+		}
+		if item.Prebiotic {
+			s.PrebioticServings += item.Servings
+		}
+	}
+
+	if s.Calories > 0 {
+		s.Inflammation = round(inflammatoryCalories/s.Calories*100, 0)
+	}
+	if share > 0 {
+		s.GutHealth = round(
+			50*math.Min(1, s.ProbioticServings/(ProbioticGoal*share))+
+				50*math.Min(1, s.PrebioticServings/(PrebioticGoal*share)), 0)
+	}
+	s.Calories = round(s.Calories, 0)
+	s.ProbioticServings = round(s.ProbioticServings, 2)
+	s.PrebioticServings = round(s.PrebioticServings, 2)
+
+	return s
+}
+
+// Fit returns the weekly change of a score over its points. lowerIsBetter
+// flips the direction for scores like inflammation. It needs at least two
+// points on different days.
+func Fit(points []Point, lowerIsBetter bool) (Trend, bool) {
+	if len(points) < 2 {
+		return Trend{}, false
+	}
+
+	origin := points[0].Date
+	var n, sumX, sumY, sumXY, sumXX float64
+	for _, p := range points {
+		x := p.Date.Sub(origin).Hours() / 24
+		n++
+		sumX += x
+		sumY += p.Value
+		sumXY += x * p.Value
+		sumXX += x * x
+	}
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return Trend{}, false
+	}
+
+	perWeek := round((n*sumXY-sumX*sumY)/denominator*7, 1)
+	trend := Trend{PointsPerWeek: perWeek, Direction: Steady}
+	if math.Abs(perWeek) >= steadyPointsPerWeek {
+		better := perWeek > 0
+		if lowerIsBetter {
+			better = !better
+		}
+		if better {
+			trend.Direction = Improving
+		} else {
+			trend.Direction = Worsening
+		}
+	}
+
+	return trend, true
+}
+
+func round(value float64, places int) float64 {
+	factor := math.Pow(10, float64(places))
+	return math.Round(value*factor) / factor
+}
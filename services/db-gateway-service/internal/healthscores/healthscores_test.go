@@ -0,0 +1,65 @@
+package healthscores
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScore(t *testing.T) {
+	items := []Item{
+		{Calories: 465, Servings: 3, Category: "FISH", NonInflammatory: true},
+		{Calories: 220, Servings: 1, Category: "GRAIN", NonInflammatory: true},
+		{Calories: 25, Servings: 1, Category: "VEGETABLE", NonInflammatory: true, Prebiotic: true},
+		{Calories: 130, Servings: 1, Category: "DAIRY", NonInflammatory: true, Probiotic: true},
+		{Calories: 160, Servings: 1, Category: "MEAT"},
+	}
+
+	day := Score(items, 1)
+	assert.Equal(t, 16.0, day.Inflammation)
+	assert.Equal(t, 1000.0, day.Calories)
+	// A full probiotic serving and half the prebiotic goal
+	assert.Equal(t, 75.0, day.GutHealth)
+
+	// Measured against a third of the day, one prebiotic serving is enough
+	meal := Score(items, 1.0/3)
+	assert.Equal(t, 100.0, meal.GutHealth)
+}
+
+func TestScore_NightshadesCountAsInflammatory(t *testing.T) {
+	scores := Score([]Item{
+		{Calories: 50, Servings: 1, Category: "NIGHTSHADES", NonInflammatory: true},
+		{Calories: 150, Servings: 1, Category: "VEGETABLE", NonInflammatory: true},
+	}, 1)
+	assert.Equal(t, 25.0, scores.Inflammation)
+
+	empty := Score(nil, 1)
+	assert.Equal(t, 0.0, empty.Inflammation)
+	assert.Equal(t, 0.0, empty.GutHealth)
+}
+
+func TestFit(t *testing.T) {
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	points := []Point{
+		{Date: start, Value: 40},
+		{Date: start.AddDate(0, 0, 7), Value: 30},
+		{Date: start.AddDate(0, 0, 14), Value: 20},
+	}
+
+	// Inflammation falling by 10 points a week is an improvement
+	trend, ok := Fit(points, true)
+	assert.True(t, ok)
+	assert.Equal(t, -10.0, trend.PointsPerWeek)
+	assert.Equal(t, Improving, trend.Direction)
+
+	// The same fall in gut health is not
+	trend, _ = Fit(points, false)
+	assert.Equal(t, Worsening, trend.Direction)
+
+	trend, _ = Fit([]Point{{Date: start, Value: 50}, {Date: start.AddDate(0, 0, 14), Value: 51}}, false)
+	assert.Equal(t, Steady, trend.Direction)
+
+	_, ok = Fit(points[:1], false)
+	assert.False(t, ok)
+}
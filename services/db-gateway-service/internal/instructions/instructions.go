@@ -0,0 +1,94 @@
+// Package instructions renders meal preparation instructions to HTML that is
+// safe to insert into a page. Markdown is converted to HTML and both Markdown
+// and submitted HTML go through the same allowlist, so scripts, event handler
+// attributes, styles and unknown elements never reach the client.
+package instructions
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+// Instruction formats, mirroring the instructions_format_type enum
+const (
+	Markdown = "MARKDOWN"
+	HTML     = "HTML"
+)
+
+// MaxLength is the longest instructions text accepted, in bytes
+const MaxLength = 20000
+
+// markdown converts Markdown to HTML. Raw HTML inside Markdown is dropped
+// rather than passed through.
+var markdown = goldmark.New(goldmark.WithExtensions(extension.Table, extension.Strikethrough))
+
+// policy is the allowlist of elements and attributes kept in rendered HTML
+var policy = newPolicy()
+
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.NewPolicy()
+	p.AllowElements(
+		"p", "br", "hr", "h1", "h2", "h3", "h4", "h5", "h6",
+		"ul", "ol", "li", "strong", "b", "em", "i", "del", "s", "sub", "sup",
+		"blockquote", "pre", "code",
+		"table", "thead", "tbody", "tr", "th", "td",
+	)
+	p.AllowAttrs("start").Matching(bluemonday.Integer).OnElements("ol")
+	p.AllowAttrs("align").Matching(bluemonday.CellAlign).OnElements("th", "td")
+	p.AllowAttrs("href").OnElements("a")
+	p.AllowURLSchemes("http", "https", "mailto")
+	p.RequireParseableURLs(true)
+	p.RequireNoFollowOnLinks(true)
+	p.AddTargetBlankToFullyQualifiedLinks(true)
+	return p
+}
+
+// ValidateFormat checks that a format is one of the instruction formats
+func ValidateFormat(format string) error {
+	switch format {
+	case Markdown, HTML:
+		return nil
+	}
+	return fmt.Errorf("invalid format %q: must be MARKDOWN or HTML", format)
+}
+
+// Clean prepares submitted instructions for storage. Markdown is kept as
+// written; HTML has everything outside the allowlist stripped, scripts
+// included, so the stored text is already safe.
+func Clean(raw, format string) (string, error) {
+	if err := ValidateFormat(format); err != nil {
+		return "", err
+	}
+	if len(raw) > MaxLength {
+		return "", fmt.Errorf("invalid instructions: longer than %d bytes", MaxLength)
+	}
+
+	raw = strings.TrimSpace(raw)
+	if format == HTML {
+		raw = policy.Sanitize(raw)
+	}
+	return raw, nil
+}
+
+// Render converts instructions to sanitized HTML. Stored HTML is sanitized
+// again so rows written before Clean existed are safe too.
+func Render(raw, format string) (string, error) {
+	if err := ValidateFormat(format); err != nil {
+		return "", err
+	}
+
+	if format == Markdown {
+		var buf bytes.Buffer
+		if err := markdown.Convert([]byte(raw), &buf); err != nil {
+			return "", fmt.Errorf("failed to render markdown: %v", err)
+		}
+		raw = buf.String()
+	}
+
+	return strings.TrimSpace(policy.Sanitize(raw)), nil
+}
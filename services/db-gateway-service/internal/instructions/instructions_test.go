@@ -0,0 +1,48 @@
+package instructions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender_Markdown(t *testing.T) {
+	html, err := Render("## Steps\n\n1. Sear the **chicken**\n2. Rest for 5 min\n\nSee [the guide](https://example.com/sear).", Markdown)
+	require.NoError(t, err)
+
+	assert.Contains(t, html, "<h2>Steps</h2>")
+	assert.Contains(t, html, "<li>Sear the <strong>chicken</strong></li>")
+	assert.Contains(t, html, `<a href="https://example.com/sear" rel="nofollow noopener" target="_blank">the guide</a>`)
+}
+
+func TestRender_MarkdownDropsRawHTML(t *testing.T) {
+	html, err := Render("Stir well <script>alert(1)</script>\n\n<img src=x onerror=alert(1)>\n\n[click](javascript:alert(1))", Markdown)
+	require.NoError(t, err)
+
+	assert.NotContains(t, html, "<script")
+	assert.NotContains(t, html, "onerror")
+	assert.NotContains(t, html, "javascript:")
+	assert.Contains(t, html, "Stir well")
+}
+
+func TestRender_SanitizesHTML(t *testing.T) {
+	html, err := Render(`<p onclick="steal()" style="color:red">Whisk <em>gently</em></p><script>steal()</script><iframe src="https://evil.example"></iframe>`, HTML)
+	require.NoError(t, err)
+
+	assert.Equal(t, "<p>Whisk <em>gently</em></p>", html)
+}
+
+func TestClean(t *testing.T) {
+	cleaned, err := Clean("  <ol><li>Boil</li></ol><script>steal()</script>  ", HTML)
+	require.NoError(t, err)
+	assert.Equal(t, "<ol><li>Boil</li></ol>", cleaned)
+
+	// Markdown is stored as written and only sanitized when rendered
+	cleaned, err = Clean("1. Boil <b>hard</b>\n", Markdown)
+	require.NoError(t, err)
+	assert.Equal(t, "1. Boil <b>hard</b>", cleaned)
+
+	_, err = Clean("Boil", "TEXT")
+	assert.ErrorContains(t, err, `invalid format "TEXT"`)
+}
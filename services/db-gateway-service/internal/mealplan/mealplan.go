@@ -0,0 +1,596 @@
+// Package mealplan builds deterministic meal plans from a user's filtered food
+// catalog and daily targets. Foods are grouped by the macro they mainly supply
+// and rotated across days for variety, liked foods first and, when asked for,
+// anti-inflammatory or gut-friendly foods next; portions are then
+// solved so each day lands within tolerance of the calorie and macro targets.
+package mealplan
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"db-gateway-service/internal/healthscores"
+)
+
+// Plan size limits
+const (
+	DefaultDays    = 7
+	MaxDays        = 14
+	MaxMealsPerDay = 6
+
+	defaultMealsPerDay = 3
+)
+
+// Solver settings. Calories matter most, then protein; each meal's share of
+// the day's calories and protein keeps portions spread across the day.
+const (
+	solverSweeps   = 100
+	roundingPasses = 20
+
+	caloriesWeight = 4.0
+	proteinWeight  = 2.0
+	carbsWeight    = 1.0
+	fatWeight      = 1.0
+	mealWeight     = 1.0
+)
+
+// Tolerances are the relative deviations from the targets a planned day may have
+var Tolerances = Macros{Calories: 0.05, ProteinGrams: 0.10, CarbsGrams: 0.15, FatGrams: 0.15}
+
+// Macros holds calories and macronutrient grams
+type Macros struct {
+	Calories     float64
+	ProteinGrams float64
+	CarbsGrams   float64
+	FatGrams     float64
+}
+
+func (m Macros) plus(o Macros, factor float64) Macros {
+	return Macros{
+		Calories:     m.Calories + o.Calories*factor,
+		ProteinGrams: m.ProteinGrams + o.ProteinGrams*factor,
+		CarbsGrams:   m.CarbsGrams + o.CarbsGrams*factor,
+		FatGrams:     m.FatGrams + o.FatGrams*factor,
+	}
+}
+
+// Food is a catalog food the user may eat, with nutrition per serving unit
+type Food struct {
+	ID              int
+	Name            string
+	Category        string
+	ServingUnits    string
+	PerServing      Macros
+	NonInflammatory bool
+	Probiotic       bool
+	Prebiotic       bool
+	Liked           bool
+}
+
+// Goal identifies a selected fitness goal
+type Goal struct {
+	Category string
+	Name     string
+}
+
+// Options control the shape of a plan
+type Options struct {
+	Days                int
+	MealsPerDay         int // 0 picks a default from the goals
+	NonInflammatoryOnly bool
+	// FavorAntiInflammatory and FavorGutHealth rotate foods that help the
+	// inflammation and gut-health scores ahead of others, after liked foods
+	FavorAntiInflammatory bool
+	FavorGutHealth        bool
+	Goals                 []Goal
+}
+
+// Portion is a number of servings of a food
+type Portion struct {
+	Food     Food
+	Servings float64
+}
+
+// Macros returns the nutrition of the portion
+func (p Portion) Macros() Macros {
+	return Macros{}.plus(p.Food.PerServing, p.Servings)
+}
+
+// Meal is the food planned for one meal_number slot
+type Meal struct {
+	MealNumber int
+	Portions   []Portion
+}
+
+// Totals sums the meal's portions
+func (m Meal) Totals() Macros {
+	var total Macros
+	for _, p := range m.Portions {
+		total = total.plus(p.Food.PerServing, p.Servings)
+	}
+	return total
+}
+
+// Day is the meals planned for one day. OffTarget describes each total that
+// missed its tolerance, e.g. "calories 7% under target".
+type Day struct {
+	Meals     []Meal
+	OffTarget []string
+}
+
+// Totals sums the day's meals
+func (d Day) Totals() Macros {
+	var total Macros
+	for _, m := range d.Meals {
+		total = total.plus(m.Totals(), 1)
+	}
+	return total
+}
+
+// Plan is a generated meal plan
+type Plan struct {
+	MealsPerDay int
+	Days        []Day
+	Notes       []string
+}
+
+// goalMealsPerDay spreads food over more meals for goals that need a large
+// intake or steady protein; the largest value among the selected goals wins
+var goalMealsPerDay = map[string]int{
+	"Weight/Gain":               5,
+	"Appearance/Bulk":           5,
+	"Strength/Gain":             4,
+	"Weight/Lose":               4,
+	"Appearance/Definition/Cut": 4,
+}
+
+// mealShares is each meal's share of the day's calories by meals per day.
+// Shares of 10% or less are snacks.
+var mealShares = map[int][]float64{
+	1: {1},
+	2: {0.45, 0.55},
+	3: {0.30, 0.35, 0.35},
+	4: {0.25, 0.30, 0.10, 0.35},
+	5: {0.20, 0.10, 0.30, 0.10, 0.30},
+	6: {0.20, 0.10, 0.25, 0.10, 0.25, 0.10},
+}
+
+const snackShare = 0.10
+
+// unitRule bounds and rounds servings of a serving unit
+type unitRule struct {
+	min, max, step float64
+}
+
+var unitRules = map[string]unitRule{
+	"GRAMS":  {min: 10, max: 500, step: 5},
+	"OUNCES": {min: 1, max: 10, step: 0.5},
+	"TSP":    {min: 0.5, max: 6, step: 0.5},
+	"TBSP":   {min: 0.5, max: 4, step: 0.5},
+	"CUPS":   {min: 0.25, max: 3, step: 0.25},
+	"PIECES": {min: 1, max: 6, step: 1},
+}
+
+var defaultUnitRule = unitRule{min: 0.25, max: 4, step: 0.25}
+
+// role is the part a food plays in a meal
+type role int
+
+const (
+	roleNone role = iota
+	roleProtein
+	roleCarbs
+	roleFat
+	roleVegetable
+)
+
+var roleNames = map[role]string{
+	roleProtein:   "protein",
+	roleCarbs:     "carbohydrate",
+	roleFat:       "fat",
+	roleVegetable: "vegetable",
+}
+
+// unplannedCategories are seasonings and drinks that are not planned as portions
+var unplannedCategories = map[string]bool{
+	"SPICE_HERB": true, "CONDIMENT": true, "SWEETENER": true, "BEVERAGE": true,
+}
+
+// snackCategories may be eaten on their own as a snack
+var snackCategories = map[string]bool{
+	"FRUIT": true, "NUTS": true, "SEEDS": true, "DAIRY": true, "DAIRY_ALTERNATIVE": true, "SNACK": true,
+}
+
+// MealsPerDay returns the default number of meals for the selected goals
+func MealsPerDay(goals []Goal) int {
+	meals := defaultMealsPerDay
+	for _, g := range goals {
+		if n := goalMealsPerDay[g.Category+"/"+g.Name]; n > meals {
+			meals = n
+		}
+	}
+	return meals
+}
+
+// Generate builds a plan of opts.Days days from the foods the user may eat
+func Generate(foods []Food, targets Macros, opts Options) (*Plan, error) {
+	if targets.Calories <= 0 {
+		return nil, fmt.Errorf("invalid targets: calories must be positive")
+	}
+
+	days := opts.Days
+	if days == 0 {
+		days = DefaultDays
+	}
+	if days < 1 || days > MaxDays {
+		return nil, fmt.Errorf("invalid days %d: must be between 1 and %d", days, MaxDays)
+	}
+
+	mealsPerDay := opts.MealsPerDay
+	if mealsPerDay == 0 {
+		mealsPerDay = MealsPerDay(opts.Goals)
+	}
+	if mealsPerDay < 1 || mealsPerDay > MaxMealsPerDay {
+		return nil, fmt.Errorf("invalid meals_per_day %d: must be between 1 and %d", mealsPerDay, MaxMealsPerDay)
+	}
+
+	plan := &Plan{MealsPerDay: mealsPerDay}
+	pools, snacks := buildPools(foods, opts.NonInflammatoryOnly, plan)
+	if len(pools[roleProtein]) == 0 || len(pools[roleCarbs]) == 0 {
+		return nil, fmt.Errorf("not enough foods to plan meals: at least one protein and one carbohydrate source must be available")
+	}
+	if len(snacks) == 0 {
+		snacks = pools[roleCarbs]
+	}
+
+	rank := healthRank(opts)
+	shares := mealShares[mealsPerDay]
+	mainMeals, snackMeals := 0, 0
+	for _, share := range shares {
+		if share <= snackShare {
+			snackMeals++
+		} else {
+			mainMeals++
+		}
+	}
+
+	for d := 0; d < days; d++ {
+		day := Day{}
+		mainIndex, snackIndex := 0, 0
+		for i, share := range shares {
+			meal := Meal{MealNumber: i + 1}
+			if share <= snackShare {
+				meal.Portions = []Portion{{Food: pick(snacks, d*snackMeals+snackIndex, rank)}}
+				snackIndex++
+			} else {
+				n := d*mainMeals + mainIndex
+				for _, r := range []role{roleProtein, roleCarbs, roleVegetable, roleFat} {
+					if len(pools[r]) > 0 {
+						meal.Portions = append(meal.Portions, Portion{Food: pick(pools[r], n, rank)})
+					}
+				}
+				mainIndex++
+			}
+			day.Meals = append(day.Meals, meal)
+		}
+
+		solve(&day, shares, targets)
+		day.OffTarget = offTarget(day.Totals(), targets)
+		plan.Days = append(plan.Days, day)
+	}
+
+	return plan, nil
+}
+
+// buildPools groups foods by role and collects snack foods, liked foods first.
+// With nonInflammatoryOnly, roles fall back to all foods (with a note) when
+// no non-inflammatory food is available for them.
+func buildPools(foods []Food, nonInflammatoryOnly bool, plan *Plan) (map[role][]Food, []Food) {
+	sorted := append([]Food(nil), foods...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Liked != sorted[j].Liked {
+			return sorted[i].Liked
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	all := map[role][]Food{}
+	var snacks []Food
+	for _, f := range sorted {
+		r := classify(f)
+		if r == roleNone {
+			continue
+		}
+		all[r] = append(all[r], f)
+		if snackCategories[strings.ToUpper(f.Category)] && (!nonInflammatoryOnly || f.NonInflammatory) {
+			snacks = append(snacks, f)
+		}
+	}
+
+	if !nonInflammatoryOnly {
+		return all, snacks
+	}
+
+	pools := map[role][]Food{}
+	for _, r := range []role{roleProtein, roleCarbs, roleFat, roleVegetable} {
+		for _, f := range all[r] {
+			if f.NonInflammatory {
+				pools[r] = append(pools[r], f)
+			}
+		}
+		if len(pools[r]) == 0 && len(all[r]) > 0 {
+			pools[r] = all[r]
+			plan.Notes = append(plan.Notes, fmt.Sprintf("No non-inflammatory %s foods are available; other %s foods were used", roleNames[r], roleNames[r]))
+		}
+	}
+	return pools, snacks
+}
+
+// classify assigns a food the role of the macro that supplies most of its calories
+func classify(f Food) role {
+	category := strings.ToUpper(f.Category)
+	if unplannedCategories[category] {
+		return roleNone
+	}
+
+	m := f.PerServing
+	calories := 4*m.ProteinGrams + 4*m.CarbsGrams + 9*m.FatGrams
+	if calories <= 0 {
+		return roleNone
+	}
+
+	switch {
+	case (category == "VEGETABLE" || category == "NIGHTSHADES") && m.Calories < 60:
+		return roleVegetable
+	case 4*m.ProteinGrams/calories >= 0.35:
+		return roleProtein
+	case 9*m.FatGrams/calories >= 0.60:
+		return roleFat
+	case 4*m.CarbsGrams/calories >= 0.50:
+		return roleCarbs
+	default:
+		return roleNone
+	}
+}
+
+// healthRank orders foods by how much they help the scores favored in opts,
+// 0 being best. It is nil when no score is favored.
+func healthRank(opts Options) func(Food) int {
+	if !opts.FavorAntiInflammatory && !opts.FavorGutHealth {
+		return nil
+	}
+	return func(f Food) int {
+		rank := 0
+		if opts.FavorAntiInflammatory && healthscores.Inflammatory(healthscores.Item{Category: f.Category, NonInflammatory: f.NonInflammatory}) {
+			rank++
+		}
+		if opts.FavorGutHealth && !f.Probiotic && !f.Prebiotic {
+			rank++
+		}
+		return rank
+	}
+}
+
+// pick rotates through a pool. When at least two foods are liked, only liked
+// foods are rotated so the plan favors them while keeping some variety; the
+// same applies among those to the best health rank when one is given.
+func pick(pool []Food, n int, rank func(Food) int) Food {
+	pool = preferred(pool, func(f Food) bool { return f.Liked })
+	if rank != nil {
+		best := rank(pool[0])
+		for _, f := range pool {
+			if r := rank(f); r < best {
+				best = r
+			}
+		}
+		pool = preferred(pool, func(f Food) bool { return rank(f) == best })
+	}
+	return pool[n%len(pool)]
+}
+
+// preferred returns the foods of pool that keep, or the whole pool when fewer
+// than two do
+func preferred(pool []Food, keep func(Food) bool) []Food {
+	var kept []Food
+	for _, f := range pool {
+		if keep(f) {
+			kept = append(kept, f)
+		}
+	}
+	if len(kept) < 2 {
+		return pool
+	}
+	return kept
+}
+
+func ruleFor(f Food) unitRule {
+	if rule, ok := unitRules[strings.ToUpper(f.ServingUnits)]; ok {
+		return rule
+	}
+	return defaultUnitRule
+}
+
+// bounds returns the servings range of a portion. Vegetables get at least a
+// full serving; added fats in main meals may be left out entirely when the
+// other foods already supply enough fat.
+func bounds(p Portion, snack bool) (float64, float64) {
+	rule := ruleFor(p.Food)
+	switch classify(p.Food) {
+	case roleVegetable:
+		return math.Max(rule.min, 1), rule.max
+	case roleFat:
+		if !snack {
+			return 0, rule.max
+		}
+	}
+	return rule.min, rule.max
+}
+
+// macro selects one component of Macros
+type macro func(Macros) float64
+
+var (
+	calories = func(m Macros) float64 { return m.Calories }
+	protein  = func(m Macros) float64 { return m.ProteinGrams }
+	carbs    = func(m Macros) float64 { return m.CarbsGrams }
+	fat      = func(m Macros) float64 { return m.FatGrams }
+)
+
+// term is one weighted target of the objective: a macro of the whole day
+// (meal < 0) or of a single meal
+type term struct {
+	weight float64
+	target float64
+	meal   int
+	macro  macro
+}
+
+// objectiveTerms are the day's calorie and macro targets plus each meal's
+// share of the day's calories and protein
+func objectiveTerms(shares []float64, targets Macros) []term {
+	terms := []term{
+		{caloriesWeight, targets.Calories, -1, calories},
+		{proteinWeight, targets.ProteinGrams, -1, protein},
+		{carbsWeight, targets.CarbsGrams, -1, carbs},
+		{fatWeight, targets.FatGrams, -1, fat},
+	}
+	for i, share := range shares {
+		terms = append(terms,
+			term{mealWeight, share * targets.Calories, i, calories},
+			term{mealWeight, share * targets.ProteinGrams, i, protein},
+		)
+	}
+	return terms
+}
+
+// actual returns the value a term measures
+func (t term) actual(day *Day) float64 {
+	if t.meal < 0 {
+		return t.macro(day.Totals())
+	}
+	return t.macro(day.Meals[t.meal].Totals())
+}
+
+// solve sets the servings of every portion of the day by coordinate descent on
+// a weighted least-squares objective, then rounds them to the unit steps
+func solve(day *Day, shares []float64, targets Macros) {
+	terms := objectiveTerms(shares, targets)
+
+	type variable struct {
+		meal, portion int
+		lo, hi, step  float64
+	}
+	var vars []variable
+	for i := range day.Meals {
+		for j := range day.Meals[i].Portions {
+			p := &day.Meals[i].Portions[j]
+			lo, hi := bounds(*p, shares[i] <= snackShare)
+			p.Servings = math.Max(lo, 1)
+			vars = append(vars, variable{i, j, lo, hi, ruleFor(p.Food).step})
+		}
+	}
+
+	for sweep := 0; sweep < solverSweeps; sweep++ {
+		for _, v := range vars {
+			p := &day.Meals[v.meal].Portions[v.portion]
+			saved := p.Servings
+			p.Servings = 0
+
+			num, den := 0.0, 0.0
+			for _, t := range terms {
+				if t.target <= 0 || (t.meal >= 0 && t.meal != v.meal) {
+					continue
+				}
+				coef := t.macro(p.Food.PerServing)
+				scale := t.weight / (t.target * t.target)
+				num += scale * (t.target - t.actual(day)) * coef
+				den += scale * coef * coef
+			}
+
+			p.Servings = saved
+			if den > 0 {
+				p.Servings = math.Min(math.Max(num/den, v.lo), v.hi)
+			}
+		}
+	}
+
+	// Round to unit steps, then nudge portions a step at a time while that helps
+	for _, v := range vars {
+		p := &day.Meals[v.meal].Portions[v.portion]
+		p.Servings = math.Min(math.Max(math.Round(p.Servings/v.step)*v.step, v.lo), v.hi)
+	}
+	for pass := 0; pass < roundingPasses; pass++ {
+		improved := false
+		for _, v := range vars {
+			p := &day.Meals[v.meal].Portions[v.portion]
+			best := objective(day, terms)
+			current := p.Servings
+			for _, candidate := range []float64{current - v.step, current + v.step} {
+				if candidate < v.lo-1e-9 || candidate > v.hi+1e-9 {
+					continue
+				}
+				p.Servings = candidate
+				if score := objective(day, terms); score < best-1e-12 {
+					best, current, improved = score, candidate, true
+				}
+			}
+			p.Servings = current
+		}
+		if !improved {
+			break
+		}
+	}
+
+	// Drop added fats the solver left out
+	for i := range day.Meals {
+		kept := day.Meals[i].Portions[:0]
+		for _, p := range day.Meals[i].Portions {
+			if p.Servings > 0 {
+				kept = append(kept, p)
+			}
+		}
+		day.Meals[i].Portions = kept
+	}
+}
+
+// objective is the weighted squared relative error of the terms
+func objective(day *Day, terms []term) float64 {
+	score := 0.0
+	for _, t := range terms {
+		if t.target > 0 {
+			diff := (t.actual(day) - t.target) / t.target
+			score += t.weight * diff * diff
+		}
+	}
+	return score
+}
+
+// offTarget describes each of the day's totals outside its tolerance
+func offTarget(totals, targets Macros) []string {
+	var misses []string
+	for _, check := range []struct {
+		name                      string
+		actual, target, tolerance float64
+	}{
+		{"calories", totals.Calories, targets.Calories, Tolerances.Calories},
+		{"protein", totals.ProteinGrams, targets.ProteinGrams, Tolerances.ProteinGrams},
+		{"carbs", totals.CarbsGrams, targets.CarbsGrams, Tolerances.CarbsGrams},
+		{"fat", totals.FatGrams, targets.FatGrams, Tolerances.FatGrams},
+	} {
+		if check.target <= 0 {
+			continue
+		}
+		deviation := (check.actual - check.target) / check.target
+		if math.Abs(deviation) <= check.tolerance {
+			continue
+		}
+		direction := "over"
+		if deviation < 0 {
+			direction = "under"
+		}
+		misses = append(misses, fmt.Sprintf("%s %.0f%% %s target", check.name, math.Abs(deviation)*100, direction))
+	}
+	return misses
+}
@@ -0,0 +1,190 @@
+package mealplan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func food(id int, name, category, units string, calories, protein, carbs, fat float64, nonInflammatory bool) Food {
+	return Food{
+		ID:              id,
+		Name:            name,
+		Category:        category,
+		ServingUnits:    units,
+		PerServing:      Macros{Calories: calories, ProteinGrams: protein, CarbsGrams: carbs, FatGrams: fat},
+		NonInflammatory: nonInflammatory,
+	}
+}
+
+// catalog mirrors a slice of the seeded food catalog
+func catalog() []Food {
+	return []Food{
+		food(1, "Salmon - Wild Atlantic", "FISH", "OUNCES", 155, 22, 0, 7, true),
+		food(2, "Cod Fillet", "FISH", "OUNCES", 70, 15, 0, 0.5, true),
+		food(5, "Chicken Breast - Skinless", "MEAT", "OUNCES", 140, 26, 0, 3, false),
+		food(11, "Greek Yogurt - Plain", "DAIRY", "CUPS", 130, 23, 9, 0, true),
+		food(19, "Broccoli", "VEGETABLE", "CUPS", 25, 3, 5, 0, true),
+		food(20, "Spinach - Raw", "VEGETABLE", "CUPS", 7, 1, 1, 0, true),
+		food(28, "Tomatoes", "NIGHTSHADES", "CUPS", 32, 2, 7, 0, false),
+		food(31, "Brown Rice - Cooked", "GRAIN", "CUPS", 216, 5, 45, 2, true),
+		food(33, "Quinoa - Cooked", "GRAIN", "CUPS", 222, 8, 39, 4, true),
+		food(22, "Sweet Potato", "VEGETABLE", "CUPS", 180, 4, 41, 0, true),
+		food(34, "Almonds - Whole", "NUTS", "OUNCES", 164, 6, 6, 14, true),
+		food(43, "Olive Oil - Extra Virgin", "OIL", "TBSP", 120, 0, 0, 14, true),
+		food(46, "Blueberries", "FRUIT", "CUPS", 84, 1, 21, 0.5, true),
+		food(49, "Banana - Medium", "FRUIT", "PIECES", 105, 1.3, 27, 0.4, true),
+		food(52, "Turmeric - Ground", "SPICE_HERB", "TSP", 8, 0.3, 1.4, 0.2, true),
+	}
+}
+
+var dailyTargets = Macros{Calories: 2200, ProteinGrams: 150, CarbsGrams: 240, FatGrams: 70}
+
+func TestGenerate_HitsTargetsWithinTolerance(t *testing.T) {
+	plan, err := Generate(catalog(), dailyTargets, Options{Days: 3, MealsPerDay: 4})
+	require.NoError(t, err)
+	require.Len(t, plan.Days, 3)
+
+	for _, day := range plan.Days {
+		assert.Empty(t, day.OffTarget)
+		assert.Len(t, day.Meals, 4)
+
+		totals := day.Totals()
+		assert.InDelta(t, dailyTargets.Calories, totals.Calories, dailyTargets.Calories*Tolerances.Calories)
+		assert.InDelta(t, dailyTargets.ProteinGrams, totals.ProteinGrams, dailyTargets.ProteinGrams*Tolerances.ProteinGrams)
+
+		// The third meal of four is a single-food snack
+		assert.Len(t, day.Meals[2].Portions, 1)
+		for _, meal := range day.Meals {
+			for _, p := range meal.Portions {
+				assert.NotEqual(t, "SPICE_HERB", p.Food.Category)
+				assert.Greater(t, p.Servings, 0.0)
+			}
+		}
+	}
+}
+
+func TestGenerate_IsDeterministicAndRotatesFoods(t *testing.T) {
+	first, err := Generate(catalog(), dailyTargets, Options{Days: 2})
+	require.NoError(t, err)
+	second, err := Generate(catalog(), dailyTargets, Options{Days: 2})
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+	assert.NotEqual(t, first.Days[0].Meals[0].Portions[0].Food.ID, first.Days[0].Meals[1].Portions[0].Food.ID,
+		"consecutive meals should not repeat the protein")
+}
+
+func TestGenerate_FavorsLikedFoods(t *testing.T) {
+	foods := catalog()
+	for i := range foods {
+		if foods[i].ID == 2 || foods[i].ID == 5 {
+			foods[i].Liked = true
+		}
+	}
+
+	plan, err := Generate(foods, dailyTargets, Options{Days: 2, MealsPerDay: 3})
+	require.NoError(t, err)
+
+	for _, day := range plan.Days {
+		for _, meal := range day.Meals {
+			protein := meal.Portions[0].Food
+			assert.Contains(t, []int{2, 5}, protein.ID)
+		}
+	}
+}
+
+func TestGenerate_NonInflammatoryOnly(t *testing.T) {
+	plan, err := Generate(catalog(), dailyTargets, Options{Days: 2, NonInflammatoryOnly: true})
+	require.NoError(t, err)
+
+	for _, day := range plan.Days {
+		for _, meal := range day.Meals {
+			for _, p := range meal.Portions {
+				assert.True(t, p.Food.NonInflammatory, p.Food.Name)
+			}
+		}
+	}
+	assert.Empty(t, plan.Notes)
+
+	// Without non-inflammatory proteins the plan falls back and says so
+	var inflammatoryProteins []Food
+	for _, f := range catalog() {
+		if f.ID == 5 || !(f.Category == "FISH" || f.Category == "DAIRY") {
+			inflammatoryProteins = append(inflammatoryProteins, f)
+		}
+	}
+	plan, err = Generate(inflammatoryProteins, dailyTargets, Options{Days: 1, NonInflammatoryOnly: true})
+	require.NoError(t, err)
+	assert.Contains(t, plan.Notes, "No non-inflammatory protein foods are available; other protein foods were used")
+}
+
+func TestGenerate_FavorsHealthScores(t *testing.T) {
+	plan, err := Generate(catalog(), dailyTargets, Options{Days: 3, MealsPerDay: 3, FavorAntiInflammatory: true})
+	require.NoError(t, err)
+
+	for _, day := range plan.Days {
+		for _, meal := range day.Meals {
+			for _, p := range meal.Portions {
+				assert.NotContains(t, []int{5, 28}, p.Food.ID, "inflammatory foods should rotate out")
+			}
+		}
+	}
+
+	// Gut-friendly snacks are rotated ahead of the others
+	foods := catalog()
+	for i := range foods {
+		switch foods[i].ID {
+		case 11:
+			foods[i].Probiotic = true
+		case 49:
+			foods[i].Prebiotic = true
+		}
+	}
+	plan, err = Generate(foods, dailyTargets, Options{Days: 2, MealsPerDay: 5, FavorGutHealth: true})
+	require.NoError(t, err)
+
+	for _, day := range plan.Days {
+		for _, meal := range []Meal{day.Meals[1], day.Meals[3]} {
+			assert.Contains(t, []int{11, 49}, meal.Portions[0].Food.ID)
+		}
+	}
+
+	// Favoring is not filtering: a lone inflammatory protein is still planned
+	var chickenOnly []Food
+	for _, f := range catalog() {
+		if f.ID == 5 || !(f.Category == "FISH" || f.Category == "DAIRY") {
+			chickenOnly = append(chickenOnly, f)
+		}
+	}
+	plan, err = Generate(chickenOnly, dailyTargets, Options{Days: 1, FavorAntiInflammatory: true})
+	require.NoError(t, err)
+	assert.Equal(t, 5, plan.Days[0].Meals[0].Portions[0].Food.ID)
+	assert.Empty(t, plan.Notes)
+}
+
+func TestGenerate_MealsPerDayFromGoals(t *testing.T) {
+	assert.Equal(t, 3, MealsPerDay(nil))
+	assert.Equal(t, 4, MealsPerDay([]Goal{{Category: "Weight", Name: "Lose"}}))
+	assert.Equal(t, 5, MealsPerDay([]Goal{{Category: "Strength", Name: "Gain"}, {Category: "Appearance", Name: "Bulk"}}))
+
+	plan, err := Generate(catalog(), dailyTargets, Options{Days: 1, Goals: []Goal{{Category: "Weight", Name: "Gain"}}})
+	require.NoError(t, err)
+	assert.Equal(t, 5, plan.MealsPerDay)
+	assert.Len(t, plan.Days[0].Meals, 5)
+}
+
+func TestGenerate_Validation(t *testing.T) {
+	_, err := Generate(catalog(), Macros{}, Options{})
+	assert.ErrorContains(t, err, "invalid targets")
+
+	_, err = Generate(catalog(), dailyTargets, Options{Days: 15})
+	assert.ErrorContains(t, err, "invalid days 15")
+
+	_, err = Generate(catalog(), dailyTargets, Options{MealsPerDay: 7})
+	assert.ErrorContains(t, err, "invalid meals_per_day 7")
+
+	_, err = Generate(catalog()[4:7], dailyTargets, Options{})
+	assert.ErrorContains(t, err, "not enough foods")
+}
@@ -0,0 +1,175 @@
+// Package micronutrients holds daily reference intakes for the micronutrients
+// tracked in FOOD_CATALOG and flags logged days that fall short of them (or go
+// over them, for nutrients with an upper limit). Values follow the US Dietary
+// Reference Intakes: RDA or AI by sex and age band, and the chronic disease
+// risk reduction intake for sodium.
+package micronutrients
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Tracked nutrients
+const (
+	Fiber     = "fiber"
+	Sugar     = "sugar"
+	Sodium    = "sodium"
+	Potassium = "potassium"
+	Iron      = "iron"
+	Calcium   = "calcium"
+	VitaminD  = "vitamin_d"
+	Omega3    = "omega_3"
+)
+
+// Kinds of reference intake
+const (
+	Minimum = "MINIMUM" // eat at least this much
+	Limit   = "LIMIT"   // eat no more than this
+)
+
+// MinimumAge is the youngest age the reference tables cover
+const MinimumAge = 14
+
+// Units lists the unit each tracked nutrient is measured in
+var Units = map[string]string{
+	Fiber:     "g",
+	Sugar:     "g",
+	Sodium:    "mg",
+	Potassium: "mg",
+	Iron:      "mg",
+	Calcium:   "mg",
+	VitaminD:  "mcg",
+	Omega3:    "g",
+}
+
+// band is the reference intake from an age onwards, until the next band
+type band struct {
+	fromAge int
+	male    float64
+	female  float64
+}
+
+// nutrientTable is the reference intake of one nutrient by age band
+type nutrientTable struct {
+	nutrient string
+	kind     string
+	bands    []band
+}
+
+// tables are in report order. Sugar has no reference intake: the guidelines
+// limit added sugar, which the catalog does not separate from natural sugar.
+var tables = []nutrientTable{
+	{Fiber, Minimum, []band{{14, 38, 26}, {19, 38, 25}, {51, 30, 21}}},
+	{Potassium, Minimum, []band{{14, 3000, 2300}, {19, 3400, 2600}}},
+	{Iron, Minimum, []band{{14, 11, 15}, {19, 8, 18}, {51, 8, 8}}},
+	{Calcium, Minimum, []band{{14, 1300, 1300}, {19, 1000, 1000}, {51, 1000, 1200}, {71, 1200, 1200}}},
+	{VitaminD, Minimum, []band{{14, 15, 15}, {71, 20, 20}}},
+	{Omega3, Minimum, []band{{14, 1.6, 1.1}}},
+	{Sodium, Limit, []band{{14, 2300, 2300}}},
+}
+
+// Reference is the daily reference intake of one nutrient
+type Reference struct {
+	Nutrient string
+	Unit     string
+	Kind     string
+	Amount   float64
+}
+
+// Day is what was eaten on one logged day, by nutrient
+type Day struct {
+	Date   string
+	Intake map[string]float64
+}
+
+// Gap compares the intake of one nutrient across logged days with its
+// reference. FlaggedDates are the days below a minimum or above a limit.
+type Gap struct {
+	Reference
+	AverageIntake      float64
+	PercentOfReference float64
+	FlaggedDates       []string
+}
+
+// ReferenceIntakes returns the daily reference intakes for a sex and age.
+// OTHER uses the midpoint between the male and female values.
+func ReferenceIntakes(sex string, age int) ([]Reference, error) {
+	missing := []string{}
+	if age <= 0 {
+		missing = append(missing, "birth_date")
+	}
+	if sex == "" {
+		missing = append(missing, "sex")
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("incomplete profile: %s required", strings.Join(missing, ", "))
+	}
+	if age < MinimumAge {
+		return nil, fmt.Errorf("invalid profile: reference intakes start at age %d", MinimumAge)
+	}
+
+	references := make([]Reference, len(tables))
+	for i, table := range tables {
+		b := table.bands[0]
+		for _, candidate := range table.bands {
+			if age >= candidate.fromAge {
+				b = candidate
+			}
+		}
+
+		var amount float64
+		switch strings.ToUpper(sex) {
+		case "MALE":
+			amount = b.male
+		case "FEMALE":
+			amount = b.female
+		default:
+			amount = (b.male + b.female) / 2
+		}
+
+		references[i] = Reference{
+			Nutrient: table.nutrient,
+			Unit:     Units[table.nutrient],
+			Kind:     table.kind,
+			Amount:   amount,
+		}
+	}
+
+	return references, nil
+}
+
+// Analyze compares logged days with the reference intakes. Every reference
+// gets a Gap, so nutrients without flagged days show how close intake was.
+func Analyze(references []Reference, days []Day) []Gap {
+	gaps := make([]Gap, len(references))
+	for i, ref := range references {
+		gap := Gap{Reference: ref, FlaggedDates: []string{}}
+
+		total := 0.0
+		for _, day := range days {
+			intake := day.Intake[ref.Nutrient]
+			total += intake
+
+			if (ref.Kind == Minimum && intake < ref.Amount) || (ref.Kind == Limit && intake > ref.Amount) {
+				gap.FlaggedDates = append(gap.FlaggedDates, day.Date)
+			}
+		}
+
+		if len(days) > 0 {
+			gap.AverageIntake = round(total/float64(len(days)), 1)
+		}
+		if ref.Amount > 0 {
+			gap.PercentOfReference = round(gap.AverageIntake/ref.Amount*100, 0)
+		}
+		gaps[i] = gap
+	}
+
+	return gaps
+}
+
+func round(value float64, places int) float64 {
+	factor := math.Pow(10, float64(places))
+	return math.Round(value*factor) / factor
+}
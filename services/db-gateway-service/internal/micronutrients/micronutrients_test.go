@@ -0,0 +1,83 @@
+package micronutrients
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// amounts maps references by nutrient for easier assertions
+func amounts(references []Reference) map[string]float64 {
+	result := make(map[string]float64, len(references))
+	for _, ref := range references {
+		result[ref.Nutrient] = ref.Amount
+	}
+	return result
+}
+
+func TestReferenceIntakes_BySexAndAge(t *testing.T) {
+	female, err := ReferenceIntakes("FEMALE", 34)
+	require.NoError(t, err)
+	assert.Equal(t, 18.0, amounts(female)[Iron])
+	assert.Equal(t, 1000.0, amounts(female)[Calcium])
+	assert.Equal(t, 25.0, amounts(female)[Fiber])
+
+	// Iron needs drop and calcium needs rise for women after 50
+	older, err := ReferenceIntakes("FEMALE", 55)
+	require.NoError(t, err)
+	assert.Equal(t, 8.0, amounts(older)[Iron])
+	assert.Equal(t, 1200.0, amounts(older)[Calcium])
+
+	male, err := ReferenceIntakes("MALE", 34)
+	require.NoError(t, err)
+	assert.Equal(t, 8.0, amounts(male)[Iron])
+	assert.Equal(t, 3400.0, amounts(male)[Potassium])
+	assert.Equal(t, 1.6, amounts(male)[Omega3])
+
+	// OTHER takes the midpoint
+	other, err := ReferenceIntakes("OTHER", 34)
+	require.NoError(t, err)
+	assert.Equal(t, 13.0, amounts(other)[Iron])
+
+	teen, err := ReferenceIntakes("MALE", 16)
+	require.NoError(t, err)
+	assert.Equal(t, 1300.0, amounts(teen)[Calcium])
+
+	assert.Equal(t, Limit, female[len(female)-1].Kind)
+	assert.Equal(t, "mg", female[len(female)-1].Unit)
+}
+
+func TestReferenceIntakes_Validation(t *testing.T) {
+	_, err := ReferenceIntakes("", 0)
+	assert.EqualError(t, err, "incomplete profile: birth_date, sex required")
+
+	_, err = ReferenceIntakes("FEMALE", 12)
+	assert.EqualError(t, err, "invalid profile: reference intakes start at age 14")
+}
+
+func TestAnalyze(t *testing.T) {
+	references := []Reference{
+		{Nutrient: Iron, Unit: "mg", Kind: Minimum, Amount: 18},
+		{Nutrient: Sodium, Unit: "mg", Kind: Limit, Amount: 2300},
+	}
+	days := []Day{
+		{Date: "2025-03-10", Intake: map[string]float64{Iron: 12, Sodium: 2600}},
+		{Date: "2025-03-11", Intake: map[string]float64{Iron: 21, Sodium: 1900}},
+		{Date: "2025-03-12", Intake: map[string]float64{Iron: 9}},
+	}
+
+	gaps := Analyze(references, days)
+	require.Len(t, gaps, 2)
+
+	assert.Equal(t, []string{"2025-03-10", "2025-03-12"}, gaps[0].FlaggedDates)
+	assert.Equal(t, 14.0, gaps[0].AverageIntake)
+	assert.Equal(t, 78.0, gaps[0].PercentOfReference)
+
+	assert.Equal(t, []string{"2025-03-10"}, gaps[1].FlaggedDates)
+
+	// No logged days means nothing to flag
+	gaps = Analyze(references, nil)
+	assert.Empty(t, gaps[0].FlaggedDates)
+	assert.Equal(t, 0.0, gaps[0].AverageIntake)
+}
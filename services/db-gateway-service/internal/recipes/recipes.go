@@ -0,0 +1,113 @@
+// Package recipes scales meal ingredients to a number of servings and spreads
+// one cooked batch across several days. Scaled amounts are rounded the way a
+// cook would measure them: pieces stay whole and spoon and cup measures are
+// written in the largest unit that keeps them readable (3 tsp become 1 tbsp).
+package recipes
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"db-gateway-service/internal/units"
+)
+
+// Limits on scaling and batch cooking
+const (
+	MaxServings  = 50
+	MaxBatchDays = 7
+)
+
+// Ingredient is an amount of a food in a recipe
+type Ingredient struct {
+	FoodID   int
+	Name     string
+	Quantity float64
+	Unit     string
+	Notes    string
+}
+
+// Portion is the servings of a batch eaten on one day
+type Portion struct {
+	Day      int // days after the first day
+	Servings float64
+}
+
+// Scale multiplies ingredients written for from servings so they make to
+// servings, rounding each amount for its unit
+func Scale(ingredients []Ingredient, from, to int) ([]Ingredient, error) {
+	if from <= 0 {
+		return nil, fmt.Errorf("invalid recipe servings %d: must be greater than 0", from)
+	}
+	if to <= 0 || to > MaxServings {
+		return nil, fmt.Errorf("invalid servings %d: must be between 1 and %d", to, MaxServings)
+	}
+
+	factor := float64(to) / float64(from)
+	scaled := make([]Ingredient, len(ingredients))
+	for i, ingredient := range ingredients {
+		scaled[i] = ingredient
+		scaled[i].Quantity, scaled[i].Unit = Round(ingredient.Quantity*factor, ingredient.Unit)
+	}
+	return scaled, nil
+}
+
+// Round rounds an amount for measuring. Pieces are whole; teaspoons,
+// tablespoons and cups are promoted or demoted to the unit that reads best
+// (tablespoons from 3 tsp, cups from ¼ cup); grams are whole under 100 g and
+// in 5 g steps above; ounces are in ¼ oz steps. Amounts never round to zero.
+func Round(quantity float64, unit string) (float64, string) {
+	unit = strings.ToUpper(unit)
+	switch unit {
+	case units.Pieces:
+		return roundTo(quantity, 1), unit
+	case units.Grams:
+		if quantity >= 100 {
+			return roundTo(quantity, 5), unit
+		}
+		return roundTo(quantity, 1), unit
+	case units.Ounces:
+		return roundTo(quantity, 0.25), unit
+	case units.Tsp, units.Tbsp, units.Cups:
+		tsp, _ := units.Convert(quantity, unit, units.Tsp)
+		if cups, _ := units.Convert(tsp, units.Tsp, units.Cups); cups >= 0.25 {
+			return roundTo(cups, 0.25), units.Cups
+		}
+		if tbsp, _ := units.Convert(tsp, units.Tsp, units.Tbsp); tbsp >= 1 {
+			return roundTo(tbsp, 0.5), units.Tbsp
+		}
+		return roundTo(tsp, 0.25), units.Tsp
+	}
+	return roundTo(quantity, 0.25), unit
+}
+
+// roundTo rounds to the nearest multiple of step, but never below one step
+func roundTo(value, step float64) float64 {
+	return math.Max(step, math.Round(value/step)*step)
+}
+
+// SpreadBatch splits a batch of servings across days as evenly as quarter
+// servings allow, giving any remainder to the earliest days
+func SpreadBatch(servings float64, days int) ([]Portion, error) {
+	if days <= 0 || days > MaxBatchDays {
+		return nil, fmt.Errorf("invalid days %d: must be between 1 and %d", days, MaxBatchDays)
+	}
+	if servings <= 0 || servings > MaxServings {
+		return nil, fmt.Errorf("invalid servings %g: must be greater than 0 and at most %d", servings, MaxServings)
+	}
+
+	quarters := int(math.Round(servings * 4))
+	if quarters < days {
+		return nil, fmt.Errorf("invalid servings %g: a batch must give each of the %d days at least a quarter serving", servings, days)
+	}
+
+	portions := make([]Portion, days)
+	for d := range portions {
+		share := quarters / days
+		if d < quarters%days {
+			share++
+		}
+		portions[d] = Portion{Day: d, Servings: float64(share) / 4}
+	}
+	return portions, nil
+}
@@ -0,0 +1,86 @@
+package recipes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScale(t *testing.T) {
+	ingredients := []Ingredient{
+		{FoodID: 5, Name: "Chicken Breast - Skinless", Quantity: 8, Unit: "OUNCES"},
+		{FoodID: 48, Name: "Eggs - Large", Quantity: 3, Unit: "PIECES"},
+		{FoodID: 43, Name: "Olive Oil - Extra Virgin", Quantity: 1, Unit: "TSP"},
+		{FoodID: 52, Name: "Turmeric - Ground", Quantity: 0.5, Unit: "TSP"},
+		{FoodID: 31, Name: "Brown Rice - Cooked", Quantity: 1.5, Unit: "CUPS"},
+		{FoodID: 46, Name: "Blueberries", Quantity: 45, Unit: "GRAMS"},
+	}
+
+	// Two servings to five
+	scaled, err := Scale(ingredients, 2, 5)
+	require.NoError(t, err)
+
+	assert.Equal(t, Ingredient{FoodID: 5, Name: "Chicken Breast - Skinless", Quantity: 20, Unit: "OUNCES"}, scaled[0])
+	// 7.5 eggs stay whole
+	assert.Equal(t, 8.0, scaled[1].Quantity)
+	assert.Equal(t, "PIECES", scaled[1].Unit)
+	// 2.5 tsp stay teaspoons, 1.25 tsp too
+	assert.Equal(t, 2.5, scaled[2].Quantity)
+	assert.Equal(t, "TSP", scaled[2].Unit)
+	assert.Equal(t, 1.25, scaled[3].Quantity)
+	assert.Equal(t, 3.75, scaled[4].Quantity)
+	assert.Equal(t, "CUPS", scaled[4].Unit)
+	// 112.5 g is rounded to 5 g steps
+	assert.Equal(t, 115.0, scaled[5].Quantity)
+
+	// The original is left untouched
+	assert.Equal(t, 8.0, ingredients[0].Quantity)
+}
+
+func TestRound_PromotesSpoonMeasures(t *testing.T) {
+	quantity, unit := Round(4, "TSP")
+	assert.Equal(t, 1.5, quantity)
+	assert.Equal(t, "TBSP", unit)
+
+	quantity, unit = Round(5, "TBSP")
+	assert.Equal(t, 0.25, quantity)
+	assert.Equal(t, "CUPS", unit)
+
+	quantity, unit = Round(0.125, "CUPS")
+	assert.Equal(t, 2.0, quantity)
+	assert.Equal(t, "TBSP", unit)
+
+	// Amounts never round to zero
+	quantity, unit = Round(0.2, "PIECES")
+	assert.Equal(t, 1.0, quantity)
+	assert.Equal(t, "PIECES", unit)
+}
+
+func TestScale_Validation(t *testing.T) {
+	_, err := Scale(nil, 0, 4)
+	assert.ErrorContains(t, err, "invalid recipe servings 0")
+
+	_, err = Scale(nil, 2, 51)
+	assert.ErrorContains(t, err, "invalid servings 51")
+}
+
+func TestSpreadBatch(t *testing.T) {
+	portions, err := SpreadBatch(4, 4)
+	require.NoError(t, err)
+	assert.Equal(t, []Portion{{0, 1}, {1, 1}, {2, 1}, {3, 1}}, portions)
+
+	// 5 servings over 3 days: 20 quarters as 7, 7 and 6
+	portions, err = SpreadBatch(5, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []Portion{{0, 1.75}, {1, 1.75}, {2, 1.5}}, portions)
+
+	_, err = SpreadBatch(4, 8)
+	assert.ErrorContains(t, err, "invalid days 8")
+
+	_, err = SpreadBatch(0.5, 3)
+	assert.ErrorContains(t, err, "at least a quarter serving")
+
+	_, err = SpreadBatch(51, 7)
+	assert.ErrorContains(t, err, "invalid servings 51: must be greater than 0 and at most 50")
+}
@@ -0,0 +1,368 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"db-gateway-service/internal/cycle"
+	"db-gateway-service/internal/micronutrients"
+	"db-gateway-service/proto"
+	meals "db-gateway-service/sql/meal-service"
+	users "db-gateway-service/sql/user-service"
+)
+
+// Cycle log listing limits
+const (
+	defaultCycleLogDays = 90
+	maxCycleLogDays     = 366
+)
+
+// ineligibleCycleTracking is returned when a user's profile sex rules out cycle tracking
+const ineligibleCycleTracking = "invalid profile: cycle tracking is only available when the biological sex is FEMALE or not set"
+
+// maxSuggestedFoods caps the foods suggested for a cycle phase
+const maxSuggestedFoods = 5
+
+// CycleService implements the gRPC CycleService server. Cycle data is
+// sensitive: it is only read for the requesting user and never logged.
+type CycleService struct {
+	proto.UnimplementedCycleServiceServer
+	repo     *meals.Repository
+	userRepo *users.Repository
+	foods    *FoodPreferenceService
+	now      func() time.Time
+}
+
+// NewCycleService creates a new CycleService instance
+func NewCycleService(repo *meals.Repository, userRepo *users.Repository) *CycleService {
+	return &CycleService{
+		repo:     repo,
+		userRepo: userRepo,
+		foods:    NewFoodPreferenceService(repo),
+		now:      time.Now,
+	}
+}
+
+// EnableCycleTracking opts a user in to cycle tracking. Only users whose
+// profile sex is FEMALE or not set may opt in.
+func (s *CycleService) EnableCycleTracking(ctx context.Context, req *proto.CycleTrackingRequest) (*proto.CycleTrackingResponse, error) {
+	log.Printf("EnableCycleTracking called for user ID: %d", req.UserId)
+
+	if req.UserId == 0 {
+		return &proto.CycleTrackingResponse{Error: "user_id is required"}, nil
+	}
+
+	user, err := s.userRepo.GetUserByID(int(req.UserId))
+	if err != nil {
+		log.Printf("Failed to get user: %v", err)
+		return &proto.CycleTrackingResponse{
+			Error: fmt.Sprintf("Failed to enable cycle tracking: %v", err),
+		}, nil
+	}
+	if !cycle.Eligible(ptrToString(user.Sex)) {
+		return &proto.CycleTrackingResponse{Error: ineligibleCycleTracking}, nil
+	}
+
+	if err := s.userRepo.EnableCycleTracking(int(req.UserId)); err != nil {
+		log.Printf("Failed to enable cycle tracking: %v", err)
+		return &proto.CycleTrackingResponse{
+			Error: fmt.Sprintf("Failed to enable cycle tracking: %v", err),
+		}, nil
+	}
+
+	return &proto.CycleTrackingResponse{Enabled: true}, nil
+}
+
+// DisableCycleTracking opts a user out of cycle tracking and deletes all of
+// their cycle logs
+func (s *CycleService) DisableCycleTracking(ctx context.Context, req *proto.CycleTrackingRequest) (*proto.CycleTrackingResponse, error) {
+	log.Printf("DisableCycleTracking called for user ID: %d", req.UserId)
+
+	if req.UserId == 0 {
+		return &proto.CycleTrackingResponse{Error: "user_id is required"}, nil
+	}
+
+	if _, err := s.userRepo.DisableCycleTracking(int(req.UserId)); err != nil {
+		log.Printf("Failed to disable cycle tracking: %v", err)
+		return &proto.CycleTrackingResponse{
+			Error: fmt.Sprintf("Failed to disable cycle tracking: %v", err),
+		}, nil
+	}
+
+	return &proto.CycleTrackingResponse{Enabled: false}, nil
+}
+
+// LogCycleDay records a day's period start and symptoms, replacing any
+// earlier log for that day
+func (s *CycleService) LogCycleDay(ctx context.Context, req *proto.LogCycleDayRequest) (*proto.CycleLogResponse, error) {
+	log.Printf("LogCycleDay called for user ID: %d", req.UserId)
+
+	if req.UserId == 0 {
+		return &proto.CycleLogResponse{Error: "user_id is required"}, nil
+	}
+
+	symptoms, err := cycle.ValidateSymptoms(req.Symptoms)
+	if err != nil {
+		return &proto.CycleLogResponse{Error: err.Error()}, nil
+	}
+	if !req.PeriodStarted && len(symptoms) == 0 {
+		return &proto.CycleLogResponse{Error: "invalid cycle log: period_started or at least one symptom required"}, nil
+	}
+
+	today, err := resolveStartDate(s.repo, s.now(), int(req.UserId), "")
+	if err != nil {
+		return &proto.CycleLogResponse{Error: err.Error()}, nil
+	}
+	date := today
+	if req.Date != "" {
+		if date, err = parseDate(req.Date); err != nil {
+			return &proto.CycleLogResponse{Error: err.Error()}, nil
+		}
+		if date.After(today) {
+			return &proto.CycleLogResponse{Error: "invalid date: cannot be in the future"}, nil
+		}
+	}
+
+	entry, err := s.userRepo.UpsertCycleLog(int(req.UserId), date, req.PeriodStarted, symptoms)
+	if err != nil {
+		log.Printf("Failed to log cycle day: %v", err)
+		return &proto.CycleLogResponse{
+			Error: fmt.Sprintf("Failed to log cycle day: %v", err),
+		}, nil
+	}
+
+	return &proto.CycleLogResponse{Log: convertCycleLogToProto(entry)}, nil
+}
+
+// ListCycleLogs returns a user's cycle logs for a date range
+func (s *CycleService) ListCycleLogs(ctx context.Context, req *proto.ListCycleLogsRequest) (*proto.ListCycleLogsResponse, error) {
+	log.Printf("ListCycleLogs called for user ID: %d", req.UserId)
+
+	if req.UserId == 0 {
+		return &proto.ListCycleLogsResponse{Error: "user_id is required"}, nil
+	}
+
+	end, err := resolveStartDate(s.repo, s.now(), int(req.UserId), req.EndDate)
+	if err != nil {
+		return &proto.ListCycleLogsResponse{Error: err.Error()}, nil
+	}
+	start := end.AddDate(0, 0, -(defaultCycleLogDays - 1))
+	if req.StartDate != "" {
+		if start, err = parseDate(req.StartDate); err != nil {
+			return &proto.ListCycleLogsResponse{Error: err.Error()}, nil
+		}
+	}
+	if start.After(end) {
+		return &proto.ListCycleLogsResponse{Error: "invalid date range: start_date is after end_date"}, nil
+	}
+	if end.Sub(start).Hours()/24 >= maxCycleLogDays {
+		return &proto.ListCycleLogsResponse{
+			Error: fmt.Sprintf("date range too large: at most %d days", maxCycleLogDays),
+		}, nil
+	}
+
+	logs, err := s.userRepo.ListCycleLogs(int(req.UserId), start, end)
+	if err != nil {
+		log.Printf("Failed to list cycle logs: %v", err)
+		return &proto.ListCycleLogsResponse{
+			Error: fmt.Sprintf("Failed to list cycle logs: %v", err),
+		}, nil
+	}
+
+	resp := &proto.ListCycleLogsResponse{Logs: make([]*proto.CycleLog, len(logs))}
+	for i := range logs {
+		resp.Logs[i] = convertCycleLogToProto(&logs[i])
+	}
+
+	return resp, nil
+}
+
+// DeleteCycleLog deletes a user's cycle log for one day
+func (s *CycleService) DeleteCycleLog(ctx context.Context, req *proto.DeleteCycleLogRequest) (*proto.DeleteCycleLogResponse, error) {
+	log.Printf("DeleteCycleLog called for user ID: %d", req.UserId)
+
+	if req.UserId == 0 {
+		return &proto.DeleteCycleLogResponse{Error: "user_id is required"}, nil
+	}
+
+	date, err := parseDate(req.Date)
+	if err != nil {
+		return &proto.DeleteCycleLogResponse{Error: err.Error()}, nil
+	}
+
+	if err := s.userRepo.DeleteCycleLog(int(req.UserId), date); err != nil {
+		log.Printf("Failed to delete cycle log: %v", err)
+		return &proto.DeleteCycleLogResponse{
+			Error: fmt.Sprintf("Failed to delete cycle log: %v", err),
+		}, nil
+	}
+
+	return &proto.DeleteCycleLogResponse{Message: "Cycle log deleted successfully"}, nil
+}
+
+// GetCyclePhase predicts the cycle phase of a date from the user's logged
+// period starts, with its target adjustment and suggested foods
+func (s *CycleService) GetCyclePhase(ctx context.Context, req *proto.GetCyclePhaseRequest) (*proto.CyclePhaseResponse, error) {
+	log.Printf("GetCyclePhase called for user ID: %d", req.UserId)
+
+	if req.UserId == 0 {
+		return &proto.CyclePhaseResponse{Error: "user_id is required"}, nil
+	}
+
+	date, err := resolveStartDate(s.repo, s.now(), int(req.UserId), req.Date)
+	if err != nil {
+		return &proto.CyclePhaseResponse{Error: err.Error()}, nil
+	}
+
+	enabled, err := s.userRepo.CycleTrackingEnabled(int(req.UserId))
+	if err != nil {
+		log.Printf("Failed to check cycle tracking: %v", err)
+		return &proto.CyclePhaseResponse{
+			Error: fmt.Sprintf("Failed to predict cycle phase: %v", err),
+		}, nil
+	}
+	if !enabled {
+		return &proto.CyclePhaseResponse{Error: "cycle tracking is not enabled"}, nil
+	}
+
+	// The profile sex may have changed since the user opted in
+	user, err := s.userRepo.GetUserByID(int(req.UserId))
+	if err != nil {
+		log.Printf("Failed to get user: %v", err)
+		return &proto.CyclePhaseResponse{
+			Error: fmt.Sprintf("Failed to predict cycle phase: %v", err),
+		}, nil
+	}
+	if !cycle.Eligible(ptrToString(user.Sex)) {
+		return &proto.CyclePhaseResponse{Error: ineligibleCycleTracking}, nil
+	}
+
+	prediction, err := predictCycle(s.userRepo, int(req.UserId), date)
+	if err != nil {
+		return &proto.CyclePhaseResponse{Error: err.Error()}, nil
+	}
+	if prediction == nil {
+		return &proto.CyclePhaseResponse{Error: "no period start logged: at least one period start required to predict cycle phases"}, nil
+	}
+
+	adjustment := cycle.AdjustmentFor(prediction.Phase)
+	phase := &proto.CyclePhase{
+		Date:                     date.Format(dateLayout),
+		Phase:                    prediction.Phase,
+		CycleDay:                 int32(prediction.CycleDay),
+		CycleLength:              int32(prediction.CycleLength),
+		CyclesUsed:               int32(prediction.CyclesUsed),
+		LastPeriodStart:          prediction.LastPeriodStart.Format(dateLayout),
+		NextPeriodStart:          prediction.NextPeriodStart.Format(dateLayout),
+		OvulationDate:            prediction.OvulationDate.Format(dateLayout),
+		Projected:                prediction.Projected,
+		CalorieAdjustmentPercent: adjustment.CalorieAdjustmentPercent,
+		FocusNutrient:            adjustment.FocusNutrient,
+		SuggestedFoods:           []*proto.SuggestedFood{},
+		Notes:                    []string{adjustment.Note},
+	}
+	if prediction.CyclesUsed == 0 {
+		phase.Notes = append(phase.Notes, fmt.Sprintf("Log two or more period starts to replace the %d day default cycle length", cycle.DefaultCycleLength))
+	}
+	if prediction.Projected {
+		phase.Notes = append(phase.Notes, "No period start was logged for the current cycle, so it was projected from the average cycle length")
+	}
+
+	if adjustment.FocusNutrient != "" {
+		catalog, _, err := s.foods.FoodsForUser(int(req.UserId), "", false)
+		if err != nil {
+			log.Printf("Failed to list foods for cycle phase: %v", err)
+			return &proto.CyclePhaseResponse{
+				Error: fmt.Sprintf("Failed to suggest foods: %v", err),
+			}, nil
+		}
+		phase.SuggestedFoods = suggestFoods(catalog, adjustment.FocusNutrient)
+	}
+
+	return &proto.CyclePhaseResponse{Phase: phase}, nil
+}
+
+// predictCycle predicts the phase of a date from the user's period starts.
+// It returns nil when no period start is logged on or before the date.
+func predictCycle(userRepo *users.Repository, userID int, date time.Time) (*cycle.Prediction, error) {
+	starts, err := userRepo.ListPeriodStarts(userID, date, cycle.MaxHistory+1)
+	if err != nil {
+		log.Printf("Failed to list period starts: %v", err)
+		return nil, fmt.Errorf("Failed to predict cycle phase: %v", err)
+	}
+	if len(starts) == 0 {
+		return nil, nil
+	}
+
+	return cycle.Predict(starts, date)
+}
+
+// suggestFoods ranks foods by the nutrient per 100 kcal, liked foods first on ties
+func suggestFoods(foods []meals.Food, nutrient string) []*proto.SuggestedFood {
+	amount := func(f meals.Food) *float64 {
+		switch nutrient {
+		case micronutrients.Iron:
+			return f.IronMg
+		case micronutrients.Fiber:
+			return f.FiberGrams
+		}
+		return nil
+	}
+
+	type candidate struct {
+		food    meals.Food
+		amount  float64
+		density float64
+	}
+	var candidates []candidate
+	for _, f := range foods {
+		a := amount(f)
+		if a == nil || *a <= 0 || f.Calories <= 0 {
+			continue
+		}
+		candidates = append(candidates, candidate{food: f, amount: *a, density: *a / f.Calories * 100})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].density != candidates[j].density {
+			return candidates[i].density > candidates[j].density
+		}
+		if candidates[i].food.Liked != candidates[j].food.Liked {
+			return candidates[i].food.Liked
+		}
+		return candidates[i].food.FoodName < candidates[j].food.FoodName
+	})
+	if len(candidates) > maxSuggestedFoods {
+		candidates = candidates[:maxSuggestedFoods]
+	}
+
+	suggestions := make([]*proto.SuggestedFood, len(candidates))
+	for i, c := range candidates {
+		suggestions[i] = &proto.SuggestedFood{
+			FoodId:         int32(c.food.ID),
+			FoodName:       c.food.FoodName,
+			Category:       c.food.Category,
+			ServingUnits:   c.food.ServingUnits,
+			Calories:       c.food.Calories,
+			NutrientAmount: c.amount,
+			NutrientUnit:   micronutrients.Units[nutrient],
+			Liked:          c.food.Liked,
+		}
+	}
+	return suggestions
+}
+
+// convertCycleLogToProto converts a cycle log to its proto message
+func convertCycleLogToProto(entry *users.CycleLog) *proto.CycleLog {
+	symptoms := []string(entry.Symptoms)
+	if symptoms == nil {
+		symptoms = []string{}
+	}
+	return &proto.CycleLog{
+		Date:          entry.Date.Format(dateLayout),
+		PeriodStarted: entry.PeriodStarted,
+		Symptoms:      symptoms,
+	}
+}
@@ -0,0 +1,257 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"db-gateway-service/proto"
+	meals "db-gateway-service/sql/meal-service"
+	users "db-gateway-service/sql/user-service"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var cycleLogColumns = []string{"id", "date", "period_started", "symptoms", "created_at", "updated_at"}
+
+// expectProfileSex mocks loading a user whose profile has the given sex
+func expectProfileSex(mock sqlmock.Sqlmock, userID int, sex interface{}) {
+	mock.ExpectQuery(`SELECT id, full_name, email, .+ FROM USERS\s+WHERE id = \$1`).
+		WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "full_name", "email", "sex", "created_at", "updated_at"}).
+			AddRow(userID, "Jane Doe", "jane@example.com", sex, time.Now(), time.Now()))
+}
+
+func TestCycleService_LogCycleDay(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewCycleService(meals.NewRepository(db), users.NewRepository(db))
+	service.now = func() time.Time { return time.Date(2025, 3, 12, 18, 0, 0, 0, time.UTC) }
+
+	date := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	now := time.Now()
+
+	// Setup mock expectations
+	mock.ExpectQuery(`SELECT timezone FROM USERS WHERE id = \$1`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"timezone"}).AddRow("UTC"))
+	mock.ExpectQuery(`INSERT INTO CYCLE_LOGS .+ ON CONFLICT \(user_id, date\) DO UPDATE`).
+		WithArgs(7, date, true, pq.StringArray{"CRAMPS", "FATIGUE"}).
+		WillReturnRows(sqlmock.NewRows(cycleLogColumns).
+			AddRow(1, date, true, "{CRAMPS,FATIGUE}", now, now))
+
+	// Execute
+	resp, err := service.LogCycleDay(context.Background(), &proto.LogCycleDayRequest{
+		UserId:        7,
+		Date:          "2025-03-10",
+		PeriodStarted: true,
+		Symptoms:      []string{"cramps", "fatigue"},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Equal(t, "2025-03-10", resp.Log.Date)
+	assert.True(t, resp.Log.PeriodStarted)
+	assert.Equal(t, []string{"CRAMPS", "FATIGUE"}, resp.Log.Symptoms)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCycleService_LogCycleDay_Validation(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewCycleService(meals.NewRepository(db), users.NewRepository(db))
+	service.now = func() time.Time { return time.Date(2025, 3, 12, 18, 0, 0, 0, time.UTC) }
+
+	resp, err := service.LogCycleDay(context.Background(), &proto.LogCycleDayRequest{UserId: 7})
+	assert.NoError(t, err)
+	assert.Equal(t, "invalid cycle log: period_started or at least one symptom required", resp.Error)
+
+	resp, err = service.LogCycleDay(context.Background(), &proto.LogCycleDayRequest{UserId: 7, Symptoms: []string{"SNEEZING"}})
+	assert.NoError(t, err)
+	assert.Contains(t, resp.Error, `invalid symptom "SNEEZING"`)
+
+	mock.ExpectQuery(`SELECT timezone FROM USERS WHERE id = \$1`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"timezone"}).AddRow("UTC"))
+	resp, err = service.LogCycleDay(context.Background(), &proto.LogCycleDayRequest{UserId: 7, Date: "2025-03-13", PeriodStarted: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "invalid date: cannot be in the future", resp.Error)
+
+	// Logging without opting in is rejected by the foreign key to CYCLE_TRACKING
+	mock.ExpectQuery(`SELECT timezone FROM USERS WHERE id = \$1`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"timezone"}).AddRow("UTC"))
+	mock.ExpectQuery(`INSERT INTO CYCLE_LOGS`).
+		WillReturnError(&pq.Error{Code: "23503"})
+	resp, err = service.LogCycleDay(context.Background(), &proto.LogCycleDayRequest{UserId: 7, PeriodStarted: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "Failed to log cycle day: cycle tracking is not enabled", resp.Error)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCycleService_GetCyclePhase(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewCycleService(meals.NewRepository(db), users.NewRepository(db))
+	service.now = func() time.Time { return time.Date(2025, 3, 12, 18, 0, 0, 0, time.UTC) }
+
+	today := time.Date(2025, 3, 12, 0, 0, 0, 0, time.UTC)
+	columns := append(append([]string{}, foodColumns[:11]...), "fiber_grams", "iron_mg", "allergens", "liked")
+
+	// Setup mock expectations
+	mock.ExpectQuery(`SELECT timezone FROM USERS WHERE id = \$1`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"timezone"}).AddRow("UTC"))
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT 1 FROM CYCLE_TRACKING WHERE user_id = \$1\)`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	expectProfileSex(mock, 7, "FEMALE")
+	// A 30 day cycle started on March 10th
+	mock.ExpectQuery(`FROM CYCLE_LOGS\s+WHERE user_id = \$1 AND period_started AND date <= \$2`).
+		WithArgs(7, today, 7).
+		WillReturnRows(sqlmock.NewRows([]string{"date"}).
+			AddRow(time.Date(2025, 2, 8, 0, 0, 0, 0, time.UTC)).
+			AddRow(time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)))
+	expectFoodPreferences(mock, 7, sqlmock.NewRows(foodColumns), nil, nil)
+	mock.ExpectQuery(`FROM FOOD_CATALOG f\s+LEFT JOIN FOOD_USER_LIKES l`).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(1, "Salmon - Wild Atlantic", "FISH", "OUNCES", 155.0, 22.0, 0.0, 7.0, true, false, false, 0.0, 0.3, "{FISH}", false).
+			AddRow(20, "Spinach - Raw", "VEGETABLE", "CUPS", 7.0, 1.0, 1.0, 0.0, true, false, false, 0.7, 0.8, "{}", false).
+			AddRow(77, "Lentils - Cooked", "LEGUMES", "CUPS", 230.0, 18.0, 40.0, 0.8, true, false, true, 15.6, 6.6, "{}", true).
+			AddRow(99, "Mystery Stew", "OTHER", "CUPS", 300.0, 20.0, 30.0, 10.0, false, false, false, nil, nil, "{}", false))
+	mock.ExpectQuery(`SELECT \(SELECT COUNT\(\*\) FROM FOOD_CATALOG f`).
+		WillReturnRows(sqlmock.NewRows([]string{"visible", "total"}).AddRow(4, 4))
+
+	// Execute
+	resp, err := service.GetCyclePhase(context.Background(), &proto.GetCyclePhaseRequest{UserId: 7})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	phase := resp.Phase
+	assert.Equal(t, "MENSTRUAL", phase.Phase)
+	assert.Equal(t, int32(3), phase.CycleDay)
+	assert.Equal(t, int32(30), phase.CycleLength)
+	assert.Equal(t, "2025-04-09", phase.NextPeriodStart)
+	assert.Equal(t, "2025-03-25", phase.OvulationDate)
+	assert.Equal(t, "iron", phase.FocusNutrient)
+
+	// Spinach has the most iron per calorie; foods without data are skipped
+	require.Len(t, phase.SuggestedFoods, 3)
+	assert.Equal(t, "Spinach - Raw", phase.SuggestedFoods[0].FoodName)
+	assert.Equal(t, "Lentils - Cooked", phase.SuggestedFoods[1].FoodName)
+	assert.Equal(t, 6.6, phase.SuggestedFoods[1].NutrientAmount)
+	assert.Equal(t, "mg", phase.SuggestedFoods[1].NutrientUnit)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCycleService_GetCyclePhase_NotEnabled(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewCycleService(meals.NewRepository(db), users.NewRepository(db))
+
+	mock.ExpectQuery(`SELECT timezone FROM USERS WHERE id = \$1`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"timezone"}).AddRow("UTC"))
+	mock.ExpectQuery(`FROM CYCLE_TRACKING`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+	resp, err := service.GetCyclePhase(context.Background(), &proto.GetCyclePhaseRequest{UserId: 7})
+
+	assert.NoError(t, err)
+	assert.Nil(t, resp.Phase)
+	assert.Equal(t, "cycle tracking is not enabled", resp.Error)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCycleService_GetCyclePhase_IneligibleProfile(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewCycleService(meals.NewRepository(db), users.NewRepository(db))
+
+	// The user opted in and later set their profile sex to MALE
+	mock.ExpectQuery(`SELECT timezone FROM USERS WHERE id = \$1`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"timezone"}).AddRow("UTC"))
+	mock.ExpectQuery(`FROM CYCLE_TRACKING`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	expectProfileSex(mock, 7, "MALE")
+
+	resp, err := service.GetCyclePhase(context.Background(), &proto.GetCyclePhaseRequest{UserId: 7})
+
+	assert.NoError(t, err)
+	assert.Nil(t, resp.Phase)
+	assert.Equal(t, ineligibleCycleTracking, resp.Error)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCycleService_EnableCycleTracking(t *testing.T) {
+	tests := []struct {
+		name    string
+		sex     interface{}
+		wantErr string
+	}{
+		{name: "female", sex: "FEMALE"},
+		{name: "sex not set", sex: nil},
+		{name: "male", sex: "MALE", wantErr: ineligibleCycleTracking},
+		{name: "other", sex: "OTHER", wantErr: ineligibleCycleTracking},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := setupTestDB(t)
+			defer db.Close()
+
+			service := NewCycleService(meals.NewRepository(db), users.NewRepository(db))
+
+			expectProfileSex(mock, 7, tt.sex)
+			if tt.wantErr == "" {
+				mock.ExpectExec(`INSERT INTO CYCLE_TRACKING \(user_id\) VALUES \(\$1\)`).
+					WithArgs(7).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+
+			resp, err := service.EnableCycleTracking(context.Background(), &proto.CycleTrackingRequest{UserId: 7})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantErr, resp.Error)
+			assert.Equal(t, tt.wantErr == "", resp.Enabled)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCycleService_DisableCycleTracking(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewCycleService(meals.NewRepository(db), users.NewRepository(db))
+
+	// Cycle logs are removed by the cascade from CYCLE_TRACKING
+	mock.ExpectExec(`DELETE FROM CYCLE_TRACKING WHERE user_id = \$1`).
+		WithArgs(7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	resp, err := service.DisableCycleTracking(context.Background(), &proto.CycleTrackingRequest{UserId: 7})
+
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	assert.False(t, resp.Enabled)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,826 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"db-gateway-service/internal/diaryimport"
+	"db-gateway-service/internal/foodtext"
+	"db-gateway-service/proto"
+	meals "db-gateway-service/sql/meal-service"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// dateLayout is the wire format for calendar dates (YYYY-MM-DD)
+const dateLayout = "2006-01-02"
+
+// Limits of a typed diary entry
+const (
+	maxDraftText        = 500
+	maxDraftItems       = 20
+	draftSearchLimit    = 200
+	draftMatchesPerItem = 4
+)
+
+// Recent items cover the last recentItemDays days of the diary
+const (
+	recentItemDays     = 30
+	defaultRecentItems = 20
+	maxRecentItems     = 50
+)
+
+// Diary import row statuses
+const (
+	importMatched = "MATCHED"
+	importCreated = "CREATED"
+	importSkipped = "SKIPPED"
+)
+
+// DiaryService implements the gRPC DiaryService server
+type DiaryService struct {
+	proto.UnimplementedDiaryServiceServer
+	repo *meals.Repository
+	now  func() time.Time
+}
+
+// NewDiaryService creates a new DiaryService instance
+func NewDiaryService(repo *meals.Repository) *DiaryService {
+	return &DiaryService{
+		repo: repo,
+		now:  time.Now,
+	}
+}
+
+// LogDiaryEntry logs a meal, a single food or quick-add calories
+func (s *DiaryService) LogDiaryEntry(ctx context.Context, req *proto.LogDiaryEntryRequest) (*proto.LogDiaryEntryResponse, error) {
+	log.Printf("LogDiaryEntry called for user ID: %d", req.UserId)
+
+	if req.UserId == 0 {
+		return &proto.LogDiaryEntryResponse{Error: "user_id is required"}, nil
+	}
+
+	date, err := s.resolveDate(int(req.UserId), req.Date)
+	if err != nil {
+		return &proto.LogDiaryEntryResponse{Error: err.Error()}, nil
+	}
+
+	servings := req.Servings
+	if servings == 0 {
+		servings = 1
+	}
+
+	entry := &meals.DiaryEntry{
+		UserID:        int(req.UserId),
+		MealID:        intToPtr(int(req.MealId)),
+		FoodID:        intToPtr(int(req.FoodId)),
+		Date:          date,
+		MealNumber:    int(req.MealNumber),
+		Servings:      servings,
+		QuickCalories: req.QuickCalories,
+		Description:   stringToPtr(req.Description),
+	}
+
+	if err := validateDiaryEntry(entry); err != nil {
+		return &proto.LogDiaryEntryResponse{Error: err.Error()}, nil
+	}
+
+	if err := s.repo.CreateDiaryEntry(entry); err != nil {
+		log.Printf("Failed to log diary entry: %v", err)
+		return &proto.LogDiaryEntryResponse{
+			Error: fmt.Sprintf("Failed to log diary entry: %v", err),
+		}, nil
+	}
+
+	created, err := s.repo.GetDiaryEntry(entry.UserID, entry.ID)
+	if err != nil {
+		log.Printf("Failed to reload diary entry: %v", err)
+		return &proto.LogDiaryEntryResponse{
+			Error: fmt.Sprintf("Failed to log diary entry: %v", err),
+		}, nil
+	}
+
+	return &proto.LogDiaryEntryResponse{
+		Entry: convertToProtoDiaryEntry(created),
+	}, nil
+}
+
+// UpdateDiaryEntry edits an existing diary entry; zero-valued fields keep
+// their current value, while quick_calories and description only change when set
+func (s *DiaryService) UpdateDiaryEntry(ctx context.Context, req *proto.UpdateDiaryEntryRequest) (*proto.UpdateDiaryEntryResponse, error) {
+	log.Printf("UpdateDiaryEntry called for ID: %d", req.Id)
+
+	existing, err := s.repo.GetDiaryEntry(int(req.UserId), int(req.Id))
+	if err != nil {
+		log.Printf("Failed to get diary entry: %v", err)
+		return &proto.UpdateDiaryEntryResponse{
+			Error: fmt.Sprintf("Failed to update diary entry: %v", err),
+		}, nil
+	}
+
+	entry := *existing
+	if req.MealId != 0 || req.FoodId != 0 || req.QuickCalories != nil {
+		entry.MealID = intToPtr(int(req.MealId))
+		entry.FoodID = intToPtr(int(req.FoodId))
+		entry.QuickCalories = req.QuickCalories
+	}
+	if req.Date != "" {
+		date, err := parseDate(req.Date)
+		if err != nil {
+			return &proto.UpdateDiaryEntryResponse{Error: err.Error()}, nil
+		}
+		entry.Date = date
+	}
+	if req.MealNumber != 0 {
+		entry.MealNumber = int(req.MealNumber)
+	}
+	if req.Servings != 0 {
+		entry.Servings = req.Servings
+	}
+	if req.Description != nil {
+		entry.Description = stringToPtr(*req.Description)
+	}
+
+	if err := validateDiaryEntry(&entry); err != nil {
+		return &proto.UpdateDiaryEntryResponse{Error: err.Error()}, nil
+	}
+
+	if err := s.repo.UpdateDiaryEntry(&entry); err != nil {
+		log.Printf("Failed to update diary entry: %v", err)
+		return &proto.UpdateDiaryEntryResponse{
+			Error: fmt.Sprintf("Failed to update diary entry: %v", err),
+		}, nil
+	}
+
+	updated, err := s.repo.GetDiaryEntry(entry.UserID, entry.ID)
+	if err != nil {
+		log.Printf("Failed to reload diary entry: %v", err)
+		return &proto.UpdateDiaryEntryResponse{
+			Error: fmt.Sprintf("Failed to update diary entry: %v", err),
+		}, nil
+	}
+
+	return &proto.UpdateDiaryEntryResponse{
+		Entry: convertToProtoDiaryEntry(updated),
+	}, nil
+}
+
+// DeleteDiaryEntry deletes a diary entry
+func (s *DiaryService) DeleteDiaryEntry(ctx context.Context, req *proto.DeleteDiaryEntryRequest) (*proto.DeleteDiaryEntryResponse, error) {
+	log.Printf("DeleteDiaryEntry called for ID: %d", req.Id)
+
+	if err := s.repo.DeleteDiaryEntry(int(req.UserId), int(req.Id)); err != nil {
+		log.Printf("Failed to delete diary entry: %v", err)
+		return &proto.DeleteDiaryEntryResponse{
+			Error: fmt.Sprintf("Failed to delete diary entry: %v", err),
+		}, nil
+	}
+
+	return &proto.DeleteDiaryEntryResponse{
+		Message: fmt.Sprintf("Diary entry with ID %d deleted successfully", req.Id),
+	}, nil
+}
+
+// ListDiaryEntries lists a user's diary entries for one day
+func (s *DiaryService) ListDiaryEntries(ctx context.Context, req *proto.ListDiaryEntriesRequest) (*proto.ListDiaryEntriesResponse, error) {
+	log.Printf("ListDiaryEntries called for user ID: %d, date: %q", req.UserId, req.Date)
+
+	if req.UserId == 0 {
+		return &proto.ListDiaryEntriesResponse{Error: "user_id is required"}, nil
+	}
+
+	date, err := s.resolveDate(int(req.UserId), req.Date)
+	if err != nil {
+		return &proto.ListDiaryEntriesResponse{Error: err.Error()}, nil
+	}
+
+	entries, err := s.repo.ListDiaryEntries(int(req.UserId), date)
+	if err != nil {
+		log.Printf("Failed to list diary entries: %v", err)
+		return &proto.ListDiaryEntriesResponse{
+			Error: fmt.Sprintf("Failed to list diary entries: %v", err),
+		}, nil
+	}
+
+	protoEntries := make([]*proto.DiaryEntry, len(entries))
+	for i := range entries {
+		protoEntries[i] = convertToProtoDiaryEntry(&entries[i])
+	}
+
+	return &proto.ListDiaryEntriesResponse{
+		Date:    date.Format(dateLayout),
+		Entries: protoEntries,
+	}, nil
+}
+
+// ImportDiary logs the rows of a diary CSV export. Each food is matched to the
+// catalog by name, or becomes a custom food of the user when nothing matches.
+// Rows dated after today or already in the diary are skipped, so importing
+// the same file twice logs it once.
+func (s *DiaryService) ImportDiary(ctx context.Context, req *proto.ImportDiaryRequest) (*proto.ImportDiaryResponse, error) {
+	log.Printf("ImportDiary called for user ID: %d", req.UserId)
+
+	if req.UserId == 0 {
+		return &proto.ImportDiaryResponse{Error: "user_id is required"}, nil
+	}
+	if len(req.Csv) == 0 {
+		return &proto.ImportDiaryResponse{Error: "csv is required"}, nil
+	}
+	userID := int(req.UserId)
+
+	timezone, err := s.repo.GetUserTimezone(userID)
+	if err != nil {
+		return &proto.ImportDiaryResponse{Error: err.Error()}, nil
+	}
+	today := userToday(s.now(), timezone)
+
+	rows, skips, err := diaryimport.Parse(bytes.NewReader(req.Csv))
+	if err != nil {
+		return &proto.ImportDiaryResponse{Error: err.Error()}, nil
+	}
+
+	foods, err := s.repo.ListVisibleFoods(userID)
+	if err != nil {
+		log.Printf("Failed to list foods: %v", err)
+		return &proto.ImportDiaryResponse{
+			Error: fmt.Sprintf("Failed to import diary: %v", err),
+		}, nil
+	}
+	catalog := make([]diaryimport.Food, len(foods))
+	for i, food := range foods {
+		catalog[i] = diaryimport.Food{ID: food.ID, Name: food.FoodName, Calories: food.Calories}
+	}
+	matcher := diaryimport.NewMatcher(catalog)
+
+	logged, err := s.loggedFoods(userID, rows)
+	if err != nil {
+		log.Printf("Failed to list logged foods: %v", err)
+		return &proto.ImportDiaryResponse{
+			Error: fmt.Sprintf("Failed to import diary: %v", err),
+		}, nil
+	}
+
+	resp := &proto.ImportDiaryResponse{}
+	for _, skip := range skips {
+		resp.Rows = append(resp.Rows, &proto.DiaryImportRow{
+			Line:     int32(skip.Line),
+			Status:   importSkipped,
+			FoodName: skip.FoodName,
+			Reason:   skip.Reason,
+		})
+	}
+
+	var newFoods []*meals.CustomFood
+	created := map[int]*meals.CustomFood{} // matcher index of the foods created by this import
+	createdRows := map[*proto.DiaryImportRow]*meals.CustomFood{}
+	var entries []meals.ImportedEntry
+	for _, row := range rows {
+		result := &proto.DiaryImportRow{
+			Line:       int32(row.Line),
+			FoodName:   row.FoodName,
+			Date:       row.Date.Format(dateLayout),
+			MealNumber: int32(row.MealNumber),
+		}
+		resp.Rows = append(resp.Rows, result)
+
+		if row.Date.After(today) {
+			result.Status, result.Reason = importSkipped, "date is in the future"
+			continue
+		}
+
+		match, ok := matcher.Match(row)
+		if !ok {
+			per := row.PerServing()
+			food := &meals.CustomFood{
+				FoodName:     row.FoodName,
+				Calories:     per.Calories,
+				ProteinGrams: per.ProteinGrams,
+				CarbsGrams:   per.CarbsGrams,
+				FatGrams:     per.FatGrams,
+				FiberGrams:   per.FiberGrams,
+				SugarGrams:   per.SugarGrams,
+				SodiumMg:     per.SodiumMg,
+			}
+			newFoods = append(newFoods, food)
+			index := matcher.Add(diaryimport.Food{Name: food.FoodName, Calories: food.Calories})
+			created[index] = food
+			match = diaryimport.Match{
+				Index:    index,
+				Food:     diaryimport.Food{Name: food.FoodName},
+				Servings: math.Round(row.Servings*1000) / 1000,
+			}
+		}
+
+		newFood := created[match.Index]
+		if newFood == nil && logged[loggedFoodKey(row.Date, row.MealNumber, match.Food.ID, match.Servings)] {
+			result.Status, result.Reason = importSkipped, "already logged"
+			continue
+		}
+
+		entries = append(entries, meals.ImportedEntry{
+			Date:       row.Date,
+			MealNumber: row.MealNumber,
+			FoodID:     match.Food.ID,
+			Servings:   match.Servings,
+			NewFood:    newFood,
+		})
+		result.FoodId = int32(match.Food.ID)
+		result.MatchedFoodName = match.Food.Name
+		result.Servings = match.Servings
+		result.Status = importMatched
+		if newFood != nil {
+			result.Status = importCreated
+			createdRows[result] = newFood
+		}
+	}
+
+	if len(entries) > 0 {
+		if err := s.repo.ImportDiaryEntries(userID, newFoods, entries); err != nil {
+			log.Printf("Failed to import diary: %v", err)
+			return &proto.ImportDiaryResponse{
+				Error: fmt.Sprintf("Failed to import diary: %v", err),
+			}, nil
+		}
+	}
+
+	sort.SliceStable(resp.Rows, func(i, j int) bool { return resp.Rows[i].Line < resp.Rows[j].Line })
+	for _, result := range resp.Rows {
+		switch result.Status {
+		case importMatched:
+			resp.Matched++
+		case importCreated:
+			resp.Created++
+			result.FoodId = int32(createdRows[result].ID)
+		case importSkipped:
+			resp.Skipped++
+		}
+	}
+	resp.CustomFoodsCreated = int32(len(newFoods))
+
+	return resp, nil
+}
+
+// DraftDiaryEntry parses a typed description such as "2 eggs and a cup of
+// brown rice" into diary items resolved against the catalog. Nothing is
+// logged: the client confirms each item by logging its food and servings.
+func (s *DiaryService) DraftDiaryEntry(ctx context.Context, req *proto.DraftDiaryEntryRequest) (*proto.DraftDiaryEntryResponse, error) {
+	log.Printf("DraftDiaryEntry called for user ID: %d", req.UserId)
+
+	if req.UserId == 0 {
+		return &proto.DraftDiaryEntryResponse{Error: "user_id is required"}, nil
+	}
+	text := strings.TrimSpace(req.Text)
+	if text == "" {
+		return &proto.DraftDiaryEntryResponse{Error: "text is required"}, nil
+	}
+	if len(text) > maxDraftText {
+		return &proto.DraftDiaryEntryResponse{
+			Error: fmt.Sprintf("invalid text: must be at most %d characters", maxDraftText),
+		}, nil
+	}
+	if req.MealNumber < 0 || req.MealNumber > 6 {
+		return &proto.DraftDiaryEntryResponse{Error: "invalid meal_number: must be between 1 and 6"}, nil
+	}
+	userID := int(req.UserId)
+
+	date, err := s.resolveDate(userID, req.Date)
+	if err != nil {
+		return &proto.DraftDiaryEntryResponse{Error: err.Error()}, nil
+	}
+
+	items := foodtext.Parse(text)
+	if len(items) == 0 {
+		return &proto.DraftDiaryEntryResponse{Error: "invalid text: no foods found"}, nil
+	}
+	if len(items) > maxDraftItems {
+		return &proto.DraftDiaryEntryResponse{
+			Error: fmt.Sprintf("invalid text: must list at most %d foods", maxDraftItems),
+		}, nil
+	}
+
+	resp := &proto.DraftDiaryEntryResponse{
+		Date:       date.Format(dateLayout),
+		MealNumber: req.MealNumber,
+	}
+	for _, item := range items {
+		foods, err := s.repo.SearchFoods(userID, searchWords(item.Food), draftSearchLimit)
+		if err != nil {
+			log.Printf("Failed to search foods: %v", err)
+			return &proto.DraftDiaryEntryResponse{
+				Error: fmt.Sprintf("Failed to draft diary entry: %v", err),
+			}, nil
+		}
+		catalog := make([]foodtext.Food, len(foods))
+		for i, food := range foods {
+			catalog[i] = foodtext.Food{
+				ID:           food.ID,
+				Name:         food.FoodName,
+				ServingUnits: food.ServingUnits,
+				Calories:     food.Calories,
+			}
+		}
+
+		draft := &proto.DraftDiaryItem{
+			Text:       item.Text,
+			Quantity:   item.Quantity,
+			Unit:       item.Unit,
+			FoodPhrase: item.Food,
+		}
+		for i, match := range foodtext.Rank(item, catalog, draftMatchesPerItem) {
+			if i == 0 {
+				draft.Match = convertToProtoDraftMatch(match)
+			} else {
+				draft.Alternatives = append(draft.Alternatives, convertToProtoDraftMatch(match))
+			}
+		}
+		if draft.Match == nil {
+			resp.Unresolved++
+		}
+		resp.Items = append(resp.Items, draft)
+	}
+
+	return resp, nil
+}
+
+// searchWords are the words of a food phrase to search the catalog by. A
+// word singularized to "y" is searched without it, so that "berry" also
+// finds "Blueberries".
+func searchWords(phrase string) []string {
+	words := diaryimport.Tokens(phrase)
+	for i, word := range words {
+		if len(word) > 3 && strings.HasSuffix(word, "y") {
+			words[i] = strings.TrimSuffix(word, "y")
+		}
+	}
+	return words
+}
+
+func convertToProtoDraftMatch(match foodtext.Match) *proto.DraftFoodMatch {
+	return &proto.DraftFoodMatch{
+		FoodId:       int32(match.Food.ID),
+		FoodName:     match.Food.Name,
+		ServingUnits: match.Food.ServingUnits,
+		Servings:     match.Servings,
+		Calories:     match.Calories,
+		Confidence:   match.Confidence,
+		Note:         match.Note,
+	}
+}
+
+// ListFavorites lists a user's favorite foods and meals
+func (s *DiaryService) ListFavorites(ctx context.Context, req *proto.ListFavoritesRequest) (*proto.ListFavoritesResponse, error) {
+	log.Printf("ListFavorites called for user ID: %d", req.UserId)
+
+	if req.UserId == 0 {
+		return &proto.ListFavoritesResponse{Error: "user_id is required"}, nil
+	}
+
+	favorites, err := s.repo.ListFavorites(int(req.UserId))
+	if err != nil {
+		log.Printf("Failed to list favorites: %v", err)
+		return &proto.ListFavoritesResponse{
+			Error: fmt.Sprintf("Failed to list favorites: %v", err),
+		}, nil
+	}
+
+	protoFavorites := make([]*proto.Favorite, len(favorites))
+	for i := range favorites {
+		protoFavorites[i] = convertToProtoFavorite(&favorites[i])
+	}
+
+	return &proto.ListFavoritesResponse{Favorites: protoFavorites}, nil
+}
+
+// AddFavorite marks a food or meal as a favorite of the user
+func (s *DiaryService) AddFavorite(ctx context.Context, req *proto.AddFavoriteRequest) (*proto.AddFavoriteResponse, error) {
+	log.Printf("AddFavorite called for user ID: %d", req.UserId)
+
+	if req.UserId == 0 {
+		return &proto.AddFavoriteResponse{Error: "user_id is required"}, nil
+	}
+	if (req.FoodId == 0) == (req.MealId == 0) {
+		return &proto.AddFavoriteResponse{Error: "invalid favorite: exactly one of food_id or meal_id is required"}, nil
+	}
+	servings := req.Servings
+	if servings == 0 {
+		servings = 1
+	}
+	if servings < 0 {
+		return &proto.AddFavoriteResponse{Error: "invalid servings: must be greater than 0"}, nil
+	}
+	userID := int(req.UserId)
+
+	var id int
+	var err error
+	if req.FoodId != 0 {
+		id, err = s.repo.AddFavoriteFood(userID, int(req.FoodId), servings)
+	} else {
+		id, err = s.repo.AddFavoriteMeal(userID, int(req.MealId), servings)
+	}
+	if err != nil {
+		log.Printf("Failed to add favorite: %v", err)
+		return &proto.AddFavoriteResponse{
+			Error: fmt.Sprintf("Failed to add favorite: %v", err),
+		}, nil
+	}
+
+	favorite, err := s.repo.GetFavorite(userID, id)
+	if err != nil {
+		log.Printf("Failed to reload favorite: %v", err)
+		return &proto.AddFavoriteResponse{
+			Error: fmt.Sprintf("Failed to add favorite: %v", err),
+		}, nil
+	}
+
+	return &proto.AddFavoriteResponse{Favorite: convertToProtoFavorite(favorite)}, nil
+}
+
+// RemoveFavorite removes a favorite of the user
+func (s *DiaryService) RemoveFavorite(ctx context.Context, req *proto.RemoveFavoriteRequest) (*proto.RemoveFavoriteResponse, error) {
+	log.Printf("RemoveFavorite called for ID: %d", req.Id)
+
+	if err := s.repo.RemoveFavorite(int(req.UserId), int(req.Id)); err != nil {
+		log.Printf("Failed to remove favorite: %v", err)
+		return &proto.RemoveFavoriteResponse{
+			Error: fmt.Sprintf("Failed to remove favorite: %v", err),
+		}, nil
+	}
+
+	return &proto.RemoveFavoriteResponse{
+		Message: fmt.Sprintf("Favorite with ID %d removed successfully", req.Id),
+	}, nil
+}
+
+// ListRecentItems lists the foods and meals the user logged in the last 30
+// days, most recently logged first, to log them again in one step
+func (s *DiaryService) ListRecentItems(ctx context.Context, req *proto.ListRecentItemsRequest) (*proto.ListRecentItemsResponse, error) {
+	log.Printf("ListRecentItems called for user ID: %d", req.UserId)
+
+	if req.UserId == 0 {
+		return &proto.ListRecentItemsResponse{Error: "user_id is required"}, nil
+	}
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultRecentItems
+	}
+	if limit < 0 || limit > maxRecentItems {
+		return &proto.ListRecentItemsResponse{
+			Error: fmt.Sprintf("invalid limit: must be between 1 and %d", maxRecentItems),
+		}, nil
+	}
+	userID := int(req.UserId)
+
+	today, err := s.resolveDate(userID, "")
+	if err != nil {
+		return &proto.ListRecentItemsResponse{Error: err.Error()}, nil
+	}
+
+	items, err := s.repo.ListRecentItems(userID, today.AddDate(0, 0, -recentItemDays), limit)
+	if err != nil {
+		log.Printf("Failed to list recent items: %v", err)
+		return &proto.ListRecentItemsResponse{
+			Error: fmt.Sprintf("Failed to list recent items: %v", err),
+		}, nil
+	}
+
+	protoItems := make([]*proto.RecentItem, len(items))
+	for i, item := range items {
+		protoItems[i] = &proto.RecentItem{
+			FoodId:      ptrToInt32(item.FoodID),
+			MealId:      ptrToInt32(item.MealID),
+			Name:        item.Name,
+			Servings:    item.Servings,
+			Calories:    item.Calories,
+			LastLogged:  item.LastLogged.Format(dateLayout),
+			TimesLogged: int32(item.TimesLogged),
+			Favorite:    item.IsFavorite,
+		}
+	}
+
+	return &proto.ListRecentItemsResponse{Items: protoItems}, nil
+}
+
+// CopyDiaryEntries duplicates a day's diary entries, or those of one meal
+// slot, onto another date. Entries already on the target date are kept.
+func (s *DiaryService) CopyDiaryEntries(ctx context.Context, req *proto.CopyDiaryEntriesRequest) (*proto.CopyDiaryEntriesResponse, error) {
+	log.Printf("CopyDiaryEntries called for user ID: %d, from: %q, to: %q", req.UserId, req.FromDate, req.ToDate)
+
+	if req.UserId == 0 {
+		return &proto.CopyDiaryEntriesResponse{Error: "user_id is required"}, nil
+	}
+	if req.FromDate == "" {
+		return &proto.CopyDiaryEntriesResponse{Error: "from_date is required"}, nil
+	}
+	from, err := parseDate(req.FromDate)
+	if err != nil {
+		return &proto.CopyDiaryEntriesResponse{Error: err.Error()}, nil
+	}
+	if req.MealNumber < 0 || req.MealNumber > 6 {
+		return &proto.CopyDiaryEntriesResponse{Error: "invalid meal_number: must be between 1 and 6"}, nil
+	}
+	if req.ToMealNumber < 0 || req.ToMealNumber > 6 {
+		return &proto.CopyDiaryEntriesResponse{Error: "invalid to_meal_number: must be between 1 and 6"}, nil
+	}
+	if req.ToMealNumber != 0 && req.MealNumber == 0 {
+		return &proto.CopyDiaryEntriesResponse{Error: "invalid to_meal_number: meal_number is required"}, nil
+	}
+	userID := int(req.UserId)
+
+	to, err := s.resolveDate(userID, req.ToDate)
+	if err != nil {
+		return &proto.CopyDiaryEntriesResponse{Error: err.Error()}, nil
+	}
+	if to.Equal(from) && (req.ToMealNumber == 0 || req.ToMealNumber == req.MealNumber) {
+		return &proto.CopyDiaryEntriesResponse{Error: "invalid copy: entries would be copied onto themselves"}, nil
+	}
+
+	copied, err := s.repo.CopyDiaryEntries(userID, from, to, int(req.MealNumber), int(req.ToMealNumber))
+	if err != nil {
+		log.Printf("Failed to copy diary entries: %v", err)
+		return &proto.CopyDiaryEntriesResponse{
+			Error: fmt.Sprintf("Failed to copy diary entries: %v", err),
+		}, nil
+	}
+	if copied == 0 {
+		return &proto.CopyDiaryEntriesResponse{Error: "diary entries to copy not found"}, nil
+	}
+
+	entries, err := s.repo.ListDiaryEntries(userID, to)
+	if err != nil {
+		log.Printf("Failed to list diary entries: %v", err)
+		return &proto.CopyDiaryEntriesResponse{
+			Error: fmt.Sprintf("Failed to copy diary entries: %v", err),
+		}, nil
+	}
+
+	protoEntries := make([]*proto.DiaryEntry, len(entries))
+	for i := range entries {
+		protoEntries[i] = convertToProtoDiaryEntry(&entries[i])
+	}
+
+	return &proto.CopyDiaryEntriesResponse{
+		Date:    to.Format(dateLayout),
+		Copied:  int32(copied),
+		Entries: protoEntries,
+	}, nil
+}
+
+// loggedFoods returns the keys of the single-food entries already logged in
+// the date range of the rows
+func (s *DiaryService) loggedFoods(userID int, rows []diaryimport.Row) (map[string]bool, error) {
+	logged := map[string]bool{}
+	if len(rows) == 0 {
+		return logged, nil
+	}
+
+	start, end := rows[0].Date, rows[0].Date
+	for _, row := range rows {
+		if row.Date.Before(start) {
+			start = row.Date
+		}
+		if row.Date.After(end) {
+			end = row.Date
+		}
+	}
+
+	entries, err := s.repo.ListLoggedFoods(userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		logged[loggedFoodKey(entry.Date, entry.MealNumber, *entry.FoodID, entry.Servings)] = true
+	}
+	return logged, nil
+}
+
+func loggedFoodKey(date time.Time, mealNumber, foodID int, servings float64) string {
+	return fmt.Sprintf("%s/%d/%d/%.3f", date.Format(dateLayout), mealNumber, foodID, servings)
+}
+
+// resolveDate parses an explicit date or falls back to today in the user's timezone
+func (s *DiaryService) resolveDate(userID int, date string) (time.Time, error) {
+	if date != "" {
+		return parseDate(date)
+	}
+
+	timezone, err := s.repo.GetUserTimezone(userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return userToday(s.now(), timezone), nil
+}
+
+// validateDiaryEntry enforces the USER_MEALS invariants before hitting the database
+func validateDiaryEntry(entry *meals.DiaryEntry) error {
+	if entry.MealNumber < 1 || entry.MealNumber > 6 {
+		return fmt.Errorf("invalid meal_number: must be between 1 and 6")
+	}
+	if entry.Servings <= 0 {
+		return fmt.Errorf("invalid servings: must be greater than 0")
+	}
+
+	sources := 0
+	if entry.MealID != nil {
+		sources++
+	}
+	if entry.FoodID != nil {
+		sources++
+	}
+	if entry.QuickCalories != nil {
+		if *entry.QuickCalories < 0 {
+			return fmt.Errorf("invalid quick_calories: must not be negative")
+		}
+		sources++
+	}
+	if sources != 1 {
+		return fmt.Errorf("invalid entry: exactly one of meal_id, food_id or quick_calories is required")
+	}
+
+	return nil
+}
+
+// Helper function to convert database diary entry to protobuf diary entry
+func convertToProtoDiaryEntry(entry *meals.DiaryEntry) *proto.DiaryEntry {
+	return &proto.DiaryEntry{
+		Id:            int32(entry.ID),
+		UserId:        int32(entry.UserID),
+		MealId:        ptrToInt32(entry.MealID),
+		FoodId:        ptrToInt32(entry.FoodID),
+		Date:          entry.Date.Format(dateLayout),
+		MealNumber:    int32(entry.MealNumber),
+		Servings:      entry.Servings,
+		QuickCalories: ptrToFloat(entry.QuickCalories),
+		Description:   ptrToString(entry.Description),
+		Name:          entry.Name,
+		Calories:      entry.Calories,
+		ProteinGrams:  entry.ProteinGrams,
+		CarbsGrams:    entry.CarbsGrams,
+		FatGrams:      entry.FatGrams,
+		CreatedAt:     timestamppb.New(entry.CreatedAt),
+		UpdatedAt:     timestamppb.New(entry.UpdatedAt),
+	}
+}
+
+// Helper function to convert database favorite to protobuf favorite
+func convertToProtoFavorite(favorite *meals.Favorite) *proto.Favorite {
+	return &proto.Favorite{
+		Id:        int32(favorite.ID),
+		FoodId:    ptrToInt32(favorite.FoodID),
+		MealId:    ptrToInt32(favorite.MealID),
+		Name:      favorite.Name,
+		Servings:  favorite.Servings,
+		Calories:  favorite.Calories,
+		CreatedAt: timestamppb.New(favorite.CreatedAt),
+	}
+}
+
+// parseDate parses a YYYY-MM-DD calendar date
+func parseDate(date string) (time.Time, error) {
+	parsed, err := time.Parse(dateLayout, date)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q: expected YYYY-MM-DD", date)
+	}
+	return parsed, nil
+}
+
+// userToday returns the current calendar date in the given IANA timezone,
+// falling back to UTC when the user has no (or an unknown) timezone
+func userToday(now time.Time, timezone string) time.Time {
+	loc := userLocation(timezone)
+	local := now.In(loc)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// userLocation loads an IANA timezone, falling back to UTC
+func userLocation(timezone string) *time.Location {
+	if timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		log.Printf("Unknown timezone %q, falling back to UTC: %v", timezone, err)
+		return time.UTC
+	}
+	return loc
+}
+
+func floatToPtr(f float64) *float64 {
+	if f == 0 {
+		return nil
+	}
+	return &f
+}
+
+func ptrToFloat(f *float64) float64 {
+	if f == nil {
+		return 0
+	}
+	return *f
+}
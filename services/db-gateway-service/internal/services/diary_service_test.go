@@ -0,0 +1,805 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"db-gateway-service/proto"
+	meals "db-gateway-service/sql/meal-service"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+var diaryEntryColumns = []string{
+	"id", "user_id", "meal_id", "food_id", "date", "meal_number",
+	"servings", "quick_calories", "description",
+	"name", "calories", "protein_grams", "carbs_grams", "fat_grams",
+	"created_at", "updated_at",
+}
+
+func TestDiaryService_LogDiaryEntry_DefaultsToUserToday(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewDiaryService(meals.NewRepository(db))
+	// 03:00 UTC on March 10th is still March 9th in New York
+	service.now = func() time.Time { return time.Date(2025, 3, 10, 3, 0, 0, 0, time.UTC) }
+
+	userDay := time.Date(2025, 3, 9, 0, 0, 0, 0, time.UTC)
+	now := time.Now()
+
+	// Setup mock expectations
+	mock.ExpectQuery(`SELECT timezone FROM USERS WHERE id = \$1`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"timezone"}).AddRow("America/New_York"))
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT 1 FROM FOOD_CATALOG f WHERE \(f.user_id IS NULL OR f.user_id = \$1\) AND f.id = \$2\)`).
+		WithArgs(7, 12).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery(`INSERT INTO USER_MEALS`).
+		WithArgs(7, nil, 12, userDay, 2, 0.5, nil, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(41))
+	mock.ExpectQuery(`SELECT .+ FROM USER_MEALS um .+ WHERE um.id = \$1 AND um.user_id = \$2`).
+		WithArgs(41, 7).
+		WillReturnRows(sqlmock.NewRows(diaryEntryColumns).AddRow(
+			41, 7, nil, 12, userDay, 2,
+			0.5, nil, nil,
+			"Greek Yogurt - Plain", 65.0, 11.5, 4.5, 0.0,
+			now, now,
+		))
+
+	// Execute
+	resp, err := service.LogDiaryEntry(context.Background(), &proto.LogDiaryEntryRequest{
+		UserId:     7,
+		FoodId:     12,
+		MealNumber: 2,
+		Servings:   0.5,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	assert.NotNil(t, resp.Entry)
+	assert.Equal(t, "2025-03-09", resp.Entry.Date)
+	assert.Equal(t, int32(12), resp.Entry.FoodId)
+	assert.Equal(t, 0.5, resp.Entry.Servings)
+	assert.Equal(t, 65.0, resp.Entry.Calories)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDiaryService_LogDiaryEntry_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *proto.LogDiaryEntryRequest
+		wantErr string
+	}{
+		{
+			name:    "missing user",
+			req:     &proto.LogDiaryEntryRequest{MealId: 1, MealNumber: 1, Date: "2025-03-09"},
+			wantErr: "user_id is required",
+		},
+		{
+			name:    "bad date",
+			req:     &proto.LogDiaryEntryRequest{UserId: 1, MealId: 1, MealNumber: 1, Date: "03/09/2025"},
+			wantErr: "invalid date",
+		},
+		{
+			name:    "meal number out of range",
+			req:     &proto.LogDiaryEntryRequest{UserId: 1, MealId: 1, MealNumber: 7, Date: "2025-03-09"},
+			wantErr: "invalid meal_number",
+		},
+		{
+			name:    "negative servings",
+			req:     &proto.LogDiaryEntryRequest{UserId: 1, MealId: 1, MealNumber: 1, Servings: -1, Date: "2025-03-09"},
+			wantErr: "invalid servings",
+		},
+		{
+			name:    "meal and food together",
+			req:     &proto.LogDiaryEntryRequest{UserId: 1, MealId: 1, FoodId: 2, MealNumber: 1, Date: "2025-03-09"},
+			wantErr: "exactly one of",
+		},
+		{
+			name:    "nothing to log",
+			req:     &proto.LogDiaryEntryRequest{UserId: 1, MealNumber: 1, Date: "2025-03-09"},
+			wantErr: "exactly one of",
+		},
+		{
+			name:    "meal and zero quick-add calories together",
+			req:     &proto.LogDiaryEntryRequest{UserId: 1, MealId: 1, MealNumber: 1, QuickCalories: floatPtr(0), Date: "2025-03-09"},
+			wantErr: "exactly one of",
+		},
+		{
+			name:    "negative quick-add calories",
+			req:     &proto.LogDiaryEntryRequest{UserId: 1, MealNumber: 1, QuickCalories: floatPtr(-50), Date: "2025-03-09"},
+			wantErr: "invalid quick_calories",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := setupTestDB(t)
+			defer db.Close()
+
+			service := NewDiaryService(meals.NewRepository(db))
+			resp, err := service.LogDiaryEntry(context.Background(), tt.req)
+
+			assert.NoError(t, err)
+			assert.Nil(t, resp.Entry)
+			assert.Contains(t, resp.Error, tt.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDiaryService_LogDiaryEntry_ZeroCalorieQuickAdd(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewDiaryService(meals.NewRepository(db))
+
+	day := time.Date(2025, 3, 9, 0, 0, 0, 0, time.UTC)
+	now := time.Now()
+
+	// Setup mock expectations
+	mock.ExpectQuery(`INSERT INTO USER_MEALS`).
+		WithArgs(7, nil, nil, day, 2, 1.0, 0.0, "Black coffee").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(42))
+	mock.ExpectQuery(`SELECT .+ FROM USER_MEALS um .+ WHERE um.id = \$1 AND um.user_id = \$2`).
+		WithArgs(42, 7).
+		WillReturnRows(sqlmock.NewRows(diaryEntryColumns).AddRow(
+			42, 7, nil, nil, day, 2,
+			1.0, 0.0, "Black coffee",
+			"Black coffee", 0.0, 0.0, 0.0, 0.0,
+			now, now,
+		))
+
+	// Execute
+	resp, err := service.LogDiaryEntry(context.Background(), &proto.LogDiaryEntryRequest{
+		UserId:        7,
+		Date:          "2025-03-09",
+		MealNumber:    2,
+		QuickCalories: floatPtr(0),
+		Description:   "Black coffee",
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Equal(t, 0.0, resp.Entry.Calories)
+	assert.Equal(t, "Black coffee", resp.Entry.Name)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDiaryService_LogDiaryEntry_OtherUsersCustomFood(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewDiaryService(meals.NewRepository(db))
+
+	// Setup mock expectations
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT 1 FROM FOOD_CATALOG f WHERE \(f.user_id IS NULL OR f.user_id = \$1\) AND f.id = \$2\)`).
+		WithArgs(7, 901).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+	// Execute
+	resp, err := service.LogDiaryEntry(context.Background(), &proto.LogDiaryEntryRequest{
+		UserId:     7,
+		FoodId:     901,
+		Date:       "2025-03-09",
+		MealNumber: 2,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Nil(t, resp.Entry)
+	assert.Contains(t, resp.Error, "food not found")
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDiaryService_ListDiaryEntries(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewDiaryService(meals.NewRepository(db))
+
+	day := time.Date(2025, 3, 9, 0, 0, 0, 0, time.UTC)
+	now := time.Now()
+
+	// Setup mock expectations
+	mock.ExpectQuery(`SELECT .+ FROM USER_MEALS um .+ WHERE um.user_id = \$1 AND um.date = \$2`).
+		WithArgs(7, day).
+		WillReturnRows(sqlmock.NewRows(diaryEntryColumns).
+			AddRow(1, 7, 3, nil, day, 1, 1.0, nil, nil, "Overnight Oats", 420.0, 18.0, 60.0, 12.0, now, now).
+			AddRow(2, 7, 3, nil, day, 1, 1.0, nil, nil, "Overnight Oats", 420.0, 18.0, 60.0, 12.0, now, now).
+			AddRow(3, 7, nil, nil, day, 4, 1.0, 250.0, "Office cake", "Office cake", 250.0, 0.0, 0.0, 0.0, now, now))
+
+	// Execute
+	resp, err := service.ListDiaryEntries(context.Background(), &proto.ListDiaryEntriesRequest{
+		UserId: 7,
+		Date:   "2025-03-09",
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Equal(t, "2025-03-09", resp.Date)
+	assert.Len(t, resp.Entries, 3)
+	assert.Equal(t, resp.Entries[0].MealId, resp.Entries[1].MealId)
+	assert.Equal(t, 250.0, resp.Entries[2].QuickCalories)
+	assert.Equal(t, "Office cake", resp.Entries[2].Description)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDiaryService_UpdateDiaryEntry_KeepsUnsetFields(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewDiaryService(meals.NewRepository(db))
+
+	day := time.Date(2025, 3, 9, 0, 0, 0, 0, time.UTC)
+	now := time.Now()
+
+	// Setup mock expectations
+	mock.ExpectQuery(`SELECT .+ FROM USER_MEALS um .+ WHERE um.id = \$1 AND um.user_id = \$2`).
+		WithArgs(5, 7).
+		WillReturnRows(sqlmock.NewRows(diaryEntryColumns).
+			AddRow(5, 7, 3, nil, day, 3, 1.0, nil, nil, "Chicken Bowl", 600.0, 45.0, 55.0, 18.0, now, now))
+	mock.ExpectExec(`UPDATE USER_MEALS .+ WHERE id = \$8 AND user_id = \$9`).
+		WithArgs(3, nil, day, 3, 1.5, nil, nil, 5, 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT .+ FROM USER_MEALS um .+ WHERE um.id = \$1 AND um.user_id = \$2`).
+		WithArgs(5, 7).
+		WillReturnRows(sqlmock.NewRows(diaryEntryColumns).
+			AddRow(5, 7, 3, nil, day, 3, 1.5, nil, nil, "Chicken Bowl", 900.0, 67.5, 82.5, 27.0, now, now))
+
+	// Execute
+	resp, err := service.UpdateDiaryEntry(context.Background(), &proto.UpdateDiaryEntryRequest{
+		Id:       5,
+		UserId:   7,
+		Servings: 1.5,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Equal(t, 1.5, resp.Entry.Servings)
+	assert.Equal(t, 900.0, resp.Entry.Calories)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDiaryService_UpdateDiaryEntry_ClearsDescription(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewDiaryService(meals.NewRepository(db))
+
+	day := time.Date(2025, 3, 9, 0, 0, 0, 0, time.UTC)
+	now := time.Now()
+
+	// Setup mock expectations
+	mock.ExpectQuery(`SELECT .+ FROM USER_MEALS um .+ WHERE um.id = \$1 AND um.user_id = \$2`).
+		WithArgs(5, 7).
+		WillReturnRows(sqlmock.NewRows(diaryEntryColumns).
+			AddRow(5, 7, 3, nil, day, 3, 1.0, nil, "Extra sauce", "Chicken Bowl", 600.0, 45.0, 55.0, 18.0, now, now))
+	mock.ExpectExec(`UPDATE USER_MEALS .+ WHERE id = \$8 AND user_id = \$9`).
+		WithArgs(3, nil, day, 3, 1.0, nil, nil, 5, 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT .+ FROM USER_MEALS um .+ WHERE um.id = \$1 AND um.user_id = \$2`).
+		WithArgs(5, 7).
+		WillReturnRows(sqlmock.NewRows(diaryEntryColumns).
+			AddRow(5, 7, 3, nil, day, 3, 1.0, nil, nil, "Chicken Bowl", 600.0, 45.0, 55.0, 18.0, now, now))
+
+	// Execute
+	description := ""
+	resp, err := service.UpdateDiaryEntry(context.Background(), &proto.UpdateDiaryEntryRequest{
+		Id:          5,
+		UserId:      7,
+		Description: &description,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Empty(t, resp.Entry.Description)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDiaryService_DeleteDiaryEntry_NotFound(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewDiaryService(meals.NewRepository(db))
+
+	// Another user's entry affects no rows
+	mock.ExpectExec(`DELETE FROM USER_MEALS WHERE id = \$1 AND user_id = \$2`).
+		WithArgs(5, 8).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Execute
+	resp, err := service.DeleteDiaryEntry(context.Background(), &proto.DeleteDiaryEntryRequest{Id: 5, UserId: 8})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Message)
+	assert.Contains(t, resp.Error, "not found")
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDiaryService_ImportDiary(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewDiaryService(meals.NewRepository(db))
+	service.now = func() time.Time { return time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC) }
+
+	csv := "Date,Meal,Food Name,Servings,Calories,Protein (g),Carbohydrates (g),Fat (g)\n" +
+		"2025-03-08,Breakfast,\"Bananas, raw\",1,210,2.6,54,0.8\n" +
+		"2025-03-08,Dinner,Grandma's Lasagna,1,600,30,50,25\n" +
+		"2025-03-09,Dinner,Grandma's Lasagna,2,1200,60,100,50\n" +
+		"2025-03-09,Breakfast,Greek Yogurt,1,65,11.5,4.5,0\n" +
+		"2025-03-11,Lunch,\"Bananas, raw\",1,105,1.3,27,0.4\n" +
+		"2025-03-09,Brunch,Pancakes,1,350,8,55,10\n"
+
+	// Setup mock expectations
+	mock.ExpectQuery(`SELECT timezone FROM USERS WHERE id = \$1`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"timezone"}).AddRow("UTC"))
+	mock.ExpectQuery(`SELECT f.id, f.food_name, f.calories FROM FOOD_CATALOG f\s+WHERE \(f.user_id IS NULL OR f.user_id = \$1\)`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "food_name", "calories"}).
+			AddRow(11, "Greek Yogurt - Plain", 130.0).
+			AddRow(49, "Banana - Medium", 105.0))
+	// The yogurt was logged before, so importing it again is skipped
+	mock.ExpectQuery(`FROM USER_MEALS\s+WHERE user_id = \$1 AND date BETWEEN \$2 AND \$3`).
+		WithArgs(7, time.Date(2025, 3, 8, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 11, 0, 0, 0, 0, time.UTC)).
+		WillReturnRows(sqlmock.NewRows([]string{"food_id", "date", "meal_number", "servings"}).
+			AddRow(11, time.Date(2025, 3, 9, 0, 0, 0, 0, time.UTC), 1, 0.5))
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO FOOD_CATALOG \(user_id, source, food_name, category, serving_units`).
+		WithArgs(7, "Grandma's Lasagna", 600.0, 30.0, 50.0, 25.0, nil, nil, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(300))
+	mock.ExpectExec(`INSERT INTO USER_MEALS \(user_id, food_id, date, meal_number, servings`).
+		WithArgs(7, pq.Int64Array{49, 300, 300}, pq.StringArray{"2025-03-08", "2025-03-08", "2025-03-09"},
+			pq.Int64Array{1, 3, 3}, pq.Float64Array{2, 1, 2}).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+
+	// Execute
+	resp, err := service.ImportDiary(context.Background(), &proto.ImportDiaryRequest{UserId: 7, Csv: []byte(csv)})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Equal(t, int32(1), resp.Matched)
+	assert.Equal(t, int32(2), resp.Created)
+	assert.Equal(t, int32(3), resp.Skipped)
+	assert.Equal(t, int32(1), resp.CustomFoodsCreated)
+
+	statuses := make([]string, len(resp.Rows))
+	for i, row := range resp.Rows {
+		statuses[i] = fmt.Sprintf("%d %s %s", row.Line, row.Status, row.Reason)
+	}
+	assert.Equal(t, []string{
+		"2 MATCHED ",
+		"3 CREATED ",
+		"4 CREATED ",
+		"5 SKIPPED already logged",
+		"6 SKIPPED date is in the future",
+		`7 SKIPPED unknown meal "Brunch"`,
+	}, statuses)
+	assert.Equal(t, "Banana - Medium", resp.Rows[0].MatchedFoodName)
+	assert.Equal(t, 2.0, resp.Rows[0].Servings)
+	assert.Equal(t, int32(300), resp.Rows[1].FoodId)
+	assert.Equal(t, int32(300), resp.Rows[2].FoodId)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDiaryService_ImportDiary_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		csv       string
+		setupMock func(mock sqlmock.Sqlmock)
+		wantErr   string
+	}{
+		{name: "empty upload", csv: "", wantErr: "csv is required"},
+		{
+			name: "unknown user",
+			csv:  "Date,Meal,Food,Calories\n",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT timezone FROM USERS WHERE id = \$1`).
+					WillReturnRows(sqlmock.NewRows([]string{"timezone"}))
+			},
+			wantErr: "user not found",
+		},
+		{
+			name: "meal totals export without foods",
+			csv:  "Date,Meal,Calories\n2025-03-08,Breakfast,400\n",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT timezone FROM USERS WHERE id = \$1`).
+					WillReturnRows(sqlmock.NewRows([]string{"timezone"}).AddRow(nil))
+			},
+			wantErr: "invalid CSV: no food column",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := setupTestDB(t)
+			defer db.Close()
+
+			service := NewDiaryService(meals.NewRepository(db))
+			if tt.setupMock != nil {
+				tt.setupMock(mock)
+			}
+
+			// Execute
+			resp, err := service.ImportDiary(context.Background(), &proto.ImportDiaryRequest{UserId: 7, Csv: []byte(tt.csv)})
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Contains(t, resp.Error, tt.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDiaryService_DraftDiaryEntry(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewDiaryService(meals.NewRepository(db))
+
+	foodColumns := []string{"id", "food_name", "serving_units", "calories"}
+
+	// Setup mock expectations
+	mock.ExpectQuery(`SELECT f.id, f.food_name, f.serving_units, f.calories FROM FOOD_CATALOG f\s+WHERE \(f.user_id IS NULL OR f.user_id = \$1\) AND f.food_name ILIKE ANY\(\$2\)`).
+		WithArgs(7, pq.StringArray{"%egg%"}, 200).
+		WillReturnRows(sqlmock.NewRows(foodColumns).
+			AddRow(3, "Eggs - Large", "PIECES", 72.0).
+			AddRow(8, "Eggplant", "CUPS", 20.0))
+	mock.ExpectQuery(`FROM FOOD_CATALOG f\s+WHERE .+ ILIKE ANY\(\$2\)`).
+		WithArgs(7, pq.StringArray{"%brown%", "%rice%"}, 200).
+		WillReturnRows(sqlmock.NewRows(foodColumns).
+			AddRow(31, "Brown Rice - Cooked", "CUPS", 216.0).
+			AddRow(32, "White Rice - Cooked", "CUPS", 205.0))
+	mock.ExpectQuery(`FROM FOOD_CATALOG f\s+WHERE .+ ILIKE ANY\(\$2\)`).
+		WithArgs(7, pq.StringArray{"%kombucha%"}, 200).
+		WillReturnRows(sqlmock.NewRows(foodColumns))
+
+	// Execute
+	resp, err := service.DraftDiaryEntry(context.Background(), &proto.DraftDiaryEntryRequest{
+		UserId:     7,
+		Text:       "2 eggs and a cup of brown rice, some kombucha",
+		Date:       "2025-03-08",
+		MealNumber: 1,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Equal(t, "2025-03-08", resp.Date)
+	assert.Equal(t, int32(1), resp.MealNumber)
+	assert.Equal(t, int32(1), resp.Unresolved)
+	if assert.Len(t, resp.Items, 3) {
+		eggs := resp.Items[0]
+		assert.Equal(t, "eggs", eggs.FoodPhrase)
+		assert.Equal(t, 2.0, eggs.Quantity)
+		assert.Equal(t, int32(3), eggs.Match.FoodId)
+		assert.Equal(t, 2.0, eggs.Match.Servings)
+		assert.Equal(t, 144.0, eggs.Match.Calories)
+		assert.Equal(t, 1.0, eggs.Match.Confidence)
+		assert.Empty(t, eggs.Alternatives)
+
+		rice := resp.Items[1]
+		assert.Equal(t, "CUPS", rice.Unit)
+		assert.Equal(t, int32(31), rice.Match.FoodId)
+		assert.Equal(t, 1.0, rice.Match.Servings)
+		if assert.Len(t, rice.Alternatives, 1) {
+			assert.Equal(t, int32(32), rice.Alternatives[0].FoodId)
+		}
+
+		assert.Equal(t, "kombucha", resp.Items[2].FoodPhrase)
+		assert.Nil(t, resp.Items[2].Match)
+	}
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDiaryService_DraftDiaryEntry_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *proto.DraftDiaryEntryRequest
+		wantErr string
+	}{
+		{name: "missing text", req: &proto.DraftDiaryEntryRequest{UserId: 7, Text: "  "}, wantErr: "text is required"},
+		{name: "no foods", req: &proto.DraftDiaryEntryRequest{UserId: 7, Text: "2 cups", Date: "2025-03-08"}, wantErr: "invalid text: no foods found"},
+		{name: "meal number", req: &proto.DraftDiaryEntryRequest{UserId: 7, Text: "2 eggs", MealNumber: 7}, wantErr: "invalid meal_number"},
+		{name: "bad date", req: &proto.DraftDiaryEntryRequest{UserId: 7, Text: "2 eggs", Date: "03/08/2025"}, wantErr: "invalid date"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := setupTestDB(t)
+			defer db.Close()
+
+			service := NewDiaryService(meals.NewRepository(db))
+
+			// Execute
+			resp, err := service.DraftDiaryEntry(context.Background(), tt.req)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Contains(t, resp.Error, tt.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDiaryService_AddFavorite(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewDiaryService(meals.NewRepository(db))
+	now := time.Now()
+
+	// Setup mock expectations
+	mock.ExpectQuery(`INSERT INTO USER_FAVORITES \(user_id, food_id, servings\)\s+SELECT \$1, f.id, \$3 FROM FOOD_CATALOG f`).
+		WithArgs(7, 12, 1.0).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	mock.ExpectQuery(`FROM USER_FAVORITES fav .+ WHERE fav.id = \$1 AND fav.user_id = \$2`).
+		WithArgs(5, 7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "food_id", "meal_id", "name", "servings", "calories", "created_at"}).
+			AddRow(5, 12, nil, "Greek Yogurt - Plain", 1.0, 130.0, now))
+
+	// Execute
+	resp, err := service.AddFavorite(context.Background(), &proto.AddFavoriteRequest{UserId: 7, FoodId: 12})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Equal(t, int32(5), resp.Favorite.Id)
+	assert.Equal(t, int32(12), resp.Favorite.FoodId)
+	assert.Equal(t, int32(0), resp.Favorite.MealId)
+	assert.Equal(t, "Greek Yogurt - Plain", resp.Favorite.Name)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDiaryService_AddFavorite_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       *proto.AddFavoriteRequest
+		setupMock func(mock sqlmock.Sqlmock)
+		wantErr   string
+	}{
+		{
+			name:    "food and meal",
+			req:     &proto.AddFavoriteRequest{UserId: 7, FoodId: 12, MealId: 3},
+			wantErr: "invalid favorite: exactly one of food_id or meal_id is required",
+		},
+		{
+			name:    "neither food nor meal",
+			req:     &proto.AddFavoriteRequest{UserId: 7},
+			wantErr: "invalid favorite",
+		},
+		{
+			name:    "negative servings",
+			req:     &proto.AddFavoriteRequest{UserId: 7, MealId: 3, Servings: -1},
+			wantErr: "invalid servings",
+		},
+		{
+			name: "unknown meal",
+			req:  &proto.AddFavoriteRequest{UserId: 7, MealId: 99, Servings: 2},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`INSERT INTO USER_FAVORITES \(user_id, meal_id, servings\)`).
+					WithArgs(7, 99, 2.0).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+			wantErr: "meal not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := setupTestDB(t)
+			defer db.Close()
+
+			service := NewDiaryService(meals.NewRepository(db))
+			if tt.setupMock != nil {
+				tt.setupMock(mock)
+			}
+
+			// Execute
+			resp, err := service.AddFavorite(context.Background(), tt.req)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Contains(t, resp.Error, tt.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDiaryService_ListRecentItems(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewDiaryService(meals.NewRepository(db))
+	service.now = func() time.Time { return time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC) }
+
+	// Setup mock expectations
+	mock.ExpectQuery(`SELECT timezone FROM USERS WHERE id = \$1`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"timezone"}).AddRow(nil))
+	mock.ExpectQuery(`WITH logged AS \(.+FROM USER_MEALS um\s+WHERE um.user_id = \$1 AND um.date >= \$2`).
+		WithArgs(7, time.Date(2025, 2, 8, 0, 0, 0, 0, time.UTC), 20).
+		WillReturnRows(sqlmock.NewRows([]string{"food_id", "meal_id", "name", "servings", "calories", "last_logged", "times_logged", "is_favorite"}).
+			AddRow(12, nil, "Greek Yogurt - Plain", 1.5, 195.0, time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), 9, true).
+			AddRow(nil, 3, "Chicken Stir Fry", 1.0, 520.0, time.Date(2025, 3, 8, 0, 0, 0, 0, time.UTC), 2, false))
+
+	// Execute
+	resp, err := service.ListRecentItems(context.Background(), &proto.ListRecentItemsRequest{UserId: 7})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	if assert.Len(t, resp.Items, 2) {
+		assert.Equal(t, int32(12), resp.Items[0].FoodId)
+		assert.Equal(t, "2025-03-10", resp.Items[0].LastLogged)
+		assert.Equal(t, int32(9), resp.Items[0].TimesLogged)
+		assert.True(t, resp.Items[0].Favorite)
+		assert.Equal(t, int32(3), resp.Items[1].MealId)
+		assert.Equal(t, 1.0, resp.Items[1].Servings)
+	}
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDiaryService_CopyDiaryEntries_MealSlot(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewDiaryService(meals.NewRepository(db))
+	service.now = func() time.Time { return time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC) }
+
+	yesterday := time.Date(2025, 3, 9, 0, 0, 0, 0, time.UTC)
+	today := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	now := time.Now()
+
+	// Setup mock expectations
+	mock.ExpectQuery(`SELECT timezone FROM USERS WHERE id = \$1`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"timezone"}).AddRow("UTC"))
+	mock.ExpectExec(`INSERT INTO USER_MEALS .+ SELECT .+ FROM USER_MEALS\s+WHERE user_id = \$1 AND date = \$2 AND NOT is_planned AND \(\$4 = 0 OR meal_number = \$4\)`).
+		WithArgs(7, yesterday, today, 1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT .+ FROM USER_MEALS um .+ WHERE um.user_id = \$1 AND um.date = \$2`).
+		WithArgs(7, today).
+		WillReturnRows(sqlmock.NewRows(diaryEntryColumns).AddRow(
+			50, 7, nil, 12, today, 2,
+			1.0, nil, nil,
+			"Greek Yogurt - Plain", 130.0, 23.0, 9.0, 0.0,
+			now, now,
+		))
+
+	// Execute
+	resp, err := service.CopyDiaryEntries(context.Background(), &proto.CopyDiaryEntriesRequest{
+		UserId:       7,
+		FromDate:     "2025-03-09",
+		MealNumber:   1,
+		ToMealNumber: 2,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Equal(t, "2025-03-10", resp.Date)
+	assert.Equal(t, int32(1), resp.Copied)
+	if assert.Len(t, resp.Entries, 1) {
+		assert.Equal(t, int32(2), resp.Entries[0].MealNumber)
+	}
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDiaryService_CopyDiaryEntries_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       *proto.CopyDiaryEntriesRequest
+		setupMock func(mock sqlmock.Sqlmock)
+		wantErr   string
+	}{
+		{
+			name:    "missing source date",
+			req:     &proto.CopyDiaryEntriesRequest{UserId: 7, ToDate: "2025-03-10"},
+			wantErr: "from_date is required",
+		},
+		{
+			name:    "target slot without source slot",
+			req:     &proto.CopyDiaryEntriesRequest{UserId: 7, FromDate: "2025-03-09", ToDate: "2025-03-10", ToMealNumber: 2},
+			wantErr: "invalid to_meal_number: meal_number is required",
+		},
+		{
+			name:    "onto itself",
+			req:     &proto.CopyDiaryEntriesRequest{UserId: 7, FromDate: "2025-03-09", ToDate: "2025-03-09", MealNumber: 1},
+			wantErr: "invalid copy",
+		},
+		{
+			name: "empty source",
+			req:  &proto.CopyDiaryEntriesRequest{UserId: 7, FromDate: "2025-03-09", ToDate: "2025-03-10"},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`INSERT INTO USER_MEALS`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: "diary entries to copy not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := setupTestDB(t)
+			defer db.Close()
+
+			service := NewDiaryService(meals.NewRepository(db))
+			if tt.setupMock != nil {
+				tt.setupMock(mock)
+			}
+
+			// Execute
+			resp, err := service.CopyDiaryEntries(context.Background(), tt.req)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Contains(t, resp.Error, tt.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserToday(t *testing.T) {
+	now := time.Date(2025, 3, 10, 3, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		timezone string
+		want     string
+	}{
+		{"", "2025-03-10"},
+		{"UTC", "2025-03-10"},
+		{"America/Los_Angeles", "2025-03-09"},
+		{"Asia/Tokyo", "2025-03-10"},
+		{"Not/AZone", "2025-03-10"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.timezone, func(t *testing.T) {
+			assert.Equal(t, tt.want, userToday(now, tt.timezone).Format(dateLayout))
+		})
+	}
+}
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
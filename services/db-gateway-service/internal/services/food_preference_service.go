@@ -0,0 +1,248 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"db-gateway-service/internal/barcode"
+	"db-gateway-service/internal/foodprefs"
+	"db-gateway-service/proto"
+	meals "db-gateway-service/sql/meal-service"
+)
+
+// FoodPreferenceService implements the gRPC FoodPreferenceService server
+type FoodPreferenceService struct {
+	proto.UnimplementedFoodPreferenceServiceServer
+	repo *meals.Repository
+}
+
+// NewFoodPreferenceService creates a new FoodPreferenceService instance
+func NewFoodPreferenceService(repo *meals.Repository) *FoodPreferenceService {
+	return &FoodPreferenceService{repo: repo}
+}
+
+// GetFoodPreferences retrieves a user's likes, dislikes, allergies and diet restrictions
+func (s *FoodPreferenceService) GetFoodPreferences(ctx context.Context, req *proto.GetFoodPreferencesRequest) (*proto.FoodPreferencesResponse, error) {
+	log.Printf("GetFoodPreferences called for user ID: %d", req.UserId)
+
+	if req.UserId == 0 {
+		return &proto.FoodPreferencesResponse{Error: "user_id is required"}, nil
+	}
+
+	return s.preferencesResponse(int(req.UserId)), nil
+}
+
+// SetFoodPreference likes or dislikes a food
+func (s *FoodPreferenceService) SetFoodPreference(ctx context.Context, req *proto.SetFoodPreferenceRequest) (*proto.FoodPreferencesResponse, error) {
+	log.Printf("SetFoodPreference called for user ID: %d, food ID: %d", req.UserId, req.FoodId)
+
+	if req.UserId == 0 {
+		return &proto.FoodPreferencesResponse{Error: "user_id is required"}, nil
+	}
+	if req.FoodId <= 0 {
+		return &proto.FoodPreferencesResponse{Error: "food_id is required"}, nil
+	}
+	if err := foodprefs.ValidatePreference(req.Preference); err != nil {
+		return &proto.FoodPreferencesResponse{Error: err.Error()}, nil
+	}
+
+	if err := s.repo.SetFoodPreference(int(req.UserId), int(req.FoodId), req.Preference); err != nil {
+		log.Printf("Failed to set food preference: %v", err)
+		return &proto.FoodPreferencesResponse{
+			Error: fmt.Sprintf("Failed to set food preference: %v", err),
+		}, nil
+	}
+
+	return s.preferencesResponse(int(req.UserId)), nil
+}
+
+// ClearFoodPreference removes a like or dislike
+func (s *FoodPreferenceService) ClearFoodPreference(ctx context.Context, req *proto.ClearFoodPreferenceRequest) (*proto.FoodPreferencesResponse, error) {
+	log.Printf("ClearFoodPreference called for user ID: %d, food ID: %d", req.UserId, req.FoodId)
+
+	if req.UserId == 0 {
+		return &proto.FoodPreferencesResponse{Error: "user_id is required"}, nil
+	}
+
+	if err := s.repo.ClearFoodPreference(int(req.UserId), int(req.FoodId)); err != nil {
+		log.Printf("Failed to clear food preference: %v", err)
+		return &proto.FoodPreferencesResponse{
+			Error: fmt.Sprintf("Failed to clear food preference: %v", err),
+		}, nil
+	}
+
+	return s.preferencesResponse(int(req.UserId)), nil
+}
+
+// SetAllergies replaces a user's allergies
+func (s *FoodPreferenceService) SetAllergies(ctx context.Context, req *proto.SetAllergiesRequest) (*proto.FoodPreferencesResponse, error) {
+	log.Printf("SetAllergies called for user ID: %d", req.UserId)
+
+	if req.UserId == 0 {
+		return &proto.FoodPreferencesResponse{Error: "user_id is required"}, nil
+	}
+
+	allergies, err := foodprefs.NormalizeAllergies(req.Allergies)
+	if err != nil {
+		return &proto.FoodPreferencesResponse{Error: err.Error()}, nil
+	}
+
+	if err := s.repo.SetAllergies(int(req.UserId), allergies); err != nil {
+		log.Printf("Failed to set allergies: %v", err)
+		return &proto.FoodPreferencesResponse{
+			Error: fmt.Sprintf("Failed to set allergies: %v", err),
+		}, nil
+	}
+
+	return s.preferencesResponse(int(req.UserId)), nil
+}
+
+// SetDietRestrictions replaces a user's diet restrictions
+func (s *FoodPreferenceService) SetDietRestrictions(ctx context.Context, req *proto.SetDietRestrictionsRequest) (*proto.FoodPreferencesResponse, error) {
+	log.Printf("SetDietRestrictions called for user ID: %d", req.UserId)
+
+	if req.UserId == 0 {
+		return &proto.FoodPreferencesResponse{Error: "user_id is required"}, nil
+	}
+
+	restrictions, err := foodprefs.NormalizeDietRestrictions(req.DietRestrictions)
+	if err != nil {
+		return &proto.FoodPreferencesResponse{Error: err.Error()}, nil
+	}
+
+	if err := s.repo.SetDietRestrictions(int(req.UserId), restrictions); err != nil {
+		log.Printf("Failed to set diet restrictions: %v", err)
+		return &proto.FoodPreferencesResponse{
+			Error: fmt.Sprintf("Failed to set diet restrictions: %v", err),
+		}, nil
+	}
+
+	return s.preferencesResponse(int(req.UserId)), nil
+}
+
+// ListFoodsForUser lists the catalog without foods the user dislikes, is
+// allergic to or excludes by diet, liked foods first
+func (s *FoodPreferenceService) ListFoodsForUser(ctx context.Context, req *proto.ListFoodsForUserRequest) (*proto.ListFoodsForUserResponse, error) {
+	log.Printf("ListFoodsForUser called for user ID: %d", req.UserId)
+
+	if req.UserId == 0 {
+		return &proto.ListFoodsForUserResponse{Error: "user_id is required"}, nil
+	}
+	if req.Category != "" {
+		if err := foodprefs.ValidateFoodCategory(req.Category); err != nil {
+			return &proto.ListFoodsForUserResponse{Error: err.Error()}, nil
+		}
+	}
+
+	foods, excluded, err := s.FoodsForUser(int(req.UserId), req.Category, req.LikedOnly)
+	if err != nil {
+		log.Printf("Failed to list foods for user: %v", err)
+		return &proto.ListFoodsForUserResponse{
+			Error: fmt.Sprintf("Failed to list foods: %v", err),
+		}, nil
+	}
+
+	resp := &proto.ListFoodsForUserResponse{
+		Foods:         make([]*proto.Food, 0, len(foods)),
+		ExcludedCount: int32(excluded),
+	}
+	for i := range foods {
+		resp.Foods = append(resp.Foods, convertFoodToProto(&foods[i]))
+	}
+
+	return resp, nil
+}
+
+// LookupBarcode finds the catalog food with a scanned barcode. Barcodes are
+// checksum-validated first, and a valid barcode no food has is recorded for
+// curation.
+func (s *FoodPreferenceService) LookupBarcode(ctx context.Context, req *proto.LookupBarcodeRequest) (*proto.LookupBarcodeResponse, error) {
+	log.Printf("LookupBarcode called for user ID: %d", req.UserId)
+
+	if req.UserId == 0 {
+		return &proto.LookupBarcodeResponse{Error: "user_id is required"}, nil
+	}
+	code, err := barcode.Normalize(req.Barcode)
+	if err != nil {
+		return &proto.LookupBarcodeResponse{Error: err.Error()}, nil
+	}
+
+	food, err := s.repo.GetFoodByBarcode(int(req.UserId), code)
+	if err != nil {
+		log.Printf("Failed to look up barcode: %v", err)
+		return &proto.LookupBarcodeResponse{
+			Barcode: code,
+			Error:   fmt.Sprintf("Failed to look up barcode: %v", err),
+		}, nil
+	}
+
+	return &proto.LookupBarcodeResponse{Food: convertFoodToProto(food), Barcode: code}, nil
+}
+
+// FoodsForUser applies a user's diet restrictions, allergies and dislikes to
+// the catalog. Features that suggest foods should use it rather than reading
+// FOOD_CATALOG directly.
+func (s *FoodPreferenceService) FoodsForUser(userID int, category string, likedOnly bool) ([]meals.Food, int, error) {
+	preferences, err := s.repo.GetFoodPreferences(userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	exclusions := foodprefs.ExclusionsFor(preferences.DietRestrictions, preferences.Allergies)
+	return s.repo.ListFoodsForUser(userID, meals.FoodFilter{
+		ExcludedCategories: exclusions.Categories,
+		ExcludedAllergens:  exclusions.Allergens,
+		Category:           category,
+		LikedOnly:          likedOnly,
+	})
+}
+
+// preferencesResponse loads a user's preferences into a response
+func (s *FoodPreferenceService) preferencesResponse(userID int) *proto.FoodPreferencesResponse {
+	preferences, err := s.repo.GetFoodPreferences(userID)
+	if err != nil {
+		log.Printf("Failed to get food preferences: %v", err)
+		return &proto.FoodPreferencesResponse{
+			Error: fmt.Sprintf("Failed to get food preferences: %v", err),
+		}
+	}
+
+	return &proto.FoodPreferencesResponse{Preferences: convertFoodPreferencesToProto(preferences)}
+}
+
+// convertFoodPreferencesToProto converts food preferences to their proto message
+func convertFoodPreferencesToProto(preferences *meals.FoodPreferences) *proto.FoodPreferences {
+	p := &proto.FoodPreferences{
+		Likes:            make([]*proto.Food, 0, len(preferences.Likes)),
+		Dislikes:         make([]*proto.Food, 0, len(preferences.Dislikes)),
+		Allergies:        preferences.Allergies,
+		DietRestrictions: preferences.DietRestrictions,
+	}
+	for i := range preferences.Likes {
+		p.Likes = append(p.Likes, convertFoodToProto(&preferences.Likes[i]))
+	}
+	for i := range preferences.Dislikes {
+		p.Dislikes = append(p.Dislikes, convertFoodToProto(&preferences.Dislikes[i]))
+	}
+	return p
+}
+
+// convertFoodToProto converts a catalog food to its proto message
+func convertFoodToProto(food *meals.Food) *proto.Food {
+	return &proto.Food{
+		Id:                int32(food.ID),
+		FoodName:          food.FoodName,
+		Category:          food.Category,
+		ServingUnits:      food.ServingUnits,
+		Calories:          food.Calories,
+		ProteinGrams:      food.ProteinGrams,
+		CarbsGrams:        food.CarbsGrams,
+		FatGrams:          food.FatGrams,
+		IsNonInflammatory: food.IsNonInflammatory,
+		IsProbiotic:       food.IsProbiotic,
+		IsPrebiotic:       food.IsPrebiotic,
+		Allergens:         food.Allergens,
+		Liked:             food.Liked,
+	}
+}
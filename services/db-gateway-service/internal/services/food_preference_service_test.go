@@ -0,0 +1,272 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"db-gateway-service/proto"
+	meals "db-gateway-service/sql/meal-service"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+var foodColumns = []string{
+	"id", "food_name", "category", "serving_units", "calories",
+	"protein_grams", "carbs_grams", "fat_grams",
+	"is_non_inflammatory", "is_probiotic", "is_prebiotic",
+	"allergens", "liked",
+}
+
+// expectFoodPreferences mocks the queries of GetFoodPreferences
+func expectFoodPreferences(mock sqlmock.Sqlmock, userID int, rated *sqlmock.Rows, allergies, diets []string) {
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT 1 FROM USERS WHERE id = \$1\)`).
+		WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery(`FROM FOOD_CATALOG f\s+JOIN FOOD_USER_LIKES l`).
+		WithArgs(userID).
+		WillReturnRows(rated)
+
+	allergyRows := sqlmock.NewRows([]string{"allergen"})
+	for _, a := range allergies {
+		allergyRows.AddRow(a)
+	}
+	mock.ExpectQuery(`FROM USER_ALLERGIES`).WithArgs(userID).WillReturnRows(allergyRows)
+
+	dietRows := sqlmock.NewRows([]string{"restriction"})
+	for _, d := range diets {
+		dietRows.AddRow(d)
+	}
+	mock.ExpectQuery(`FROM USER_DIET_RESTRICTIONS`).WithArgs(userID).WillReturnRows(dietRows)
+}
+
+func TestFoodPreferenceService_SetFoodPreference(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewFoodPreferenceService(meals.NewRepository(db))
+
+	// Setup mock expectations
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT 1 FROM FOOD_CATALOG f WHERE \(f.user_id IS NULL OR f.user_id = \$1\) AND f.id = \$2\)`).
+		WithArgs(7, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectExec(`INSERT INTO FOOD_USER_LIKES .+ ON CONFLICT \(user_id, food_id\)`).
+		WithArgs(7, 3, "DISLIKE").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectFoodPreferences(mock, 7,
+		sqlmock.NewRows(foodColumns).
+			AddRow(1, "Salmon - Wild Atlantic", "FISH", "GRAMS", 208.0, 20.0, 0.0, 13.0, true, false, false, "{FISH}", true).
+			AddRow(3, "Tomatoes", "NIGHTSHADES", "PIECES", 22.0, 1.1, 4.8, 0.2, true, false, false, "{}", false),
+		[]string{"PEANUTS"}, nil)
+
+	// Execute
+	resp, err := service.SetFoodPreference(context.Background(), &proto.SetFoodPreferenceRequest{
+		UserId:     7,
+		FoodId:     3,
+		Preference: "DISLIKE",
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Len(t, resp.Preferences.Likes, 1)
+	assert.Equal(t, []string{"FISH"}, resp.Preferences.Likes[0].Allergens)
+	assert.Len(t, resp.Preferences.Dislikes, 1)
+	assert.Equal(t, int32(3), resp.Preferences.Dislikes[0].Id)
+	assert.Equal(t, []string{"PEANUTS"}, resp.Preferences.Allergies)
+	assert.Empty(t, resp.Preferences.DietRestrictions)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFoodPreferenceService_Validation(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewFoodPreferenceService(meals.NewRepository(db))
+	ctx := context.Background()
+
+	resp, err := service.SetFoodPreference(ctx, &proto.SetFoodPreferenceRequest{UserId: 7, FoodId: 3, Preference: "LOVE"})
+	assert.NoError(t, err)
+	assert.Contains(t, resp.Error, `invalid preference "LOVE"`)
+
+	resp, err = service.SetAllergies(ctx, &proto.SetAllergiesRequest{UserId: 7, Allergies: []string{"GLUTEN"}})
+	assert.NoError(t, err)
+	assert.Contains(t, resp.Error, `invalid allergen "GLUTEN"`)
+
+	resp, err = service.SetDietRestrictions(ctx, &proto.SetDietRestrictionsRequest{UserId: 7, DietRestrictions: []string{"KETO"}})
+	assert.NoError(t, err)
+	assert.Contains(t, resp.Error, `invalid diet restriction "KETO"`)
+
+	listResp, err := service.ListFoodsForUser(ctx, &proto.ListFoodsForUserRequest{UserId: 7, Category: "Fish"})
+	assert.NoError(t, err)
+	assert.Contains(t, listResp.Error, `invalid category "Fish"`)
+
+	// Validation failures never reach the database
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFoodPreferenceService_SetAllergies(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewFoodPreferenceService(meals.NewRepository(db))
+
+	// Setup mock expectations
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT 1 FROM USERS WHERE id = \$1\)`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM USER_ALLERGIES WHERE user_id = \$1`).
+		WithArgs(7).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`INSERT INTO USER_ALLERGIES`).
+		WithArgs(7, "{\"EGG\",\"PEANUTS\"}").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+	expectFoodPreferences(mock, 7, sqlmock.NewRows(foodColumns), []string{"EGG", "PEANUTS"}, nil)
+
+	// Execute
+	resp, err := service.SetAllergies(context.Background(), &proto.SetAllergiesRequest{
+		UserId:    7,
+		Allergies: []string{"peanuts", "EGG", "PEANUTS"},
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Equal(t, []string{"EGG", "PEANUTS"}, resp.Preferences.Allergies)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFoodPreferenceService_ListFoodsForUser_AppliesExclusions(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewFoodPreferenceService(meals.NewRepository(db))
+
+	// Setup mock expectations
+	expectFoodPreferences(mock, 7, sqlmock.NewRows(foodColumns), []string{"PEANUTS"}, []string{"VEGETARIAN", "DAIRY_FREE"})
+	mock.ExpectQuery(`FROM FOOD_CATALOG f\s+LEFT JOIN FOOD_USER_LIKES l .+ ORDER BY liked DESC`).
+		WithArgs(7, "{\"FISH\",\"MEAT\"}", "{\"MILK\",\"PEANUTS\"}", "", false).
+		WillReturnRows(sqlmock.NewRows(foodColumns).
+			AddRow(9, "Quinoa", "GRAIN", "CUPS", 222.0, 8.1, 39.4, 3.6, true, false, true, "{}", true).
+			AddRow(4, "Spinach", "VEGETABLE", "CUPS", 7.0, 0.9, 1.1, 0.1, true, false, true, "{}", false))
+	mock.ExpectQuery(`SELECT \(SELECT COUNT\(\*\) FROM FOOD_CATALOG f`).
+		WithArgs(7, "{\"FISH\",\"MEAT\"}", "{\"MILK\",\"PEANUTS\"}", "").
+		WillReturnRows(sqlmock.NewRows([]string{"visible", "total"}).AddRow(40, 52))
+
+	// Execute
+	resp, err := service.ListFoodsForUser(context.Background(), &proto.ListFoodsForUserRequest{UserId: 7})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Len(t, resp.Foods, 2)
+	assert.True(t, resp.Foods[0].Liked)
+	assert.Equal(t, "Spinach", resp.Foods[1].FoodName)
+	assert.Equal(t, int32(12), resp.ExcludedCount)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFoodPreferenceService_ClearFoodPreference_NotFound(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewFoodPreferenceService(meals.NewRepository(db))
+
+	// Setup mock expectations
+	mock.ExpectExec(`DELETE FROM FOOD_USER_LIKES WHERE user_id = \$1 AND food_id = \$2`).
+		WithArgs(7, 3).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Execute
+	resp, err := service.ClearFoodPreference(context.Background(), &proto.ClearFoodPreferenceRequest{UserId: 7, FoodId: 3})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Contains(t, resp.Error, "food preference not found")
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFoodPreferenceService_LookupBarcode(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewFoodPreferenceService(meals.NewRepository(db))
+
+	// Setup mock expectations: a UPC-A scan finds the food stored under its EAN-13 code
+	mock.ExpectQuery(`JOIN FOOD_BARCODES b ON b.food_id = f.id .+ AND b.barcode = \$2`).
+		WithArgs(7, "0012345678905").
+		WillReturnRows(sqlmock.NewRows(foodColumns).
+			AddRow(412, "Orange Juice - Sunny Groves", "FRUIT", "CUPS", 108.83, 1.89, 24.6, 0.0, false, false, false, "{}", false))
+
+	// Execute
+	resp, err := service.LookupBarcode(context.Background(), &proto.LookupBarcodeRequest{UserId: 7, Barcode: "012345678905"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Equal(t, "0012345678905", resp.Barcode)
+	assert.Equal(t, int32(412), resp.Food.Id)
+	assert.Equal(t, "CUPS", resp.Food.ServingUnits)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFoodPreferenceService_LookupBarcode_Unknown(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewFoodPreferenceService(meals.NewRepository(db))
+
+	// Setup mock expectations
+	mock.ExpectQuery(`JOIN FOOD_BARCODES b`).
+		WithArgs(7, "3017620422003").
+		WillReturnRows(sqlmock.NewRows(foodColumns))
+	mock.ExpectExec(`INSERT INTO UNKNOWN_BARCODES \(barcode\) VALUES \(\$1\)\s+ON CONFLICT \(barcode\) DO UPDATE\s+SET lookup_count = UNKNOWN_BARCODES.lookup_count \+ 1`).
+		WithArgs("3017620422003").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Execute
+	resp, err := service.LookupBarcode(context.Background(), &proto.LookupBarcodeRequest{UserId: 7, Barcode: "3017620422003"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Failed to look up barcode: barcode not found", resp.Error)
+	assert.Nil(t, resp.Food)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFoodPreferenceService_LookupBarcode_Invalid(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewFoodPreferenceService(meals.NewRepository(db))
+	ctx := context.Background()
+
+	resp, err := service.LookupBarcode(ctx, &proto.LookupBarcodeRequest{UserId: 7, Barcode: "3017620422004"})
+	assert.NoError(t, err)
+	assert.Equal(t, `invalid barcode "3017620422004": check digit does not match`, resp.Error)
+
+	resp, err = service.LookupBarcode(ctx, &proto.LookupBarcodeRequest{UserId: 7, Barcode: "12345"})
+	assert.NoError(t, err)
+	assert.Contains(t, resp.Error, "must be 8, 12, 13 or 14 digits")
+
+	resp, err = service.LookupBarcode(ctx, &proto.LookupBarcodeRequest{Barcode: "3017620422003"})
+	assert.NoError(t, err)
+	assert.Equal(t, "user_id is required", resp.Error)
+
+	// Invalid barcodes are neither looked up nor recorded
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,273 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"db-gateway-service/internal/mealplan"
+	"db-gateway-service/proto"
+	meals "db-gateway-service/sql/meal-service"
+	users "db-gateway-service/sql/user-service"
+)
+
+// maxMealPlanRangeDays caps the date range GetMealPlan may cover
+const maxMealPlanRangeDays = 31
+
+// MealPlanService implements the gRPC MealPlanService server
+type MealPlanService struct {
+	proto.UnimplementedMealPlanServiceServer
+	repo     *meals.Repository
+	userRepo *users.Repository
+	foods    *FoodPreferenceService
+	now      func() time.Time
+}
+
+// NewMealPlanService creates a new MealPlanService instance
+func NewMealPlanService(repo *meals.Repository, userRepo *users.Repository) *MealPlanService {
+	return &MealPlanService{
+		repo:     repo,
+		userRepo: userRepo,
+		foods:    NewFoodPreferenceService(repo),
+		now:      time.Now,
+	}
+}
+
+// GenerateMealPlan plans meals for the coming days from the user's targets,
+// goals and the foods they may eat, replacing any plan for those days
+func (s *MealPlanService) GenerateMealPlan(ctx context.Context, req *proto.GenerateMealPlanRequest) (*proto.MealPlanResponse, error) {
+	log.Printf("GenerateMealPlan called for user ID: %d, days: %d", req.UserId, req.Days)
+
+	if req.UserId == 0 {
+		return &proto.MealPlanResponse{Error: "user_id is required"}, nil
+	}
+
+	start, err := resolveStartDate(s.repo, s.now(), int(req.UserId), req.StartDate)
+	if err != nil {
+		return &proto.MealPlanResponse{Error: err.Error()}, nil
+	}
+
+	computed, err := computeUserTargets(s.userRepo, int(req.UserId), s.now())
+	if err != nil {
+		return &proto.MealPlanResponse{Error: err.Error()}, nil
+	}
+
+	catalog, _, err := s.foods.FoodsForUser(int(req.UserId), "", false)
+	if err != nil {
+		log.Printf("Failed to list foods for meal plan: %v", err)
+		return &proto.MealPlanResponse{
+			Error: fmt.Sprintf("Failed to generate meal plan: %v", err),
+		}, nil
+	}
+
+	foods := make([]mealplan.Food, len(catalog))
+	for i, f := range catalog {
+		foods[i] = mealplan.Food{
+			ID:           f.ID,
+			Name:         f.FoodName,
+			Category:     f.Category,
+			ServingUnits: f.ServingUnits,
+			PerServing: mealplan.Macros{
+				Calories:     f.Calories,
+				ProteinGrams: f.ProteinGrams,
+				CarbsGrams:   f.CarbsGrams,
+				FatGrams:     f.FatGrams,
+			},
+			NonInflammatory: f.IsNonInflammatory,
+			Probiotic:       f.IsProbiotic,
+			Prebiotic:       f.IsPrebiotic,
+			Liked:           f.Liked,
+		}
+	}
+
+	goals := make([]mealplan.Goal, len(computed.goals))
+	for i, g := range computed.goals {
+		goals[i] = mealplan.Goal{Category: g.Category, Name: g.Name}
+	}
+
+	targets := mealplan.Macros{
+		Calories:     computed.targets.Calories,
+		ProteinGrams: computed.targets.ProteinGrams,
+		CarbsGrams:   computed.targets.CarbsGrams,
+		FatGrams:     computed.targets.FatGrams,
+	}
+
+	plan, err := mealplan.Generate(foods, targets, mealplan.Options{
+		Days:                  int(req.Days),
+		MealsPerDay:           int(req.MealsPerDay),
+		NonInflammatoryOnly:   req.NonInflammatoryOnly,
+		FavorAntiInflammatory: req.FavorAntiInflammatory,
+		FavorGutHealth:        req.FavorGutHealth,
+		Goals:                 goals,
+	})
+	if err != nil {
+		return &proto.MealPlanResponse{Error: err.Error()}, nil
+	}
+
+	end := start.AddDate(0, 0, len(plan.Days)-1)
+	notes := append([]string{}, plan.Notes...)
+	var planned []meals.PlannedFood
+	for d, day := range plan.Days {
+		date := start.AddDate(0, 0, d)
+		for _, miss := range day.OffTarget {
+			notes = append(notes, fmt.Sprintf("%s: %s", date.Format(dateLayout), miss))
+		}
+		for _, meal := range day.Meals {
+			for _, portion := range meal.Portions {
+				planned = append(planned, meals.PlannedFood{
+					FoodID:     portion.Food.ID,
+					Date:       date,
+					MealNumber: meal.MealNumber,
+					Servings:   portion.Servings,
+				})
+			}
+		}
+	}
+
+	if err := s.repo.ReplaceMealPlan(int(req.UserId), start, end, planned); err != nil {
+		log.Printf("Failed to save meal plan: %v", err)
+		return &proto.MealPlanResponse{
+			Error: fmt.Sprintf("Failed to save meal plan: %v", err),
+		}, nil
+	}
+
+	rows, err := s.repo.ListPlannedMeals(int(req.UserId), start, end)
+	if err != nil {
+		log.Printf("Failed to reload meal plan: %v", err)
+		return &proto.MealPlanResponse{
+			Error: fmt.Sprintf("Failed to save meal plan: %v", err),
+		}, nil
+	}
+
+	return &proto.MealPlanResponse{
+		Plan: &proto.MealPlan{
+			StartDate: start.Format(dateLayout),
+			EndDate:   end.Format(dateLayout),
+			Days:      buildMealPlanDays(rows, start, end),
+			Targets: &proto.MealPlanTargets{
+				Calories:     targets.Calories,
+				ProteinGrams: targets.ProteinGrams,
+				CarbsGrams:   targets.CarbsGrams,
+				FatGrams:     targets.FatGrams,
+			},
+			Notes: notes,
+		},
+	}, nil
+}
+
+// GetMealPlan retrieves the user's planned meals for a date range
+func (s *MealPlanService) GetMealPlan(ctx context.Context, req *proto.GetMealPlanRequest) (*proto.MealPlanResponse, error) {
+	log.Printf("GetMealPlan called for user ID: %d", req.UserId)
+
+	if req.UserId == 0 {
+		return &proto.MealPlanResponse{Error: "user_id is required"}, nil
+	}
+
+	start, end, err := resolvePlanRange(s.repo, s.now(), int(req.UserId), req.StartDate, req.EndDate)
+	if err != nil {
+		return &proto.MealPlanResponse{Error: err.Error()}, nil
+	}
+
+	rows, err := s.repo.ListPlannedMeals(int(req.UserId), start, end)
+	if err != nil {
+		log.Printf("Failed to list planned meals: %v", err)
+		return &proto.MealPlanResponse{
+			Error: fmt.Sprintf("Failed to get meal plan: %v", err),
+		}, nil
+	}
+
+	return &proto.MealPlanResponse{
+		Plan: &proto.MealPlan{
+			StartDate: start.Format(dateLayout),
+			EndDate:   end.Format(dateLayout),
+			Days:      buildMealPlanDays(rows, start, end),
+		},
+	}, nil
+}
+
+// resolveStartDate parses a start date, defaulting to the user's today
+func resolveStartDate(repo *meals.Repository, now time.Time, userID int, date string) (time.Time, error) {
+	if date != "" {
+		return parseDate(date)
+	}
+
+	timezone, err := repo.GetUserTimezone(userID)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Failed to resolve date: %v", err)
+	}
+	return userToday(now, timezone), nil
+}
+
+// resolvePlanRange parses a range of planned days. The start defaults to the
+// user's today and the end to the week that follows; the range may cover at
+// most maxMealPlanRangeDays days.
+func resolvePlanRange(repo *meals.Repository, now time.Time, userID int, startDate, endDate string) (time.Time, time.Time, error) {
+	start, err := resolveStartDate(repo, now, userID, startDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	end := start.AddDate(0, 0, mealplan.DefaultDays-1)
+	if endDate != "" {
+		end, err = parseDate(endDate)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+	if start.After(end) {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid date range: start_date is after end_date")
+	}
+	if end.Sub(start) >= maxMealPlanRangeDays*24*time.Hour {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid date range: must not exceed %d days", maxMealPlanRangeDays)
+	}
+
+	return start, end, nil
+}
+
+// buildMealPlanDays groups planned foods by day and meal number. Every day in
+// the range is listed, so days without a plan come back empty.
+func buildMealPlanDays(rows []meals.PlannedFood, start, end time.Time) []*proto.MealPlanDay {
+	byDate := map[string]*proto.MealPlanDay{}
+	days := []*proto.MealPlanDay{}
+	for date := start; !date.After(end); date = date.AddDate(0, 0, 1) {
+		day := &proto.MealPlanDay{Date: date.Format(dateLayout), Meals: []*proto.PlannedMeal{}}
+		byDate[day.Date] = day
+		days = append(days, day)
+	}
+
+	for _, row := range rows {
+		day, ok := byDate[row.Date.Format(dateLayout)]
+		if !ok {
+			continue
+		}
+
+		// Rows are ordered by meal number, so a new meal starts whenever it changes
+		if len(day.Meals) == 0 || day.Meals[len(day.Meals)-1].MealNumber != int32(row.MealNumber) {
+			day.Meals = append(day.Meals, &proto.PlannedMeal{MealNumber: int32(row.MealNumber)})
+		}
+		meal := day.Meals[len(day.Meals)-1]
+
+		meal.Foods = append(meal.Foods, &proto.PlannedFood{
+			EntryId:      int32(row.ID),
+			FoodId:       int32(row.FoodID),
+			Name:         row.Name,
+			ServingUnits: row.ServingUnits,
+			Servings:     row.Servings,
+			Calories:     row.Calories,
+			ProteinGrams: row.ProteinGrams,
+			CarbsGrams:   row.CarbsGrams,
+			FatGrams:     row.FatGrams,
+		})
+		meal.Calories += row.Calories
+		meal.ProteinGrams += row.ProteinGrams
+		meal.CarbsGrams += row.CarbsGrams
+		meal.FatGrams += row.FatGrams
+		day.Calories += row.Calories
+		day.ProteinGrams += row.ProteinGrams
+		day.CarbsGrams += row.CarbsGrams
+		day.FatGrams += row.FatGrams
+	}
+
+	return days
+}
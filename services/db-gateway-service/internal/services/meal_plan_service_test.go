@@ -0,0 +1,188 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"db-gateway-service/internal/mealplan"
+	"db-gateway-service/internal/targets"
+	"db-gateway-service/proto"
+	meals "db-gateway-service/sql/meal-service"
+	users "db-gateway-service/sql/user-service"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var plannedFoodColumns = []string{
+	"id", "food_id", "date", "meal_number", "servings",
+	"name", "serving_units", "calories", "protein_grams", "carbs_grams", "fat_grams",
+}
+
+func TestMealPlanService_GenerateMealPlan(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewMealPlanService(meals.NewRepository(db), users.NewRepository(db))
+	service.now = func() time.Time { return time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC) }
+
+	start := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 1)
+	now := time.Now()
+
+	catalogRows := sqlmock.NewRows(foodColumns).
+		AddRow(1, "Salmon - Wild Atlantic", "FISH", "OUNCES", 155.0, 22.0, 0.0, 7.0, true, false, false, "{FISH}", true).
+		AddRow(5, "Chicken Breast - Skinless", "MEAT", "OUNCES", 140.0, 26.0, 0.0, 3.0, false, false, false, "{}", false).
+		AddRow(19, "Broccoli", "VEGETABLE", "CUPS", 25.0, 3.0, 5.0, 0.0, true, false, true, "{}", false).
+		AddRow(31, "Brown Rice - Cooked", "GRAIN", "CUPS", 216.0, 5.0, 45.0, 2.0, true, false, false, "{}", false).
+		AddRow(43, "Olive Oil - Extra Virgin", "OIL", "TBSP", 120.0, 0.0, 0.0, 14.0, true, false, false, "{}", false).
+		AddRow(46, "Blueberries", "FRUIT", "CUPS", 84.0, 1.0, 21.0, 0.5, true, false, false, "{}", false)
+
+	// Setup mock expectations
+	mock.ExpectQuery(`FROM USERS\s+WHERE id = \$1`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "full_name", "email", "sex", "timezone", "height_cm", "weight_kg",
+			"birth_date", "activity_level", "created_at", "updated_at",
+		}).AddRow(
+			7, "Jane Doe", "jane@example.com", "FEMALE", "UTC", 165.0, 60.0,
+			time.Date(1990, 6, 2, 0, 0, 0, 0, time.UTC), "LIGHT", now, now,
+		))
+	mock.ExpectQuery(`FROM USER_GOALS`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "category", "name", "description"}).
+			AddRow(1, "Weight", "Lose", nil))
+	expectFoodPreferences(mock, 7, sqlmock.NewRows(foodColumns), nil, nil)
+	mock.ExpectQuery(`FROM FOOD_CATALOG f\s+LEFT JOIN FOOD_USER_LIKES l`).
+		WillReturnRows(catalogRows)
+	mock.ExpectQuery(`SELECT \(SELECT COUNT\(\*\) FROM FOOD_CATALOG f`).
+		WillReturnRows(sqlmock.NewRows([]string{"visible", "total"}).AddRow(6, 6))
+
+	// The plan itself is covered by the mealplan tests; here it decides which rows are saved
+	daily, err := targets.Calculate(
+		targets.Profile{Sex: "FEMALE", WeightKg: 60, HeightCm: 165, Age: 34, ActivityLevel: "LIGHT"},
+		[]targets.Goal{{Category: "Weight", Name: "Lose"}},
+	)
+	require.NoError(t, err)
+	expected, err := mealplan.Generate([]mealplan.Food{
+		{ID: 1, Category: "FISH", ServingUnits: "OUNCES", PerServing: mealplan.Macros{Calories: 155, ProteinGrams: 22, FatGrams: 7}, NonInflammatory: true, Liked: true},
+		{ID: 5, Category: "MEAT", ServingUnits: "OUNCES", PerServing: mealplan.Macros{Calories: 140, ProteinGrams: 26, FatGrams: 3}},
+		{ID: 19, Category: "VEGETABLE", ServingUnits: "CUPS", PerServing: mealplan.Macros{Calories: 25, ProteinGrams: 3, CarbsGrams: 5}, NonInflammatory: true},
+		{ID: 31, Category: "GRAIN", ServingUnits: "CUPS", PerServing: mealplan.Macros{Calories: 216, ProteinGrams: 5, CarbsGrams: 45, FatGrams: 2}, NonInflammatory: true},
+		{ID: 43, Category: "OIL", ServingUnits: "TBSP", PerServing: mealplan.Macros{Calories: 120, FatGrams: 14}, NonInflammatory: true},
+		{ID: 46, Category: "FRUIT", ServingUnits: "CUPS", PerServing: mealplan.Macros{Calories: 84, ProteinGrams: 1, CarbsGrams: 21, FatGrams: 0.5}, NonInflammatory: true},
+	}, mealplan.Macros{
+		Calories:     daily.Calories,
+		ProteinGrams: daily.ProteinGrams,
+		CarbsGrams:   daily.CarbsGrams,
+		FatGrams:     daily.FatGrams,
+	}, mealplan.Options{
+		Days:  2,
+		Goals: []mealplan.Goal{{Category: "Weight", Name: "Lose"}},
+	})
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM USER_MEALS\s+WHERE user_id = \$1 AND is_planned AND date BETWEEN \$2 AND \$3`).
+		WithArgs(7, start, end).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	for d, day := range expected.Days {
+		for _, meal := range day.Meals {
+			for _, portion := range meal.Portions {
+				mock.ExpectExec(`INSERT INTO USER_MEALS .+ is_planned`).
+					WithArgs(7, portion.Food.ID, start.AddDate(0, 0, d), meal.MealNumber, portion.Servings).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+		}
+	}
+	mock.ExpectCommit()
+	mock.ExpectQuery(`FROM USER_MEALS um\s+JOIN FOOD_CATALOG f .+ um.is_planned`).
+		WithArgs(7, start, end).
+		WillReturnRows(sqlmock.NewRows(plannedFoodColumns).
+			AddRow(90, 1, start, 1, 2.0, "Salmon - Wild Atlantic", "OUNCES", 310.0, 44.0, 0.0, 14.0).
+			AddRow(91, 31, start, 1, 1.0, "Brown Rice - Cooked", "CUPS", 216.0, 5.0, 45.0, 2.0).
+			AddRow(92, 46, start, 3, 1.5, "Blueberries", "CUPS", 126.0, 1.5, 31.5, 0.75))
+
+	// Execute
+	resp, err := service.GenerateMealPlan(context.Background(), &proto.GenerateMealPlanRequest{
+		UserId:    7,
+		StartDate: "2025-06-02",
+		Days:      2,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	require.NotNil(t, resp.Plan)
+	assert.Equal(t, "2025-06-02", resp.Plan.StartDate)
+	assert.Equal(t, "2025-06-03", resp.Plan.EndDate)
+	assert.Equal(t, daily.Calories, resp.Plan.Targets.Calories)
+	require.Len(t, resp.Plan.Days, 2)
+	require.Len(t, resp.Plan.Days[0].Meals, 2)
+	assert.Len(t, resp.Plan.Days[0].Meals[0].Foods, 2)
+	assert.Equal(t, 526.0, resp.Plan.Days[0].Meals[0].Calories)
+	assert.Equal(t, int32(3), resp.Plan.Days[0].Meals[1].MealNumber)
+	assert.Equal(t, 652.0, resp.Plan.Days[0].Calories)
+	assert.Empty(t, resp.Plan.Days[1].Meals)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMealPlanService_Validation(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewMealPlanService(meals.NewRepository(db), users.NewRepository(db))
+	ctx := context.Background()
+
+	resp, err := service.GenerateMealPlan(ctx, &proto.GenerateMealPlanRequest{StartDate: "2025-06-02"})
+	assert.NoError(t, err)
+	assert.Equal(t, "user_id is required", resp.Error)
+
+	resp, err = service.GenerateMealPlan(ctx, &proto.GenerateMealPlanRequest{UserId: 7, StartDate: "06/02/2025"})
+	assert.NoError(t, err)
+	assert.Contains(t, resp.Error, "invalid date")
+
+	resp, err = service.GetMealPlan(ctx, &proto.GetMealPlanRequest{UserId: 7, StartDate: "2025-06-09", EndDate: "2025-06-02"})
+	assert.NoError(t, err)
+	assert.Contains(t, resp.Error, "start_date is after end_date")
+
+	resp, err = service.GetMealPlan(ctx, &proto.GetMealPlanRequest{UserId: 7, StartDate: "2025-06-01", EndDate: "2025-07-15"})
+	assert.NoError(t, err)
+	assert.Contains(t, resp.Error, "must not exceed 31 days")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMealPlanService_GetMealPlan_DefaultsToTheWeekAhead(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewMealPlanService(meals.NewRepository(db), users.NewRepository(db))
+	service.now = func() time.Time { return time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC) }
+
+	start := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	// Setup mock expectations
+	mock.ExpectQuery(`SELECT timezone FROM USERS WHERE id = \$1`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"timezone"}).AddRow("UTC"))
+	mock.ExpectQuery(`FROM USER_MEALS um\s+JOIN FOOD_CATALOG f`).
+		WithArgs(7, start, start.AddDate(0, 0, 6)).
+		WillReturnRows(sqlmock.NewRows(plannedFoodColumns))
+
+	// Execute
+	resp, err := service.GetMealPlan(context.Background(), &proto.GetMealPlanRequest{UserId: 7})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Equal(t, "2025-06-07", resp.Plan.EndDate)
+	assert.Len(t, resp.Plan.Days, 7)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,277 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"db-gateway-service/internal/instructions"
+	"db-gateway-service/internal/recipes"
+	"db-gateway-service/proto"
+	meals "db-gateway-service/sql/meal-service"
+)
+
+// MealService implements the gRPC MealService server
+type MealService struct {
+	proto.UnimplementedMealServiceServer
+	repo *meals.Repository
+	now  func() time.Time
+}
+
+// NewMealService creates a new MealService instance
+func NewMealService(repo *meals.Repository) *MealService {
+	return &MealService{repo: repo, now: time.Now}
+}
+
+// GetMeal returns a meal with its ingredients and its preparation instructions
+// both as stored and rendered to sanitized HTML
+func (s *MealService) GetMeal(ctx context.Context, req *proto.GetMealRequest) (*proto.MealResponse, error) {
+	log.Printf("GetMeal called for meal ID: %d", req.MealId)
+
+	if req.MealId == 0 {
+		return &proto.MealResponse{Error: "meal_id is required"}, nil
+	}
+
+	meal, err := s.load(int(req.MealId))
+	if err != nil {
+		return &proto.MealResponse{Error: err.Error()}, nil
+	}
+
+	return &proto.MealResponse{Meal: meal}, nil
+}
+
+// UpdateMealInstructions stores new preparation instructions for a meal.
+// Submitted HTML is stripped of everything outside the allowlist, scripts
+// included, before it is stored.
+func (s *MealService) UpdateMealInstructions(ctx context.Context, req *proto.UpdateMealInstructionsRequest) (*proto.MealResponse, error) {
+	log.Printf("UpdateMealInstructions called for meal ID: %d, format: %s", req.MealId, req.Format)
+
+	if req.MealId == 0 {
+		return &proto.MealResponse{Error: "meal_id is required"}, nil
+	}
+
+	format := strings.ToUpper(req.Format)
+	if format == "" {
+		format = instructions.Markdown
+	}
+	cleaned, err := instructions.Clean(req.Raw, format)
+	if err != nil {
+		return &proto.MealResponse{Error: err.Error()}, nil
+	}
+
+	var text *string
+	if cleaned != "" {
+		text = &cleaned
+	}
+	if err := s.repo.UpdateMealInstructions(int(req.MealId), text, format); err != nil {
+		log.Printf("Failed to update meal instructions: %v", err)
+		return &proto.MealResponse{
+			Error: fmt.Sprintf("Failed to update meal instructions: %v", err),
+		}, nil
+	}
+
+	meal, err := s.load(int(req.MealId))
+	if err != nil {
+		return &proto.MealResponse{Error: err.Error()}, nil
+	}
+
+	return &proto.MealResponse{Meal: meal}, nil
+}
+
+// ScaleMeal returns a meal with its ingredients scaled to a number of servings
+func (s *MealService) ScaleMeal(ctx context.Context, req *proto.ScaleMealRequest) (*proto.ScaledMealResponse, error) {
+	log.Printf("ScaleMeal called for meal ID: %d, servings: %d", req.MealId, req.Servings)
+
+	if req.MealId == 0 {
+		return &proto.ScaledMealResponse{Error: "meal_id is required"}, nil
+	}
+
+	scaled, err := s.scale(int(req.MealId), int(req.Servings))
+	if err != nil {
+		return &proto.ScaledMealResponse{Error: err.Error()}, nil
+	}
+
+	return &proto.ScaledMealResponse{Meal: scaled}, nil
+}
+
+// PlanBatchCook scales a meal to one batch and plans its servings across
+// consecutive days in the same meal slot, so it is cooked once and eaten
+// over several days
+func (s *MealService) PlanBatchCook(ctx context.Context, req *proto.PlanBatchCookRequest) (*proto.PlanBatchCookResponse, error) {
+	log.Printf("PlanBatchCook called for user ID: %d, meal ID: %d, days: %d", req.UserId, req.MealId, req.Days)
+
+	if req.UserId == 0 {
+		return &proto.PlanBatchCookResponse{Error: "user_id is required"}, nil
+	}
+	if req.MealId == 0 {
+		return &proto.PlanBatchCookResponse{Error: "meal_id is required"}, nil
+	}
+	if req.MealNumber < 1 || req.MealNumber > 6 {
+		return &proto.PlanBatchCookResponse{Error: "invalid meal_number: must be between 1 and 6"}, nil
+	}
+
+	servings := req.Servings
+	if servings == 0 {
+		servings = req.Days
+	}
+	portions, err := recipes.SpreadBatch(float64(servings), int(req.Days))
+	if err != nil {
+		return &proto.PlanBatchCookResponse{Error: err.Error()}, nil
+	}
+
+	start, err := resolveStartDate(s.repo, s.now(), int(req.UserId), req.StartDate)
+	if err != nil {
+		return &proto.PlanBatchCookResponse{Error: err.Error()}, nil
+	}
+
+	batch, err := s.scale(int(req.MealId), int(servings))
+	if err != nil {
+		return &proto.PlanBatchCookResponse{Error: err.Error()}, nil
+	}
+
+	entries := make([]meals.BatchEntry, len(portions))
+	for i, portion := range portions {
+		entries[i] = meals.BatchEntry{
+			Date:       start.AddDate(0, 0, portion.Day),
+			MealNumber: int(req.MealNumber),
+			Servings:   portion.Servings,
+		}
+	}
+
+	if err := s.repo.PlanBatchEntries(int(req.UserId), int(req.MealId), entries); err != nil {
+		log.Printf("Failed to plan batch entries: %v", err)
+		return &proto.PlanBatchCookResponse{
+			Error: fmt.Sprintf("Failed to plan batch cook: %v", err),
+		}, nil
+	}
+
+	resp := &proto.PlanBatchCookResponse{
+		Batch:            batch,
+		Entries:          make([]*proto.BatchEntry, len(entries)),
+		PrepMinutesSaved: batch.PrepTime * (req.Days - 1),
+	}
+	for i, entry := range entries {
+		resp.Entries[i] = &proto.BatchEntry{
+			EntryId:    int32(entry.ID),
+			Date:       entry.Date.Format(dateLayout),
+			MealNumber: int32(entry.MealNumber),
+			Servings:   entry.Servings,
+		}
+	}
+
+	return resp, nil
+}
+
+// scale loads a meal and scales its ingredients and nutrition to servings
+func (s *MealService) scale(mealID, servings int) (*proto.ScaledMeal, error) {
+	meal, err := s.repo.GetMeal(mealID)
+	if err != nil {
+		log.Printf("Failed to get meal: %v", err)
+		return nil, fmt.Errorf("Failed to get meal: %v", err)
+	}
+
+	rows, err := s.repo.ListMealIngredients(mealID)
+	if err != nil {
+		log.Printf("Failed to list meal ingredients: %v", err)
+		return nil, fmt.Errorf("Failed to get meal: %v", err)
+	}
+
+	ingredients := make([]recipes.Ingredient, len(rows))
+	for i, row := range rows {
+		ingredients[i] = recipes.Ingredient{
+			FoodID:   row.FoodID,
+			Name:     row.Name,
+			Quantity: row.Quantity,
+			Unit:     row.Unit,
+			Notes:    ptrToString(row.Notes),
+		}
+	}
+
+	scaled, err := recipes.Scale(ingredients, meal.Servings, servings)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &proto.ScaledMeal{
+		MealId:         int32(meal.ID),
+		Name:           meal.Name,
+		RecipeServings: int32(meal.Servings),
+		Servings:       int32(servings),
+		Ingredients:    make([]*proto.MealIngredient, len(scaled)),
+		Calories:       ptrToFloat(meal.TotalCalories) * float64(servings),
+		ProteinGrams:   ptrToFloat(meal.TotalProtein) * float64(servings),
+		CarbsGrams:     ptrToFloat(meal.TotalCarbs) * float64(servings),
+		FatGrams:       ptrToFloat(meal.TotalFat) * float64(servings),
+	}
+	if meal.PrepTime != nil {
+		result.PrepTime = int32(*meal.PrepTime)
+	}
+	for i, ingredient := range scaled {
+		result.Ingredients[i] = &proto.MealIngredient{
+			FoodId:   int32(ingredient.FoodID),
+			Name:     ingredient.Name,
+			Quantity: ingredient.Quantity,
+			Unit:     ingredient.Unit,
+			Notes:    ingredient.Notes,
+		}
+	}
+
+	return result, nil
+}
+
+// load reads a meal and its ingredients and renders its instructions
+func (s *MealService) load(mealID int) (*proto.Meal, error) {
+	meal, err := s.repo.GetMeal(mealID)
+	if err != nil {
+		log.Printf("Failed to get meal: %v", err)
+		return nil, fmt.Errorf("Failed to get meal: %v", err)
+	}
+
+	rows, err := s.repo.ListMealIngredients(mealID)
+	if err != nil {
+		log.Printf("Failed to list meal ingredients: %v", err)
+		return nil, fmt.Errorf("Failed to get meal: %v", err)
+	}
+
+	result := &proto.Meal{
+		Id:           int32(meal.ID),
+		Name:         meal.Name,
+		Description:  ptrToString(meal.Description),
+		Servings:     int32(meal.Servings),
+		Ingredients:  make([]*proto.MealIngredient, len(rows)),
+		Calories:     ptrToFloat(meal.TotalCalories),
+		ProteinGrams: ptrToFloat(meal.TotalProtein),
+		CarbsGrams:   ptrToFloat(meal.TotalCarbs),
+		FatGrams:     ptrToFloat(meal.TotalFat),
+	}
+	if meal.PrepTime != nil {
+		result.PrepTime = int32(*meal.PrepTime)
+	}
+	for i, row := range rows {
+		result.Ingredients[i] = &proto.MealIngredient{
+			Id:       int32(row.ID),
+			FoodId:   int32(row.FoodID),
+			Name:     row.Name,
+			Quantity: row.Quantity,
+			Unit:     row.Unit,
+			Notes:    ptrToString(row.Notes),
+		}
+	}
+
+	if meal.PrepInstructions != nil {
+		html, err := instructions.Render(*meal.PrepInstructions, meal.PrepInstructionsFormat)
+		if err != nil {
+			log.Printf("Failed to render meal instructions: %v", err)
+			return nil, fmt.Errorf("Failed to render meal instructions: %v", err)
+		}
+		result.PrepInstructions = &proto.PrepInstructions{
+			Format: meal.PrepInstructionsFormat,
+			Raw:    *meal.PrepInstructions,
+			Html:   html,
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,209 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"db-gateway-service/proto"
+	meals "db-gateway-service/sql/meal-service"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var mealColumns = []string{
+	"id", "name", "description", "servings", "total_calories", "total_protein",
+	"total_carbs", "total_fat", "prep_time", "prep_instructions", "prep_instructions_format",
+}
+
+var mealIngredientColumns = []string{"food_id", "name", "quantity", "unit", "notes"}
+
+// expectChickenBowl mocks loading a two-serving meal and its ingredients
+func expectChickenBowl(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(`FROM MEALS m.+\) mn ON true\s+WHERE m.id = \$1`).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows(mealColumns).
+			AddRow(3, "Chicken Bowl", nil, 2, 600.0, 45.0, 55.0, 18.0, 40, nil, "MARKDOWN"))
+	mock.ExpectQuery(`FROM MEAL_INGREDIENTS mi\s+JOIN FOOD_CATALOG f`).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows(mealIngredientColumns).
+			AddRow(5, "Chicken Breast - Skinless", 8.0, "OUNCES", nil).
+			AddRow(31, "Brown Rice - Cooked", 1.5, "CUPS", nil).
+			AddRow(48, "Eggs - Large", 1.0, "PIECES", "soft boiled").
+			AddRow(43, "Olive Oil - Extra Virgin", 2.0, "TSP", nil))
+}
+
+func TestMealService_ScaleMeal(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewMealService(meals.NewRepository(db))
+
+	// Setup mock expectations
+	expectChickenBowl(mock)
+
+	// Execute
+	resp, err := service.ScaleMeal(context.Background(), &proto.ScaleMealRequest{MealId: 3, Servings: 3})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Equal(t, int32(2), resp.Meal.RecipeServings)
+	assert.Equal(t, 1800.0, resp.Meal.Calories)
+	require.Len(t, resp.Meal.Ingredients, 4)
+	assert.Equal(t, 12.0, resp.Meal.Ingredients[0].Quantity)
+	assert.Equal(t, 2.25, resp.Meal.Ingredients[1].Quantity)
+	assert.Equal(t, 2.0, resp.Meal.Ingredients[2].Quantity)
+	assert.Equal(t, "soft boiled", resp.Meal.Ingredients[2].Notes)
+	// 3 tsp are promoted to a tablespoon
+	assert.Equal(t, 1.0, resp.Meal.Ingredients[3].Quantity)
+	assert.Equal(t, "TBSP", resp.Meal.Ingredients[3].Unit)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMealService_PlanBatchCook(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewMealService(meals.NewRepository(db))
+
+	start := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+
+	// Setup mock expectations
+	expectChickenBowl(mock)
+	mock.ExpectBegin()
+	for d, servings := range []float64{1.5, 1.25, 1.25} {
+		date := start.AddDate(0, 0, d)
+		mock.ExpectExec(`DELETE FROM USER_MEALS\s+WHERE user_id = \$1 AND is_planned AND date = \$2 AND meal_number = \$3`).
+			WithArgs(7, date, 2).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`INSERT INTO USER_MEALS .+ RETURNING id`).
+			WithArgs(7, 3, date, 2, servings).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(100 + d))
+	}
+	mock.ExpectCommit()
+
+	// Execute
+	resp, err := service.PlanBatchCook(context.Background(), &proto.PlanBatchCookRequest{
+		UserId:     7,
+		MealId:     3,
+		StartDate:  "2025-06-02",
+		Days:       3,
+		MealNumber: 2,
+		Servings:   4,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Equal(t, int32(4), resp.Batch.Servings)
+	assert.Equal(t, 16.0, resp.Batch.Ingredients[0].Quantity)
+	require.Len(t, resp.Entries, 3)
+	assert.Equal(t, int32(102), resp.Entries[2].EntryId)
+	assert.Equal(t, "2025-06-04", resp.Entries[2].Date)
+	assert.Equal(t, 1.25, resp.Entries[2].Servings)
+	assert.Equal(t, int32(80), resp.PrepMinutesSaved)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMealService_GetMeal(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewMealService(meals.NewRepository(db))
+
+	// Setup mock expectations
+	mock.ExpectQuery(`FROM MEALS m.+\) mn ON true\s+WHERE m.id = \$1`).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows(mealColumns).
+			AddRow(3, "Chicken Bowl", "Rice bowl", 2, 600.0, 45.0, 55.0, 18.0, 40,
+				"1. Sear the **chicken**\n2. Serve <script>alert(1)</script>", "MARKDOWN"))
+	mock.ExpectQuery(`FROM MEAL_INGREDIENTS mi\s+JOIN FOOD_CATALOG f`).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows(mealIngredientColumns).
+			AddRow(5, "Chicken Breast - Skinless", 8.0, "OUNCES", nil))
+
+	// Execute
+	resp, err := service.GetMeal(context.Background(), &proto.GetMealRequest{MealId: 3})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Equal(t, "Rice bowl", resp.Meal.Description)
+	assert.Equal(t, 600.0, resp.Meal.Calories)
+	require.Len(t, resp.Meal.Ingredients, 1)
+	require.NotNil(t, resp.Meal.PrepInstructions)
+	assert.Equal(t, "MARKDOWN", resp.Meal.PrepInstructions.Format)
+	assert.Contains(t, resp.Meal.PrepInstructions.Raw, "**chicken**")
+	assert.Contains(t, resp.Meal.PrepInstructions.Html, "<li>Sear the <strong>chicken</strong></li>")
+	assert.NotContains(t, resp.Meal.PrepInstructions.Html, "<script")
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMealService_UpdateMealInstructions_StripsScripts(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewMealService(meals.NewRepository(db))
+
+	// Setup mock expectations
+	mock.ExpectExec(`UPDATE MEALS\s+SET prep_instructions = \$2, prep_instructions_format = \$3`).
+		WithArgs(3, "<p>Sear</p>", "HTML").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`FROM MEALS m.+\) mn ON true\s+WHERE m.id = \$1`).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows(mealColumns).
+			AddRow(3, "Chicken Bowl", nil, 2, 600.0, 45.0, 55.0, 18.0, 40, "<p>Sear</p>", "HTML"))
+	mock.ExpectQuery(`FROM MEAL_INGREDIENTS mi\s+JOIN FOOD_CATALOG f`).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows(mealIngredientColumns))
+
+	// Execute
+	resp, err := service.UpdateMealInstructions(context.Background(), &proto.UpdateMealInstructionsRequest{
+		MealId: 3,
+		Format: "html",
+		Raw:    `<p onclick="steal()">Sear</p><script>steal()</script>`,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Equal(t, "<p>Sear</p>", resp.Meal.PrepInstructions.Html)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMealService_Validation(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewMealService(meals.NewRepository(db))
+	ctx := context.Background()
+
+	resp, err := service.ScaleMeal(ctx, &proto.ScaleMealRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "meal_id is required", resp.Error)
+
+	batchResp, err := service.PlanBatchCook(ctx, &proto.PlanBatchCookRequest{UserId: 7, MealId: 3, MealNumber: 9})
+	assert.NoError(t, err)
+	assert.Equal(t, "invalid meal_number: must be between 1 and 6", batchResp.Error)
+
+	batchResp, err = service.PlanBatchCook(ctx, &proto.PlanBatchCookRequest{UserId: 7, MealId: 3, MealNumber: 1, Days: 10})
+	assert.NoError(t, err)
+	assert.Contains(t, batchResp.Error, "invalid days 10")
+
+	mealResp, err := service.UpdateMealInstructions(ctx, &proto.UpdateMealInstructionsRequest{MealId: 3, Format: "TEXT", Raw: "Boil"})
+	assert.NoError(t, err)
+	assert.Equal(t, `invalid format "TEXT": must be MARKDOWN or HTML`, mealResp.Error)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,558 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"db-gateway-service/internal/cycle"
+	"db-gateway-service/internal/healthscores"
+	"db-gateway-service/internal/micronutrients"
+	"db-gateway-service/internal/targets"
+	"db-gateway-service/proto"
+	meals "db-gateway-service/sql/meal-service"
+	users "db-gateway-service/sql/user-service"
+	workouts "db-gateway-service/sql/workout-service"
+)
+
+// maxReportDays caps the date range a single nutrition report may cover
+const maxReportDays = 731
+
+// defaultScoreDays is the number of days a health score report covers by default
+const defaultScoreDays = 28
+
+// NutritionService implements the gRPC NutritionService server
+type NutritionService struct {
+	proto.UnimplementedNutritionServiceServer
+	repo        *meals.Repository
+	userRepo    *users.Repository
+	workoutRepo *workouts.Repository
+	now         func() time.Time
+}
+
+// NewNutritionService creates a new NutritionService instance
+func NewNutritionService(repo *meals.Repository, userRepo *users.Repository, workoutRepo *workouts.Repository) *NutritionService {
+	return &NutritionService{
+		repo:        repo,
+		userRepo:    userRepo,
+		workoutRepo: workoutRepo,
+		now:         time.Now,
+	}
+}
+
+// NutritionReport summarizes consumed nutrition per day, week or month, with
+// the calories burned in workouts and the resulting net calories.
+// Dates are calendar days in the user's timezone, matching how diary entries are logged.
+func (s *NutritionService) NutritionReport(ctx context.Context, req *proto.NutritionReportRequest) (*proto.NutritionReportResponse, error) {
+	log.Printf("NutritionReport called for user ID: %d, granularity: %q", req.UserId, req.Granularity)
+
+	if req.UserId == 0 {
+		return &proto.NutritionReportResponse{Error: "user_id is required"}, nil
+	}
+
+	granularity := req.Granularity
+	if granularity == "" {
+		granularity = "day"
+	}
+	if granularity != "day" && granularity != "week" && granularity != "month" {
+		return &proto.NutritionReportResponse{
+			Error: fmt.Sprintf("invalid granularity %q: must be day, week or month", granularity),
+		}, nil
+	}
+
+	user, err := s.userRepo.GetUserByID(int(req.UserId))
+	if err != nil {
+		log.Printf("Failed to get user: %v", err)
+		return &proto.NutritionReportResponse{
+			Error: fmt.Sprintf("Failed to build nutrition report: %v", err),
+		}, nil
+	}
+	timezone := ptrToString(user.Timezone)
+	today := userToday(s.now(), timezone)
+
+	start, end, err := reportRange(req.StartDate, req.EndDate, granularity, today)
+	if err != nil {
+		return &proto.NutritionReportResponse{Error: err.Error()}, nil
+	}
+
+	rows, err := s.repo.NutritionByPeriod(int(req.UserId), start, end, granularity)
+	if err != nil {
+		log.Printf("Failed to aggregate nutrition: %v", err)
+		return &proto.NutritionReportResponse{
+			Error: fmt.Sprintf("Failed to build nutrition report: %v", err),
+		}, nil
+	}
+
+	burned, err := s.workoutRepo.CaloriesBurnedByPeriod(int(req.UserId), userLocation(timezone).String(), start, end, granularity)
+	if err != nil {
+		log.Printf("Failed to aggregate calories burned: %v", err)
+		return &proto.NutritionReportResponse{
+			Error: fmt.Sprintf("Failed to build nutrition report: %v", err),
+		}, nil
+	}
+
+	// Gaps are flagged per day whatever the granularity of the report
+	days := rows
+	if granularity != "day" {
+		days, err = s.repo.NutritionByPeriod(int(req.UserId), start, end, "day")
+		if err != nil {
+			log.Printf("Failed to aggregate daily nutrition: %v", err)
+			return &proto.NutritionReportResponse{
+				Error: fmt.Sprintf("Failed to build nutrition report: %v", err),
+			}, nil
+		}
+	}
+
+	age := 0
+	if user.BirthDate != nil {
+		age = ageOn(*user.BirthDate, today)
+	}
+	gaps, notes := nutrientGaps(ptrToString(user.Sex), age, days)
+	unconverted := 0
+	for _, row := range rows {
+		unconverted += row.UnconvertedIngredients
+	}
+	if unconverted > 0 {
+		notes = append(notes, unconvertedNote(unconverted, "the totals"))
+	}
+
+	if timezone == "" {
+		timezone = "UTC"
+	}
+
+	return &proto.NutritionReportResponse{
+		Granularity:  granularity,
+		StartDate:    start.Format(dateLayout),
+		EndDate:      end.Format(dateLayout),
+		Timezone:     timezone,
+		Periods:      fillPeriods(rows, burned, start, end, granularity),
+		NutrientGaps: gaps,
+		Notes:        notes,
+	}, nil
+}
+
+// nutrientGaps compares each logged day's micronutrients with the reference
+// intakes for the user's sex and age. A profile without them only adds a note.
+func nutrientGaps(sex string, age int, days []meals.NutritionPeriod) ([]*proto.NutrientGap, []string) {
+	notes := []string{}
+
+	references, err := micronutrients.ReferenceIntakes(sex, age)
+	if err != nil {
+		return []*proto.NutrientGap{}, append(notes, fmt.Sprintf("Nutrient gap analysis skipped: %v", err))
+	}
+
+	logged := []micronutrients.Day{}
+	untracked := 0
+	for _, day := range days {
+		if day.DaysLogged == 0 {
+			continue
+		}
+		if day.UntrackedItems > 0 {
+			untracked++
+		}
+		logged = append(logged, micronutrients.Day{
+			Date: day.PeriodStart.Format(dateLayout),
+			Intake: map[string]float64{
+				micronutrients.Fiber:     day.FiberGrams,
+				micronutrients.Sugar:     day.SugarGrams,
+				micronutrients.Sodium:    day.SodiumMg,
+				micronutrients.Potassium: day.PotassiumMg,
+				micronutrients.Iron:      day.IronMg,
+				micronutrients.Calcium:   day.CalciumMg,
+				micronutrients.VitaminD:  day.VitaminDMcg,
+				micronutrients.Omega3:    day.Omega3Grams,
+			},
+		})
+	}
+	if untracked > 0 {
+		notes = append(notes, fmt.Sprintf(
+			"%d of %d logged days include meals or foods without micronutrient data and may be flagged low", untracked, len(logged)))
+	}
+
+	analyzed := micronutrients.Analyze(references, logged)
+	gaps := make([]*proto.NutrientGap, len(analyzed))
+	for i, gap := range analyzed {
+		gaps[i] = &proto.NutrientGap{
+			Nutrient:           gap.Nutrient,
+			Unit:               gap.Unit,
+			Kind:               gap.Kind,
+			ReferenceIntake:    gap.Amount,
+			AverageIntake:      gap.AverageIntake,
+			PercentOfReference: gap.PercentOfReference,
+			FlaggedDates:       gap.FlaggedDates,
+		}
+	}
+
+	return gaps, notes
+}
+
+// unconvertedNote explains that meal ingredients whose unit does not convert
+// to their food's serving unit were left out of a report
+func unconvertedNote(count int, leftOutOf string) string {
+	return fmt.Sprintf("%d meal ingredients use a unit that does not convert to their food's serving unit and were left out of %s", count, leftOutOf)
+}
+
+// GetNutritionTargets computes daily calorie and macro targets from the
+// user's body profile, activity level, biological sex and selected goals
+func (s *NutritionService) GetNutritionTargets(ctx context.Context, req *proto.GetNutritionTargetsRequest) (*proto.GetNutritionTargetsResponse, error) {
+	log.Printf("GetNutritionTargets called for user ID: %d", req.UserId)
+
+	if req.UserId == 0 {
+		return &proto.GetNutritionTargetsResponse{Error: "user_id is required"}, nil
+	}
+
+	computed, err := computeUserTargets(s.userRepo, int(req.UserId), s.now())
+	if err != nil {
+		return &proto.GetNutritionTargetsResponse{Error: err.Error()}, nil
+	}
+	profile, result := computed.profile, computed.targets
+
+	// Today's cycle phase adjusts the targets for users who opted in, as long
+	// as their profile sex still allows cycle tracking
+	cyclePhase := ""
+	enabled, err := s.userRepo.CycleTrackingEnabled(int(req.UserId))
+	if err != nil {
+		log.Printf("Failed to check cycle tracking: %v", err)
+		return &proto.GetNutritionTargetsResponse{
+			Error: fmt.Sprintf("Failed to get nutrition targets: %v", err),
+		}, nil
+	}
+	if enabled && cycle.Eligible(profile.Sex) {
+		prediction, err := predictCycle(s.userRepo, int(req.UserId), computed.today)
+		if err != nil {
+			return &proto.GetNutritionTargetsResponse{Error: err.Error()}, nil
+		}
+		if prediction != nil {
+			cyclePhase = prediction.Phase
+			cycle.Adjust(result, cyclePhase)
+		}
+	}
+
+	activityLevel := profile.ActivityLevel
+	if activityLevel == "" {
+		activityLevel = targets.Sedentary
+	}
+
+	return &proto.GetNutritionTargetsResponse{
+		Targets: &proto.NutritionTargets{
+			Bmr:                      result.BMR,
+			Tdee:                     result.TDEE,
+			CalorieAdjustmentPercent: result.CalorieAdjustmentPercent,
+			Calories:                 result.Calories,
+			ProteinGrams:             result.ProteinGrams,
+			CarbsGrams:               result.CarbsGrams,
+			FatGrams:                 result.FatGrams,
+			ActivityLevel:            activityLevel,
+			Goals:                    computed.goalNames,
+			Notes:                    result.Notes,
+			CyclePhase:               cyclePhase,
+		},
+	}, nil
+}
+
+// HealthScores rates each logged day and meal on inflammation and gut health
+// and fits the trend of both scores over the range
+func (s *NutritionService) HealthScores(ctx context.Context, req *proto.HealthScoresRequest) (*proto.HealthScoresResponse, error) {
+	log.Printf("HealthScores called for user ID: %d", req.UserId)
+
+	if req.UserId == 0 {
+		return &proto.HealthScoresResponse{Error: "user_id is required"}, nil
+	}
+
+	timezone, err := s.repo.GetUserTimezone(int(req.UserId))
+	if err != nil {
+		log.Printf("Failed to get user timezone: %v", err)
+		return &proto.HealthScoresResponse{
+			Error: fmt.Sprintf("Failed to build health scores: %v", err),
+		}, nil
+	}
+
+	start, end, err := reportRange(req.StartDate, req.EndDate, "day", userToday(s.now(), timezone))
+	if err != nil {
+		return &proto.HealthScoresResponse{Error: err.Error()}, nil
+	}
+	if req.StartDate == "" {
+		start = end.AddDate(0, 0, -(defaultScoreDays - 1))
+	}
+
+	foods, err := s.repo.ListConsumedFoods(int(req.UserId), start, end)
+	if err != nil {
+		log.Printf("Failed to list consumed foods: %v", err)
+		return &proto.HealthScoresResponse{
+			Error: fmt.Sprintf("Failed to build health scores: %v", err),
+		}, nil
+	}
+
+	scored := make([]meals.ConsumedFood, 0, len(foods))
+	unconverted := 0
+	for _, food := range foods {
+		if food.Unconverted {
+			unconverted++
+			continue
+		}
+		scored = append(scored, food)
+	}
+
+	resp := &proto.HealthScoresResponse{
+		StartDate: start.Format(dateLayout),
+		EndDate:   end.Format(dateLayout),
+		Days:      scoreDays(scored),
+		Notes:     []string{},
+	}
+	if unconverted > 0 {
+		resp.Notes = append(resp.Notes, unconvertedNote(unconverted, "the scores"))
+	}
+
+	var inflammation, gutHealth []healthscores.Point
+	for _, day := range resp.Days {
+		date, _ := parseDate(day.Date)
+		inflammation = append(inflammation, healthscores.Point{Date: date, Value: day.InflammationScore})
+		gutHealth = append(gutHealth, healthscores.Point{Date: date, Value: day.GutHealthScore})
+		resp.AverageInflammationScore += day.InflammationScore / float64(len(resp.Days))
+		resp.AverageGutHealthScore += day.GutHealthScore / float64(len(resp.Days))
+	}
+	resp.AverageInflammationScore = math.Round(resp.AverageInflammationScore)
+	resp.AverageGutHealthScore = math.Round(resp.AverageGutHealthScore)
+
+	if trend, ok := healthscores.Fit(inflammation, true); ok {
+		resp.InflammationTrend = &proto.ScoreTrend{PointsPerWeek: trend.PointsPerWeek, Direction: trend.Direction}
+	}
+	if trend, ok := healthscores.Fit(gutHealth, false); ok {
+		resp.GutHealthTrend = &proto.ScoreTrend{PointsPerWeek: trend.PointsPerWeek, Direction: trend.Direction}
+	}
+
+	return resp, nil
+}
+
+// scoreDays groups consumed foods (ordered by date and meal) into days and
+// meals and scores each. A meal is measured against its share of the day's
+// gut-health goal.
+func scoreDays(foods []meals.ConsumedFood) []*proto.DayScores {
+	days := []*proto.DayScores{}
+	for i := 0; i < len(foods); {
+		date := foods[i].Date
+		var dayItems []healthscores.Item
+		var mealNumbers []int
+		mealItems := map[int][]healthscores.Item{}
+		for ; i < len(foods) && foods[i].Date.Equal(date); i++ {
+			food := foods[i]
+			item := healthscores.Item{
+				Calories:        food.Calories,
+				Servings:        food.Servings,
+				Category:        food.Category,
+				NonInflammatory: food.IsNonInflammatory,
+				Probiotic:       food.IsProbiotic,
+				Prebiotic:       food.IsPrebiotic,
+			}
+			dayItems = append(dayItems, item)
+			if _, ok := mealItems[food.MealNumber]; !ok {
+				mealNumbers = append(mealNumbers, food.MealNumber)
+			}
+			mealItems[food.MealNumber] = append(mealItems[food.MealNumber], item)
+		}
+
+		scores := healthscores.Score(dayItems, 1)
+		day := &proto.DayScores{
+			Date:              date.Format(dateLayout),
+			Calories:          scores.Calories,
+			InflammationScore: scores.Inflammation,
+			GutHealthScore:    scores.GutHealth,
+			ProbioticServings: scores.ProbioticServings,
+			PrebioticServings: scores.PrebioticServings,
+			Meals:             make([]*proto.MealScores, len(mealNumbers)),
+		}
+		for j, mealNumber := range mealNumbers {
+			mealScores := healthscores.Score(mealItems[mealNumber], 1/float64(len(mealNumbers)))
+			day.Meals[j] = &proto.MealScores{
+				MealNumber:        int32(mealNumber),
+				Calories:          mealScores.Calories,
+				InflammationScore: mealScores.Inflammation,
+				GutHealthScore:    mealScores.GutHealth,
+			}
+		}
+		days = append(days, day)
+	}
+
+	return days
+}
+
+// userTargets are a user's computed daily targets with the inputs they came from
+type userTargets struct {
+	profile   targets.Profile
+	goals     []targets.Goal
+	goalNames []string
+	targets   *targets.Targets
+	today     time.Time // in the user's timezone
+}
+
+// computeUserTargets loads the user's body profile and selected goals and
+// computes their daily calorie and macro targets
+func computeUserTargets(userRepo *users.Repository, userID int, now time.Time) (*userTargets, error) {
+	user, err := userRepo.GetUserByID(userID)
+	if err != nil {
+		log.Printf("Failed to get user: %v", err)
+		return nil, fmt.Errorf("Failed to get user: %v", err)
+	}
+
+	userGoals, err := userRepo.GetUserGoals(user.ID)
+	if err != nil {
+		log.Printf("Failed to get user goals: %v", err)
+		return nil, fmt.Errorf("Failed to get user goals: %v", err)
+	}
+
+	computed := &userTargets{
+		profile: targets.Profile{
+			Sex:           ptrToString(user.Sex),
+			WeightKg:      ptrToFloat(user.WeightKg),
+			HeightCm:      ptrToFloat(user.HeightCm),
+			ActivityLevel: ptrToString(user.ActivityLevel),
+		},
+		goals:     make([]targets.Goal, len(userGoals)),
+		goalNames: make([]string, len(userGoals)),
+	}
+	computed.today = userToday(now, ptrToString(user.Timezone))
+	if user.BirthDate != nil {
+		computed.profile.Age = ageOn(*user.BirthDate, computed.today)
+	}
+	for i, g := range userGoals {
+		computed.goals[i] = targets.Goal{Category: g.Category, Name: g.Name}
+		computed.goalNames[i] = g.Category + "/" + g.Name
+	}
+
+	computed.targets, err = targets.Calculate(computed.profile, computed.goals)
+	if err != nil {
+		return nil, err
+	}
+
+	return computed, nil
+}
+
+// ageOn returns the age in whole years on the given date
+func ageOn(birthDate, date time.Time) int {
+	age := date.Year() - birthDate.Year()
+	if date.Month() < birthDate.Month() || (date.Month() == birthDate.Month() && date.Day() < birthDate.Day()) {
+		age--
+	}
+	return age
+}
+
+// reportRange resolves the requested date range, defaulting the end to the
+// user's today and the start to a sensible window for the granularity
+func reportRange(startDate, endDate, granularity string, today time.Time) (time.Time, time.Time, error) {
+	end := today
+	if endDate != "" {
+		parsed, err := parseDate(endDate)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		end = parsed
+	}
+
+	var start time.Time
+	if startDate != "" {
+		parsed, err := parseDate(startDate)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		start = parsed
+	} else {
+		switch granularity {
+		case "week":
+			start = periodStart(end, granularity).AddDate(0, 0, -21)
+		case "month":
+			start = periodStart(end, granularity).AddDate(0, -2, 0)
+		default:
+			start = end.AddDate(0, 0, -6)
+		}
+	}
+
+	if start.After(end) {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid date range: start_date is after end_date")
+	}
+	if end.Sub(start) > maxReportDays*24*time.Hour {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid date range: must not exceed %d days", maxReportDays)
+	}
+
+	return start, end, nil
+}
+
+// periodStart truncates a date to the first day of its bucket (weeks start on Monday)
+func periodStart(date time.Time, granularity string) time.Time {
+	switch granularity {
+	case "week":
+		offset := (int(date.Weekday()) + 6) % 7
+		return date.AddDate(0, 0, -offset)
+	case "month":
+		return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return date
+	}
+}
+
+// nextPeriod returns the first day of the bucket following the one starting at start
+func nextPeriod(start time.Time, granularity string) time.Time {
+	switch granularity {
+	case "week":
+		return start.AddDate(0, 0, 7)
+	case "month":
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// fillPeriods lays out every bucket in the range, clipped to the range
+// boundaries, so days or weeks without diary entries or workouts report zeros
+func fillPeriods(rows []meals.NutritionPeriod, burned []workouts.CaloriesBurned, start, end time.Time, granularity string) []*proto.NutritionPeriod {
+	byStart := make(map[string]meals.NutritionPeriod, len(rows))
+	for _, row := range rows {
+		byStart[row.PeriodStart.Format(dateLayout)] = row
+	}
+	burnedByStart := make(map[string]float64, len(burned))
+	for _, row := range burned {
+		burnedByStart[row.PeriodStart.Format(dateLayout)] = row.Calories
+	}
+
+	periods := []*proto.NutritionPeriod{}
+	for bucket := periodStart(start, granularity); !bucket.After(end); bucket = nextPeriod(bucket, granularity) {
+		from := bucket
+		if from.Before(start) {
+			from = start
+		}
+		to := nextPeriod(bucket, granularity).AddDate(0, 0, -1)
+		if to.After(end) {
+			to = end
+		}
+
+		row := byStart[bucket.Format(dateLayout)]
+		caloriesBurned := burnedByStart[bucket.Format(dateLayout)]
+		periods = append(periods, &proto.NutritionPeriod{
+			PeriodStart:            from.Format(dateLayout),
+			PeriodEnd:              to.Format(dateLayout),
+			DaysLogged:             int32(row.DaysLogged),
+			Calories:               row.Calories,
+			ProteinGrams:           row.ProteinGrams,
+			CarbsGrams:             row.CarbsGrams,
+			FatGrams:               row.FatGrams,
+			NonInflammatoryFoods:   int32(row.NonInflammatoryFoods),
+			ProbioticFoods:         int32(row.ProbioticFoods),
+			PrebioticFoods:         int32(row.PrebioticFoods),
+			FiberGrams:             row.FiberGrams,
+			SugarGrams:             row.SugarGrams,
+			SodiumMg:               row.SodiumMg,
+			PotassiumMg:            row.PotassiumMg,
+			IronMg:                 row.IronMg,
+			CalciumMg:              row.CalciumMg,
+			VitaminDMcg:            row.VitaminDMcg,
+			Omega3Grams:            row.Omega3Grams,
+			UntrackedItems:         int32(row.UntrackedItems),
+			CaloriesBurned:         caloriesBurned,
+			NetCalories:            row.Calories - caloriesBurned,
+			UnconvertedIngredients: int32(row.UnconvertedIngredients),
+		})
+	}
+
+	return periods
+}
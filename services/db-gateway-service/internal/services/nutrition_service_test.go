@@ -0,0 +1,552 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"db-gateway-service/proto"
+	meals "db-gateway-service/sql/meal-service"
+	users "db-gateway-service/sql/user-service"
+	workouts "db-gateway-service/sql/workout-service"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var nutritionPeriodColumns = []string{
+	"period_start", "days_logged", "calories", "protein_grams", "carbs_grams", "fat_grams",
+	"non_inflammatory_foods", "probiotic_foods", "prebiotic_foods",
+}
+
+func TestNutritionService_NutritionReport_Weekly(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewNutritionService(meals.NewRepository(db), users.NewRepository(db), workouts.NewRepository(db))
+	// Thursday March 13th, 2025 at 02:00 UTC is still Wednesday evening in Los Angeles
+	service.now = func() time.Time { return time.Date(2025, 3, 13, 2, 0, 0, 0, time.UTC) }
+
+	start := time.Date(2025, 2, 17, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 3, 12, 0, 0, 0, 0, time.UTC)
+
+	// Setup mock expectations
+	mock.ExpectQuery(`FROM USERS\s+WHERE id = \$1`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "full_name", "email", "timezone", "created_at", "updated_at"}).
+			AddRow(7, "Jane Doe", "jane@example.com", "America/Los_Angeles", time.Now(), time.Now()))
+	mock.ExpectQuery(`WITH consumed AS .+ SELECT date_trunc\(\$4, date\)::date AS period_start`).
+		WithArgs(7, start, end, "week").
+		WillReturnRows(sqlmock.NewRows(nutritionPeriodColumns).
+			AddRow(time.Date(2025, 2, 24, 0, 0, 0, 0, time.UTC), 5, 9500.0, 610.0, 980.0, 320.0, 6, 1, 2).
+			AddRow(time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), 3, 5400.0, 390.0, 510.0, 190.0, 4, 1, 0))
+	mock.ExpectQuery(`SELECT date_trunc\(\$5, \(started_at AT TIME ZONE 'UTC' AT TIME ZONE \$2\)::date\)::date AS period_start`).
+		WithArgs(7, "America/Los_Angeles", start, end, "week").
+		WillReturnRows(sqlmock.NewRows([]string{"period_start", "calories"}).
+			AddRow(time.Date(2025, 2, 24, 0, 0, 0, 0, time.UTC), 1450.0))
+	mock.ExpectQuery(`WITH consumed AS .+ SELECT date_trunc\(\$4, date\)::date AS period_start`).
+		WithArgs(7, start, end, "day").
+		WillReturnRows(sqlmock.NewRows(nutritionPeriodColumns))
+
+	// Execute
+	resp, err := service.NutritionReport(context.Background(), &proto.NutritionReportRequest{
+		UserId:      7,
+		Granularity: "week",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Equal(t, "America/Los_Angeles", resp.Timezone)
+	assert.Equal(t, "2025-02-17", resp.StartDate)
+	assert.Equal(t, "2025-03-12", resp.EndDate)
+	require.Len(t, resp.Periods, 4)
+
+	assert.Equal(t, "2025-02-17", resp.Periods[0].PeriodStart)
+	assert.Equal(t, "2025-02-23", resp.Periods[0].PeriodEnd)
+	assert.Equal(t, 0.0, resp.Periods[0].Calories)
+
+	assert.Equal(t, int32(5), resp.Periods[1].DaysLogged)
+	assert.Equal(t, 9500.0, resp.Periods[1].Calories)
+	assert.Equal(t, 1450.0, resp.Periods[1].CaloriesBurned)
+	assert.Equal(t, 8050.0, resp.Periods[1].NetCalories)
+	assert.Equal(t, int32(6), resp.Periods[1].NonInflammatoryFoods)
+
+	// The current week is clipped to the user's today
+	assert.Equal(t, "2025-03-10", resp.Periods[3].PeriodStart)
+	assert.Equal(t, "2025-03-12", resp.Periods[3].PeriodEnd)
+	assert.Equal(t, int32(1), resp.Periods[3].ProbioticFoods)
+
+	// Without sex and birth date there is nothing to compare against
+	assert.Empty(t, resp.NutrientGaps)
+	assert.Equal(t, []string{"Nutrient gap analysis skipped: incomplete profile: birth_date, sex required"}, resp.Notes)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNutritionService_NutritionReport_NutrientGaps(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewNutritionService(meals.NewRepository(db), users.NewRepository(db), workouts.NewRepository(db))
+	service.now = func() time.Time { return time.Date(2025, 3, 12, 18, 0, 0, 0, time.UTC) }
+
+	start := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 3, 12, 0, 0, 0, 0, time.UTC)
+	columns := append(nutritionPeriodColumns,
+		"fiber_grams", "sugar_grams", "sodium_mg", "potassium_mg", "iron_mg",
+		"calcium_mg", "vitamin_d_mcg", "omega3_grams", "untracked_items")
+
+	// Setup mock expectations
+	mock.ExpectQuery(`FROM USERS\s+WHERE id = \$1`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "full_name", "email", "sex", "timezone", "birth_date", "created_at", "updated_at"}).
+			AddRow(7, "Jane Doe", "jane@example.com", "FEMALE", "UTC", time.Date(1990, 6, 2, 0, 0, 0, 0, time.UTC), time.Now(), time.Now()))
+	mock.ExpectQuery(`WITH consumed AS .+ SELECT date_trunc\(\$4, date\)::date AS period_start`).
+		WithArgs(7, start, end, "day").
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(start, 1, 1900.0, 120.0, 200.0, 60.0, 5, 1, 2, 28.0, 40.0, 2100.0, 2900.0, 19.0, 1100.0, 16.0, 1.4, 0).
+			AddRow(start.AddDate(0, 0, 2), 1, 1700.0, 100.0, 180.0, 55.0, 3, 0, 1, 14.0, 35.0, 2600.0, 2700.0, 11.0, 700.0, 4.0, 0.6, 1))
+	mock.ExpectQuery(`FROM WORKOUT_SESSIONS`).
+		WithArgs(7, "UTC", start, end, "day").
+		WillReturnRows(sqlmock.NewRows([]string{"period_start", "calories"}))
+
+	// Execute
+	resp, err := service.NutritionReport(context.Background(), &proto.NutritionReportRequest{
+		UserId:    7,
+		StartDate: "2025-03-10",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	require.Len(t, resp.Periods, 3)
+	assert.Equal(t, 19.0, resp.Periods[0].IronMg)
+	assert.Equal(t, int32(1), resp.Periods[2].UntrackedItems)
+
+	gaps := map[string]*proto.NutrientGap{}
+	for _, gap := range resp.NutrientGaps {
+		gaps[gap.Nutrient] = gap
+	}
+	// Iron for a 34 year old woman is 18 mg; the day without entries is not flagged
+	assert.Equal(t, 18.0, gaps["iron"].ReferenceIntake)
+	assert.Equal(t, 15.0, gaps["iron"].AverageIntake)
+	assert.Equal(t, []string{"2025-03-12"}, gaps["iron"].FlaggedDates)
+	assert.Equal(t, []string{"2025-03-12"}, gaps["calcium"].FlaggedDates)
+	assert.Equal(t, "LIMIT", gaps["sodium"].Kind)
+	assert.Equal(t, []string{"2025-03-12"}, gaps["sodium"].FlaggedDates)
+	assert.Empty(t, gaps["potassium"].FlaggedDates)
+	assert.Equal(t, []string{"1 of 2 logged days include meals or foods without micronutrient data and may be flagged low"}, resp.Notes)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNutritionService_NutritionReport_ConvertsIngredientUnits(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewNutritionService(meals.NewRepository(db), users.NewRepository(db), workouts.NewRepository(db))
+	service.now = func() time.Time { return time.Date(2025, 3, 12, 18, 0, 0, 0, time.UTC) }
+
+	day := time.Date(2025, 3, 12, 0, 0, 0, 0, time.UTC)
+	columns := append(nutritionPeriodColumns, "untracked_items", "unconverted_ingredients")
+
+	// Setup mock expectations
+	mock.ExpectQuery(`FROM USERS\s+WHERE id = \$1`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "full_name", "email", "timezone", "created_at", "updated_at"}).
+			AddRow(7, "Jane Doe", "jane@example.com", "UTC", time.Now(), time.Now()))
+	// Ingredient quantities are converted to the food's serving unit with the
+	// units package factors (170 GRAMS of a per-OUNCE food is about 6 servings);
+	// units of another dimension leave servings NULL and are counted instead
+	mock.ExpectQuery(`WITH consumed AS .+ um.servings / m.servings \* mi.servings \* f.calories AS calories`+
+		`.+ SELECT mi.id, mi.meal_id, mi.food_id, mi.quantity \* iu.size / fu.size AS servings`+
+		`.+ JOIN \(VALUES \('GRAMS', 'mass', 1\), \('OUNCES', 'mass', 28.3495\), .+\('PIECES', 'count', 1\)\) AS iu\(unit, dimension, size\) ON iu.unit = mi.unit::text`+
+		` LEFT JOIN .+ AS fu\(unit, dimension, size\) ON fu.unit = f.serving_units::text AND fu.dimension = iu.dimension`+
+		`.+ AND mi.servings IS NOT NULL`+
+		`.+ AND mi.servings IS NULL`+
+		`.+ COUNT\(\*\) FILTER \(WHERE unconverted\) AS unconverted_ingredients`).
+		WithArgs(7, day, day, "day").
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(day, 1, 300.0, 42.0, 0.0, 12.0, 1, 0, 0, 0, 1))
+	mock.ExpectQuery(`FROM WORKOUT_SESSIONS`).
+		WithArgs(7, "UTC", day, day, "day").
+		WillReturnRows(sqlmock.NewRows([]string{"period_start", "calories"}))
+
+	// Execute
+	resp, err := service.NutritionReport(context.Background(), &proto.NutritionReportRequest{
+		UserId:    7,
+		StartDate: "2025-03-12",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	require.Len(t, resp.Periods, 1)
+	assert.Equal(t, 300.0, resp.Periods[0].Calories)
+	assert.Equal(t, int32(1), resp.Periods[0].UnconvertedIngredients)
+	assert.Contains(t, resp.Notes, "1 meal ingredients use a unit that does not convert to their food's serving unit and were left out of the totals")
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNutritionService_NutritionReport_MatchesDiary(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	mealRepo := meals.NewRepository(db)
+	diary := NewDiaryService(mealRepo)
+	service := NewNutritionService(mealRepo, users.NewRepository(db), workouts.NewRepository(db))
+	service.now = func() time.Time { return time.Date(2025, 3, 12, 18, 0, 0, 0, time.UTC) }
+
+	day := time.Date(2025, 3, 12, 0, 0, 0, 0, time.UTC)
+	now := time.Now()
+	columns := append(nutritionPeriodColumns, "untracked_items", "unconverted_ingredients")
+
+	// Setup mock expectations
+	// The diary totals a meal from its converted ingredients, falling back to
+	// the stored MEALS totals only when it has none, the same way the report
+	// breaks meals down, so a stale stored total cannot split the two
+	mock.ExpectQuery(`COALESCE\(mn.calories \* um.servings, f.calories \* um.servings, um.quick_calories, 0\) AS calories`+
+		`.+ LEFT JOIN MEALS m ON m.id = um.meal_id LEFT JOIN LATERAL \(`+
+		` SELECT CASE WHEN COUNT\(mi.id\) = 0 THEN m.total_calories ELSE COALESCE\(SUM\(mi.servings \* f.calories\), 0\) / m.servings END AS calories`+
+		`.+ SELECT mi.id, mi.meal_id, mi.food_id, mi.quantity \* iu.size / fu.size AS servings`+
+		`.+ WHERE mi.meal_id = m.id \) mn ON true .+ WHERE um.user_id = \$1 AND um.date = \$2`).
+		WithArgs(7, day).
+		WillReturnRows(sqlmock.NewRows(diaryEntryColumns).
+			AddRow(1, 7, 3, nil, day, 1, 1.5, nil, nil, "Overnight Oats", 630.0, 27.0, 90.0, 18.0, now, now).
+			AddRow(2, 7, nil, nil, day, 4, 1.0, 250.0, "Office cake", "Office cake", 250.0, 0.0, 0.0, 0.0, now, now))
+	mock.ExpectQuery(`FROM USERS\s+WHERE id = \$1`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "full_name", "email", "timezone", "created_at", "updated_at"}).
+			AddRow(7, "Jane Doe", "jane@example.com", "UTC", time.Now(), time.Now()))
+	mock.ExpectQuery(`WITH consumed AS .+ um.servings / m.servings \* mi.servings \* f.calories AS calories`+
+		`.+ SELECT mi.id, mi.meal_id, mi.food_id, mi.quantity \* iu.size / fu.size AS servings`+
+		`.+ um.servings \* COALESCE\(mn.calories, 0\)`+
+		`.+ LEFT JOIN LATERAL \( SELECT CASE WHEN COUNT\(mi.id\) = 0 THEN m.total_calories`).
+		WithArgs(7, day, day, "day").
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(day, 2, 880.0, 27.0, 0.0, 18.0, 1, 0, 0, 1, 0))
+	mock.ExpectQuery(`FROM WORKOUT_SESSIONS`).
+		WithArgs(7, "UTC", day, day, "day").
+		WillReturnRows(sqlmock.NewRows([]string{"period_start", "calories"}))
+
+	// Execute
+	entries, err := diary.ListDiaryEntries(context.Background(), &proto.ListDiaryEntriesRequest{
+		UserId: 7,
+		Date:   "2025-03-12",
+	})
+	require.NoError(t, err)
+	report, err := service.NutritionReport(context.Background(), &proto.NutritionReportRequest{
+		UserId:    7,
+		StartDate: "2025-03-12",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, entries.Error)
+	assert.Empty(t, report.Error)
+	require.Len(t, report.Periods, 1)
+	var diaryCalories float64
+	for _, entry := range entries.Entries {
+		diaryCalories += entry.Calories
+	}
+	assert.Equal(t, diaryCalories, report.Periods[0].Calories)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNutritionService_NutritionReport_InvalidGranularity(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewNutritionService(meals.NewRepository(db), users.NewRepository(db), workouts.NewRepository(db))
+
+	resp, err := service.NutritionReport(context.Background(), &proto.NutritionReportRequest{
+		UserId:      7,
+		Granularity: "year",
+	})
+
+	assert.NoError(t, err)
+	assert.Contains(t, resp.Error, "invalid granularity")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNutritionService_HealthScores(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewNutritionService(meals.NewRepository(db), users.NewRepository(db), workouts.NewRepository(db))
+	service.now = func() time.Time { return time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC) }
+
+	start := time.Date(2025, 2, 16, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)
+	first := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	second := time.Date(2025, 3, 8, 0, 0, 0, 0, time.UTC)
+
+	// Setup mock expectations
+	mock.ExpectQuery(`SELECT timezone FROM USERS WHERE id = \$1`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"timezone"}).AddRow("UTC"))
+	mock.ExpectQuery(`WITH consumed AS .+ FROM consumed\s+WHERE food_id IS NOT NULL OR unconverted`).
+		WithArgs(7, start, end).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"date", "meal_number", "category", "servings", "calories",
+			"is_non_inflammatory", "is_probiotic", "is_prebiotic", "unconverted",
+		}).
+			AddRow(first, 1, "DAIRY", 1.0, 130.0, true, true, false, false).
+			AddRow(first, 1, "GRAIN", 1.0, 220.0, true, false, false, false).
+			AddRow(first, 2, "MEAT", 4.0, 400.0, false, false, false, false).
+			AddRow(first, 2, "NIGHTSHADES", 1.0, 50.0, true, false, false, false).
+			AddRow(first, 2, "", 0.0, 0.0, false, false, false, true).
+			AddRow(second, 1, "FISH", 4.0, 620.0, true, false, false, false).
+			AddRow(second, 1, "VEGETABLE", 2.0, 50.0, true, false, true, false).
+			AddRow(second, 1, "DAIRY", 1.0, 130.0, true, true, false, false))
+
+	// Execute
+	resp, err := service.HealthScores(context.Background(), &proto.HealthScoresRequest{UserId: 7})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Equal(t, "2025-02-16", resp.StartDate)
+	assert.Equal(t, "2025-03-15", resp.EndDate)
+	require.Len(t, resp.Days, 2)
+
+	// Meat and nightshades are 450 of 800 calories
+	assert.Equal(t, "2025-03-01", resp.Days[0].Date)
+	assert.Equal(t, 56.0, resp.Days[0].InflammationScore)
+	assert.Equal(t, 50.0, resp.Days[0].GutHealthScore)
+	require.Len(t, resp.Days[0].Meals, 2)
+	assert.Equal(t, int32(1), resp.Days[0].Meals[0].MealNumber)
+	assert.Equal(t, 0.0, resp.Days[0].Meals[0].InflammationScore)
+	assert.Equal(t, 100.0, resp.Days[0].Meals[1].InflammationScore)
+
+	assert.Equal(t, 0.0, resp.Days[1].InflammationScore)
+	assert.Equal(t, 100.0, resp.Days[1].GutHealthScore)
+	assert.Equal(t, 2.0, resp.Days[1].PrebioticServings)
+
+	assert.Equal(t, 28.0, resp.AverageInflammationScore)
+	assert.Equal(t, 75.0, resp.AverageGutHealthScore)
+	assert.Equal(t, "improving", resp.InflammationTrend.Direction)
+	assert.Equal(t, -56.0, resp.InflammationTrend.PointsPerWeek)
+	assert.Equal(t, "improving", resp.GutHealthTrend.Direction)
+
+	// The ingredient whose unit does not convert is left out of the scores and noted
+	assert.Equal(t, []string{"1 meal ingredients use a unit that does not convert to their food's serving unit and were left out of the scores"}, resp.Notes)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReportRange(t *testing.T) {
+	today := time.Date(2025, 3, 12, 0, 0, 0, 0, time.UTC) // Wednesday
+
+	tests := []struct {
+		name        string
+		startDate   string
+		endDate     string
+		granularity string
+		wantStart   string
+		wantEnd     string
+		wantErr     string
+	}{
+		{name: "default days", granularity: "day", wantStart: "2025-03-06", wantEnd: "2025-03-12"},
+		{name: "default weeks", granularity: "week", wantStart: "2025-02-17", wantEnd: "2025-03-12"},
+		{name: "default months", granularity: "month", wantStart: "2025-01-01", wantEnd: "2025-03-12"},
+		{name: "explicit range", startDate: "2025-01-05", endDate: "2025-01-20", granularity: "day", wantStart: "2025-01-05", wantEnd: "2025-01-20"},
+		{name: "reversed range", startDate: "2025-02-01", endDate: "2025-01-01", granularity: "day", wantErr: "start_date is after end_date"},
+		{name: "too long", startDate: "2020-01-01", granularity: "month", wantErr: "must not exceed"},
+		{name: "bad date", endDate: "tomorrow", granularity: "day", wantErr: "invalid date"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, err := reportRange(tt.startDate, tt.endDate, tt.granularity, today)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStart, start.Format(dateLayout))
+			assert.Equal(t, tt.wantEnd, end.Format(dateLayout))
+		})
+	}
+}
+
+func TestNutritionService_GetNutritionTargets(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewNutritionService(meals.NewRepository(db), users.NewRepository(db), workouts.NewRepository(db))
+	// The user turns 35 tomorrow
+	service.now = func() time.Time { return time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC) }
+
+	now := time.Now()
+
+	// Setup mock expectations
+	mock.ExpectQuery(`SELECT id, full_name, email, .+ FROM USERS\s+WHERE id = \$1`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "full_name", "email", "sex", "timezone", "height_cm", "weight_kg",
+			"birth_date", "activity_level", "created_at", "updated_at",
+		}).AddRow(
+			7, "Jane Doe", "jane@example.com", "FEMALE", "UTC", 165.0, 60.0,
+			time.Date(1990, 6, 2, 0, 0, 0, 0, time.UTC), "LIGHT", now, now,
+		))
+	mock.ExpectQuery(`SELECT g.id, g.category, g.name, g.description FROM USER_GOALS ug JOIN GOALS g`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "category", "name", "description"}).
+			AddRow(1, "Weight", "Lose", nil).
+			AddRow(7, "Strength", "Gain", nil))
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT 1 FROM CYCLE_TRACKING WHERE user_id = \$1\)`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+	// Execute
+	resp, err := service.GetNutritionTargets(context.Background(), &proto.GetNutritionTargetsRequest{UserId: 7})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	require.NotNil(t, resp.Targets)
+	assert.Equal(t, 1300.0, resp.Targets.Bmr)
+	assert.Equal(t, 1430.0, resp.Targets.Calories)
+	assert.Equal(t, -20.0, resp.Targets.CalorieAdjustmentPercent)
+	assert.Equal(t, 132.0, resp.Targets.ProteinGrams)
+	assert.Equal(t, "LIGHT", resp.Targets.ActivityLevel)
+	assert.Equal(t, []string{"Weight/Lose", "Strength/Gain"}, resp.Targets.Goals)
+	assert.Empty(t, resp.Targets.CyclePhase)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNutritionService_GetNutritionTargets_CyclePhase(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewNutritionService(meals.NewRepository(db), users.NewRepository(db), workouts.NewRepository(db))
+	service.now = func() time.Time { return time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC) }
+
+	now := time.Now()
+
+	// Setup mock expectations
+	mock.ExpectQuery(`SELECT id, full_name, email, .+ FROM USERS\s+WHERE id = \$1`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "full_name", "email", "sex", "timezone", "height_cm", "weight_kg",
+			"birth_date", "activity_level", "created_at", "updated_at",
+		}).AddRow(
+			7, "Jane Doe", "jane@example.com", "FEMALE", "UTC", 165.0, 60.0,
+			time.Date(1990, 6, 2, 0, 0, 0, 0, time.UTC), "LIGHT", now, now,
+		))
+	mock.ExpectQuery(`FROM USER_GOALS`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "category", "name", "description"}).
+			AddRow(1, "Weight", "Lose", nil).
+			AddRow(7, "Strength", "Gain", nil))
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT 1 FROM CYCLE_TRACKING WHERE user_id = \$1\)`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	// June 1st is day 21 of a 28 day cycle
+	mock.ExpectQuery(`FROM CYCLE_LOGS\s+WHERE user_id = \$1 AND period_started AND date <= \$2`).
+		WithArgs(7, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), 7).
+		WillReturnRows(sqlmock.NewRows([]string{"date"}).
+			AddRow(time.Date(2025, 4, 14, 0, 0, 0, 0, time.UTC)).
+			AddRow(time.Date(2025, 5, 12, 0, 0, 0, 0, time.UTC)))
+
+	// Execute
+	resp, err := service.GetNutritionTargets(context.Background(), &proto.GetNutritionTargetsRequest{UserId: 7})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	require.NotNil(t, resp.Targets)
+	assert.Equal(t, "LUTEAL", resp.Targets.CyclePhase)
+	assert.Equal(t, 1502.0, resp.Targets.Calories)
+	assert.Equal(t, 132.0, resp.Targets.ProteinGrams)
+	assert.Contains(t, resp.Targets.Notes[len(resp.Targets.Notes)-1], "Luteal phase")
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNutritionService_GetNutritionTargets_CyclePhaseIneligibleProfile(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewNutritionService(meals.NewRepository(db), users.NewRepository(db), workouts.NewRepository(db))
+	service.now = func() time.Time { return time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC) }
+
+	now := time.Now()
+
+	// Setup mock expectations
+	// The user opted in to cycle tracking before setting their profile sex to MALE
+	mock.ExpectQuery(`SELECT id, full_name, email, .+ FROM USERS\s+WHERE id = \$1`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "full_name", "email", "sex", "timezone", "height_cm", "weight_kg",
+			"birth_date", "activity_level", "created_at", "updated_at",
+		}).AddRow(
+			7, "John Doe", "john@example.com", "MALE", "UTC", 180.0, 80.0,
+			time.Date(1990, 6, 2, 0, 0, 0, 0, time.UTC), "LIGHT", now, now,
+		))
+	mock.ExpectQuery(`FROM USER_GOALS`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "category", "name", "description"}))
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT 1 FROM CYCLE_TRACKING WHERE user_id = \$1\)`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	// Execute
+	resp, err := service.GetNutritionTargets(context.Background(), &proto.GetNutritionTargetsRequest{UserId: 7})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	require.NotNil(t, resp.Targets)
+	assert.Empty(t, resp.Targets.CyclePhase)
+	for _, note := range resp.Targets.Notes {
+		assert.NotContains(t, note, "phase")
+	}
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNutritionService_GetNutritionTargets_IncompleteProfile(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewNutritionService(meals.NewRepository(db), users.NewRepository(db), workouts.NewRepository(db))
+	now := time.Now()
+
+	mock.ExpectQuery(`FROM USERS\s+WHERE id = \$1`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "full_name", "email", "sex", "created_at", "updated_at"}).
+			AddRow(7, "Jane Doe", "jane@example.com", "FEMALE", now, now))
+	mock.ExpectQuery(`FROM USER_GOALS`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "category", "name", "description"}))
+
+	resp, err := service.GetNutritionTargets(context.Background(), &proto.GetNutritionTargetsRequest{UserId: 7})
+
+	assert.NoError(t, err)
+	assert.Nil(t, resp.Targets)
+	assert.Equal(t, "incomplete profile: weight_kg, height_cm, birth_date required", resp.Error)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAgeOn(t *testing.T) {
+	birthDate := time.Date(1990, 6, 2, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 34, ageOn(birthDate, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 35, ageOn(birthDate, time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 35, ageOn(birthDate, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+}
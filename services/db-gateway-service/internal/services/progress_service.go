@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"db-gateway-service/internal/weighttrend"
+	"db-gateway-service/proto"
+	checkins "db-gateway-service/sql/check-in-service"
+	meals "db-gateway-service/sql/meal-service"
+)
+
+// Weight progress window limits, in days ending today
+const (
+	defaultProgressDays = 90
+	maxProgressDays     = 365
+)
+
+// ProgressService implements the gRPC ProgressService server
+type ProgressService struct {
+	proto.UnimplementedProgressServiceServer
+	checkInRepo *checkins.Repository
+	mealRepo    *meals.Repository
+	now         func() time.Time
+}
+
+// NewProgressService creates a new ProgressService instance
+func NewProgressService(checkInRepo *checkins.Repository, mealRepo *meals.Repository) *ProgressService {
+	return &ProgressService{
+		checkInRepo: checkInRepo,
+		mealRepo:    mealRepo,
+		now:         time.Now,
+	}
+}
+
+// GetWeightProgress smooths the user's weigh-ins into a trend, reports the
+// weekly rate of change and plateaus, and compares the energy balance the
+// trend implies with calories logged in the diary
+func (s *ProgressService) GetWeightProgress(ctx context.Context, req *proto.GetWeightProgressRequest) (*proto.GetWeightProgressResponse, error) {
+	log.Printf("GetWeightProgress called for user ID: %d, days: %d", req.UserId, req.Days)
+
+	if req.UserId == 0 {
+		return &proto.GetWeightProgressResponse{Error: "user_id is required"}, nil
+	}
+
+	days := int(req.Days)
+	if days == 0 {
+		days = defaultProgressDays
+	}
+	if days < 1 || days > maxProgressDays {
+		return &proto.GetWeightProgressResponse{
+			Error: fmt.Sprintf("invalid days: must be between 1 and %d", maxProgressDays),
+		}, nil
+	}
+
+	timezone, err := s.mealRepo.GetUserTimezone(int(req.UserId))
+	if err != nil {
+		log.Printf("Failed to get user timezone: %v", err)
+		return &proto.GetWeightProgressResponse{
+			Error: fmt.Sprintf("Failed to get weight progress: %v", err),
+		}, nil
+	}
+	timezone = userLocation(timezone).String()
+
+	end := userToday(s.now(), timezone)
+	start := end.AddDate(0, 0, -(days - 1))
+
+	weights, err := s.checkInRepo.DailyWeights(int(req.UserId), timezone, start, end)
+	if err != nil {
+		log.Printf("Failed to get daily weights: %v", err)
+		return &proto.GetWeightProgressResponse{
+			Error: fmt.Sprintf("Failed to get weight progress: %v", err),
+		}, nil
+	}
+
+	intakeRows, err := s.mealRepo.NutritionByPeriod(int(req.UserId), start, end, "day")
+	if err != nil {
+		log.Printf("Failed to aggregate nutrition: %v", err)
+		return &proto.GetWeightProgressResponse{
+			Error: fmt.Sprintf("Failed to get weight progress: %v", err),
+		}, nil
+	}
+
+	weighIns := make([]weighttrend.WeighIn, len(weights))
+	for i, w := range weights {
+		weighIns[i] = weighttrend.WeighIn{Date: w.Date, WeightKg: w.WeightKg}
+	}
+	intake := make([]weighttrend.Intake, len(intakeRows))
+	unconverted := 0
+	for i, row := range intakeRows {
+		intake[i] = weighttrend.Intake{Date: row.PeriodStart, Calories: row.Calories}
+		unconverted += row.UnconvertedIngredients
+	}
+
+	analysis := weighttrend.Analyze(weighIns, intake)
+	if unconverted > 0 {
+		analysis.Notes = append(analysis.Notes, unconvertedNote(unconverted, "the logged intake"))
+	}
+
+	return &proto.GetWeightProgressResponse{
+		Progress: convertToProtoWeightProgress(analysis, start, end, timezone),
+	}, nil
+}
+
+// convertToProtoWeightProgress converts a trend analysis to its proto message
+func convertToProtoWeightProgress(analysis weighttrend.Analysis, start, end time.Time, timezone string) *proto.WeightProgress {
+	progress := &proto.WeightProgress{
+		StartDate:      start.Format(dateLayout),
+		EndDate:        end.Format(dateLayout),
+		Timezone:       timezone,
+		WeighIns:       int32(len(analysis.Points)),
+		LatestWeightKg: analysis.LatestWeightKg,
+		TrendWeightKg:  analysis.TrendWeightKg,
+		WeeklyRateKg:   analysis.WeeklyRateKg,
+		Plateau:        analysis.Plateau,
+		PlateauDays:    int32(analysis.PlateauDays),
+		Points:         make([]*proto.WeightTrendPoint, len(analysis.Points)),
+		Notes:          analysis.Notes,
+	}
+
+	for i, p := range analysis.Points {
+		progress.Points[i] = &proto.WeightTrendPoint{
+			Date:     p.Date.Format(dateLayout),
+			WeightKg: math.Round(p.WeightKg*100) / 100,
+			TrendKg:  math.Round(p.TrendKg*100) / 100,
+		}
+	}
+
+	if balance := analysis.EnergyBalance; balance != nil {
+		progress.EnergyBalance = &proto.EnergyBalance{
+			StartDate:                    balance.StartDate.Format(dateLayout),
+			EndDate:                      balance.EndDate.Format(dateLayout),
+			IntakeDays:                   int32(balance.IntakeDays),
+			AverageIntakeCalories:        balance.AverageIntakeCalories,
+			DailyBalanceCalories:         balance.DailyBalanceCalories,
+			EstimatedMaintenanceCalories: balance.EstimatedMaintenanceCalories,
+		}
+	}
+
+	return progress
+}
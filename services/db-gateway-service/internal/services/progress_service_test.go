@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"db-gateway-service/proto"
+	checkins "db-gateway-service/sql/check-in-service"
+	meals "db-gateway-service/sql/meal-service"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgressService_GetWeightProgress(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewProgressService(checkins.NewRepository(db), meals.NewRepository(db))
+	// March 29th at 03:00 UTC is still March 28th in Chicago
+	service.now = func() time.Time { return time.Date(2025, 3, 29, 3, 0, 0, 0, time.UTC) }
+
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 3, 28, 0, 0, 0, 0, time.UTC)
+
+	weights := sqlmock.NewRows([]string{"date", "weight_kg"})
+	intake := sqlmock.NewRows(nutritionPeriodColumns)
+	for i := 0; i < 28; i++ {
+		day := start.AddDate(0, 0, i)
+		weights.AddRow(day, 80-0.1*float64(i))
+		intake.AddRow(day, 1, 1800.0, 120.0, 180.0, 60.0, 0, 0, 0)
+	}
+
+	// Setup mock expectations
+	mock.ExpectQuery(`SELECT timezone FROM USERS WHERE id = \$1`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"timezone"}).AddRow("America/Chicago"))
+	mock.ExpectQuery(`FROM CHECK_INS\s+WHERE user_id = \$1\s+AND weight_kg IS NOT NULL`).
+		WithArgs(7, "America/Chicago", start, end).
+		WillReturnRows(weights)
+	mock.ExpectQuery(`WITH consumed AS .+ SELECT date_trunc\(\$4, date\)::date AS period_start`).
+		WithArgs(7, start, end, "day").
+		WillReturnRows(intake)
+
+	// Execute
+	resp, err := service.GetWeightProgress(context.Background(), &proto.GetWeightProgressRequest{
+		UserId: 7,
+		Days:   28,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	progress := resp.Progress
+	assert.Equal(t, "2025-03-01", progress.StartDate)
+	assert.Equal(t, "2025-03-28", progress.EndDate)
+	assert.Equal(t, "America/Chicago", progress.Timezone)
+	assert.Equal(t, int32(28), progress.WeighIns)
+	assert.Equal(t, 77.3, progress.LatestWeightKg)
+	require.NotNil(t, progress.WeeklyRateKg)
+	assert.Less(t, *progress.WeeklyRateKg, 0.0)
+	assert.False(t, progress.Plateau)
+	require.Len(t, progress.Points, 28)
+	assert.Equal(t, "2025-03-28", progress.Points[27].Date)
+
+	require.NotNil(t, progress.EnergyBalance)
+	assert.Equal(t, "2025-03-15", progress.EnergyBalance.StartDate)
+	assert.Equal(t, int32(14), progress.EnergyBalance.IntakeDays)
+	assert.Equal(t, 1800.0, progress.EnergyBalance.AverageIntakeCalories)
+	assert.Greater(t, progress.EnergyBalance.EstimatedMaintenanceCalories, 1800.0)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestProgressService_GetWeightProgress_InvalidDays(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewProgressService(checkins.NewRepository(db), meals.NewRepository(db))
+
+	resp, err := service.GetWeightProgress(context.Background(), &proto.GetWeightProgressRequest{
+		UserId: 7,
+		Days:   400,
+	})
+
+	require.NoError(t, err)
+	assert.Contains(t, resp.Error, "invalid days")
+	assert.Nil(t, resp.Progress)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
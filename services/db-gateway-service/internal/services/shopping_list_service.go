@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"db-gateway-service/internal/shopping"
+	"db-gateway-service/proto"
+	meals "db-gateway-service/sql/meal-service"
+)
+
+// ShoppingListService implements the gRPC ShoppingListService server
+type ShoppingListService struct {
+	proto.UnimplementedShoppingListServiceServer
+	repo *meals.Repository
+	now  func() time.Time
+}
+
+// NewShoppingListService creates a new ShoppingListService instance
+func NewShoppingListService(repo *meals.Repository) *ShoppingListService {
+	return &ShoppingListService{repo: repo, now: time.Now}
+}
+
+// GetShoppingList builds one grocery list from the user's planned meals in a
+// date range, optionally rendered as Markdown or plain text
+func (s *ShoppingListService) GetShoppingList(ctx context.Context, req *proto.GetShoppingListRequest) (*proto.ShoppingListResponse, error) {
+	log.Printf("GetShoppingList called for user ID: %d", req.UserId)
+
+	if req.UserId == 0 {
+		return &proto.ShoppingListResponse{Error: "user_id is required"}, nil
+	}
+
+	format := req.Format
+	if format == "" {
+		format = shopping.FormatJSON
+	}
+	if err := shopping.ValidateFormat(format); err != nil {
+		return &proto.ShoppingListResponse{Error: err.Error()}, nil
+	}
+
+	start, end, err := resolvePlanRange(s.repo, s.now(), int(req.UserId), req.StartDate, req.EndDate)
+	if err != nil {
+		return &proto.ShoppingListResponse{Error: err.Error()}, nil
+	}
+
+	rows, err := s.repo.ListShoppingLines(int(req.UserId), start, end)
+	if err != nil {
+		log.Printf("Failed to list shopping lines: %v", err)
+		return &proto.ShoppingListResponse{
+			Error: fmt.Sprintf("Failed to get shopping list: %v", err),
+		}, nil
+	}
+
+	missing, err := s.repo.ListPlannedMealsWithoutIngredients(int(req.UserId), start, end)
+	if err != nil {
+		log.Printf("Failed to list meals without ingredients: %v", err)
+		return &proto.ShoppingListResponse{
+			Error: fmt.Sprintf("Failed to get shopping list: %v", err),
+		}, nil
+	}
+
+	lines := make([]shopping.Line, len(rows))
+	for i, row := range rows {
+		lines[i] = shopping.Line{
+			FoodID:   row.FoodID,
+			Name:     row.Name,
+			Category: row.Category,
+			Quantity: row.Quantity,
+			Unit:     row.Unit,
+		}
+	}
+
+	list := shopping.Build(lines)
+	for _, name := range missing {
+		list.Notes = append(list.Notes, fmt.Sprintf("%s has no ingredients and was left out", name))
+	}
+
+	resp := &proto.ShoppingListResponse{List: convertShoppingListToProto(list, start, end)}
+
+	title := fmt.Sprintf("Shopping List: %s to %s", start.Format(dateLayout), end.Format(dateLayout))
+	switch format {
+	case shopping.FormatMarkdown:
+		resp.Document = shopping.Markdown(list, title)
+	case shopping.FormatText:
+		resp.Document = shopping.Text(list, title)
+	}
+
+	return resp, nil
+}
+
+// convertShoppingListToProto converts a shopping list to its proto message
+func convertShoppingListToProto(list shopping.List, start, end time.Time) *proto.ShoppingList {
+	p := &proto.ShoppingList{
+		StartDate: start.Format(dateLayout),
+		EndDate:   end.Format(dateLayout),
+		Aisles:    make([]*proto.ShoppingAisle, len(list.Aisles)),
+		Notes:     list.Notes,
+	}
+	for i, aisle := range list.Aisles {
+		items := make([]*proto.ShoppingItem, len(aisle.Items))
+		for j, item := range aisle.Items {
+			items[j] = &proto.ShoppingItem{
+				FoodId:   int32(item.FoodID),
+				Name:     item.Name,
+				Quantity: item.Quantity,
+				Unit:     item.Unit,
+			}
+		}
+		p.Aisles[i] = &proto.ShoppingAisle{Category: aisle.Category, Name: aisle.Name, Items: items}
+	}
+	return p
+}
@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"db-gateway-service/proto"
+	meals "db-gateway-service/sql/meal-service"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var shoppingLineColumns = []string{"food_id", "name", "category", "quantity", "unit"}
+
+func TestShoppingListService_GetShoppingList(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewShoppingListService(meals.NewRepository(db))
+
+	start := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 6, 4, 0, 0, 0, 0, time.UTC)
+
+	// Setup mock expectations
+	mock.ExpectQuery(`WITH needed AS .+ JOIN MEAL_INGREDIENTS mi .+ UNION ALL .+ GROUP BY f.id`).
+		WithArgs(7, start, end).
+		WillReturnRows(sqlmock.NewRows(shoppingLineColumns).
+			AddRow(19, "Broccoli", "VEGETABLE", 3.0, "CUPS").
+			AddRow(1, "Salmon - Wild Atlantic", "FISH", 12.0, "OUNCES").
+			AddRow(1, "Salmon - Wild Atlantic", "FISH", 100.0, "GRAMS"))
+	mock.ExpectQuery(`SELECT DISTINCT m.name .+ NOT EXISTS \(SELECT 1 FROM MEAL_INGREDIENTS`).
+		WithArgs(7, start, end).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("Overnight Oats"))
+
+	// Execute
+	resp, err := service.GetShoppingList(context.Background(), &proto.GetShoppingListRequest{
+		UserId:    7,
+		StartDate: "2025-06-02",
+		EndDate:   "2025-06-04",
+		Format:    "markdown",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	require.Len(t, resp.List.Aisles, 2)
+	assert.Equal(t, "Vegetables", resp.List.Aisles[0].Name)
+	assert.Equal(t, "Seafood", resp.List.Aisles[1].Name)
+	assert.Equal(t, 1.0, resp.List.Aisles[1].Items[0].Quantity)
+	assert.Equal(t, "POUNDS", resp.List.Aisles[1].Items[0].Unit)
+	assert.Equal(t, []string{"Overnight Oats has no ingredients and was left out"}, resp.List.Notes)
+	assert.Contains(t, resp.Document, "# Shopping List: 2025-06-02 to 2025-06-04")
+	assert.Contains(t, resp.Document, "- [ ] Broccoli: 3 cups")
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestShoppingListService_Validation(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewShoppingListService(meals.NewRepository(db))
+	ctx := context.Background()
+
+	resp, err := service.GetShoppingList(ctx, &proto.GetShoppingListRequest{UserId: 7, Format: "pdf"})
+	assert.NoError(t, err)
+	assert.Contains(t, resp.Error, `invalid format "pdf"`)
+
+	resp, err = service.GetShoppingList(ctx, &proto.GetShoppingListRequest{UserId: 7, StartDate: "2025-06-09", EndDate: "2025-06-02"})
+	assert.NoError(t, err)
+	assert.Contains(t, resp.Error, "start_date is after end_date")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
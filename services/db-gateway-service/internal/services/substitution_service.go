@@ -0,0 +1,258 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"db-gateway-service/internal/substitutes"
+	"db-gateway-service/proto"
+	meals "db-gateway-service/sql/meal-service"
+)
+
+// SubstitutionService implements the gRPC SubstitutionService server
+type SubstitutionService struct {
+	proto.UnimplementedSubstitutionServiceServer
+	repo  *meals.Repository
+	foods *FoodPreferenceService
+}
+
+// NewSubstitutionService creates a new SubstitutionService instance
+func NewSubstitutionService(repo *meals.Repository) *SubstitutionService {
+	return &SubstitutionService{
+		repo:  repo,
+		foods: NewFoodPreferenceService(repo),
+	}
+}
+
+// SuggestSubstitutes ranks the foods the user may eat that can replace a
+// quantity of a catalog food, closest macros first
+func (s *SubstitutionService) SuggestSubstitutes(ctx context.Context, req *proto.SuggestSubstitutesRequest) (*proto.SuggestSubstitutesResponse, error) {
+	log.Printf("SuggestSubstitutes called for user ID: %d, food ID: %d", req.UserId, req.FoodId)
+
+	if req.UserId == 0 {
+		return &proto.SuggestSubstitutesResponse{Error: "user_id is required"}, nil
+	}
+	if req.FoodId == 0 {
+		return &proto.SuggestSubstitutesResponse{Error: "food_id is required"}, nil
+	}
+
+	original, candidates, err := s.load(int(req.UserId), int(req.FoodId))
+	if err != nil {
+		return &proto.SuggestSubstitutesResponse{Error: err.Error()}, nil
+	}
+
+	quantity := req.Quantity
+	if quantity == 0 {
+		quantity = 1
+	}
+	sized, suggestions, err := substitutes.Rank(original, quantity, req.Unit, candidates, substitutes.Options{
+		NonInflammatoryOnly: req.NonInflammatoryOnly,
+		Limit:               int(req.Limit),
+	})
+	if err != nil {
+		return &proto.SuggestSubstitutesResponse{Error: err.Error()}, nil
+	}
+
+	resp := &proto.SuggestSubstitutesResponse{
+		Original:    convertSuggestionToProto(sized),
+		Substitutes: make([]*proto.SubstituteFood, len(suggestions)),
+	}
+	for i, suggestion := range suggestions {
+		resp.Substitutes[i] = convertSuggestionToProto(suggestion)
+	}
+
+	return resp, nil
+}
+
+// ApplySubstitute swaps the food of a planned or logged single-food entry, or
+// one ingredient of a meal entry, for a substitute sized to the same amount,
+// and returns the meal with new totals. A meal ingredient is replaced in the
+// substitute's serving unit and the meal's stored totals are recomputed; a
+// meal shared with other entries or favorites is copied for this entry first.
+func (s *SubstitutionService) ApplySubstitute(ctx context.Context, req *proto.ApplySubstituteRequest) (*proto.ApplySubstituteResponse, error) {
+	log.Printf("ApplySubstitute called for user ID: %d, entry ID: %d", req.UserId, req.EntryId)
+
+	if req.UserId == 0 {
+		return &proto.ApplySubstituteResponse{Error: "user_id is required"}, nil
+	}
+	if req.EntryId == 0 || req.FoodId == 0 {
+		return &proto.ApplySubstituteResponse{Error: "entry_id and food_id are required"}, nil
+	}
+
+	entry, err := s.repo.GetMealEntry(int(req.UserId), int(req.EntryId))
+	if err != nil {
+		log.Printf("Failed to get meal entry: %v", err)
+		return &proto.ApplySubstituteResponse{
+			Error: fmt.Sprintf("Failed to apply substitute: %v", err),
+		}, nil
+	}
+
+	// A single-food entry is swapped as a whole; a meal entry one ingredient at a time
+	var ingredient *meals.MealIngredient
+	var foodID int
+	var quantity float64
+	var unit string
+	switch {
+	case entry.FoodID != nil:
+		foodID, quantity = *entry.FoodID, entry.Servings
+	case entry.MealID != nil:
+		if req.IngredientId == 0 {
+			return &proto.ApplySubstituteResponse{Error: "ingredient_id is required to swap part of a meal entry"}, nil
+		}
+		ingredient, err = s.repo.GetMealIngredient(*entry.MealID, int(req.IngredientId))
+		if err != nil {
+			log.Printf("Failed to get meal ingredient: %v", err)
+			return &proto.ApplySubstituteResponse{
+				Error: fmt.Sprintf("Failed to apply substitute: %v", err),
+			}, nil
+		}
+		foodID, quantity, unit = ingredient.FoodID, ingredient.Quantity, ingredient.Unit
+	default:
+		return &proto.ApplySubstituteResponse{Error: "invalid entry: quick-add entries cannot be swapped"}, nil
+	}
+
+	original, candidates, err := s.load(int(req.UserId), foodID)
+	if err != nil {
+		return &proto.ApplySubstituteResponse{Error: err.Error()}, nil
+	}
+
+	_, suggestions, err := substitutes.Rank(original, quantity, unit, candidates, substitutes.Options{
+		NonInflammatoryOnly: req.NonInflammatoryOnly,
+		Limit:               -1,
+	})
+	if err != nil {
+		return &proto.ApplySubstituteResponse{Error: err.Error()}, nil
+	}
+
+	var chosen *substitutes.Suggestion
+	for i := range suggestions {
+		if suggestions[i].Food.ID == int(req.FoodId) {
+			chosen = &suggestions[i]
+			break
+		}
+	}
+	if chosen == nil {
+		return &proto.ApplySubstituteResponse{
+			Error: fmt.Sprintf("invalid substitute: food %d cannot replace %s", req.FoodId, original.Name),
+		}, nil
+	}
+
+	if ingredient != nil {
+		// chosen.Servings is already in the substitute's serving unit
+		_, err = s.repo.SwapMealIngredient(int(req.UserId), int(req.EntryId), *entry.MealID, ingredient.ID,
+			chosen.Food.ID, chosen.Servings, chosen.Food.ServingUnits)
+	} else {
+		err = s.repo.SwapMealFood(int(req.UserId), int(req.EntryId), chosen.Food.ID, chosen.Servings)
+	}
+	if err != nil {
+		log.Printf("Failed to swap meal food: %v", err)
+		return &proto.ApplySubstituteResponse{
+			Error: fmt.Sprintf("Failed to apply substitute: %v", err),
+		}, nil
+	}
+
+	entries, err := s.repo.ListMealEntries(int(req.UserId), int(req.EntryId))
+	if err != nil {
+		log.Printf("Failed to list meal entries: %v", err)
+		return &proto.ApplySubstituteResponse{
+			Error: fmt.Sprintf("Failed to apply substitute: %v", err),
+		}, nil
+	}
+
+	return &proto.ApplySubstituteResponse{Meal: buildSwappedMeal(entry, entries)}, nil
+}
+
+// load fetches the original food and the foods the user may eat in its place
+func (s *SubstitutionService) load(userID, foodID int) (substitutes.Food, []substitutes.Food, error) {
+	original, err := s.repo.GetFood(userID, foodID)
+	if err != nil {
+		log.Printf("Failed to get food: %v", err)
+		return substitutes.Food{}, nil, fmt.Errorf("Failed to get food: %v", err)
+	}
+
+	catalog, _, err := s.foods.FoodsForUser(userID, "", false)
+	if err != nil {
+		log.Printf("Failed to list foods for substitutes: %v", err)
+		return substitutes.Food{}, nil, fmt.Errorf("Failed to list substitutes: %v", err)
+	}
+
+	candidates := make([]substitutes.Food, len(catalog))
+	for i := range catalog {
+		candidates[i] = toSubstituteFood(&catalog[i])
+	}
+
+	return toSubstituteFood(original), candidates, nil
+}
+
+// toSubstituteFood converts a catalog food for ranking
+func toSubstituteFood(f *meals.Food) substitutes.Food {
+	return substitutes.Food{
+		ID:           f.ID,
+		Name:         f.FoodName,
+		Category:     f.Category,
+		ServingUnits: f.ServingUnits,
+		PerServing: substitutes.Macros{
+			Calories:     f.Calories,
+			ProteinGrams: f.ProteinGrams,
+			CarbsGrams:   f.CarbsGrams,
+			FatGrams:     f.FatGrams,
+		},
+		NonInflammatory: f.IsNonInflammatory,
+		Liked:           f.Liked,
+	}
+}
+
+// convertSuggestionToProto converts a ranked suggestion to its proto message
+func convertSuggestionToProto(s substitutes.Suggestion) *proto.SubstituteFood {
+	return &proto.SubstituteFood{
+		FoodId:            int32(s.Food.ID),
+		Name:              s.Food.Name,
+		Category:          s.Food.Category,
+		ServingUnits:      s.Food.ServingUnits,
+		Servings:          s.Servings,
+		Calories:          s.Macros.Calories,
+		ProteinGrams:      s.Macros.ProteinGrams,
+		CarbsGrams:        s.Macros.CarbsGrams,
+		FatGrams:          s.Macros.FatGrams,
+		IsNonInflammatory: s.Food.NonInflammatory,
+		Liked:             s.Food.Liked,
+		Distance:          s.Distance,
+		SameCategory:      s.SameCategory,
+	}
+}
+
+// buildSwappedMeal sums the entries of the meal the swapped entry belongs to
+func buildSwappedMeal(entry *meals.DiaryEntry, entries []meals.DiaryEntry) *proto.SwappedMeal {
+	meal := &proto.SwappedMeal{
+		Date:       entry.Date.Format(dateLayout),
+		MealNumber: int32(entry.MealNumber),
+		IsPlanned:  entry.IsPlanned,
+		Foods:      make([]*proto.MealFood, len(entries)),
+	}
+	for i, e := range entries {
+		var foodID, mealID int32
+		if e.FoodID != nil {
+			foodID = int32(*e.FoodID)
+		}
+		if e.MealID != nil {
+			mealID = int32(*e.MealID)
+		}
+		meal.Foods[i] = &proto.MealFood{
+			EntryId:      int32(e.ID),
+			FoodId:       foodID,
+			MealId:       mealID,
+			Name:         e.Name,
+			Servings:     e.Servings,
+			Calories:     e.Calories,
+			ProteinGrams: e.ProteinGrams,
+			CarbsGrams:   e.CarbsGrams,
+			FatGrams:     e.FatGrams,
+		}
+		meal.Calories += e.Calories
+		meal.ProteinGrams += e.ProteinGrams
+		meal.CarbsGrams += e.CarbsGrams
+		meal.FatGrams += e.FatGrams
+	}
+	return meal
+}
@@ -0,0 +1,336 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"db-gateway-service/proto"
+	meals "db-gateway-service/sql/meal-service"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var mealEntryColumns = []string{
+	"id", "user_id", "meal_id", "food_id", "date", "meal_number",
+	"servings", "quick_calories", "description", "is_planned",
+	"name", "calories", "protein_grams", "carbs_grams", "fat_grams",
+	"created_at", "updated_at",
+}
+
+// expectSubstituteCandidates mocks loading the original food and the user's filtered catalog
+func expectSubstituteCandidates(mock sqlmock.Sqlmock, userID, foodID int, original, catalog *sqlmock.Rows) {
+	mock.ExpectQuery(`FROM FOOD_CATALOG f\s+LEFT JOIN FOOD_USER_LIKES l .+ AND f.id = \$2`).
+		WithArgs(userID, foodID).
+		WillReturnRows(original)
+	expectFoodPreferences(mock, userID, sqlmock.NewRows(foodColumns), nil, nil)
+	mock.ExpectQuery(`FROM FOOD_CATALOG f\s+LEFT JOIN FOOD_USER_LIKES l`).
+		WillReturnRows(catalog)
+	mock.ExpectQuery(`SELECT \(SELECT COUNT\(\*\) FROM FOOD_CATALOG f`).
+		WillReturnRows(sqlmock.NewRows([]string{"visible", "total"}).AddRow(4, 4))
+}
+
+func substituteCatalogRows() *sqlmock.Rows {
+	return sqlmock.NewRows(foodColumns).
+		AddRow(1, "Salmon - Wild Atlantic", "FISH", "OUNCES", 155.0, 22.0, 0.0, 7.0, true, false, false, "{FISH}", true).
+		AddRow(2, "Cod Fillet", "FISH", "OUNCES", 70.0, 15.0, 0.0, 0.5, true, false, false, "{FISH}", false).
+		AddRow(31, "Brown Rice - Cooked", "GRAIN", "CUPS", 216.0, 5.0, 45.0, 2.0, true, false, false, "{}", false).
+		AddRow(37, "Lentils - Cooked", "LEGUMES", "CUPS", 230.0, 18.0, 40.0, 0.8, true, false, false, "{}", false)
+}
+
+func TestSubstitutionService_SuggestSubstitutes(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewSubstitutionService(meals.NewRepository(db))
+
+	// Setup mock expectations
+	expectSubstituteCandidates(mock, 7, 5,
+		sqlmock.NewRows(foodColumns).
+			AddRow(5, "Chicken Breast - Skinless", "MEAT", "OUNCES", 140.0, 26.0, 0.0, 3.0, false, false, false, "{}", false),
+		substituteCatalogRows())
+
+	// Execute
+	resp, err := service.SuggestSubstitutes(context.Background(), &proto.SuggestSubstitutesRequest{
+		UserId:   7,
+		FoodId:   5,
+		Quantity: 113.4,
+		Unit:     "GRAMS",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	assert.InDelta(t, 4.0, resp.Original.Servings, 0.001)
+	assert.InDelta(t, 560.0, resp.Original.Calories, 0.5)
+
+	// Rice is not a compatible category for meat
+	require.Len(t, resp.Substitutes, 3)
+	assert.Equal(t, "Salmon - Wild Atlantic", resp.Substitutes[0].Name)
+	assert.Equal(t, 4.0, resp.Substitutes[0].Servings)
+	assert.Equal(t, "Lentils - Cooked", resp.Substitutes[2].Name)
+	assert.Equal(t, 2.5, resp.Substitutes[2].Servings)
+	assert.Less(t, resp.Substitutes[0].Distance, resp.Substitutes[1].Distance)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSubstitutionService_ApplySubstitute_RecomputesMeal(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewSubstitutionService(meals.NewRepository(db))
+
+	day := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	now := time.Now()
+
+	// Setup mock expectations
+	mock.ExpectQuery(`FROM USER_MEALS um .+ WHERE um.id = \$1 AND um.user_id = \$2$`).
+		WithArgs(40, 7).
+		WillReturnRows(sqlmock.NewRows(mealEntryColumns).
+			AddRow(40, 7, nil, 5, day, 1, 4.0, nil, nil, true, "Chicken Breast - Skinless", 560.0, 104.0, 0.0, 12.0, now, now))
+	expectSubstituteCandidates(mock, 7, 5,
+		sqlmock.NewRows(foodColumns).
+			AddRow(5, "Chicken Breast - Skinless", "MEAT", "OUNCES", 140.0, 26.0, 0.0, 3.0, false, false, false, "{}", false),
+		substituteCatalogRows())
+	mock.ExpectExec(`UPDATE USER_MEALS\s+SET food_id = \$1, servings = \$2`).
+		WithArgs(1, 4.0, 40, 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`JOIN USER_MEALS e ON .+ WHERE e.id = \$1 AND e.user_id = \$2`).
+		WithArgs(40, 7).
+		WillReturnRows(sqlmock.NewRows(mealEntryColumns).
+			AddRow(40, 7, nil, 1, day, 1, 4.0, nil, nil, true, "Salmon - Wild Atlantic", 620.0, 88.0, 0.0, 28.0, now, now).
+			AddRow(41, 7, nil, 31, day, 1, 1.0, nil, nil, true, "Brown Rice - Cooked", 216.0, 5.0, 45.0, 2.0, now, now))
+
+	// Execute
+	resp, err := service.ApplySubstitute(context.Background(), &proto.ApplySubstituteRequest{
+		UserId:  7,
+		EntryId: 40,
+		FoodId:  1,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Equal(t, "2025-06-02", resp.Meal.Date)
+	assert.True(t, resp.Meal.IsPlanned)
+	require.Len(t, resp.Meal.Foods, 2)
+	assert.Equal(t, int32(1), resp.Meal.Foods[0].FoodId)
+	assert.Equal(t, 836.0, resp.Meal.Calories)
+	assert.Equal(t, 93.0, resp.Meal.ProteinGrams)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// expectMealIngredientSwap mocks loading ingredient 90 of meal 12, logged by
+// entry 40, as 170 GRAMS of chicken with the fish catalog as candidates
+func expectMealIngredientSwap(mock sqlmock.Sqlmock, day time.Time) {
+	now := time.Now()
+	mock.ExpectQuery(`FROM USER_MEALS um .+ WHERE um.id = \$1 AND um.user_id = \$2$`).
+		WithArgs(40, 7).
+		WillReturnRows(sqlmock.NewRows(mealEntryColumns).
+			AddRow(40, 7, 12, nil, day, 2, 1.0, nil, nil, false, "Chicken Bowl", 600.0, 45.0, 55.0, 18.0, now, now))
+	mock.ExpectQuery(`FROM MEAL_INGREDIENTS mi .+ WHERE mi.id = \$1 AND mi.meal_id = \$2`).
+		WithArgs(90, 12).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "food_id", "name", "quantity", "unit", "notes"}).
+			AddRow(90, 5, "Chicken Breast - Skinless", 170.0, "GRAMS", nil))
+	expectSubstituteCandidates(mock, 7, 5,
+		sqlmock.NewRows(foodColumns).
+			AddRow(5, "Chicken Breast - Skinless", "MEAT", "OUNCES", 140.0, 26.0, 0.0, 3.0, false, false, false, "{}", false),
+		substituteCatalogRows())
+}
+
+// expectMealTotalsRecomputed mocks recomputing the stored totals of a meal
+// from its converted ingredients
+func expectMealTotalsRecomputed(mock sqlmock.Sqlmock, mealID int) {
+	mock.ExpectExec(`UPDATE MEALS SET total_calories = mn.calories, total_protein = mn.protein_grams,` +
+		` total_carbs = mn.carbs_grams, total_fat = mn.fat_grams, updated_at = CURRENT_TIMESTAMP` +
+		` FROM MEALS m LEFT JOIN LATERAL \( SELECT CASE WHEN COUNT\(mi.id\) = 0 THEN m.total_calories` +
+		` ELSE COALESCE\(SUM\(mi.servings \* f.calories\), 0\) / m.servings END AS calories` +
+		`.+ mi.quantity \* iu.size / fu.size AS servings` +
+		`.+ WHERE MEALS.id = m.id AND m.id = \$1`).
+		WithArgs(mealID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func TestSubstitutionService_ApplySubstitute_MealIngredient(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewSubstitutionService(meals.NewRepository(db))
+
+	day := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	now := time.Now()
+
+	// Setup mock expectations
+	expectMealIngredientSwap(mock, day)
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT 1 FROM USER_MEALS WHERE meal_id = \$1 AND id <> \$2\)`).
+		WithArgs(12, 40).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	// The 170 GRAMS are converted into the salmon's OUNCES, rounded to the unit's step
+	mock.ExpectExec(`UPDATE MEAL_INGREDIENTS\s+SET food_id = \$1, quantity = \$2, unit = \$3::serving_unit_type`).
+		WithArgs(1, 6.0, "OUNCES", 90, 12).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectMealTotalsRecomputed(mock, 12)
+	mock.ExpectCommit()
+	mock.ExpectQuery(`JOIN USER_MEALS e ON .+ WHERE e.id = \$1 AND e.user_id = \$2`).
+		WithArgs(40, 7).
+		WillReturnRows(sqlmock.NewRows(mealEntryColumns).
+			AddRow(40, 7, 12, nil, day, 2, 1.0, nil, nil, false, "Chicken Bowl", 690.0, 40.0, 55.0, 40.0, now, now))
+
+	// Execute
+	resp, err := service.ApplySubstitute(context.Background(), &proto.ApplySubstituteRequest{
+		UserId:       7,
+		EntryId:      40,
+		FoodId:       1,
+		IngredientId: 90,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	require.Len(t, resp.Meal.Foods, 1)
+	assert.Equal(t, int32(12), resp.Meal.Foods[0].MealId)
+	assert.Equal(t, 690.0, resp.Meal.Calories)
+	assert.Equal(t, 40.0, resp.Meal.ProteinGrams)
+	assert.Equal(t, 40.0, resp.Meal.FatGrams)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSubstitutionService_ApplySubstitute_CopiesSharedMeal(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewSubstitutionService(meals.NewRepository(db))
+
+	day := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	now := time.Now()
+
+	// Setup mock expectations
+	expectMealIngredientSwap(mock, day)
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT 1 FROM USER_MEALS WHERE meal_id = \$1 AND id <> \$2\)`).
+		WithArgs(12, 40).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery(`INSERT INTO MEALS .+ FROM MEALS\s+WHERE id = \$1\s+RETURNING id`).
+		WithArgs(12).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(55))
+	mock.ExpectExec(`INSERT INTO MEAL_INGREDIENTS .+ CASE WHEN mi.id = \$3 THEN \$4 ELSE mi.food_id END`+
+		`.+ FROM MEAL_INGREDIENTS mi\s+WHERE mi.meal_id = \$2`).
+		WithArgs(55, 12, 90, 1, 6.0, "OUNCES").
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(`UPDATE USER_MEALS\s+SET meal_id = \$1`).
+		WithArgs(55, 40, 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectMealTotalsRecomputed(mock, 55)
+	mock.ExpectCommit()
+	mock.ExpectQuery(`JOIN USER_MEALS e ON .+ WHERE e.id = \$1 AND e.user_id = \$2`).
+		WithArgs(40, 7).
+		WillReturnRows(sqlmock.NewRows(mealEntryColumns).
+			AddRow(40, 7, 55, nil, day, 2, 1.0, nil, nil, false, "Chicken Bowl", 690.0, 40.0, 55.0, 40.0, now, now))
+
+	// Execute
+	resp, err := service.ApplySubstitute(context.Background(), &proto.ApplySubstituteRequest{
+		UserId:       7,
+		EntryId:      40,
+		FoodId:       1,
+		IngredientId: 90,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, resp.Error)
+	require.Len(t, resp.Meal.Foods, 1)
+	assert.Equal(t, int32(55), resp.Meal.Foods[0].MealId)
+	assert.Equal(t, 690.0, resp.Meal.Calories)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSubstitutionService_ApplySubstitute_MealEntryNeedsIngredient(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewSubstitutionService(meals.NewRepository(db))
+
+	now := time.Now()
+
+	// Setup mock expectations
+	mock.ExpectQuery(`FROM USER_MEALS um .+ WHERE um.id = \$1 AND um.user_id = \$2$`).
+		WithArgs(40, 7).
+		WillReturnRows(sqlmock.NewRows(mealEntryColumns).
+			AddRow(40, 7, 12, nil, now, 2, 1.0, nil, nil, false, "Chicken Bowl", 600.0, 45.0, 55.0, 18.0, now, now))
+
+	// Execute
+	resp, err := service.ApplySubstitute(context.Background(), &proto.ApplySubstituteRequest{
+		UserId:  7,
+		EntryId: 40,
+		FoodId:  1,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "ingredient_id is required to swap part of a meal entry", resp.Error)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSubstitutionService_ApplySubstitute_RejectsIncompatibleFood(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewSubstitutionService(meals.NewRepository(db))
+
+	now := time.Now()
+
+	// Setup mock expectations
+	mock.ExpectQuery(`FROM USER_MEALS um .+ WHERE um.id = \$1 AND um.user_id = \$2$`).
+		WithArgs(40, 7).
+		WillReturnRows(sqlmock.NewRows(mealEntryColumns).
+			AddRow(40, 7, nil, 5, now, 1, 4.0, nil, nil, false, "Chicken Breast - Skinless", 560.0, 104.0, 0.0, 12.0, now, now))
+	expectSubstituteCandidates(mock, 7, 5,
+		sqlmock.NewRows(foodColumns).
+			AddRow(5, "Chicken Breast - Skinless", "MEAT", "OUNCES", 140.0, 26.0, 0.0, 3.0, false, false, false, "{}", false),
+		substituteCatalogRows())
+
+	// Execute
+	resp, err := service.ApplySubstitute(context.Background(), &proto.ApplySubstituteRequest{
+		UserId:  7,
+		EntryId: 40,
+		FoodId:  31,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "invalid substitute: food 31 cannot replace Chicken Breast - Skinless", resp.Error)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSubstitutionService_Validation(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewSubstitutionService(meals.NewRepository(db))
+	ctx := context.Background()
+
+	resp, err := service.SuggestSubstitutes(ctx, &proto.SuggestSubstitutesRequest{UserId: 7})
+	assert.NoError(t, err)
+	assert.Equal(t, "food_id is required", resp.Error)
+
+	applyResp, err := service.ApplySubstitute(ctx, &proto.ApplySubstituteRequest{UserId: 7, EntryId: 40})
+	assert.NoError(t, err)
+	assert.Equal(t, "entry_id and food_id are required", applyResp.Error)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
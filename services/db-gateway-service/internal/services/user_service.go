@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"db-gateway-service/proto"
 	users "db-gateway-service/sql/user-service"
@@ -29,6 +30,11 @@ func NewUserService(repo *users.Repository) *UserService {
 func (s *UserService) CreateUser(ctx context.Context, req *proto.CreateUserRequest) (*proto.CreateUserResponse, error) {
 	log.Printf("CreateUser called with email: %s", req.Email)
 	
+	birthDate, err := dateToPtr(req.BirthDate)
+	if err != nil {
+		return &proto.CreateUserResponse{Error: err.Error()}, nil
+	}
+
 	// Convert request to repository model
 	dbUser := &users.User{
 		FullName:      req.FullName,
@@ -43,6 +49,10 @@ func (s *UserService) CreateUser(ctx context.Context, req *proto.CreateUserReque
 		Locale:        stringToPtr(req.Locale),
 		Timezone:      stringToPtr(req.Timezone),
 		UtcOffset:     intToPtr(int(req.UtcOffset)),
+		HeightCm:      floatToPtr(req.HeightCm),
+		WeightKg:      floatToPtr(req.WeightKg),
+		BirthDate:     birthDate,
+		ActivityLevel: stringToPtr(req.ActivityLevel),
 	}
 
 	// Create user in database
@@ -104,6 +114,11 @@ func (s *UserService) GetAllUsers(ctx context.Context, req *proto.GetAllUsersReq
 func (s *UserService) UpdateUser(ctx context.Context, req *proto.UpdateUserRequest) (*proto.UpdateUserResponse, error) {
 	log.Printf("UpdateUser called for ID: %d", req.Id)
 	
+	birthDate, err := dateToPtr(req.BirthDate)
+	if err != nil {
+		return &proto.UpdateUserResponse{Error: err.Error()}, nil
+	}
+
 	// Convert request to repository model
 	dbUser := &users.User{
 		ID:            int(req.Id),
@@ -118,6 +133,10 @@ func (s *UserService) UpdateUser(ctx context.Context, req *proto.UpdateUserReque
 		Locale:        stringToPtr(req.Locale),
 		Timezone:      stringToPtr(req.Timezone),
 		UtcOffset:     intToPtr(int(req.UtcOffset)),
+		HeightCm:      floatToPtr(req.HeightCm),
+		WeightKg:      floatToPtr(req.WeightKg),
+		BirthDate:     birthDate,
+		ActivityLevel: stringToPtr(req.ActivityLevel),
 	}
 
 	// Update user in database
@@ -182,6 +201,11 @@ func (s *UserService) VerifyUser(ctx context.Context, req *proto.VerifyUserReque
 func (s *UserService) UpsertUser(ctx context.Context, req *proto.UpsertUserRequest) (*proto.UpsertUserResponse, error) {
 	log.Printf("UpsertUser called with email: %s", req.Email)
 	
+	birthDate, err := dateToPtr(req.BirthDate)
+	if err != nil {
+		return &proto.UpsertUserResponse{Error: err.Error()}, nil
+	}
+
 	// Convert request to repository model
 	dbUser := &users.User{
 		FullName:      req.FullName,
@@ -196,6 +220,10 @@ func (s *UserService) UpsertUser(ctx context.Context, req *proto.UpsertUserReque
 		Locale:        stringToPtr(req.Locale),
 		Timezone:      stringToPtr(req.Timezone),
 		UtcOffset:     intToPtr(int(req.UtcOffset)),
+		HeightCm:      floatToPtr(req.HeightCm),
+		WeightKg:      floatToPtr(req.WeightKg),
+		BirthDate:     birthDate,
+		ActivityLevel: stringToPtr(req.ActivityLevel),
 	}
 
 	// Upsert user in database
@@ -226,6 +254,10 @@ func convertToProtoUser(dbUser *users.User) *proto.User {
 		Locale:        ptrToString(dbUser.Locale),
 		Timezone:      ptrToString(dbUser.Timezone),
 		UtcOffset:     ptrToInt32(dbUser.UtcOffset),
+		HeightCm:      ptrToFloat(dbUser.HeightCm),
+		WeightKg:      ptrToFloat(dbUser.WeightKg),
+		BirthDate:     ptrToDate(dbUser.BirthDate),
+		ActivityLevel: ptrToString(dbUser.ActivityLevel),
 		CreatedAt:     timestamppb.New(dbUser.CreatedAt),
 		UpdatedAt:     timestamppb.New(dbUser.UpdatedAt),
 	}
@@ -265,3 +297,21 @@ func ptrToInt32(i *int) int32 {
 	}
 	return int32(*i)
 }
+
+func dateToPtr(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	date, err := parseDate(s)
+	if err != nil {
+		return nil, err
+	}
+	return &date, nil
+}
+
+func ptrToDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(dateLayout)
+}
\ No newline at end of file
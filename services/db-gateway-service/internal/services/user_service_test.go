@@ -43,6 +43,10 @@ func TestUserService_CreateUser(t *testing.T) {
 		Locale:        "en-US",
 		Timezone:      "America/New_York",
 		UtcOffset:     -5,
+		HeightCm:      180.5,
+		WeightKg:      82,
+		BirthDate:     "1990-05-14",
+		ActivityLevel: "MODERATE",
 	}
 
 	now := time.Now()
@@ -53,6 +57,7 @@ func TestUserService_CreateUser(t *testing.T) {
 			req.FullName, req.Email, req.Password,
 			req.PhoneNumber, req.Sex, req.City, req.StateProvince,
 			req.PostalCode, req.CountryCode, req.Locale, req.Timezone, int(req.UtcOffset),
+			req.HeightCm, req.WeightKg, time.Date(1990, 5, 14, 0, 0, 0, 0, time.UTC), req.ActivityLevel,
 		).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).
 			AddRow(1, now, now))
@@ -67,12 +72,32 @@ func TestUserService_CreateUser(t *testing.T) {
 	assert.Equal(t, int32(1), resp.User.Id)
 	assert.Equal(t, req.FullName, resp.User.FullName)
 	assert.Equal(t, req.Email, resp.User.Email)
+	assert.Equal(t, req.BirthDate, resp.User.BirthDate)
+	assert.Equal(t, req.ActivityLevel, resp.User.ActivityLevel)
 	assert.Empty(t, resp.Error)
 
 	// Verify all expectations were met
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestUserService_CreateUser_InvalidBirthDate(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	service := NewUserService(users.NewRepository(db))
+
+	resp, err := service.CreateUser(context.Background(), &proto.CreateUserRequest{
+		FullName:  "John Doe",
+		Email:     "john@example.com",
+		Password:  "password123",
+		BirthDate: "14/05/1990",
+	})
+
+	assert.NoError(t, err)
+	assert.Contains(t, resp.Error, "invalid date")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestUserService_GetUserByID(t *testing.T) {
 	db, mock := setupTestDB(t)
 	defer db.Close()
@@ -200,20 +225,21 @@ func TestUserService_UpdateUser(t *testing.T) {
 	now := time.Now()
 
 	// Setup mock expectations
-	mock.ExpectQuery(`UPDATE USERS .+ WHERE id = \$12 RETURNING`).
+	mock.ExpectQuery(`UPDATE USERS .+ WHERE id = \$16 RETURNING`).
 		WithArgs(
 			req.FullName, req.Password, req.PhoneNumber, req.Sex,
 			req.City, req.StateProvince, req.PostalCode, req.CountryCode,
-			req.Locale, req.Timezone, int(req.UtcOffset), int(req.Id),
+			req.Locale, req.Timezone, int(req.UtcOffset), nil, nil, nil, nil, int(req.Id),
 		).
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "full_name", "email", "phone_number", "sex", "city",
 			"state_province", "postal_code", "country_code", "locale",
-			"timezone", "utc_offset", "created_at", "updated_at",
+			"timezone", "utc_offset", "height_cm", "weight_kg", "birth_date",
+			"activity_level", "created_at", "updated_at",
 		}).AddRow(
 			req.Id, req.FullName, "john@example.com", req.PhoneNumber, req.Sex, req.City,
 			req.StateProvince, req.PostalCode, req.CountryCode, req.Locale,
-			req.Timezone, req.UtcOffset, now, now,
+			req.Timezone, req.UtcOffset, nil, nil, nil, nil, now, now,
 		))
 
 	// Execute
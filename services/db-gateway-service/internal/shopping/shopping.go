@@ -0,0 +1,250 @@
+// Package shopping turns the food quantities of planned meals into a grocery
+// list. Quantities of the same food are added up across units of the same
+// dimension, converted to one purchasing unit per food and rounded up to an
+// amount that can be bought, then grouped into aisles by food category.
+package shopping
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"db-gateway-service/internal/units"
+)
+
+// Export formats
+const (
+	FormatJSON     = "json"
+	FormatMarkdown = "markdown"
+	FormatText     = "text"
+)
+
+// Pounds is a purchasing unit for large amounts; it is not a serving unit
+const Pounds = "POUNDS"
+
+const gramsPerPound = 453.592
+
+// Line is an amount of a food needed by the planned meals
+type Line struct {
+	FoodID   int
+	Name     string
+	Category string
+	Quantity float64
+	Unit     string
+}
+
+// Item is a food to buy, in its purchasing unit
+type Item struct {
+	FoodID   int
+	Name     string
+	Quantity float64
+	Unit     string
+}
+
+// Aisle groups the items of one food category
+type Aisle struct {
+	Category string
+	Name     string
+	Items    []Item
+}
+
+// List is a grocery list
+type List struct {
+	Aisles []Aisle
+	Notes  []string
+}
+
+// aisles orders the food categories roughly as a walk through a grocery store
+var aisles = []struct {
+	category string
+	name     string
+}{
+	{"VEGETABLE", "Vegetables"},
+	{"NIGHTSHADES", "Nightshades"},
+	{"FRUIT", "Fruit"},
+	{"MEAT", "Meat"},
+	{"FISH", "Seafood"},
+	{"DAIRY", "Dairy & Eggs"},
+	{"DAIRY_ALTERNATIVE", "Dairy Alternatives"},
+	{"GRAIN", "Grains"},
+	{"LEGUMES", "Legumes"},
+	{"NUTS", "Nuts"},
+	{"SEEDS", "Seeds"},
+	{"FAT", "Fats"},
+	{"OIL", "Oils"},
+	{"SPICE_HERB", "Spices & Herbs"},
+	{"CONDIMENT", "Condiments"},
+	{"SWEETENER", "Sweeteners"},
+	{"SNACK", "Snacks"},
+	{"BEVERAGE", "Beverages"},
+	{"OTHER", "Other"},
+}
+
+// unitLabels are the names units are written with in exported lists
+var unitLabels = map[string]string{
+	units.Grams:  "g",
+	units.Ounces: "oz",
+	Pounds:       "lb",
+	units.Tsp:    "tsp",
+	units.Tbsp:   "tbsp",
+	units.Cups:   "cups",
+	units.Pieces: "pieces",
+}
+
+// ValidateFormat checks an export format
+func ValidateFormat(format string) error {
+	switch format {
+	case FormatJSON, FormatMarkdown, FormatText:
+		return nil
+	}
+	return fmt.Errorf("invalid format %q: must be one of %s, %s, %s", format, FormatJSON, FormatMarkdown, FormatText)
+}
+
+// total is the amount of a food in one dimension's base unit
+type total struct {
+	line      Line
+	dimension string
+	base      float64
+}
+
+// Build adds up the lines per food and dimension and groups them into aisles.
+// A food used by both weight and volume is listed once for each.
+func Build(lines []Line) List {
+	list := List{Aisles: []Aisle{}, Notes: []string{}}
+
+	var totals []*total
+	byKey := map[string]*total{}
+	for _, line := range lines {
+		dimension, err := units.Dimension(line.Unit)
+		if err != nil {
+			list.Notes = append(list.Notes, fmt.Sprintf("%s was left out: %v", line.Name, err))
+			continue
+		}
+		base, _ := units.Convert(line.Quantity, line.Unit, baseUnit(dimension))
+
+		key := fmt.Sprintf("%d/%s", line.FoodID, dimension)
+		t, ok := byKey[key]
+		if !ok {
+			t = &total{line: line, dimension: dimension}
+			byKey[key] = t
+			totals = append(totals, t)
+		}
+		t.base += base
+	}
+
+	byCategory := map[string][]Item{}
+	for _, t := range totals {
+		quantity, unit := purchase(t.base, t.dimension)
+		byCategory[t.line.Category] = append(byCategory[t.line.Category], Item{
+			FoodID:   t.line.FoodID,
+			Name:     t.line.Name,
+			Quantity: quantity,
+			Unit:     unit,
+		})
+	}
+
+	for _, a := range aisles {
+		items, ok := byCategory[a.category]
+		if !ok {
+			continue
+		}
+		sort.SliceStable(items, func(i, j int) bool { return items[i].Name < items[j].Name })
+		list.Aisles = append(list.Aisles, Aisle{Category: a.category, Name: a.name, Items: items})
+	}
+
+	return list
+}
+
+// baseUnit is the unit totals of a dimension are added up in
+func baseUnit(dimension string) string {
+	switch dimension {
+	case units.Mass:
+		return units.Grams
+	case units.Volume:
+		return units.Tsp
+	}
+	return units.Pieces
+}
+
+// purchase converts a base amount to the unit it is bought in, rounded up:
+// whole ounces up to a pound, then pounds; cups from a quarter cup,
+// tablespoons from a tablespoon, otherwise teaspoons; whole pieces
+func purchase(base float64, dimension string) (float64, string) {
+	switch dimension {
+	case units.Mass:
+		ounces, _ := units.Convert(base, units.Grams, units.Ounces)
+		if ounces = roundUp(ounces, 1); ounces < 16 {
+			return ounces, units.Ounces
+		}
+		return roundUp(base/gramsPerPound, 0.25), Pounds
+	case units.Volume:
+		if cups, _ := units.Convert(base, units.Tsp, units.Cups); cups >= 0.25 {
+			return roundUp(cups, 0.25), units.Cups
+		}
+		if tbsp, _ := units.Convert(base, units.Tsp, units.Tbsp); tbsp >= 1 {
+			return roundUp(tbsp, 0.5), units.Tbsp
+		}
+		return roundUp(base, 0.5), units.Tsp
+	}
+	return roundUp(base, 1), units.Pieces
+}
+
+// roundUp rounds up to a multiple of step, ignoring floating point noise
+func roundUp(value, step float64) float64 {
+	return math.Max(step, math.Ceil(value/step-1e-9)*step)
+}
+
+// Markdown renders the list as a Markdown checklist
+func Markdown(list List, title string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n", title)
+	for _, aisle := range list.Aisles {
+		fmt.Fprintf(&b, "\n## %s\n\n", aisle.Name)
+		for _, item := range aisle.Items {
+			fmt.Fprintf(&b, "- [ ] %s: %s\n", item.Name, Amount(item))
+		}
+	}
+	if len(list.Aisles) == 0 {
+		b.WriteString("\nNothing to buy.\n")
+	}
+	if len(list.Notes) > 0 {
+		b.WriteString("\n## Notes\n\n")
+		for _, note := range list.Notes {
+			fmt.Fprintf(&b, "- %s\n", note)
+		}
+	}
+	return b.String()
+}
+
+// Text renders the list as plain text
+func Text(list List, title string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n", strings.ToUpper(title))
+	for _, aisle := range list.Aisles {
+		fmt.Fprintf(&b, "\n%s\n", strings.ToUpper(aisle.Name))
+		for _, item := range aisle.Items {
+			fmt.Fprintf(&b, "  %s - %s\n", item.Name, Amount(item))
+		}
+	}
+	if len(list.Aisles) == 0 {
+		b.WriteString("\nNothing to buy.\n")
+	}
+	if len(list.Notes) > 0 {
+		b.WriteString("\nNOTES\n")
+		for _, note := range list.Notes {
+			fmt.Fprintf(&b, "  %s\n", note)
+		}
+	}
+	return b.String()
+}
+
+// Amount writes an item's quantity with its unit, e.g. "1.5 lb"
+func Amount(item Item) string {
+	label, ok := unitLabels[item.Unit]
+	if !ok {
+		label = strings.ToLower(item.Unit)
+	}
+	return strconv.FormatFloat(item.Quantity, 'f', -1, 64) + " " + label
+}
@@ -0,0 +1,82 @@
+package shopping
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuild_AddsUpAndConvertsToPurchasingUnits(t *testing.T) {
+	list := Build([]Line{
+		{FoodID: 1, Name: "Salmon - Wild Atlantic", Category: "FISH", Quantity: 4, Unit: "OUNCES"},
+		{FoodID: 1, Name: "Salmon - Wild Atlantic", Category: "FISH", Quantity: 4.5, Unit: "OUNCES"},
+		{FoodID: 1, Name: "Salmon - Wild Atlantic", Category: "FISH", Quantity: 250, Unit: "GRAMS"},
+		{FoodID: 19, Name: "Broccoli", Category: "VEGETABLE", Quantity: 1.5, Unit: "CUPS"},
+		{FoodID: 19, Name: "Broccoli", Category: "VEGETABLE", Quantity: 2, Unit: "TBSP"},
+		{FoodID: 43, Name: "Olive Oil - Extra Virgin", Category: "OIL", Quantity: 2, Unit: "TSP"},
+		{FoodID: 43, Name: "Olive Oil - Extra Virgin", Category: "OIL", Quantity: 0.5, Unit: "TBSP"},
+		{FoodID: 49, Name: "Banana - Medium", Category: "FRUIT", Quantity: 1.5, Unit: "PIECES"},
+		{FoodID: 20, Name: "Spinach - Raw", Category: "VEGETABLE", Quantity: 2, Unit: "CUPS"},
+	})
+
+	require.Len(t, list.Aisles, 4)
+	assert.Empty(t, list.Notes)
+
+	// Aisles follow the store walk, items are sorted by name
+	assert.Equal(t, "Vegetables", list.Aisles[0].Name)
+	assert.Equal(t, []Item{
+		{FoodID: 19, Name: "Broccoli", Quantity: 1.75, Unit: "CUPS"},
+		{FoodID: 20, Name: "Spinach - Raw", Quantity: 2, Unit: "CUPS"},
+	}, list.Aisles[0].Items)
+
+	assert.Equal(t, "Fruit", list.Aisles[1].Name)
+	assert.Equal(t, []Item{{FoodID: 49, Name: "Banana - Medium", Quantity: 2, Unit: "PIECES"}}, list.Aisles[1].Items)
+
+	// 8.5 oz + 250 g is about 491 g, so it is bought by the pound
+	assert.Equal(t, "Seafood", list.Aisles[2].Name)
+	assert.Equal(t, []Item{{FoodID: 1, Name: "Salmon - Wild Atlantic", Quantity: 1.25, Unit: Pounds}}, list.Aisles[2].Items)
+
+	// 3.5 tsp
+	assert.Equal(t, []Item{{FoodID: 43, Name: "Olive Oil - Extra Virgin", Quantity: 1.5, Unit: "TBSP"}}, list.Aisles[3].Items)
+}
+
+func TestBuild_SkipsUnknownUnits(t *testing.T) {
+	list := Build([]Line{
+		{FoodID: 5, Name: "Chicken Breast - Skinless", Category: "MEAT", Quantity: 4, Unit: "SLICES"},
+		{FoodID: 5, Name: "Chicken Breast - Skinless", Category: "MEAT", Quantity: 3.2, Unit: "OUNCES"},
+	})
+
+	require.Len(t, list.Aisles, 1)
+	assert.Equal(t, []Item{{FoodID: 5, Name: "Chicken Breast - Skinless", Quantity: 4, Unit: "OUNCES"}}, list.Aisles[0].Items)
+	assert.Equal(t, []string{`Chicken Breast - Skinless was left out: invalid unit "SLICES"`}, list.Notes)
+}
+
+func TestRender(t *testing.T) {
+	list := List{
+		Aisles: []Aisle{
+			{Category: "FISH", Name: "Seafood", Items: []Item{{FoodID: 1, Name: "Salmon", Quantity: 1.25, Unit: Pounds}}},
+			{Category: "OIL", Name: "Oils", Items: []Item{{FoodID: 43, Name: "Olive Oil", Quantity: 2, Unit: "TBSP"}}},
+		},
+		Notes: []string{"Overnight Oats has no ingredients and was left out"},
+	}
+
+	assert.Equal(t, "# Shopping List\n"+
+		"\n## Seafood\n\n- [ ] Salmon: 1.25 lb\n"+
+		"\n## Oils\n\n- [ ] Olive Oil: 2 tbsp\n"+
+		"\n## Notes\n\n- Overnight Oats has no ingredients and was left out\n",
+		Markdown(list, "Shopping List"))
+
+	assert.Equal(t, "SHOPPING LIST\n"+
+		"\nSEAFOOD\n  Salmon - 1.25 lb\n"+
+		"\nOILS\n  Olive Oil - 2 tbsp\n"+
+		"\nNOTES\n  Overnight Oats has no ingredients and was left out\n",
+		Text(list, "Shopping List"))
+
+	assert.Contains(t, Markdown(List{}, "Shopping List"), "Nothing to buy.")
+}
+
+func TestValidateFormat(t *testing.T) {
+	assert.NoError(t, ValidateFormat(FormatMarkdown))
+	assert.ErrorContains(t, ValidateFormat("pdf"), `invalid format "pdf"`)
+}
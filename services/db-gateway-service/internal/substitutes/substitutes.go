@@ -0,0 +1,193 @@
+// Package substitutes ranks catalog foods that can stand in for another food.
+// A quantity is first converted into the original food's serving unit; each
+// candidate from the same or a compatible category is then sized to the same
+// amount (or, across mass, volume and count units, to the same calories) and
+// ranked by how far its nutrition lands from the original's.
+package substitutes
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"db-gateway-service/internal/units"
+)
+
+// Result limits
+const (
+	DefaultLimit = 5
+	MaxLimit     = 20
+)
+
+// minDistanceCalories keeps the distance of near-zero calorie foods such as
+// leafy greens from blowing up
+const minDistanceCalories = 50.0
+
+// Macros holds calories and macronutrient grams
+type Macros struct {
+	Calories     float64
+	ProteinGrams float64
+	CarbsGrams   float64
+	FatGrams     float64
+}
+
+func (m Macros) scale(factor float64) Macros {
+	return Macros{
+		Calories:     m.Calories * factor,
+		ProteinGrams: m.ProteinGrams * factor,
+		CarbsGrams:   m.CarbsGrams * factor,
+		FatGrams:     m.FatGrams * factor,
+	}
+}
+
+// Food is a catalog food with nutrition per serving unit
+type Food struct {
+	ID              int
+	Name            string
+	Category        string
+	ServingUnits    string
+	PerServing      Macros
+	NonInflammatory bool
+	Liked           bool
+}
+
+// Options control which candidates are suggested
+type Options struct {
+	NonInflammatoryOnly bool
+	Limit               int // 0 returns DefaultLimit suggestions, -1 all of them
+}
+
+// Suggestion is a candidate sized to replace the original amount
+type Suggestion struct {
+	Food         Food
+	Servings     float64 // in the candidate's serving unit
+	Macros       Macros
+	Distance     float64
+	SameCategory bool
+}
+
+// compatibleCategories lists the categories that can stand in for each other
+// beyond the food's own category. Categories not listed only swap within
+// themselves.
+var compatibleCategories = map[string][]string{
+	"MEAT":              {"FISH", "LEGUMES"},
+	"FISH":              {"MEAT", "LEGUMES"},
+	"LEGUMES":           {"MEAT", "FISH", "GRAIN"},
+	"GRAIN":             {"LEGUMES"},
+	"DAIRY":             {"DAIRY_ALTERNATIVE"},
+	"DAIRY_ALTERNATIVE": {"DAIRY"},
+	"VEGETABLE":         {"NIGHTSHADES"},
+	"NIGHTSHADES":       {"VEGETABLE"},
+	"FAT":               {"OIL"},
+	"OIL":               {"FAT"},
+	"NUTS":              {"SEEDS"},
+	"SEEDS":             {"NUTS"},
+}
+
+// Compatible reports whether a food of category to can replace one of category from
+func Compatible(from, to string) bool {
+	if from == to {
+		return true
+	}
+	for _, c := range compatibleCategories[from] {
+		if c == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Rank sizes every compatible candidate to replace quantity of original, given
+// in unit, and orders them closest first. The original food's non-inflammatory
+// status is kept: a non-inflammatory food is only swapped for another one.
+// It returns the original sized to the quantity along with the suggestions.
+func Rank(original Food, quantity float64, unit string, candidates []Food, opts Options) (Suggestion, []Suggestion, error) {
+	if quantity <= 0 {
+		return Suggestion{}, nil, fmt.Errorf("invalid quantity: must be greater than 0")
+	}
+	if opts.Limit > MaxLimit || opts.Limit < -1 {
+		return Suggestion{}, nil, fmt.Errorf("invalid limit %d: must be between 1 and %d", opts.Limit, MaxLimit)
+	}
+	if unit == "" {
+		unit = original.ServingUnits
+	}
+
+	servings, err := units.Convert(quantity, unit, original.ServingUnits)
+	if err != nil {
+		return Suggestion{}, nil, err
+	}
+	target := original.PerServing.scale(servings)
+	sized := Suggestion{Food: original, Servings: servings, Macros: target, SameCategory: true}
+	nonInflammatoryOnly := opts.NonInflammatoryOnly || original.NonInflammatory
+
+	suggestions := []Suggestion{}
+	for _, c := range candidates {
+		if c.ID == original.ID || !Compatible(original.Category, c.Category) {
+			continue
+		}
+		if nonInflammatoryOnly && !c.NonInflammatory {
+			continue
+		}
+
+		s, ok := size(c, quantity, unit, target)
+		if !ok {
+			continue
+		}
+		s.SameCategory = c.Category == original.Category
+		s.Distance = distance(target, s.Macros)
+		suggestions = append(suggestions, s)
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		a, b := suggestions[i], suggestions[j]
+		if a.Distance != b.Distance {
+			return a.Distance < b.Distance
+		}
+		if a.SameCategory != b.SameCategory {
+			return a.SameCategory
+		}
+		if a.Food.Liked != b.Food.Liked {
+			return a.Food.Liked
+		}
+		return a.Food.Name < b.Food.Name
+	})
+
+	limit := opts.Limit
+	if limit == 0 {
+		limit = DefaultLimit
+	}
+	if limit > 0 && len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+
+	return sized, suggestions, nil
+}
+
+// size picks the servings of a candidate: the same amount when the units
+// convert, otherwise the amount with the same calories, rounded to the unit's step
+func size(c Food, quantity float64, unit string, target Macros) (Suggestion, bool) {
+	servings, err := units.Convert(quantity, unit, c.ServingUnits)
+	if err != nil {
+		if c.PerServing.Calories <= 0 {
+			return Suggestion{}, false
+		}
+		servings = target.Calories / c.PerServing.Calories
+	}
+
+	step := units.Step(c.ServingUnits)
+	servings = math.Max(step, math.Round(servings/step)*step)
+
+	return Suggestion{Food: c, Servings: servings, Macros: c.PerServing.scale(servings)}, true
+}
+
+// distance compares two amounts of food by calories and by the calories each
+// macro supplies, relative to the original's calories; 0 is a perfect match
+func distance(target, candidate Macros) float64 {
+	d := math.Sqrt(
+		math.Pow(candidate.Calories-target.Calories, 2) +
+			math.Pow(4*(candidate.ProteinGrams-target.ProteinGrams), 2) +
+			math.Pow(4*(candidate.CarbsGrams-target.CarbsGrams), 2) +
+			math.Pow(9*(candidate.FatGrams-target.FatGrams), 2),
+	)
+	return math.Round(d/math.Max(target.Calories, minDistanceCalories)*1000) / 1000
+}
@@ -0,0 +1,89 @@
+package substitutes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func food(id int, name, category, units string, calories, protein, carbs, fat float64, nonInflammatory bool) Food {
+	return Food{
+		ID:              id,
+		Name:            name,
+		Category:        category,
+		ServingUnits:    units,
+		PerServing:      Macros{Calories: calories, ProteinGrams: protein, CarbsGrams: carbs, FatGrams: fat},
+		NonInflammatory: nonInflammatory,
+	}
+}
+
+var (
+	chicken  = food(5, "Chicken Breast - Skinless", "MEAT", "OUNCES", 140, 26, 0, 3, false)
+	turkey   = food(6, "Turkey Breast", "MEAT", "GRAMS", 4.8, 0.95, 0, 0.05, false)
+	salmon   = food(1, "Salmon - Wild Atlantic", "FISH", "OUNCES", 155, 22, 0, 7, true)
+	cod      = food(2, "Cod Fillet", "FISH", "OUNCES", 70, 15, 0, 0.5, true)
+	lentils  = food(37, "Lentils - Cooked", "LEGUMES", "CUPS", 230, 18, 40, 0.8, true)
+	rice     = food(31, "Brown Rice - Cooked", "GRAIN", "CUPS", 216, 5, 45, 2, true)
+	broccoli = food(19, "Broccoli", "VEGETABLE", "CUPS", 25, 3, 5, 0, true)
+)
+
+func TestRank_OrdersByMacroDistance(t *testing.T) {
+	candidates := []Food{chicken, turkey, salmon, cod, lentils, rice, broccoli}
+
+	original, suggestions, err := Rank(chicken, 113.4, "GRAMS", candidates, Options{Limit: -1})
+	require.NoError(t, err)
+	assert.InDelta(t, 560, original.Macros.Calories, 0.5)
+
+	names := make([]string, len(suggestions))
+	for i, s := range suggestions {
+		names[i] = s.Food.Name
+	}
+	// Rice is not compatible with meat and chicken itself is never suggested
+	assert.Equal(t, []string{"Turkey Breast", "Salmon - Wild Atlantic", "Cod Fillet", "Lentils - Cooked"}, names)
+
+	// Turkey is sized to the same weight in its own unit, rounded to 5 g
+	assert.Equal(t, 115.0, suggestions[0].Servings)
+	assert.True(t, suggestions[0].SameCategory)
+
+	// Lentils are measured by volume, so they are sized to the same calories
+	assert.Equal(t, 2.5, suggestions[3].Servings)
+	assert.False(t, suggestions[3].SameCategory)
+}
+
+func TestRank_KeepsNonInflammatoryFoods(t *testing.T) {
+	candidates := []Food{chicken, salmon, cod, lentils}
+
+	_, suggestions, err := Rank(salmon, 4, "", candidates, Options{})
+	require.NoError(t, err)
+	for _, s := range suggestions {
+		assert.True(t, s.Food.NonInflammatory, s.Food.Name)
+	}
+
+	_, suggestions, err = Rank(chicken, 4, "", candidates, Options{NonInflammatoryOnly: true})
+	require.NoError(t, err)
+	require.Len(t, suggestions, 3)
+	for _, s := range suggestions {
+		assert.NotEqual(t, chicken.ID, s.Food.ID)
+		assert.True(t, s.Food.NonInflammatory)
+	}
+}
+
+func TestRank_Limit(t *testing.T) {
+	candidates := []Food{turkey, salmon, cod, lentils}
+
+	_, suggestions, err := Rank(chicken, 4, "OUNCES", candidates, Options{Limit: 2})
+	require.NoError(t, err)
+	assert.Len(t, suggestions, 2)
+}
+
+func TestRank_Validation(t *testing.T) {
+	_, _, err := Rank(chicken, 0, "OUNCES", nil, Options{})
+	assert.ErrorContains(t, err, "invalid quantity")
+
+	_, _, err = Rank(chicken, 1, "CUPS", nil, Options{})
+	assert.ErrorContains(t, err, "cannot convert CUPS to OUNCES")
+
+	_, _, err = Rank(chicken, 1, "OUNCES", nil, Options{Limit: 50})
+	assert.ErrorContains(t, err, "invalid limit 50")
+}
@@ -0,0 +1,227 @@
+// Package targets computes daily calorie and macro targets from a user's
+// body profile, activity level, biological sex and selected fitness goals.
+package targets
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Activity levels stored in USERS.activity_level
+const (
+	Sedentary  = "SEDENTARY"
+	Light      = "LIGHT"
+	Moderate   = "MODERATE"
+	Active     = "ACTIVE"
+	VeryActive = "VERY_ACTIVE"
+)
+
+// activityMultipliers are the standard TDEE multipliers applied to BMR
+var activityMultipliers = map[string]float64{
+	Sedentary:  1.2,
+	Light:      1.375,
+	Moderate:   1.55,
+	Active:     1.725,
+	VeryActive: 1.9,
+}
+
+// Calorie and macro constants
+const (
+	caloriesPerGramProtein = 4.0
+	caloriesPerGramCarbs   = 4.0
+	caloriesPerGramFat     = 9.0
+
+	// fatShare is the default share of calories coming from fat
+	fatShare = 0.25
+
+	// baseProteinPerKg applies when no goal asks for more
+	baseProteinPerKg = 1.6
+	// maleProteinBonusPerKg reflects the higher protein needs for muscle building in males
+	maleProteinBonusPerKg = 0.2
+
+	// Calorie adjustments are clamped to keep deficits and surpluses sustainable
+	minAdjustment = -0.25
+	maxAdjustment = 0.20
+)
+
+// goalRule describes how a single goal shapes calories and protein
+type goalRule struct {
+	calorieAdjustment float64 // fraction of TDEE, e.g. -0.20 for a 20% deficit
+	adjustsCalories   bool
+	proteinPerKg      float64
+}
+
+// goalRules is keyed by "Category/Name" as seeded in GOALS
+var goalRules = map[string]goalRule{
+	"Weight/Lose":               {calorieAdjustment: -0.20, adjustsCalories: true, proteinPerKg: 2.2},
+	"Weight/Maintain":           {calorieAdjustment: 0, adjustsCalories: true, proteinPerKg: 1.6},
+	"Weight/Gain":               {calorieAdjustment: 0.10, adjustsCalories: true, proteinPerKg: 2.0},
+	"Appearance/Bulk":           {calorieAdjustment: 0.15, adjustsCalories: true, proteinPerKg: 2.0},
+	"Appearance/Lean":           {calorieAdjustment: 0.05, adjustsCalories: true, proteinPerKg: 2.0},
+	"Appearance/Definition/Cut": {calorieAdjustment: -0.20, adjustsCalories: true, proteinPerKg: 2.4},
+	"Strength/Gain":             {proteinPerKg: 2.0},
+	"Strength/Maintain":         {proteinPerKg: 1.8},
+	"Endurance/Gain":            {proteinPerKg: 1.6},
+	"Endurance/Maintain":        {proteinPerKg: 1.4},
+}
+
+// Profile holds the body measurements needed for the calculation
+type Profile struct {
+	Sex           string // MALE, FEMALE or OTHER
+	WeightKg      float64
+	HeightCm      float64
+	Age           int
+	ActivityLevel string
+}
+
+// Goal identifies a selected fitness goal
+type Goal struct {
+	Category string
+	Name     string
+}
+
+// Targets are the computed daily targets
+type Targets struct {
+	BMR                      float64
+	TDEE                     float64
+	CalorieAdjustmentPercent float64
+	Calories                 float64
+	ProteinGrams             float64
+	CarbsGrams               float64
+	FatGrams                 float64
+	Notes                    []string
+}
+
+// BMR returns the basal metabolic rate using the Mifflin-St Jeor equation.
+// OTHER uses the midpoint between the male and female constants.
+func BMR(p Profile) float64 {
+	base := 10*p.WeightKg + 6.25*p.HeightCm - 5*float64(p.Age)
+	switch strings.ToUpper(p.Sex) {
+	case "MALE":
+		return base + 5
+	case "FEMALE":
+		return base - 161
+	default:
+		return base - 78
+	}
+}
+
+// Calculate computes BMR, TDEE and goal-adjusted calorie and macro targets
+func Calculate(p Profile, goals []Goal) (*Targets, error) {
+	if err := validate(p); err != nil {
+		return nil, err
+	}
+
+	t := &Targets{}
+
+	activity := strings.ToUpper(p.ActivityLevel)
+	multiplier, ok := activityMultipliers[activity]
+	if !ok {
+		multiplier = activityMultipliers[Sedentary]
+		t.Notes = append(t.Notes, "Activity level not set, assuming SEDENTARY")
+	}
+
+	t.BMR = BMR(p)
+	t.TDEE = t.BMR * multiplier
+
+	// Average the calorie adjustments of weight and appearance goals and
+	// take the highest protein requirement of any goal
+	adjustmentSum, adjustmentCount := 0.0, 0
+	proteinPerKg := baseProteinPerKg
+	for _, g := range goals {
+		rule, ok := goalRules[g.Category+"/"+g.Name]
+		if !ok {
+			t.Notes = append(t.Notes, fmt.Sprintf("Goal %s/%s has no targeting rule and was ignored", g.Category, g.Name))
+			continue
+		}
+		if rule.adjustsCalories {
+			adjustmentSum += rule.calorieAdjustment
+			adjustmentCount++
+		}
+		proteinPerKg = math.Max(proteinPerKg, rule.proteinPerKg)
+	}
+
+	adjustment := 0.0
+	if adjustmentCount > 0 {
+		adjustment = adjustmentSum / float64(adjustmentCount)
+	}
+	adjustment = math.Max(minAdjustment, math.Min(maxAdjustment, adjustment))
+
+	t.CalorieAdjustmentPercent = round(adjustment*100, 1)
+	t.Calories = t.TDEE * (1 + adjustment)
+
+	if floor := minimumCalories(p.Sex); t.Calories < floor {
+		t.Calories = floor
+		t.Notes = append(t.Notes, fmt.Sprintf("Calories raised to the %.0f kcal safety minimum", floor))
+	}
+
+	if strings.ToUpper(p.Sex) == "MALE" {
+		proteinPerKg += maleProteinBonusPerKg
+	}
+	t.ProteinGrams = proteinPerKg * p.WeightKg
+
+	// Fat supports hormone production, so it never drops below a per-kg floor
+	t.FatGrams = math.Max(t.Calories*fatShare/caloriesPerGramFat, minimumFatPerKg(p.Sex)*p.WeightKg)
+
+	remaining := t.Calories - t.ProteinGrams*caloriesPerGramProtein - t.FatGrams*caloriesPerGramFat
+	t.CarbsGrams = math.Max(0, remaining/caloriesPerGramCarbs)
+
+	t.BMR = round(t.BMR, 0)
+	t.TDEE = round(t.TDEE, 0)
+	t.Calories = round(t.Calories, 0)
+	t.ProteinGrams = round(t.ProteinGrams, 0)
+	t.FatGrams = round(t.FatGrams, 0)
+	t.CarbsGrams = round(t.CarbsGrams, 0)
+
+	return t, nil
+}
+
+// validate checks that the profile has everything Mifflin-St Jeor needs
+func validate(p Profile) error {
+	missing := []string{}
+	if p.WeightKg <= 0 {
+		missing = append(missing, "weight_kg")
+	}
+	if p.HeightCm <= 0 {
+		missing = append(missing, "height_cm")
+	}
+	if p.Age <= 0 {
+		missing = append(missing, "birth_date")
+	}
+	if p.Sex == "" {
+		missing = append(missing, "sex")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("incomplete profile: %s required", strings.Join(missing, ", "))
+	}
+	if p.Age < 18 {
+		return fmt.Errorf("invalid profile: targets are only calculated for adults")
+	}
+	return nil
+}
+
+// minimumCalories is the lowest daily target we will recommend
+func minimumCalories(sex string) float64 {
+	switch strings.ToUpper(sex) {
+	case "MALE":
+		return 1500
+	case "FEMALE":
+		return 1200
+	default:
+		return 1350
+	}
+}
+
+// minimumFatPerKg keeps enough dietary fat for hormonal health
+func minimumFatPerKg(sex string) float64 {
+	if strings.ToUpper(sex) == "MALE" {
+		return 0.6
+	}
+	return 0.8
+}
+
+func round(value float64, places int) float64 {
+	factor := math.Pow(10, float64(places))
+	return math.Round(value*factor) / factor
+}
@@ -0,0 +1,119 @@
+package targets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBMR(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile Profile
+		want    float64
+	}{
+		{name: "male", profile: Profile{Sex: "MALE", WeightKg: 80, HeightCm: 180, Age: 30}, want: 1780},
+		{name: "female", profile: Profile{Sex: "FEMALE", WeightKg: 60, HeightCm: 165, Age: 30}, want: 1320.25},
+		{name: "other uses midpoint", profile: Profile{Sex: "OTHER", WeightKg: 70, HeightCm: 170, Age: 40}, want: 1484.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, BMR(tt.profile), 0.001)
+		})
+	}
+}
+
+func TestCalculate(t *testing.T) {
+	tests := []struct {
+		name           string
+		profile        Profile
+		goals          []Goal
+		wantTDEE       float64
+		wantCalories   float64
+		wantAdjustment float64
+		wantProtein    float64
+		wantFat        float64
+		wantCarbs      float64
+		wantNotes      int
+	}{
+		{
+			name:           "maintenance without goals",
+			profile:        Profile{Sex: "MALE", WeightKg: 80, HeightCm: 180, Age: 30, ActivityLevel: Moderate},
+			wantTDEE:       2759,
+			wantCalories:   2759,
+			wantAdjustment: 0,
+			wantProtein:    144,
+			wantFat:        77,
+			wantCarbs:      373,
+		},
+		{
+			name:           "female weight loss with higher protein",
+			profile:        Profile{Sex: "FEMALE", WeightKg: 60, HeightCm: 165, Age: 30, ActivityLevel: Light},
+			goals:          []Goal{{Category: "Weight", Name: "Lose"}, {Category: "Strength", Name: "Gain"}},
+			wantTDEE:       1815,
+			wantCalories:   1452,
+			wantAdjustment: -20,
+			wantProtein:    132,
+			wantFat:        48,
+			wantCarbs:      123,
+		},
+		{
+			name:           "bulk and lean are averaged",
+			profile:        Profile{Sex: "MALE", WeightKg: 80, HeightCm: 180, Age: 30, ActivityLevel: Active},
+			goals:          []Goal{{Category: "Appearance", Name: "Bulk"}, {Category: "Appearance", Name: "Lean"}},
+			wantTDEE:       3071,
+			wantCalories:   3378,
+			wantAdjustment: 10,
+			wantProtein:    176,
+			wantFat:        94,
+			wantCarbs:      457,
+		},
+		{
+			name:           "safety minimum and default activity",
+			profile:        Profile{Sex: "FEMALE", WeightKg: 45, HeightCm: 150, Age: 60},
+			goals:          []Goal{{Category: "Appearance", Name: "Definition/Cut"}},
+			wantTDEE:       1112,
+			wantCalories:   1200,
+			wantAdjustment: -20,
+			wantProtein:    108,
+			wantFat:        36,
+			wantCarbs:      111,
+			wantNotes:      2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Calculate(tt.profile, tt.goals)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTDEE, result.TDEE)
+			assert.Equal(t, tt.wantCalories, result.Calories)
+			assert.Equal(t, tt.wantAdjustment, result.CalorieAdjustmentPercent)
+			assert.Equal(t, tt.wantProtein, result.ProteinGrams)
+			assert.Equal(t, tt.wantFat, result.FatGrams)
+			assert.Equal(t, tt.wantCarbs, result.CarbsGrams)
+			assert.Len(t, result.Notes, tt.wantNotes)
+		})
+	}
+}
+
+func TestCalculate_InvalidProfile(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile Profile
+		wantErr string
+	}{
+		{name: "missing measurements", profile: Profile{Sex: "MALE", Age: 30}, wantErr: "weight_kg, height_cm required"},
+		{name: "missing sex and age", profile: Profile{WeightKg: 70, HeightCm: 170}, wantErr: "birth_date, sex required"},
+		{name: "minor", profile: Profile{Sex: "FEMALE", WeightKg: 50, HeightCm: 160, Age: 15}, wantErr: "only calculated for adults"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Calculate(tt.profile, nil)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
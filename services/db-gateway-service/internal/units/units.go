@@ -0,0 +1,98 @@
+// Package units converts quantities between the serving_unit_type units.
+// Mass units convert to each other, as do volume units; pieces are counted
+// and never convert.
+package units
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Serving units, mirroring the serving_unit_type enum
+const (
+	Grams  = "GRAMS"
+	Ounces = "OUNCES"
+	Tsp    = "TSP"
+	Tbsp   = "TBSP"
+	Cups   = "CUPS"
+	Pieces = "PIECES"
+)
+
+// Names lists every serving unit
+var Names = []string{Grams, Ounces, Tsp, Tbsp, Cups, Pieces}
+
+// Dimensions of the serving units
+const (
+	Mass   = "mass"
+	Volume = "volume"
+	Count  = "count"
+)
+
+// unit describes a serving unit: its dimension, its size in the dimension's
+// base unit (grams, teaspoons or pieces) and the step amounts are rounded to
+type unit struct {
+	dimension string
+	size      float64
+	step      float64
+}
+
+var units = map[string]unit{
+	Grams:  {dimension: Mass, size: 1, step: 5},
+	Ounces: {dimension: Mass, size: 28.3495, step: 0.5},
+	Tsp:    {dimension: Volume, size: 1, step: 0.5},
+	Tbsp:   {dimension: Volume, size: 3, step: 0.5},
+	Cups:   {dimension: Volume, size: 48, step: 0.25},
+	Pieces: {dimension: Count, size: 1, step: 0.5},
+}
+
+func lookup(name string) (unit, error) {
+	u, ok := units[strings.ToUpper(name)]
+	if !ok {
+		return unit{}, fmt.Errorf("invalid unit %q", name)
+	}
+	return u, nil
+}
+
+// Dimension returns the dimension of a unit
+func Dimension(name string) (string, error) {
+	u, err := lookup(name)
+	if err != nil {
+		return "", err
+	}
+	return u.dimension, nil
+}
+
+// Size returns the size of a unit in its dimension's base unit (grams,
+// teaspoons or pieces); Convert scales quantities by the ratio of two sizes
+func Size(name string) (float64, error) {
+	u, err := lookup(name)
+	if err != nil {
+		return 0, err
+	}
+	return u.size, nil
+}
+
+// Step returns the practical step amounts of a unit are rounded to, e.g. ½ ounce
+func Step(name string) float64 {
+	u, err := lookup(name)
+	if err != nil {
+		return 0.25
+	}
+	return u.step
+}
+
+// Convert converts a quantity between units of the same dimension
+func Convert(quantity float64, from, to string) (float64, error) {
+	f, err := lookup(from)
+	if err != nil {
+		return 0, err
+	}
+	t, err := lookup(to)
+	if err != nil {
+		return 0, err
+	}
+	if f.dimension != t.dimension {
+		return 0, fmt.Errorf("invalid unit: cannot convert %s to %s", from, to)
+	}
+	return quantity * f.size / t.size, nil
+}
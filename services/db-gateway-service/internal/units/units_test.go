@@ -0,0 +1,60 @@
+package units
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvert(t *testing.T) {
+	grams, err := Convert(4, Ounces, Grams)
+	require.NoError(t, err)
+	assert.InDelta(t, 113.4, grams, 0.01)
+
+	tsp, err := Convert(0.25, "cups", Tsp)
+	require.NoError(t, err)
+	assert.Equal(t, 12.0, tsp)
+
+	_, err = Convert(1, Cups, Ounces)
+	assert.ErrorContains(t, err, "cannot convert CUPS to OUNCES")
+
+	_, err = Convert(1, "HANDFULS", Cups)
+	assert.ErrorContains(t, err, "invalid unit")
+}
+
+func TestDimensionAndStep(t *testing.T) {
+	dimension, err := Dimension(Tbsp)
+	require.NoError(t, err)
+	assert.Equal(t, Volume, dimension)
+
+	_, err = Dimension("LITERS")
+	assert.ErrorContains(t, err, "invalid unit")
+
+	assert.Equal(t, 5.0, Step(Grams))
+	assert.Equal(t, 0.25, Step("LITERS"))
+}
+
+func TestSizeMatchesConvert(t *testing.T) {
+	for _, from := range Names {
+		for _, to := range Names {
+			converted, err := Convert(1, from, to)
+			fromDimension, _ := Dimension(from)
+			toDimension, _ := Dimension(to)
+			if fromDimension != toDimension {
+				assert.Error(t, err, "%s to %s", from, to)
+				continue
+			}
+			require.NoError(t, err)
+
+			fromSize, err := Size(from)
+			require.NoError(t, err)
+			toSize, err := Size(to)
+			require.NoError(t, err)
+			assert.Equal(t, converted, fromSize/toSize, "%s to %s", from, to)
+		}
+	}
+
+	_, err := Size("LITERS")
+	assert.ErrorContains(t, err, "invalid unit")
+}
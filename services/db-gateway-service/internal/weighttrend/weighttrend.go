@@ -0,0 +1,214 @@
+// Package weighttrend smooths noisy daily weigh-ins into a trend, measures the
+// rate of change, detects plateaus and estimates the energy balance the trend
+// implies compared with the calories logged in the diary.
+package weighttrend
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Trend and plateau constants
+const (
+	// smoothing is the daily weight given to a new weigh-in in the moving average.
+	// 0.1 follows the Hacker's Diet; the trend lags the scale by about a week.
+	smoothing = 0.1
+
+	// caloriesPerKg is the approximate energy content of a kilogram of body mass change
+	caloriesPerKg = 7700.0
+
+	// rateWindowDays is how far back the weekly rate and energy balance look
+	rateWindowDays = 14
+	// minRateSpanDays is the shortest span of weigh-ins a rate is computed from
+	minRateSpanDays = 7
+
+	// A plateau is a trend that stayed within plateauBandKg of its latest value
+	// for at least minPlateauDays, backed by at least minPlateauWeighIns
+	plateauBandKg      = 0.3
+	minPlateauDays     = 14
+	minPlateauWeighIns = 4
+
+	// minIntakeDays is the number of logged days below which the energy balance is flagged as unreliable
+	minIntakeDays = 7
+)
+
+// WeighIn is the weight logged on one calendar day
+type WeighIn struct {
+	Date     time.Time
+	WeightKg float64
+}
+
+// Intake is the calories logged in the diary on one calendar day
+type Intake struct {
+	Date     time.Time
+	Calories float64
+}
+
+// Point is a weigh-in with the smoothed trend on that day
+type Point struct {
+	Date     time.Time
+	WeightKg float64
+	TrendKg  float64
+}
+
+// EnergyBalance compares the balance implied by the trend with logged intake
+type EnergyBalance struct {
+	StartDate                    time.Time
+	EndDate                      time.Time
+	IntakeDays                   int
+	AverageIntakeCalories        float64
+	DailyBalanceCalories         float64 // negative for a deficit
+	EstimatedMaintenanceCalories float64
+}
+
+// Analysis is the result of Analyze
+type Analysis struct {
+	Points         []Point
+	LatestWeightKg float64
+	TrendWeightKg  float64
+	WeeklyRateKg   *float64
+	Plateau        bool
+	PlateauDays    int
+	EnergyBalance  *EnergyBalance
+	Notes          []string
+}
+
+// Analyze computes the weight trend from weigh-ins sorted by date (one per
+// day) and compares it with the diary intake over the same period
+func Analyze(weighIns []WeighIn, intake []Intake) Analysis {
+	analysis := Analysis{Points: smooth(weighIns)}
+	if len(analysis.Points) == 0 {
+		analysis.Notes = append(analysis.Notes, "No weigh-ins in this period; log your weight to see a trend")
+		return analysis
+	}
+
+	last := analysis.Points[len(analysis.Points)-1]
+	analysis.LatestWeightKg = round(last.WeightKg, 2)
+	analysis.TrendWeightKg = round(last.TrendKg, 2)
+
+	windowStart := last.Date.AddDate(0, 0, -(rateWindowDays - 1))
+	rate, ok := weeklyRate(analysis.Points, windowStart)
+	if !ok {
+		analysis.Notes = append(analysis.Notes,
+			fmt.Sprintf("Log your weight at least weekly over %d days to see a rate of change", minRateSpanDays))
+		return analysis
+	}
+	analysis.WeeklyRateKg = &rate
+
+	analysis.PlateauDays = plateauDays(analysis.Points)
+	analysis.Plateau = analysis.PlateauDays >= minPlateauDays
+	if analysis.Plateau {
+		analysis.Notes = append(analysis.Notes,
+			fmt.Sprintf("Your trend has stayed within %.1f kg for %d days", plateauBandKg, analysis.PlateauDays))
+	}
+
+	analysis.EnergyBalance = energyBalance(rate, intake, windowStart, last.Date)
+	if analysis.EnergyBalance == nil {
+		analysis.Notes = append(analysis.Notes, "Log meals in your diary to compare intake with your weight trend")
+	} else if analysis.EnergyBalance.IntakeDays < minIntakeDays {
+		analysis.Notes = append(analysis.Notes, fmt.Sprintf(
+			"Calories were logged on only %d of the last %d days; the maintenance estimate assumes the other days were similar",
+			analysis.EnergyBalance.IntakeDays, rateWindowDays))
+	}
+
+	return analysis
+}
+
+// smooth computes an exponentially weighted moving average seeded with the
+// first weigh-in. Gaps between weigh-ins are treated as days without new
+// information: the weight of the next weigh-in grows with the gap as if the
+// trend had been updated with it every missing day.
+func smooth(weighIns []WeighIn) []Point {
+	points := make([]Point, len(weighIns))
+	for i, w := range weighIns {
+		points[i] = Point{Date: w.Date, WeightKg: w.WeightKg, TrendKg: w.WeightKg}
+		if i == 0 {
+			continue
+		}
+		previous := points[i-1]
+		gap := daysBetween(previous.Date, w.Date)
+		alpha := 1 - math.Pow(1-smoothing, float64(gap))
+		points[i].TrendKg = previous.TrendKg + alpha*(w.WeightKg-previous.TrendKg)
+	}
+	return points
+}
+
+// weeklyRate fits a least-squares line through the trend points on or after
+// windowStart and returns its slope in kg per week
+func weeklyRate(points []Point, windowStart time.Time) (float64, bool) {
+	var window []Point
+	for _, p := range points {
+		if !p.Date.Before(windowStart) {
+			window = append(window, p)
+		}
+	}
+	if len(window) < 2 || daysBetween(window[0].Date, window[len(window)-1].Date) < minRateSpanDays {
+		return 0, false
+	}
+
+	var sumX, sumY, sumXY, sumXX float64
+	for _, p := range window {
+		x := float64(daysBetween(windowStart, p.Date))
+		sumX += x
+		sumY += p.TrendKg
+		sumXY += x * p.TrendKg
+		sumXX += x * x
+	}
+	n := float64(len(window))
+	slope := (n*sumXY - sumX*sumY) / (n*sumXX - sumX*sumX)
+	return round(slope*7, 2), true
+}
+
+// plateauDays returns how many days the trend has stayed within the plateau
+// band of its latest value, or 0 when too few weigh-ins back the span
+func plateauDays(points []Point) int {
+	last := points[len(points)-1]
+	start := len(points) - 1
+	for i := len(points) - 2; i >= 0; i-- {
+		if math.Abs(points[i].TrendKg-last.TrendKg) > plateauBandKg {
+			break
+		}
+		start = i
+	}
+	if len(points)-start < minPlateauWeighIns {
+		return 0
+	}
+	return daysBetween(points[start].Date, last.Date)
+}
+
+// energyBalance converts the weekly rate into a daily calorie balance and
+// subtracts it from the average logged intake to estimate maintenance calories
+func energyBalance(weeklyRateKg float64, intake []Intake, start, end time.Time) *EnergyBalance {
+	var total float64
+	days := 0
+	for _, day := range intake {
+		if day.Calories > 0 && !day.Date.Before(start) && !day.Date.After(end) {
+			total += day.Calories
+			days++
+		}
+	}
+	if days == 0 {
+		return nil
+	}
+
+	average := total / float64(days)
+	balance := weeklyRateKg * caloriesPerKg / 7
+	return &EnergyBalance{
+		StartDate:                    start,
+		EndDate:                      end,
+		IntakeDays:                   days,
+		AverageIntakeCalories:        math.Round(average),
+		DailyBalanceCalories:         math.Round(balance),
+		EstimatedMaintenanceCalories: math.Round(average - balance),
+	}
+}
+
+func daysBetween(from, to time.Time) int {
+	return int(math.Round(to.Sub(from).Hours() / 24))
+}
+
+func round(value float64, places int) float64 {
+	scale := math.Pow(10, float64(places))
+	return math.Round(value*scale) / scale
+}
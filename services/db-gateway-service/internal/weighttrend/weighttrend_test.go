@@ -0,0 +1,136 @@
+package weighttrend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var start = time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+// daily builds one weigh-in per day starting on March 1st
+func daily(weights ...float64) []WeighIn {
+	weighIns := make([]WeighIn, len(weights))
+	for i, w := range weights {
+		weighIns[i] = WeighIn{Date: start.AddDate(0, 0, i), WeightKg: w}
+	}
+	return weighIns
+}
+
+// intakeFor logs the same calories on each of the first n days
+func intakeFor(n int, calories float64) []Intake {
+	intake := make([]Intake, n)
+	for i := range intake {
+		intake[i] = Intake{Date: start.AddDate(0, 0, i), Calories: calories}
+	}
+	return intake
+}
+
+func TestSmooth_WeightsGapsByDaysElapsed(t *testing.T) {
+	points := smooth([]WeighIn{
+		{Date: start, WeightKg: 70},
+		{Date: start.AddDate(0, 0, 1), WeightKg: 71},
+		{Date: start.AddDate(0, 0, 8), WeightKg: 71},
+	})
+
+	require.Len(t, points, 3)
+	assert.Equal(t, 70.0, points[0].TrendKg)
+	// One day moves the trend 10% of the way to the weigh-in
+	assert.InDelta(t, 70.1, points[1].TrendKg, 1e-9)
+	// Seven days move it 1 - 0.9^7 (about 52%) of the way
+	assert.InDelta(t, 70.1+0.9*(1-0.4782969), points[2].TrendKg, 1e-6)
+}
+
+func TestAnalyze_SteadyLoss(t *testing.T) {
+	weights := make([]float64, 28)
+	for i := range weights {
+		weights[i] = 80 - 0.1*float64(i)
+	}
+
+	analysis := Analyze(daily(weights...), intakeFor(28, 1800))
+
+	assert.Equal(t, 77.3, analysis.LatestWeightKg)
+	// The trend lags the scale while catching up with the loss
+	assert.Greater(t, analysis.TrendWeightKg, analysis.LatestWeightKg)
+	require.NotNil(t, analysis.WeeklyRateKg)
+	assert.InDelta(t, -0.6, *analysis.WeeklyRateKg, 0.1)
+	assert.False(t, analysis.Plateau)
+
+	balance := analysis.EnergyBalance
+	require.NotNil(t, balance)
+	assert.Equal(t, "2025-03-15", balance.StartDate.Format("2006-01-02"))
+	assert.Equal(t, "2025-03-28", balance.EndDate.Format("2006-01-02"))
+	assert.Equal(t, 14, balance.IntakeDays)
+	assert.Equal(t, 1800.0, balance.AverageIntakeCalories)
+	assert.Less(t, balance.DailyBalanceCalories, -500.0)
+	assert.Equal(t, balance.AverageIntakeCalories-balance.DailyBalanceCalories, balance.EstimatedMaintenanceCalories)
+	assert.Empty(t, analysis.Notes)
+}
+
+func TestAnalyze_NoisyPlateau(t *testing.T) {
+	weights := make([]float64, 21)
+	for i := range weights {
+		// Daily water swings of +/-0.4 kg around 70 kg
+		weights[i] = 70.4
+		if i%2 == 1 {
+			weights[i] = 69.6
+		}
+	}
+
+	analysis := Analyze(daily(weights...), intakeFor(21, 2100))
+
+	require.NotNil(t, analysis.WeeklyRateKg)
+	assert.InDelta(t, 0, *analysis.WeeklyRateKg, 0.1)
+	assert.True(t, analysis.Plateau)
+	assert.GreaterOrEqual(t, analysis.PlateauDays, minPlateauDays)
+	assert.Contains(t, analysis.Notes[0], "stayed within 0.3 kg")
+	// Intake roughly equals maintenance on a plateau
+	require.NotNil(t, analysis.EnergyBalance)
+	assert.InDelta(t, 2100, analysis.EnergyBalance.EstimatedMaintenanceCalories, 100)
+}
+
+func TestAnalyze_NotEnoughData(t *testing.T) {
+	tests := []struct {
+		name     string
+		weighIns []WeighIn
+		intake   []Intake
+		wantRate bool
+		wantNote string
+	}{
+		{
+			name:     "no weigh-ins",
+			wantNote: "No weigh-ins",
+		},
+		{
+			name:     "weigh-ins span less than a week",
+			weighIns: daily(70, 69.8, 69.9, 69.7),
+			intake:   intakeFor(4, 2000),
+			wantNote: "at least weekly",
+		},
+		{
+			name:     "no diary intake",
+			weighIns: daily(70, 69.9, 69.8, 69.7, 69.6, 69.5, 69.4, 69.3),
+			wantRate: true,
+			wantNote: "Log meals in your diary",
+		},
+		{
+			name:     "few intake days",
+			weighIns: daily(70, 69.9, 69.8, 69.7, 69.6, 69.5, 69.4, 69.3),
+			intake:   intakeFor(3, 2000),
+			wantRate: true,
+			wantNote: "logged on only 3 of the last 14 days",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analysis := Analyze(tt.weighIns, tt.intake)
+
+			assert.Equal(t, tt.wantRate, analysis.WeeklyRateKg != nil)
+			require.NotEmpty(t, analysis.Notes)
+			assert.Contains(t, analysis.Notes[len(analysis.Notes)-1], tt.wantNote)
+		})
+	}
+}
@@ -8,7 +8,10 @@ import (
 	"db-gateway-service/internal/database"
 	"db-gateway-service/internal/services"
 	"db-gateway-service/proto"
+	checkins "db-gateway-service/sql/check-in-service"
+	meals "db-gateway-service/sql/meal-service"
 	users "db-gateway-service/sql/user-service"
+	workouts "db-gateway-service/sql/workout-service"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -45,15 +48,36 @@ func main() {
 
 	// Initialize repositories
 	userRepo := users.NewRepository(dbPool.GetDB())
+	mealRepo := meals.NewRepository(dbPool.GetDB())
+	checkInRepo := checkins.NewRepository(dbPool.GetDB())
+	workoutRepo := workouts.NewRepository(dbPool.GetDB())
 
 	// Create gRPC server
 	grpcServer := grpc.NewServer()
 
 	// Initialize and register services
 	userService := services.NewUserService(userRepo)
+	diaryService := services.NewDiaryService(mealRepo)
+	nutritionService := services.NewNutritionService(mealRepo, userRepo, workoutRepo)
+	progressService := services.NewProgressService(checkInRepo, mealRepo)
+	foodPreferenceService := services.NewFoodPreferenceService(mealRepo)
+	mealPlanService := services.NewMealPlanService(mealRepo, userRepo)
+	substitutionService := services.NewSubstitutionService(mealRepo)
+	shoppingListService := services.NewShoppingListService(mealRepo)
+	mealService := services.NewMealService(mealRepo)
+	cycleService := services.NewCycleService(mealRepo, userRepo)
 
 	// Register services with gRPC server
 	proto.RegisterUserServiceServer(grpcServer, userService)
+	proto.RegisterDiaryServiceServer(grpcServer, diaryService)
+	proto.RegisterNutritionServiceServer(grpcServer, nutritionService)
+	proto.RegisterProgressServiceServer(grpcServer, progressService)
+	proto.RegisterFoodPreferenceServiceServer(grpcServer, foodPreferenceService)
+	proto.RegisterMealPlanServiceServer(grpcServer, mealPlanService)
+	proto.RegisterSubstitutionServiceServer(grpcServer, substitutionService)
+	proto.RegisterShoppingListServiceServer(grpcServer, shoppingListService)
+	proto.RegisterMealServiceServer(grpcServer, mealService)
+	proto.RegisterCycleServiceServer(grpcServer, cycleService)
 
 	// Enable reflection for development
 	reflection.Register(grpcServer)
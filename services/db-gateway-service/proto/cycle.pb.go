@@ -0,0 +1,1014 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        v5.29.3
+// source: proto/cycle.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A day of a user's cycle log
+type CycleLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD in the user's timezone
+	PeriodStarted bool                   `protobuf:"varint,2,opt,name=period_started,json=periodStarted,proto3" json:"period_started,omitempty"`
+	Symptoms      []string               `protobuf:"bytes,3,rep,name=symptoms,proto3" json:"symptoms,omitempty"` // CRAMPS, BLOATING, FATIGUE, HEADACHE, CRAVINGS, MOOD_CHANGES, BREAST_TENDERNESS, ACNE, NAUSEA, INSOMNIA
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CycleLog) Reset() {
+	*x = CycleLog{}
+	mi := &file_proto_cycle_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CycleLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CycleLog) ProtoMessage() {}
+
+func (x *CycleLog) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cycle_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CycleLog.ProtoReflect.Descriptor instead.
+func (*CycleLog) Descriptor() ([]byte, []int) {
+	return file_proto_cycle_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CycleLog) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CycleLog) GetPeriodStarted() bool {
+	if x != nil {
+		return x.PeriodStarted
+	}
+	return false
+}
+
+func (x *CycleLog) GetSymptoms() []string {
+	if x != nil {
+		return x.Symptoms
+	}
+	return nil
+}
+
+// A catalog food rich in the phase's focus nutrient
+type SuggestedFood struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FoodId         int32                  `protobuf:"varint,1,opt,name=food_id,json=foodId,proto3" json:"food_id,omitempty"`
+	FoodName       string                 `protobuf:"bytes,2,opt,name=food_name,json=foodName,proto3" json:"food_name,omitempty"`
+	Category       string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	ServingUnits   string                 `protobuf:"bytes,4,opt,name=serving_units,json=servingUnits,proto3" json:"serving_units,omitempty"`
+	Calories       float64                `protobuf:"fixed64,5,opt,name=calories,proto3" json:"calories,omitempty"`                                   // per serving
+	NutrientAmount float64                `protobuf:"fixed64,6,opt,name=nutrient_amount,json=nutrientAmount,proto3" json:"nutrient_amount,omitempty"` // focus nutrient per serving, in its unit
+	NutrientUnit   string                 `protobuf:"bytes,7,opt,name=nutrient_unit,json=nutrientUnit,proto3" json:"nutrient_unit,omitempty"`
+	Liked          bool                   `protobuf:"varint,8,opt,name=liked,proto3" json:"liked,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SuggestedFood) Reset() {
+	*x = SuggestedFood{}
+	mi := &file_proto_cycle_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestedFood) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestedFood) ProtoMessage() {}
+
+func (x *SuggestedFood) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cycle_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestedFood.ProtoReflect.Descriptor instead.
+func (*SuggestedFood) Descriptor() ([]byte, []int) {
+	return file_proto_cycle_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SuggestedFood) GetFoodId() int32 {
+	if x != nil {
+		return x.FoodId
+	}
+	return 0
+}
+
+func (x *SuggestedFood) GetFoodName() string {
+	if x != nil {
+		return x.FoodName
+	}
+	return ""
+}
+
+func (x *SuggestedFood) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SuggestedFood) GetServingUnits() string {
+	if x != nil {
+		return x.ServingUnits
+	}
+	return ""
+}
+
+func (x *SuggestedFood) GetCalories() float64 {
+	if x != nil {
+		return x.Calories
+	}
+	return 0
+}
+
+func (x *SuggestedFood) GetNutrientAmount() float64 {
+	if x != nil {
+		return x.NutrientAmount
+	}
+	return 0
+}
+
+func (x *SuggestedFood) GetNutrientUnit() string {
+	if x != nil {
+		return x.NutrientUnit
+	}
+	return ""
+}
+
+func (x *SuggestedFood) GetLiked() bool {
+	if x != nil {
+		return x.Liked
+	}
+	return false
+}
+
+// The predicted cycle phase of a date and how it adjusts targets and foods
+type CyclePhase struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Date                     string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Phase                    string                 `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"`                                 // MENSTRUAL, FOLLICULAR, OVULATORY or LUTEAL
+	CycleDay                 int32                  `protobuf:"varint,3,opt,name=cycle_day,json=cycleDay,proto3" json:"cycle_day,omitempty"`          // 1 on the day a period starts
+	CycleLength              int32                  `protobuf:"varint,4,opt,name=cycle_length,json=cycleLength,proto3" json:"cycle_length,omitempty"` // average of recent cycles, or 28 when unknown
+	CyclesUsed               int32                  `protobuf:"varint,5,opt,name=cycles_used,json=cyclesUsed,proto3" json:"cycles_used,omitempty"`    // cycles averaged; 0 means the default length was assumed
+	LastPeriodStart          string                 `protobuf:"bytes,6,opt,name=last_period_start,json=lastPeriodStart,proto3" json:"last_period_start,omitempty"`
+	NextPeriodStart          string                 `protobuf:"bytes,7,opt,name=next_period_start,json=nextPeriodStart,proto3" json:"next_period_start,omitempty"` // predicted
+	OvulationDate            string                 `protobuf:"bytes,8,opt,name=ovulation_date,json=ovulationDate,proto3" json:"ovulation_date,omitempty"`         // predicted
+	Projected                bool                   `protobuf:"varint,9,opt,name=projected,proto3" json:"projected,omitempty"`                                     // starts since the last logged one were projected
+	CalorieAdjustmentPercent float64                `protobuf:"fixed64,10,opt,name=calorie_adjustment_percent,json=calorieAdjustmentPercent,proto3" json:"calorie_adjustment_percent,omitempty"`
+	FocusNutrient            string                 `protobuf:"bytes,11,opt,name=focus_nutrient,json=focusNutrient,proto3" json:"focus_nutrient,omitempty"`    // micronutrient the suggested foods are rich in, if any
+	SuggestedFoods           []*SuggestedFood       `protobuf:"bytes,12,rep,name=suggested_foods,json=suggestedFoods,proto3" json:"suggested_foods,omitempty"` // highest focus nutrient per 100 kcal first
+	Notes                    []string               `protobuf:"bytes,13,rep,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *CyclePhase) Reset() {
+	*x = CyclePhase{}
+	mi := &file_proto_cycle_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CyclePhase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CyclePhase) ProtoMessage() {}
+
+func (x *CyclePhase) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cycle_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CyclePhase.ProtoReflect.Descriptor instead.
+func (*CyclePhase) Descriptor() ([]byte, []int) {
+	return file_proto_cycle_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CyclePhase) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CyclePhase) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *CyclePhase) GetCycleDay() int32 {
+	if x != nil {
+		return x.CycleDay
+	}
+	return 0
+}
+
+func (x *CyclePhase) GetCycleLength() int32 {
+	if x != nil {
+		return x.CycleLength
+	}
+	return 0
+}
+
+func (x *CyclePhase) GetCyclesUsed() int32 {
+	if x != nil {
+		return x.CyclesUsed
+	}
+	return 0
+}
+
+func (x *CyclePhase) GetLastPeriodStart() string {
+	if x != nil {
+		return x.LastPeriodStart
+	}
+	return ""
+}
+
+func (x *CyclePhase) GetNextPeriodStart() string {
+	if x != nil {
+		return x.NextPeriodStart
+	}
+	return ""
+}
+
+func (x *CyclePhase) GetOvulationDate() string {
+	if x != nil {
+		return x.OvulationDate
+	}
+	return ""
+}
+
+func (x *CyclePhase) GetProjected() bool {
+	if x != nil {
+		return x.Projected
+	}
+	return false
+}
+
+func (x *CyclePhase) GetCalorieAdjustmentPercent() float64 {
+	if x != nil {
+		return x.CalorieAdjustmentPercent
+	}
+	return 0
+}
+
+func (x *CyclePhase) GetFocusNutrient() string {
+	if x != nil {
+		return x.FocusNutrient
+	}
+	return ""
+}
+
+func (x *CyclePhase) GetSuggestedFoods() []*SuggestedFood {
+	if x != nil {
+		return x.SuggestedFoods
+	}
+	return nil
+}
+
+func (x *CyclePhase) GetNotes() []string {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+// Request/Response messages
+type CycleTrackingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CycleTrackingRequest) Reset() {
+	*x = CycleTrackingRequest{}
+	mi := &file_proto_cycle_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CycleTrackingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CycleTrackingRequest) ProtoMessage() {}
+
+func (x *CycleTrackingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cycle_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CycleTrackingRequest.ProtoReflect.Descriptor instead.
+func (*CycleTrackingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cycle_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CycleTrackingRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type CycleTrackingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CycleTrackingResponse) Reset() {
+	*x = CycleTrackingResponse{}
+	mi := &file_proto_cycle_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CycleTrackingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CycleTrackingResponse) ProtoMessage() {}
+
+func (x *CycleTrackingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cycle_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CycleTrackingResponse.ProtoReflect.Descriptor instead.
+func (*CycleTrackingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cycle_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CycleTrackingResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *CycleTrackingResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type LogCycleDayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // optional, defaults to today in the user's timezone
+	PeriodStarted bool                   `protobuf:"varint,3,opt,name=period_started,json=periodStarted,proto3" json:"period_started,omitempty"`
+	Symptoms      []string               `protobuf:"bytes,4,rep,name=symptoms,proto3" json:"symptoms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogCycleDayRequest) Reset() {
+	*x = LogCycleDayRequest{}
+	mi := &file_proto_cycle_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogCycleDayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogCycleDayRequest) ProtoMessage() {}
+
+func (x *LogCycleDayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cycle_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogCycleDayRequest.ProtoReflect.Descriptor instead.
+func (*LogCycleDayRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cycle_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LogCycleDayRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LogCycleDayRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *LogCycleDayRequest) GetPeriodStarted() bool {
+	if x != nil {
+		return x.PeriodStarted
+	}
+	return false
+}
+
+func (x *LogCycleDayRequest) GetSymptoms() []string {
+	if x != nil {
+		return x.Symptoms
+	}
+	return nil
+}
+
+type CycleLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Log           *CycleLog              `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CycleLogResponse) Reset() {
+	*x = CycleLogResponse{}
+	mi := &file_proto_cycle_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CycleLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CycleLogResponse) ProtoMessage() {}
+
+func (x *CycleLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cycle_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CycleLogResponse.ProtoReflect.Descriptor instead.
+func (*CycleLogResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cycle_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CycleLogResponse) GetLog() *CycleLog {
+	if x != nil {
+		return x.Log
+	}
+	return nil
+}
+
+func (x *CycleLogResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListCycleLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // optional, defaults to 89 days before end_date
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // optional, defaults to today in the user's timezone
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCycleLogsRequest) Reset() {
+	*x = ListCycleLogsRequest{}
+	mi := &file_proto_cycle_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCycleLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCycleLogsRequest) ProtoMessage() {}
+
+func (x *ListCycleLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cycle_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCycleLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListCycleLogsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cycle_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListCycleLogsRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListCycleLogsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ListCycleLogsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type ListCycleLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Logs          []*CycleLog            `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCycleLogsResponse) Reset() {
+	*x = ListCycleLogsResponse{}
+	mi := &file_proto_cycle_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCycleLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCycleLogsResponse) ProtoMessage() {}
+
+func (x *ListCycleLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cycle_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCycleLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListCycleLogsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cycle_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListCycleLogsResponse) GetLogs() []*CycleLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *ListCycleLogsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DeleteCycleLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCycleLogRequest) Reset() {
+	*x = DeleteCycleLogRequest{}
+	mi := &file_proto_cycle_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCycleLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCycleLogRequest) ProtoMessage() {}
+
+func (x *DeleteCycleLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cycle_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCycleLogRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCycleLogRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cycle_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteCycleLogRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteCycleLogRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type DeleteCycleLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCycleLogResponse) Reset() {
+	*x = DeleteCycleLogResponse{}
+	mi := &file_proto_cycle_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCycleLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCycleLogResponse) ProtoMessage() {}
+
+func (x *DeleteCycleLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cycle_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCycleLogResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCycleLogResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cycle_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteCycleLogResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DeleteCycleLogResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetCyclePhaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // optional, defaults to today in the user's timezone
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCyclePhaseRequest) Reset() {
+	*x = GetCyclePhaseRequest{}
+	mi := &file_proto_cycle_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCyclePhaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCyclePhaseRequest) ProtoMessage() {}
+
+func (x *GetCyclePhaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cycle_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCyclePhaseRequest.ProtoReflect.Descriptor instead.
+func (*GetCyclePhaseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_cycle_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetCyclePhaseRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetCyclePhaseRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type CyclePhaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phase         *CyclePhase            `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CyclePhaseResponse) Reset() {
+	*x = CyclePhaseResponse{}
+	mi := &file_proto_cycle_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CyclePhaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CyclePhaseResponse) ProtoMessage() {}
+
+func (x *CyclePhaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_cycle_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CyclePhaseResponse.ProtoReflect.Descriptor instead.
+func (*CyclePhaseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_cycle_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CyclePhaseResponse) GetPhase() *CyclePhase {
+	if x != nil {
+		return x.Phase
+	}
+	return nil
+}
+
+func (x *CyclePhaseResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_proto_cycle_proto protoreflect.FileDescriptor
+
+const file_proto_cycle_proto_rawDesc = "" +
+	"\n" +
+	"\x11proto/cycle.proto\x12\x04user\"a\n" +
+	"\bCycleLog\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12%\n" +
+	"\x0eperiod_started\x18\x02 \x01(\bR\rperiodStarted\x12\x1a\n" +
+	"\bsymptoms\x18\x03 \x03(\tR\bsymptoms\"\x86\x02\n" +
+	"\rSuggestedFood\x12\x17\n" +
+	"\afood_id\x18\x01 \x01(\x05R\x06foodId\x12\x1b\n" +
+	"\tfood_name\x18\x02 \x01(\tR\bfoodName\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12#\n" +
+	"\rserving_units\x18\x04 \x01(\tR\fservingUnits\x12\x1a\n" +
+	"\bcalories\x18\x05 \x01(\x01R\bcalories\x12'\n" +
+	"\x0fnutrient_amount\x18\x06 \x01(\x01R\x0enutrientAmount\x12#\n" +
+	"\rnutrient_unit\x18\a \x01(\tR\fnutrientUnit\x12\x14\n" +
+	"\x05liked\x18\b \x01(\bR\x05liked\"\xed\x03\n" +
+	"\n" +
+	"CyclePhase\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
+	"\x05phase\x18\x02 \x01(\tR\x05phase\x12\x1b\n" +
+	"\tcycle_day\x18\x03 \x01(\x05R\bcycleDay\x12!\n" +
+	"\fcycle_length\x18\x04 \x01(\x05R\vcycleLength\x12\x1f\n" +
+	"\vcycles_used\x18\x05 \x01(\x05R\n" +
+	"cyclesUsed\x12*\n" +
+	"\x11last_period_start\x18\x06 \x01(\tR\x0flastPeriodStart\x12*\n" +
+	"\x11next_period_start\x18\a \x01(\tR\x0fnextPeriodStart\x12%\n" +
+	"\x0eovulation_date\x18\b \x01(\tR\rovulationDate\x12\x1c\n" +
+	"\tprojected\x18\t \x01(\bR\tprojected\x12<\n" +
+	"\x1acalorie_adjustment_percent\x18\n" +
+	" \x01(\x01R\x18calorieAdjustmentPercent\x12%\n" +
+	"\x0efocus_nutrient\x18\v \x01(\tR\rfocusNutrient\x12<\n" +
+	"\x0fsuggested_foods\x18\f \x03(\v2\x13.user.SuggestedFoodR\x0esuggestedFoods\x12\x14\n" +
+	"\x05notes\x18\r \x03(\tR\x05notes\"/\n" +
+	"\x14CycleTrackingRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"G\n" +
+	"\x15CycleTrackingResponse\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\x84\x01\n" +
+	"\x12LogCycleDayRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12%\n" +
+	"\x0eperiod_started\x18\x03 \x01(\bR\rperiodStarted\x12\x1a\n" +
+	"\bsymptoms\x18\x04 \x03(\tR\bsymptoms\"J\n" +
+	"\x10CycleLogResponse\x12 \n" +
+	"\x03log\x18\x01 \x01(\v2\x0e.user.CycleLogR\x03log\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"i\n" +
+	"\x14ListCycleLogsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\"Q\n" +
+	"\x15ListCycleLogsResponse\x12\"\n" +
+	"\x04logs\x18\x01 \x03(\v2\x0e.user.CycleLogR\x04logs\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"D\n" +
+	"\x15DeleteCycleLogRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\"H\n" +
+	"\x16DeleteCycleLogResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"C\n" +
+	"\x14GetCyclePhaseRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\"R\n" +
+	"\x12CyclePhaseResponse\x12&\n" +
+	"\x05phase\x18\x01 \x01(\v2\x10.user.CyclePhaseR\x05phase\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error2\xce\x03\n" +
+	"\fCycleService\x12N\n" +
+	"\x13EnableCycleTracking\x12\x1a.user.CycleTrackingRequest\x1a\x1b.user.CycleTrackingResponse\x12O\n" +
+	"\x14DisableCycleTracking\x12\x1a.user.CycleTrackingRequest\x1a\x1b.user.CycleTrackingResponse\x12?\n" +
+	"\vLogCycleDay\x12\x18.user.LogCycleDayRequest\x1a\x16.user.CycleLogResponse\x12H\n" +
+	"\rListCycleLogs\x12\x1a.user.ListCycleLogsRequest\x1a\x1b.user.ListCycleLogsResponse\x12K\n" +
+	"\x0eDeleteCycleLog\x12\x1b.user.DeleteCycleLogRequest\x1a\x1c.user.DeleteCycleLogResponse\x12E\n" +
+	"\rGetCyclePhase\x12\x1a.user.GetCyclePhaseRequest\x1a\x18.user.CyclePhaseResponseB\tZ\a./protob\x06proto3"
+
+var (
+	file_proto_cycle_proto_rawDescOnce sync.Once
+	file_proto_cycle_proto_rawDescData []byte
+)
+
+func file_proto_cycle_proto_rawDescGZIP() []byte {
+	file_proto_cycle_proto_rawDescOnce.Do(func() {
+		file_proto_cycle_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_cycle_proto_rawDesc), len(file_proto_cycle_proto_rawDesc)))
+	})
+	return file_proto_cycle_proto_rawDescData
+}
+
+var file_proto_cycle_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_proto_cycle_proto_goTypes = []any{
+	(*CycleLog)(nil),               // 0: user.CycleLog
+	(*SuggestedFood)(nil),          // 1: user.SuggestedFood
+	(*CyclePhase)(nil),             // 2: user.CyclePhase
+	(*CycleTrackingRequest)(nil),   // 3: user.CycleTrackingRequest
+	(*CycleTrackingResponse)(nil),  // 4: user.CycleTrackingResponse
+	(*LogCycleDayRequest)(nil),     // 5: user.LogCycleDayRequest
+	(*CycleLogResponse)(nil),       // 6: user.CycleLogResponse
+	(*ListCycleLogsRequest)(nil),   // 7: user.ListCycleLogsRequest
+	(*ListCycleLogsResponse)(nil),  // 8: user.ListCycleLogsResponse
+	(*DeleteCycleLogRequest)(nil),  // 9: user.DeleteCycleLogRequest
+	(*DeleteCycleLogResponse)(nil), // 10: user.DeleteCycleLogResponse
+	(*GetCyclePhaseRequest)(nil),   // 11: user.GetCyclePhaseRequest
+	(*CyclePhaseResponse)(nil),     // 12: user.CyclePhaseResponse
+}
+var file_proto_cycle_proto_depIdxs = []int32{
+	1,  // 0: user.CyclePhase.suggested_foods:type_name -> user.SuggestedFood
+	0,  // 1: user.CycleLogResponse.log:type_name -> user.CycleLog
+	0,  // 2: user.ListCycleLogsResponse.logs:type_name -> user.CycleLog
+	2,  // 3: user.CyclePhaseResponse.phase:type_name -> user.CyclePhase
+	3,  // 4: user.CycleService.EnableCycleTracking:input_type -> user.CycleTrackingRequest
+	3,  // 5: user.CycleService.DisableCycleTracking:input_type -> user.CycleTrackingRequest
+	5,  // 6: user.CycleService.LogCycleDay:input_type -> user.LogCycleDayRequest
+	7,  // 7: user.CycleService.ListCycleLogs:input_type -> user.ListCycleLogsRequest
+	9,  // 8: user.CycleService.DeleteCycleLog:input_type -> user.DeleteCycleLogRequest
+	11, // 9: user.CycleService.GetCyclePhase:input_type -> user.GetCyclePhaseRequest
+	4,  // 10: user.CycleService.EnableCycleTracking:output_type -> user.CycleTrackingResponse
+	4,  // 11: user.CycleService.DisableCycleTracking:output_type -> user.CycleTrackingResponse
+	6,  // 12: user.CycleService.LogCycleDay:output_type -> user.CycleLogResponse
+	8,  // 13: user.CycleService.ListCycleLogs:output_type -> user.ListCycleLogsResponse
+	10, // 14: user.CycleService.DeleteCycleLog:output_type -> user.DeleteCycleLogResponse
+	12, // 15: user.CycleService.GetCyclePhase:output_type -> user.CyclePhaseResponse
+	10, // [10:16] is the sub-list for method output_type
+	4,  // [4:10] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_proto_cycle_proto_init() }
+func file_proto_cycle_proto_init() {
+	if File_proto_cycle_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_cycle_proto_rawDesc), len(file_proto_cycle_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_cycle_proto_goTypes,
+		DependencyIndexes: file_proto_cycle_proto_depIdxs,
+		MessageInfos:      file_proto_cycle_proto_msgTypes,
+	}.Build()
+	File_proto_cycle_proto = out.File
+	file_proto_cycle_proto_goTypes = nil
+	file_proto_cycle_proto_depIdxs = nil
+}
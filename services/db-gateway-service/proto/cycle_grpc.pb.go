@@ -0,0 +1,317 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: proto/cycle.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CycleService_EnableCycleTracking_FullMethodName  = "/user.CycleService/EnableCycleTracking"
+	CycleService_DisableCycleTracking_FullMethodName = "/user.CycleService/DisableCycleTracking"
+	CycleService_LogCycleDay_FullMethodName          = "/user.CycleService/LogCycleDay"
+	CycleService_ListCycleLogs_FullMethodName        = "/user.CycleService/ListCycleLogs"
+	CycleService_DeleteCycleLog_FullMethodName       = "/user.CycleService/DeleteCycleLog"
+	CycleService_GetCyclePhase_FullMethodName        = "/user.CycleService/GetCyclePhase"
+)
+
+// CycleServiceClient is the client API for CycleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Menstrual cycle tracking gRPC definitions. Tracking is opt-in and cycle
+// data is only ever returned to the user it belongs to.
+type CycleServiceClient interface {
+	EnableCycleTracking(ctx context.Context, in *CycleTrackingRequest, opts ...grpc.CallOption) (*CycleTrackingResponse, error)
+	DisableCycleTracking(ctx context.Context, in *CycleTrackingRequest, opts ...grpc.CallOption) (*CycleTrackingResponse, error)
+	LogCycleDay(ctx context.Context, in *LogCycleDayRequest, opts ...grpc.CallOption) (*CycleLogResponse, error)
+	ListCycleLogs(ctx context.Context, in *ListCycleLogsRequest, opts ...grpc.CallOption) (*ListCycleLogsResponse, error)
+	DeleteCycleLog(ctx context.Context, in *DeleteCycleLogRequest, opts ...grpc.CallOption) (*DeleteCycleLogResponse, error)
+	GetCyclePhase(ctx context.Context, in *GetCyclePhaseRequest, opts ...grpc.CallOption) (*CyclePhaseResponse, error)
+}
+
+type cycleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCycleServiceClient(cc grpc.ClientConnInterface) CycleServiceClient {
+	return &cycleServiceClient{cc}
+}
+
+func (c *cycleServiceClient) EnableCycleTracking(ctx context.Context, in *CycleTrackingRequest, opts ...grpc.CallOption) (*CycleTrackingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CycleTrackingResponse)
+	err := c.cc.Invoke(ctx, CycleService_EnableCycleTracking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cycleServiceClient) DisableCycleTracking(ctx context.Context, in *CycleTrackingRequest, opts ...grpc.CallOption) (*CycleTrackingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CycleTrackingResponse)
+	err := c.cc.Invoke(ctx, CycleService_DisableCycleTracking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cycleServiceClient) LogCycleDay(ctx context.Context, in *LogCycleDayRequest, opts ...grpc.CallOption) (*CycleLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CycleLogResponse)
+	err := c.cc.Invoke(ctx, CycleService_LogCycleDay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cycleServiceClient) ListCycleLogs(ctx context.Context, in *ListCycleLogsRequest, opts ...grpc.CallOption) (*ListCycleLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCycleLogsResponse)
+	err := c.cc.Invoke(ctx, CycleService_ListCycleLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cycleServiceClient) DeleteCycleLog(ctx context.Context, in *DeleteCycleLogRequest, opts ...grpc.CallOption) (*DeleteCycleLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCycleLogResponse)
+	err := c.cc.Invoke(ctx, CycleService_DeleteCycleLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cycleServiceClient) GetCyclePhase(ctx context.Context, in *GetCyclePhaseRequest, opts ...grpc.CallOption) (*CyclePhaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CyclePhaseResponse)
+	err := c.cc.Invoke(ctx, CycleService_GetCyclePhase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CycleServiceServer is the server API for CycleService service.
+// All implementations must embed UnimplementedCycleServiceServer
+// for forward compatibility.
+//
+// Menstrual cycle tracking gRPC definitions. Tracking is opt-in and cycle
+// data is only ever returned to the user it belongs to.
+type CycleServiceServer interface {
+	EnableCycleTracking(context.Context, *CycleTrackingRequest) (*CycleTrackingResponse, error)
+	DisableCycleTracking(context.Context, *CycleTrackingRequest) (*CycleTrackingResponse, error)
+	LogCycleDay(context.Context, *LogCycleDayRequest) (*CycleLogResponse, error)
+	ListCycleLogs(context.Context, *ListCycleLogsRequest) (*ListCycleLogsResponse, error)
+	DeleteCycleLog(context.Context, *DeleteCycleLogRequest) (*DeleteCycleLogResponse, error)
+	GetCyclePhase(context.Context, *GetCyclePhaseRequest) (*CyclePhaseResponse, error)
+	mustEmbedUnimplementedCycleServiceServer()
+}
+
+// UnimplementedCycleServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCycleServiceServer struct{}
+
+func (UnimplementedCycleServiceServer) EnableCycleTracking(context.Context, *CycleTrackingRequest) (*CycleTrackingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableCycleTracking not implemented")
+}
+func (UnimplementedCycleServiceServer) DisableCycleTracking(context.Context, *CycleTrackingRequest) (*CycleTrackingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableCycleTracking not implemented")
+}
+func (UnimplementedCycleServiceServer) LogCycleDay(context.Context, *LogCycleDayRequest) (*CycleLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogCycleDay not implemented")
+}
+func (UnimplementedCycleServiceServer) ListCycleLogs(context.Context, *ListCycleLogsRequest) (*ListCycleLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCycleLogs not implemented")
+}
+func (UnimplementedCycleServiceServer) DeleteCycleLog(context.Context, *DeleteCycleLogRequest) (*DeleteCycleLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCycleLog not implemented")
+}
+func (UnimplementedCycleServiceServer) GetCyclePhase(context.Context, *GetCyclePhaseRequest) (*CyclePhaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCyclePhase not implemented")
+}
+func (UnimplementedCycleServiceServer) mustEmbedUnimplementedCycleServiceServer() {}
+func (UnimplementedCycleServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeCycleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CycleServiceServer will
+// result in compilation errors.
+type UnsafeCycleServiceServer interface {
+	mustEmbedUnimplementedCycleServiceServer()
+}
+
+func RegisterCycleServiceServer(s grpc.ServiceRegistrar, srv CycleServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCycleServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CycleService_ServiceDesc, srv)
+}
+
+func _CycleService_EnableCycleTracking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CycleTrackingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CycleServiceServer).EnableCycleTracking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CycleService_EnableCycleTracking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CycleServiceServer).EnableCycleTracking(ctx, req.(*CycleTrackingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CycleService_DisableCycleTracking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CycleTrackingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CycleServiceServer).DisableCycleTracking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CycleService_DisableCycleTracking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CycleServiceServer).DisableCycleTracking(ctx, req.(*CycleTrackingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CycleService_LogCycleDay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogCycleDayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CycleServiceServer).LogCycleDay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CycleService_LogCycleDay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CycleServiceServer).LogCycleDay(ctx, req.(*LogCycleDayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CycleService_ListCycleLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCycleLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CycleServiceServer).ListCycleLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CycleService_ListCycleLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CycleServiceServer).ListCycleLogs(ctx, req.(*ListCycleLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CycleService_DeleteCycleLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCycleLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CycleServiceServer).DeleteCycleLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CycleService_DeleteCycleLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CycleServiceServer).DeleteCycleLog(ctx, req.(*DeleteCycleLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CycleService_GetCyclePhase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCyclePhaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CycleServiceServer).GetCyclePhase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CycleService_GetCyclePhase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CycleServiceServer).GetCyclePhase(ctx, req.(*GetCyclePhaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CycleService_ServiceDesc is the grpc.ServiceDesc for CycleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CycleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.CycleService",
+	HandlerType: (*CycleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EnableCycleTracking",
+			Handler:    _CycleService_EnableCycleTracking_Handler,
+		},
+		{
+			MethodName: "DisableCycleTracking",
+			Handler:    _CycleService_DisableCycleTracking_Handler,
+		},
+		{
+			MethodName: "LogCycleDay",
+			Handler:    _CycleService_LogCycleDay_Handler,
+		},
+		{
+			MethodName: "ListCycleLogs",
+			Handler:    _CycleService_ListCycleLogs_Handler,
+		},
+		{
+			MethodName: "DeleteCycleLog",
+			Handler:    _CycleService_DeleteCycleLog_Handler,
+		},
+		{
+			MethodName: "GetCyclePhase",
+			Handler:    _CycleService_GetCyclePhase_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/cycle.proto",
+}
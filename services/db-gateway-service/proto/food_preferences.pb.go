@@ -0,0 +1,930 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        v5.29.3
+// source: proto/food_preferences.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Food catalog item. liked is set when the food is listed for a user.
+type Food struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FoodName          string                 `protobuf:"bytes,2,opt,name=food_name,json=foodName,proto3" json:"food_name,omitempty"`
+	Category          string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	ServingUnits      string                 `protobuf:"bytes,4,opt,name=serving_units,json=servingUnits,proto3" json:"serving_units,omitempty"`
+	Calories          float64                `protobuf:"fixed64,5,opt,name=calories,proto3" json:"calories,omitempty"`
+	ProteinGrams      float64                `protobuf:"fixed64,6,opt,name=protein_grams,json=proteinGrams,proto3" json:"protein_grams,omitempty"`
+	CarbsGrams        float64                `protobuf:"fixed64,7,opt,name=carbs_grams,json=carbsGrams,proto3" json:"carbs_grams,omitempty"`
+	FatGrams          float64                `protobuf:"fixed64,8,opt,name=fat_grams,json=fatGrams,proto3" json:"fat_grams,omitempty"`
+	IsNonInflammatory bool                   `protobuf:"varint,9,opt,name=is_non_inflammatory,json=isNonInflammatory,proto3" json:"is_non_inflammatory,omitempty"`
+	IsProbiotic       bool                   `protobuf:"varint,10,opt,name=is_probiotic,json=isProbiotic,proto3" json:"is_probiotic,omitempty"`
+	IsPrebiotic       bool                   `protobuf:"varint,11,opt,name=is_prebiotic,json=isPrebiotic,proto3" json:"is_prebiotic,omitempty"`
+	Allergens         []string               `protobuf:"bytes,12,rep,name=allergens,proto3" json:"allergens,omitempty"`
+	Liked             bool                   `protobuf:"varint,13,opt,name=liked,proto3" json:"liked,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Food) Reset() {
+	*x = Food{}
+	mi := &file_proto_food_preferences_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Food) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Food) ProtoMessage() {}
+
+func (x *Food) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_food_preferences_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Food.ProtoReflect.Descriptor instead.
+func (*Food) Descriptor() ([]byte, []int) {
+	return file_proto_food_preferences_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Food) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Food) GetFoodName() string {
+	if x != nil {
+		return x.FoodName
+	}
+	return ""
+}
+
+func (x *Food) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Food) GetServingUnits() string {
+	if x != nil {
+		return x.ServingUnits
+	}
+	return ""
+}
+
+func (x *Food) GetCalories() float64 {
+	if x != nil {
+		return x.Calories
+	}
+	return 0
+}
+
+func (x *Food) GetProteinGrams() float64 {
+	if x != nil {
+		return x.ProteinGrams
+	}
+	return 0
+}
+
+func (x *Food) GetCarbsGrams() float64 {
+	if x != nil {
+		return x.CarbsGrams
+	}
+	return 0
+}
+
+func (x *Food) GetFatGrams() float64 {
+	if x != nil {
+		return x.FatGrams
+	}
+	return 0
+}
+
+func (x *Food) GetIsNonInflammatory() bool {
+	if x != nil {
+		return x.IsNonInflammatory
+	}
+	return false
+}
+
+func (x *Food) GetIsProbiotic() bool {
+	if x != nil {
+		return x.IsProbiotic
+	}
+	return false
+}
+
+func (x *Food) GetIsPrebiotic() bool {
+	if x != nil {
+		return x.IsPrebiotic
+	}
+	return false
+}
+
+func (x *Food) GetAllergens() []string {
+	if x != nil {
+		return x.Allergens
+	}
+	return nil
+}
+
+func (x *Food) GetLiked() bool {
+	if x != nil {
+		return x.Liked
+	}
+	return false
+}
+
+// A user's liked and disliked foods, allergies and diet restrictions
+type FoodPreferences struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Likes            []*Food                `protobuf:"bytes,1,rep,name=likes,proto3" json:"likes,omitempty"`
+	Dislikes         []*Food                `protobuf:"bytes,2,rep,name=dislikes,proto3" json:"dislikes,omitempty"`
+	Allergies        []string               `protobuf:"bytes,3,rep,name=allergies,proto3" json:"allergies,omitempty"`
+	DietRestrictions []string               `protobuf:"bytes,4,rep,name=diet_restrictions,json=dietRestrictions,proto3" json:"diet_restrictions,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FoodPreferences) Reset() {
+	*x = FoodPreferences{}
+	mi := &file_proto_food_preferences_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FoodPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FoodPreferences) ProtoMessage() {}
+
+func (x *FoodPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_food_preferences_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FoodPreferences.ProtoReflect.Descriptor instead.
+func (*FoodPreferences) Descriptor() ([]byte, []int) {
+	return file_proto_food_preferences_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FoodPreferences) GetLikes() []*Food {
+	if x != nil {
+		return x.Likes
+	}
+	return nil
+}
+
+func (x *FoodPreferences) GetDislikes() []*Food {
+	if x != nil {
+		return x.Dislikes
+	}
+	return nil
+}
+
+func (x *FoodPreferences) GetAllergies() []string {
+	if x != nil {
+		return x.Allergies
+	}
+	return nil
+}
+
+func (x *FoodPreferences) GetDietRestrictions() []string {
+	if x != nil {
+		return x.DietRestrictions
+	}
+	return nil
+}
+
+// Request/Response messages
+type GetFoodPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFoodPreferencesRequest) Reset() {
+	*x = GetFoodPreferencesRequest{}
+	mi := &file_proto_food_preferences_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFoodPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFoodPreferencesRequest) ProtoMessage() {}
+
+func (x *GetFoodPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_food_preferences_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFoodPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetFoodPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_food_preferences_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetFoodPreferencesRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type FoodPreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preferences   *FoodPreferences       `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FoodPreferencesResponse) Reset() {
+	*x = FoodPreferencesResponse{}
+	mi := &file_proto_food_preferences_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FoodPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FoodPreferencesResponse) ProtoMessage() {}
+
+func (x *FoodPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_food_preferences_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FoodPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*FoodPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_food_preferences_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FoodPreferencesResponse) GetPreferences() *FoodPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *FoodPreferencesResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SetFoodPreferenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FoodId        int32                  `protobuf:"varint,2,opt,name=food_id,json=foodId,proto3" json:"food_id,omitempty"`
+	Preference    string                 `protobuf:"bytes,3,opt,name=preference,proto3" json:"preference,omitempty"` // LIKE or DISLIKE
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFoodPreferenceRequest) Reset() {
+	*x = SetFoodPreferenceRequest{}
+	mi := &file_proto_food_preferences_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFoodPreferenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFoodPreferenceRequest) ProtoMessage() {}
+
+func (x *SetFoodPreferenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_food_preferences_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFoodPreferenceRequest.ProtoReflect.Descriptor instead.
+func (*SetFoodPreferenceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_food_preferences_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetFoodPreferenceRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetFoodPreferenceRequest) GetFoodId() int32 {
+	if x != nil {
+		return x.FoodId
+	}
+	return 0
+}
+
+func (x *SetFoodPreferenceRequest) GetPreference() string {
+	if x != nil {
+		return x.Preference
+	}
+	return ""
+}
+
+type ClearFoodPreferenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FoodId        int32                  `protobuf:"varint,2,opt,name=food_id,json=foodId,proto3" json:"food_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearFoodPreferenceRequest) Reset() {
+	*x = ClearFoodPreferenceRequest{}
+	mi := &file_proto_food_preferences_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearFoodPreferenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearFoodPreferenceRequest) ProtoMessage() {}
+
+func (x *ClearFoodPreferenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_food_preferences_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearFoodPreferenceRequest.ProtoReflect.Descriptor instead.
+func (*ClearFoodPreferenceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_food_preferences_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ClearFoodPreferenceRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ClearFoodPreferenceRequest) GetFoodId() int32 {
+	if x != nil {
+		return x.FoodId
+	}
+	return 0
+}
+
+type SetAllergiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Allergies     []string               `protobuf:"bytes,2,rep,name=allergies,proto3" json:"allergies,omitempty"` // replaces the user's allergies
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAllergiesRequest) Reset() {
+	*x = SetAllergiesRequest{}
+	mi := &file_proto_food_preferences_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAllergiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAllergiesRequest) ProtoMessage() {}
+
+func (x *SetAllergiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_food_preferences_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAllergiesRequest.ProtoReflect.Descriptor instead.
+func (*SetAllergiesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_food_preferences_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetAllergiesRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetAllergiesRequest) GetAllergies() []string {
+	if x != nil {
+		return x.Allergies
+	}
+	return nil
+}
+
+type SetDietRestrictionsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DietRestrictions []string               `protobuf:"bytes,2,rep,name=diet_restrictions,json=dietRestrictions,proto3" json:"diet_restrictions,omitempty"` // replaces the user's diet restrictions
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetDietRestrictionsRequest) Reset() {
+	*x = SetDietRestrictionsRequest{}
+	mi := &file_proto_food_preferences_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDietRestrictionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDietRestrictionsRequest) ProtoMessage() {}
+
+func (x *SetDietRestrictionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_food_preferences_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDietRestrictionsRequest.ProtoReflect.Descriptor instead.
+func (*SetDietRestrictionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_food_preferences_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetDietRestrictionsRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetDietRestrictionsRequest) GetDietRestrictions() []string {
+	if x != nil {
+		return x.DietRestrictions
+	}
+	return nil
+}
+
+type ListFoodsForUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"` // optional
+	LikedOnly     bool                   `protobuf:"varint,3,opt,name=liked_only,json=likedOnly,proto3" json:"liked_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFoodsForUserRequest) Reset() {
+	*x = ListFoodsForUserRequest{}
+	mi := &file_proto_food_preferences_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFoodsForUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFoodsForUserRequest) ProtoMessage() {}
+
+func (x *ListFoodsForUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_food_preferences_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFoodsForUserRequest.ProtoReflect.Descriptor instead.
+func (*ListFoodsForUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_food_preferences_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListFoodsForUserRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListFoodsForUserRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ListFoodsForUserRequest) GetLikedOnly() bool {
+	if x != nil {
+		return x.LikedOnly
+	}
+	return false
+}
+
+type ListFoodsForUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Foods         []*Food                `protobuf:"bytes,1,rep,name=foods,proto3" json:"foods,omitempty"`
+	ExcludedCount int32                  `protobuf:"varint,2,opt,name=excluded_count,json=excludedCount,proto3" json:"excluded_count,omitempty"` // catalog foods hidden by the user's preferences
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFoodsForUserResponse) Reset() {
+	*x = ListFoodsForUserResponse{}
+	mi := &file_proto_food_preferences_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFoodsForUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFoodsForUserResponse) ProtoMessage() {}
+
+func (x *ListFoodsForUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_food_preferences_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFoodsForUserResponse.ProtoReflect.Descriptor instead.
+func (*ListFoodsForUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_food_preferences_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListFoodsForUserResponse) GetFoods() []*Food {
+	if x != nil {
+		return x.Foods
+	}
+	return nil
+}
+
+func (x *ListFoodsForUserResponse) GetExcludedCount() int32 {
+	if x != nil {
+		return x.ExcludedCount
+	}
+	return 0
+}
+
+func (x *ListFoodsForUserResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type LookupBarcodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Barcode       string                 `protobuf:"bytes,2,opt,name=barcode,proto3" json:"barcode,omitempty"` // EAN-8, UPC-A, EAN-13 or GTIN-14
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupBarcodeRequest) Reset() {
+	*x = LookupBarcodeRequest{}
+	mi := &file_proto_food_preferences_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupBarcodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupBarcodeRequest) ProtoMessage() {}
+
+func (x *LookupBarcodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_food_preferences_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupBarcodeRequest.ProtoReflect.Descriptor instead.
+func (*LookupBarcodeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_food_preferences_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LookupBarcodeRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LookupBarcodeRequest) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+type LookupBarcodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Food          *Food                  `protobuf:"bytes,1,opt,name=food,proto3" json:"food,omitempty"`
+	Barcode       string                 `protobuf:"bytes,2,opt,name=barcode,proto3" json:"barcode,omitempty"` // normalized barcode
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupBarcodeResponse) Reset() {
+	*x = LookupBarcodeResponse{}
+	mi := &file_proto_food_preferences_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupBarcodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupBarcodeResponse) ProtoMessage() {}
+
+func (x *LookupBarcodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_food_preferences_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupBarcodeResponse.ProtoReflect.Descriptor instead.
+func (*LookupBarcodeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_food_preferences_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LookupBarcodeResponse) GetFood() *Food {
+	if x != nil {
+		return x.Food
+	}
+	return nil
+}
+
+func (x *LookupBarcodeResponse) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *LookupBarcodeResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_proto_food_preferences_proto protoreflect.FileDescriptor
+
+const file_proto_food_preferences_proto_rawDesc = "" +
+	"\n" +
+	"\x1cproto/food_preferences.proto\x12\x04user\"\x9d\x03\n" +
+	"\x04Food\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
+	"\tfood_name\x18\x02 \x01(\tR\bfoodName\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12#\n" +
+	"\rserving_units\x18\x04 \x01(\tR\fservingUnits\x12\x1a\n" +
+	"\bcalories\x18\x05 \x01(\x01R\bcalories\x12#\n" +
+	"\rprotein_grams\x18\x06 \x01(\x01R\fproteinGrams\x12\x1f\n" +
+	"\vcarbs_grams\x18\a \x01(\x01R\n" +
+	"carbsGrams\x12\x1b\n" +
+	"\tfat_grams\x18\b \x01(\x01R\bfatGrams\x12.\n" +
+	"\x13is_non_inflammatory\x18\t \x01(\bR\x11isNonInflammatory\x12!\n" +
+	"\fis_probiotic\x18\n" +
+	" \x01(\bR\visProbiotic\x12!\n" +
+	"\fis_prebiotic\x18\v \x01(\bR\visPrebiotic\x12\x1c\n" +
+	"\tallergens\x18\f \x03(\tR\tallergens\x12\x14\n" +
+	"\x05liked\x18\r \x01(\bR\x05liked\"\xa6\x01\n" +
+	"\x0fFoodPreferences\x12 \n" +
+	"\x05likes\x18\x01 \x03(\v2\n" +
+	".user.FoodR\x05likes\x12&\n" +
+	"\bdislikes\x18\x02 \x03(\v2\n" +
+	".user.FoodR\bdislikes\x12\x1c\n" +
+	"\tallergies\x18\x03 \x03(\tR\tallergies\x12+\n" +
+	"\x11diet_restrictions\x18\x04 \x03(\tR\x10dietRestrictions\"4\n" +
+	"\x19GetFoodPreferencesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"h\n" +
+	"\x17FoodPreferencesResponse\x127\n" +
+	"\vpreferences\x18\x01 \x01(\v2\x15.user.FoodPreferencesR\vpreferences\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"l\n" +
+	"\x18SetFoodPreferenceRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x17\n" +
+	"\afood_id\x18\x02 \x01(\x05R\x06foodId\x12\x1e\n" +
+	"\n" +
+	"preference\x18\x03 \x01(\tR\n" +
+	"preference\"N\n" +
+	"\x1aClearFoodPreferenceRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x17\n" +
+	"\afood_id\x18\x02 \x01(\x05R\x06foodId\"L\n" +
+	"\x13SetAllergiesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1c\n" +
+	"\tallergies\x18\x02 \x03(\tR\tallergies\"b\n" +
+	"\x1aSetDietRestrictionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12+\n" +
+	"\x11diet_restrictions\x18\x02 \x03(\tR\x10dietRestrictions\"m\n" +
+	"\x17ListFoodsForUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x1d\n" +
+	"\n" +
+	"liked_only\x18\x03 \x01(\bR\tlikedOnly\"y\n" +
+	"\x18ListFoodsForUserResponse\x12 \n" +
+	"\x05foods\x18\x01 \x03(\v2\n" +
+	".user.FoodR\x05foods\x12%\n" +
+	"\x0eexcluded_count\x18\x02 \x01(\x05R\rexcludedCount\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"I\n" +
+	"\x14LookupBarcodeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x18\n" +
+	"\abarcode\x18\x02 \x01(\tR\abarcode\"g\n" +
+	"\x15LookupBarcodeResponse\x12\x1e\n" +
+	"\x04food\x18\x01 \x01(\v2\n" +
+	".user.FoodR\x04food\x12\x18\n" +
+	"\abarcode\x18\x02 \x01(\tR\abarcode\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error2\xd8\x04\n" +
+	"\x15FoodPreferenceService\x12T\n" +
+	"\x12GetFoodPreferences\x12\x1f.user.GetFoodPreferencesRequest\x1a\x1d.user.FoodPreferencesResponse\x12R\n" +
+	"\x11SetFoodPreference\x12\x1e.user.SetFoodPreferenceRequest\x1a\x1d.user.FoodPreferencesResponse\x12V\n" +
+	"\x13ClearFoodPreference\x12 .user.ClearFoodPreferenceRequest\x1a\x1d.user.FoodPreferencesResponse\x12H\n" +
+	"\fSetAllergies\x12\x19.user.SetAllergiesRequest\x1a\x1d.user.FoodPreferencesResponse\x12V\n" +
+	"\x13SetDietRestrictions\x12 .user.SetDietRestrictionsRequest\x1a\x1d.user.FoodPreferencesResponse\x12Q\n" +
+	"\x10ListFoodsForUser\x12\x1d.user.ListFoodsForUserRequest\x1a\x1e.user.ListFoodsForUserResponse\x12H\n" +
+	"\rLookupBarcode\x12\x1a.user.LookupBarcodeRequest\x1a\x1b.user.LookupBarcodeResponseB\tZ\a./protob\x06proto3"
+
+var (
+	file_proto_food_preferences_proto_rawDescOnce sync.Once
+	file_proto_food_preferences_proto_rawDescData []byte
+)
+
+func file_proto_food_preferences_proto_rawDescGZIP() []byte {
+	file_proto_food_preferences_proto_rawDescOnce.Do(func() {
+		file_proto_food_preferences_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_food_preferences_proto_rawDesc), len(file_proto_food_preferences_proto_rawDesc)))
+	})
+	return file_proto_food_preferences_proto_rawDescData
+}
+
+var file_proto_food_preferences_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_proto_food_preferences_proto_goTypes = []any{
+	(*Food)(nil),                       // 0: user.Food
+	(*FoodPreferences)(nil),            // 1: user.FoodPreferences
+	(*GetFoodPreferencesRequest)(nil),  // 2: user.GetFoodPreferencesRequest
+	(*FoodPreferencesResponse)(nil),    // 3: user.FoodPreferencesResponse
+	(*SetFoodPreferenceRequest)(nil),   // 4: user.SetFoodPreferenceRequest
+	(*ClearFoodPreferenceRequest)(nil), // 5: user.ClearFoodPreferenceRequest
+	(*SetAllergiesRequest)(nil),        // 6: user.SetAllergiesRequest
+	(*SetDietRestrictionsRequest)(nil), // 7: user.SetDietRestrictionsRequest
+	(*ListFoodsForUserRequest)(nil),    // 8: user.ListFoodsForUserRequest
+	(*ListFoodsForUserResponse)(nil),   // 9: user.ListFoodsForUserResponse
+	(*LookupBarcodeRequest)(nil),       // 10: user.LookupBarcodeRequest
+	(*LookupBarcodeResponse)(nil),      // 11: user.LookupBarcodeResponse
+}
+var file_proto_food_preferences_proto_depIdxs = []int32{
+	0,  // 0: user.FoodPreferences.likes:type_name -> user.Food
+	0,  // 1: user.FoodPreferences.dislikes:type_name -> user.Food
+	1,  // 2: user.FoodPreferencesResponse.preferences:type_name -> user.FoodPreferences
+	0,  // 3: user.ListFoodsForUserResponse.foods:type_name -> user.Food
+	0,  // 4: user.LookupBarcodeResponse.food:type_name -> user.Food
+	2,  // 5: user.FoodPreferenceService.GetFoodPreferences:input_type -> user.GetFoodPreferencesRequest
+	4,  // 6: user.FoodPreferenceService.SetFoodPreference:input_type -> user.SetFoodPreferenceRequest
+	5,  // 7: user.FoodPreferenceService.ClearFoodPreference:input_type -> user.ClearFoodPreferenceRequest
+	6,  // 8: user.FoodPreferenceService.SetAllergies:input_type -> user.SetAllergiesRequest
+	7,  // 9: user.FoodPreferenceService.SetDietRestrictions:input_type -> user.SetDietRestrictionsRequest
+	8,  // 10: user.FoodPreferenceService.ListFoodsForUser:input_type -> user.ListFoodsForUserRequest
+	10, // 11: user.FoodPreferenceService.LookupBarcode:input_type -> user.LookupBarcodeRequest
+	3,  // 12: user.FoodPreferenceService.GetFoodPreferences:output_type -> user.FoodPreferencesResponse
+	3,  // 13: user.FoodPreferenceService.SetFoodPreference:output_type -> user.FoodPreferencesResponse
+	3,  // 14: user.FoodPreferenceService.ClearFoodPreference:output_type -> user.FoodPreferencesResponse
+	3,  // 15: user.FoodPreferenceService.SetAllergies:output_type -> user.FoodPreferencesResponse
+	3,  // 16: user.FoodPreferenceService.SetDietRestrictions:output_type -> user.FoodPreferencesResponse
+	9,  // 17: user.FoodPreferenceService.ListFoodsForUser:output_type -> user.ListFoodsForUserResponse
+	11, // 18: user.FoodPreferenceService.LookupBarcode:output_type -> user.LookupBarcodeResponse
+	12, // [12:19] is the sub-list for method output_type
+	5,  // [5:12] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_proto_food_preferences_proto_init() }
+func file_proto_food_preferences_proto_init() {
+	if File_proto_food_preferences_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_food_preferences_proto_rawDesc), len(file_proto_food_preferences_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_food_preferences_proto_goTypes,
+		DependencyIndexes: file_proto_food_preferences_proto_depIdxs,
+		MessageInfos:      file_proto_food_preferences_proto_msgTypes,
+	}.Build()
+	File_proto_food_preferences_proto = out.File
+	file_proto_food_preferences_proto_goTypes = nil
+	file_proto_food_preferences_proto_depIdxs = nil
+}
@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: proto/meal_plan.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MealPlanService_GenerateMealPlan_FullMethodName = "/user.MealPlanService/GenerateMealPlan"
+	MealPlanService_GetMealPlan_FullMethodName      = "/user.MealPlanService/GetMealPlan"
+)
+
+// MealPlanServiceClient is the client API for MealPlanService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Meal planning gRPC definitions
+type MealPlanServiceClient interface {
+	GenerateMealPlan(ctx context.Context, in *GenerateMealPlanRequest, opts ...grpc.CallOption) (*MealPlanResponse, error)
+	GetMealPlan(ctx context.Context, in *GetMealPlanRequest, opts ...grpc.CallOption) (*MealPlanResponse, error)
+}
+
+type mealPlanServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMealPlanServiceClient(cc grpc.ClientConnInterface) MealPlanServiceClient {
+	return &mealPlanServiceClient{cc}
+}
+
+func (c *mealPlanServiceClient) GenerateMealPlan(ctx context.Context, in *GenerateMealPlanRequest, opts ...grpc.CallOption) (*MealPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MealPlanResponse)
+	err := c.cc.Invoke(ctx, MealPlanService_GenerateMealPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealPlanServiceClient) GetMealPlan(ctx context.Context, in *GetMealPlanRequest, opts ...grpc.CallOption) (*MealPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MealPlanResponse)
+	err := c.cc.Invoke(ctx, MealPlanService_GetMealPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MealPlanServiceServer is the server API for MealPlanService service.
+// All implementations must embed UnimplementedMealPlanServiceServer
+// for forward compatibility.
+//
+// Meal planning gRPC definitions
+type MealPlanServiceServer interface {
+	GenerateMealPlan(context.Context, *GenerateMealPlanRequest) (*MealPlanResponse, error)
+	GetMealPlan(context.Context, *GetMealPlanRequest) (*MealPlanResponse, error)
+	mustEmbedUnimplementedMealPlanServiceServer()
+}
+
+// UnimplementedMealPlanServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMealPlanServiceServer struct{}
+
+func (UnimplementedMealPlanServiceServer) GenerateMealPlan(context.Context, *GenerateMealPlanRequest) (*MealPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateMealPlan not implemented")
+}
+func (UnimplementedMealPlanServiceServer) GetMealPlan(context.Context, *GetMealPlanRequest) (*MealPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMealPlan not implemented")
+}
+func (UnimplementedMealPlanServiceServer) mustEmbedUnimplementedMealPlanServiceServer() {}
+func (UnimplementedMealPlanServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeMealPlanServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MealPlanServiceServer will
+// result in compilation errors.
+type UnsafeMealPlanServiceServer interface {
+	mustEmbedUnimplementedMealPlanServiceServer()
+}
+
+func RegisterMealPlanServiceServer(s grpc.ServiceRegistrar, srv MealPlanServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMealPlanServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MealPlanService_ServiceDesc, srv)
+}
+
+func _MealPlanService_GenerateMealPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateMealPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanServiceServer).GenerateMealPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanService_GenerateMealPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanServiceServer).GenerateMealPlan(ctx, req.(*GenerateMealPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealPlanService_GetMealPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMealPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanServiceServer).GetMealPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanService_GetMealPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanServiceServer).GetMealPlan(ctx, req.(*GetMealPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MealPlanService_ServiceDesc is the grpc.ServiceDesc for MealPlanService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MealPlanService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.MealPlanService",
+	HandlerType: (*MealPlanServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GenerateMealPlan",
+			Handler:    _MealPlanService_GenerateMealPlan_Handler,
+		},
+		{
+			MethodName: "GetMealPlan",
+			Handler:    _MealPlanService_GetMealPlan_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/meal_plan.proto",
+}
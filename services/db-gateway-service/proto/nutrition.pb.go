@@ -0,0 +1,1294 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        v5.29.3
+// source: proto/nutrition.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Nutrition consumed during one reporting period
+type NutritionPeriod struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart            string                 `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"` // YYYY-MM-DD
+	PeriodEnd              string                 `protobuf:"bytes,2,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`       // YYYY-MM-DD, inclusive
+	DaysLogged             int32                  `protobuf:"varint,3,opt,name=days_logged,json=daysLogged,proto3" json:"days_logged,omitempty"`
+	Calories               float64                `protobuf:"fixed64,4,opt,name=calories,proto3" json:"calories,omitempty"`
+	ProteinGrams           float64                `protobuf:"fixed64,5,opt,name=protein_grams,json=proteinGrams,proto3" json:"protein_grams,omitempty"`
+	CarbsGrams             float64                `protobuf:"fixed64,6,opt,name=carbs_grams,json=carbsGrams,proto3" json:"carbs_grams,omitempty"`
+	FatGrams               float64                `protobuf:"fixed64,7,opt,name=fat_grams,json=fatGrams,proto3" json:"fat_grams,omitempty"`
+	NonInflammatoryFoods   int32                  `protobuf:"varint,8,opt,name=non_inflammatory_foods,json=nonInflammatoryFoods,proto3" json:"non_inflammatory_foods,omitempty"`
+	ProbioticFoods         int32                  `protobuf:"varint,9,opt,name=probiotic_foods,json=probioticFoods,proto3" json:"probiotic_foods,omitempty"`
+	PrebioticFoods         int32                  `protobuf:"varint,10,opt,name=prebiotic_foods,json=prebioticFoods,proto3" json:"prebiotic_foods,omitempty"`
+	FiberGrams             float64                `protobuf:"fixed64,11,opt,name=fiber_grams,json=fiberGrams,proto3" json:"fiber_grams,omitempty"`
+	SugarGrams             float64                `protobuf:"fixed64,12,opt,name=sugar_grams,json=sugarGrams,proto3" json:"sugar_grams,omitempty"`
+	SodiumMg               float64                `protobuf:"fixed64,13,opt,name=sodium_mg,json=sodiumMg,proto3" json:"sodium_mg,omitempty"`
+	PotassiumMg            float64                `protobuf:"fixed64,14,opt,name=potassium_mg,json=potassiumMg,proto3" json:"potassium_mg,omitempty"`
+	IronMg                 float64                `protobuf:"fixed64,15,opt,name=iron_mg,json=ironMg,proto3" json:"iron_mg,omitempty"`
+	CalciumMg              float64                `protobuf:"fixed64,16,opt,name=calcium_mg,json=calciumMg,proto3" json:"calcium_mg,omitempty"`
+	VitaminDMcg            float64                `protobuf:"fixed64,17,opt,name=vitamin_d_mcg,json=vitaminDMcg,proto3" json:"vitamin_d_mcg,omitempty"`
+	Omega3Grams            float64                `protobuf:"fixed64,18,opt,name=omega3_grams,json=omega3Grams,proto3" json:"omega3_grams,omitempty"`
+	UntrackedItems         int32                  `protobuf:"varint,19,opt,name=untracked_items,json=untrackedItems,proto3" json:"untracked_items,omitempty"`                         // consumed items without micronutrient data
+	CaloriesBurned         float64                `protobuf:"fixed64,20,opt,name=calories_burned,json=caloriesBurned,proto3" json:"calories_burned,omitempty"`                        // from workouts started in the period
+	NetCalories            float64                `protobuf:"fixed64,21,opt,name=net_calories,json=netCalories,proto3" json:"net_calories,omitempty"`                                 // calories consumed minus calories burned
+	UnconvertedIngredients int32                  `protobuf:"varint,22,opt,name=unconverted_ingredients,json=unconvertedIngredients,proto3" json:"unconverted_ingredients,omitempty"` // meal ingredients left out: their unit does not convert to the food's serving unit
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *NutritionPeriod) Reset() {
+	*x = NutritionPeriod{}
+	mi := &file_proto_nutrition_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NutritionPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NutritionPeriod) ProtoMessage() {}
+
+func (x *NutritionPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nutrition_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NutritionPeriod.ProtoReflect.Descriptor instead.
+func (*NutritionPeriod) Descriptor() ([]byte, []int) {
+	return file_proto_nutrition_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NutritionPeriod) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *NutritionPeriod) GetPeriodEnd() string {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return ""
+}
+
+func (x *NutritionPeriod) GetDaysLogged() int32 {
+	if x != nil {
+		return x.DaysLogged
+	}
+	return 0
+}
+
+func (x *NutritionPeriod) GetCalories() float64 {
+	if x != nil {
+		return x.Calories
+	}
+	return 0
+}
+
+func (x *NutritionPeriod) GetProteinGrams() float64 {
+	if x != nil {
+		return x.ProteinGrams
+	}
+	return 0
+}
+
+func (x *NutritionPeriod) GetCarbsGrams() float64 {
+	if x != nil {
+		return x.CarbsGrams
+	}
+	return 0
+}
+
+func (x *NutritionPeriod) GetFatGrams() float64 {
+	if x != nil {
+		return x.FatGrams
+	}
+	return 0
+}
+
+func (x *NutritionPeriod) GetNonInflammatoryFoods() int32 {
+	if x != nil {
+		return x.NonInflammatoryFoods
+	}
+	return 0
+}
+
+func (x *NutritionPeriod) GetProbioticFoods() int32 {
+	if x != nil {
+		return x.ProbioticFoods
+	}
+	return 0
+}
+
+func (x *NutritionPeriod) GetPrebioticFoods() int32 {
+	if x != nil {
+		return x.PrebioticFoods
+	}
+	return 0
+}
+
+func (x *NutritionPeriod) GetFiberGrams() float64 {
+	if x != nil {
+		return x.FiberGrams
+	}
+	return 0
+}
+
+func (x *NutritionPeriod) GetSugarGrams() float64 {
+	if x != nil {
+		return x.SugarGrams
+	}
+	return 0
+}
+
+func (x *NutritionPeriod) GetSodiumMg() float64 {
+	if x != nil {
+		return x.SodiumMg
+	}
+	return 0
+}
+
+func (x *NutritionPeriod) GetPotassiumMg() float64 {
+	if x != nil {
+		return x.PotassiumMg
+	}
+	return 0
+}
+
+func (x *NutritionPeriod) GetIronMg() float64 {
+	if x != nil {
+		return x.IronMg
+	}
+	return 0
+}
+
+func (x *NutritionPeriod) GetCalciumMg() float64 {
+	if x != nil {
+		return x.CalciumMg
+	}
+	return 0
+}
+
+func (x *NutritionPeriod) GetVitaminDMcg() float64 {
+	if x != nil {
+		return x.VitaminDMcg
+	}
+	return 0
+}
+
+func (x *NutritionPeriod) GetOmega3Grams() float64 {
+	if x != nil {
+		return x.Omega3Grams
+	}
+	return 0
+}
+
+func (x *NutritionPeriod) GetUntrackedItems() int32 {
+	if x != nil {
+		return x.UntrackedItems
+	}
+	return 0
+}
+
+func (x *NutritionPeriod) GetCaloriesBurned() float64 {
+	if x != nil {
+		return x.CaloriesBurned
+	}
+	return 0
+}
+
+func (x *NutritionPeriod) GetNetCalories() float64 {
+	if x != nil {
+		return x.NetCalories
+	}
+	return 0
+}
+
+func (x *NutritionPeriod) GetUnconvertedIngredients() int32 {
+	if x != nil {
+		return x.UnconvertedIngredients
+	}
+	return 0
+}
+
+// Daily intake of one micronutrient over the logged days of a report compared
+// with the reference intake for the user's sex and age
+type NutrientGap struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Nutrient           string                 `protobuf:"bytes,1,opt,name=nutrient,proto3" json:"nutrient,omitempty"` // fiber, potassium, iron, calcium, vitamin_d, omega_3 or sodium
+	Unit               string                 `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`         // g, mg or mcg
+	Kind               string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`         // MINIMUM or LIMIT
+	ReferenceIntake    float64                `protobuf:"fixed64,4,opt,name=reference_intake,json=referenceIntake,proto3" json:"reference_intake,omitempty"`
+	AverageIntake      float64                `protobuf:"fixed64,5,opt,name=average_intake,json=averageIntake,proto3" json:"average_intake,omitempty"`
+	PercentOfReference float64                `protobuf:"fixed64,6,opt,name=percent_of_reference,json=percentOfReference,proto3" json:"percent_of_reference,omitempty"`
+	FlaggedDates       []string               `protobuf:"bytes,7,rep,name=flagged_dates,json=flaggedDates,proto3" json:"flagged_dates,omitempty"` // days below a MINIMUM or above a LIMIT
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *NutrientGap) Reset() {
+	*x = NutrientGap{}
+	mi := &file_proto_nutrition_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NutrientGap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NutrientGap) ProtoMessage() {}
+
+func (x *NutrientGap) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nutrition_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NutrientGap.ProtoReflect.Descriptor instead.
+func (*NutrientGap) Descriptor() ([]byte, []int) {
+	return file_proto_nutrition_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NutrientGap) GetNutrient() string {
+	if x != nil {
+		return x.Nutrient
+	}
+	return ""
+}
+
+func (x *NutrientGap) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *NutrientGap) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *NutrientGap) GetReferenceIntake() float64 {
+	if x != nil {
+		return x.ReferenceIntake
+	}
+	return 0
+}
+
+func (x *NutrientGap) GetAverageIntake() float64 {
+	if x != nil {
+		return x.AverageIntake
+	}
+	return 0
+}
+
+func (x *NutrientGap) GetPercentOfReference() float64 {
+	if x != nil {
+		return x.PercentOfReference
+	}
+	return 0
+}
+
+func (x *NutrientGap) GetFlaggedDates() []string {
+	if x != nil {
+		return x.FlaggedDates
+	}
+	return nil
+}
+
+// Daily calorie and macro targets
+type NutritionTargets struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Bmr                      float64                `protobuf:"fixed64,1,opt,name=bmr,proto3" json:"bmr,omitempty"`
+	Tdee                     float64                `protobuf:"fixed64,2,opt,name=tdee,proto3" json:"tdee,omitempty"`
+	CalorieAdjustmentPercent float64                `protobuf:"fixed64,3,opt,name=calorie_adjustment_percent,json=calorieAdjustmentPercent,proto3" json:"calorie_adjustment_percent,omitempty"`
+	Calories                 float64                `protobuf:"fixed64,4,opt,name=calories,proto3" json:"calories,omitempty"`
+	ProteinGrams             float64                `protobuf:"fixed64,5,opt,name=protein_grams,json=proteinGrams,proto3" json:"protein_grams,omitempty"`
+	CarbsGrams               float64                `protobuf:"fixed64,6,opt,name=carbs_grams,json=carbsGrams,proto3" json:"carbs_grams,omitempty"`
+	FatGrams                 float64                `protobuf:"fixed64,7,opt,name=fat_grams,json=fatGrams,proto3" json:"fat_grams,omitempty"`
+	ActivityLevel            string                 `protobuf:"bytes,8,opt,name=activity_level,json=activityLevel,proto3" json:"activity_level,omitempty"`
+	Goals                    []string               `protobuf:"bytes,9,rep,name=goals,proto3" json:"goals,omitempty"` // "Category/Name" of the goals that were applied
+	Notes                    []string               `protobuf:"bytes,10,rep,name=notes,proto3" json:"notes,omitempty"`
+	CyclePhase               string                 `protobuf:"bytes,11,opt,name=cycle_phase,json=cyclePhase,proto3" json:"cycle_phase,omitempty"` // today's cycle phase when cycle tracking is enabled and a period start is logged
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *NutritionTargets) Reset() {
+	*x = NutritionTargets{}
+	mi := &file_proto_nutrition_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NutritionTargets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NutritionTargets) ProtoMessage() {}
+
+func (x *NutritionTargets) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nutrition_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NutritionTargets.ProtoReflect.Descriptor instead.
+func (*NutritionTargets) Descriptor() ([]byte, []int) {
+	return file_proto_nutrition_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NutritionTargets) GetBmr() float64 {
+	if x != nil {
+		return x.Bmr
+	}
+	return 0
+}
+
+func (x *NutritionTargets) GetTdee() float64 {
+	if x != nil {
+		return x.Tdee
+	}
+	return 0
+}
+
+func (x *NutritionTargets) GetCalorieAdjustmentPercent() float64 {
+	if x != nil {
+		return x.CalorieAdjustmentPercent
+	}
+	return 0
+}
+
+func (x *NutritionTargets) GetCalories() float64 {
+	if x != nil {
+		return x.Calories
+	}
+	return 0
+}
+
+func (x *NutritionTargets) GetProteinGrams() float64 {
+	if x != nil {
+		return x.ProteinGrams
+	}
+	return 0
+}
+
+func (x *NutritionTargets) GetCarbsGrams() float64 {
+	if x != nil {
+		return x.CarbsGrams
+	}
+	return 0
+}
+
+func (x *NutritionTargets) GetFatGrams() float64 {
+	if x != nil {
+		return x.FatGrams
+	}
+	return 0
+}
+
+func (x *NutritionTargets) GetActivityLevel() string {
+	if x != nil {
+		return x.ActivityLevel
+	}
+	return ""
+}
+
+func (x *NutritionTargets) GetGoals() []string {
+	if x != nil {
+		return x.Goals
+	}
+	return nil
+}
+
+func (x *NutritionTargets) GetNotes() []string {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *NutritionTargets) GetCyclePhase() string {
+	if x != nil {
+		return x.CyclePhase
+	}
+	return ""
+}
+
+// Inflammation (0-100, percent of calories from inflammatory foods, lower is
+// better) and gut-health (0-100, higher is better) scores of one meal
+type MealScores struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	MealNumber        int32                  `protobuf:"varint,1,opt,name=meal_number,json=mealNumber,proto3" json:"meal_number,omitempty"`
+	Calories          float64                `protobuf:"fixed64,2,opt,name=calories,proto3" json:"calories,omitempty"`
+	InflammationScore float64                `protobuf:"fixed64,3,opt,name=inflammation_score,json=inflammationScore,proto3" json:"inflammation_score,omitempty"`
+	GutHealthScore    float64                `protobuf:"fixed64,4,opt,name=gut_health_score,json=gutHealthScore,proto3" json:"gut_health_score,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MealScores) Reset() {
+	*x = MealScores{}
+	mi := &file_proto_nutrition_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealScores) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealScores) ProtoMessage() {}
+
+func (x *MealScores) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nutrition_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealScores.ProtoReflect.Descriptor instead.
+func (*MealScores) Descriptor() ([]byte, []int) {
+	return file_proto_nutrition_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MealScores) GetMealNumber() int32 {
+	if x != nil {
+		return x.MealNumber
+	}
+	return 0
+}
+
+func (x *MealScores) GetCalories() float64 {
+	if x != nil {
+		return x.Calories
+	}
+	return 0
+}
+
+func (x *MealScores) GetInflammationScore() float64 {
+	if x != nil {
+		return x.InflammationScore
+	}
+	return 0
+}
+
+func (x *MealScores) GetGutHealthScore() float64 {
+	if x != nil {
+		return x.GutHealthScore
+	}
+	return 0
+}
+
+// Scores of one logged day and its meals
+type DayScores struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Date              string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Calories          float64                `protobuf:"fixed64,2,opt,name=calories,proto3" json:"calories,omitempty"`
+	InflammationScore float64                `protobuf:"fixed64,3,opt,name=inflammation_score,json=inflammationScore,proto3" json:"inflammation_score,omitempty"`
+	GutHealthScore    float64                `protobuf:"fixed64,4,opt,name=gut_health_score,json=gutHealthScore,proto3" json:"gut_health_score,omitempty"`
+	ProbioticServings float64                `protobuf:"fixed64,5,opt,name=probiotic_servings,json=probioticServings,proto3" json:"probiotic_servings,omitempty"`
+	PrebioticServings float64                `protobuf:"fixed64,6,opt,name=prebiotic_servings,json=prebioticServings,proto3" json:"prebiotic_servings,omitempty"`
+	Meals             []*MealScores          `protobuf:"bytes,7,rep,name=meals,proto3" json:"meals,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DayScores) Reset() {
+	*x = DayScores{}
+	mi := &file_proto_nutrition_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DayScores) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DayScores) ProtoMessage() {}
+
+func (x *DayScores) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nutrition_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DayScores.ProtoReflect.Descriptor instead.
+func (*DayScores) Descriptor() ([]byte, []int) {
+	return file_proto_nutrition_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DayScores) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DayScores) GetCalories() float64 {
+	if x != nil {
+		return x.Calories
+	}
+	return 0
+}
+
+func (x *DayScores) GetInflammationScore() float64 {
+	if x != nil {
+		return x.InflammationScore
+	}
+	return 0
+}
+
+func (x *DayScores) GetGutHealthScore() float64 {
+	if x != nil {
+		return x.GutHealthScore
+	}
+	return 0
+}
+
+func (x *DayScores) GetProbioticServings() float64 {
+	if x != nil {
+		return x.ProbioticServings
+	}
+	return 0
+}
+
+func (x *DayScores) GetPrebioticServings() float64 {
+	if x != nil {
+		return x.PrebioticServings
+	}
+	return 0
+}
+
+func (x *DayScores) GetMeals() []*MealScores {
+	if x != nil {
+		return x.Meals
+	}
+	return nil
+}
+
+// Least-squares change of a daily score
+type ScoreTrend struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PointsPerWeek float64                `protobuf:"fixed64,1,opt,name=points_per_week,json=pointsPerWeek,proto3" json:"points_per_week,omitempty"`
+	Direction     string                 `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"` // improving, worsening or steady
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreTrend) Reset() {
+	*x = ScoreTrend{}
+	mi := &file_proto_nutrition_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreTrend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreTrend) ProtoMessage() {}
+
+func (x *ScoreTrend) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nutrition_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreTrend.ProtoReflect.Descriptor instead.
+func (*ScoreTrend) Descriptor() ([]byte, []int) {
+	return file_proto_nutrition_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ScoreTrend) GetPointsPerWeek() float64 {
+	if x != nil {
+		return x.PointsPerWeek
+	}
+	return 0
+}
+
+func (x *ScoreTrend) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+// Request/Response messages
+type NutritionReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Granularity   string                 `protobuf:"bytes,2,opt,name=granularity,proto3" json:"granularity,omitempty"`              // day, week or month (default day)
+	StartDate     string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // optional, YYYY-MM-DD
+	EndDate       string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // optional, YYYY-MM-DD, defaults to today in the user's timezone
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NutritionReportRequest) Reset() {
+	*x = NutritionReportRequest{}
+	mi := &file_proto_nutrition_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NutritionReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NutritionReportRequest) ProtoMessage() {}
+
+func (x *NutritionReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nutrition_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NutritionReportRequest.ProtoReflect.Descriptor instead.
+func (*NutritionReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nutrition_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *NutritionReportRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *NutritionReportRequest) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *NutritionReportRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *NutritionReportRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type NutritionReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Granularity   string                 `protobuf:"bytes,1,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Periods       []*NutritionPeriod     `protobuf:"bytes,5,rep,name=periods,proto3" json:"periods,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	NutrientGaps  []*NutrientGap         `protobuf:"bytes,7,rep,name=nutrient_gaps,json=nutrientGaps,proto3" json:"nutrient_gaps,omitempty"`
+	Notes         []string               `protobuf:"bytes,8,rep,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NutritionReportResponse) Reset() {
+	*x = NutritionReportResponse{}
+	mi := &file_proto_nutrition_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NutritionReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NutritionReportResponse) ProtoMessage() {}
+
+func (x *NutritionReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nutrition_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NutritionReportResponse.ProtoReflect.Descriptor instead.
+func (*NutritionReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nutrition_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *NutritionReportResponse) GetGranularity() string {
+	if x != nil {
+		return x.Granularity
+	}
+	return ""
+}
+
+func (x *NutritionReportResponse) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *NutritionReportResponse) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *NutritionReportResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *NutritionReportResponse) GetPeriods() []*NutritionPeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *NutritionReportResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *NutritionReportResponse) GetNutrientGaps() []*NutrientGap {
+	if x != nil {
+		return x.NutrientGaps
+	}
+	return nil
+}
+
+func (x *NutritionReportResponse) GetNotes() []string {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type GetNutritionTargetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNutritionTargetsRequest) Reset() {
+	*x = GetNutritionTargetsRequest{}
+	mi := &file_proto_nutrition_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNutritionTargetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNutritionTargetsRequest) ProtoMessage() {}
+
+func (x *GetNutritionTargetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nutrition_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNutritionTargetsRequest.ProtoReflect.Descriptor instead.
+func (*GetNutritionTargetsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nutrition_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetNutritionTargetsRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetNutritionTargetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Targets       *NutritionTargets      `protobuf:"bytes,1,opt,name=targets,proto3" json:"targets,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNutritionTargetsResponse) Reset() {
+	*x = GetNutritionTargetsResponse{}
+	mi := &file_proto_nutrition_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNutritionTargetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNutritionTargetsResponse) ProtoMessage() {}
+
+func (x *GetNutritionTargetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nutrition_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNutritionTargetsResponse.ProtoReflect.Descriptor instead.
+func (*GetNutritionTargetsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nutrition_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetNutritionTargetsResponse) GetTargets() *NutritionTargets {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *GetNutritionTargetsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type HealthScoresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // optional, YYYY-MM-DD, defaults to 27 days before end_date
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // optional, YYYY-MM-DD, defaults to today in the user's timezone
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthScoresRequest) Reset() {
+	*x = HealthScoresRequest{}
+	mi := &file_proto_nutrition_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthScoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthScoresRequest) ProtoMessage() {}
+
+func (x *HealthScoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nutrition_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthScoresRequest.ProtoReflect.Descriptor instead.
+func (*HealthScoresRequest) Descriptor() ([]byte, []int) {
+	return file_proto_nutrition_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *HealthScoresRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *HealthScoresRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *HealthScoresRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type HealthScoresResponse struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	StartDate                string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate                  string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Days                     []*DayScores           `protobuf:"bytes,3,rep,name=days,proto3" json:"days,omitempty"` // logged days only
+	AverageInflammationScore float64                `protobuf:"fixed64,4,opt,name=average_inflammation_score,json=averageInflammationScore,proto3" json:"average_inflammation_score,omitempty"`
+	AverageGutHealthScore    float64                `protobuf:"fixed64,5,opt,name=average_gut_health_score,json=averageGutHealthScore,proto3" json:"average_gut_health_score,omitempty"`
+	InflammationTrend        *ScoreTrend            `protobuf:"bytes,6,opt,name=inflammation_trend,json=inflammationTrend,proto3" json:"inflammation_trend,omitempty"` // unset with fewer than two logged days
+	GutHealthTrend           *ScoreTrend            `protobuf:"bytes,7,opt,name=gut_health_trend,json=gutHealthTrend,proto3" json:"gut_health_trend,omitempty"`
+	Error                    string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	Notes                    []string               `protobuf:"bytes,9,rep,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *HealthScoresResponse) Reset() {
+	*x = HealthScoresResponse{}
+	mi := &file_proto_nutrition_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthScoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthScoresResponse) ProtoMessage() {}
+
+func (x *HealthScoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_nutrition_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthScoresResponse.ProtoReflect.Descriptor instead.
+func (*HealthScoresResponse) Descriptor() ([]byte, []int) {
+	return file_proto_nutrition_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *HealthScoresResponse) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *HealthScoresResponse) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *HealthScoresResponse) GetDays() []*DayScores {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *HealthScoresResponse) GetAverageInflammationScore() float64 {
+	if x != nil {
+		return x.AverageInflammationScore
+	}
+	return 0
+}
+
+func (x *HealthScoresResponse) GetAverageGutHealthScore() float64 {
+	if x != nil {
+		return x.AverageGutHealthScore
+	}
+	return 0
+}
+
+func (x *HealthScoresResponse) GetInflammationTrend() *ScoreTrend {
+	if x != nil {
+		return x.InflammationTrend
+	}
+	return nil
+}
+
+func (x *HealthScoresResponse) GetGutHealthTrend() *ScoreTrend {
+	if x != nil {
+		return x.GutHealthTrend
+	}
+	return nil
+}
+
+func (x *HealthScoresResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *HealthScoresResponse) GetNotes() []string {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+var File_proto_nutrition_proto protoreflect.FileDescriptor
+
+const file_proto_nutrition_proto_rawDesc = "" +
+	"\n" +
+	"\x15proto/nutrition.proto\x12\x04user\"\xaa\x06\n" +
+	"\x0fNutritionPeriod\x12!\n" +
+	"\fperiod_start\x18\x01 \x01(\tR\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x02 \x01(\tR\tperiodEnd\x12\x1f\n" +
+	"\vdays_logged\x18\x03 \x01(\x05R\n" +
+	"daysLogged\x12\x1a\n" +
+	"\bcalories\x18\x04 \x01(\x01R\bcalories\x12#\n" +
+	"\rprotein_grams\x18\x05 \x01(\x01R\fproteinGrams\x12\x1f\n" +
+	"\vcarbs_grams\x18\x06 \x01(\x01R\n" +
+	"carbsGrams\x12\x1b\n" +
+	"\tfat_grams\x18\a \x01(\x01R\bfatGrams\x124\n" +
+	"\x16non_inflammatory_foods\x18\b \x01(\x05R\x14nonInflammatoryFoods\x12'\n" +
+	"\x0fprobiotic_foods\x18\t \x01(\x05R\x0eprobioticFoods\x12'\n" +
+	"\x0fprebiotic_foods\x18\n" +
+	" \x01(\x05R\x0eprebioticFoods\x12\x1f\n" +
+	"\vfiber_grams\x18\v \x01(\x01R\n" +
+	"fiberGrams\x12\x1f\n" +
+	"\vsugar_grams\x18\f \x01(\x01R\n" +
+	"sugarGrams\x12\x1b\n" +
+	"\tsodium_mg\x18\r \x01(\x01R\bsodiumMg\x12!\n" +
+	"\fpotassium_mg\x18\x0e \x01(\x01R\vpotassiumMg\x12\x17\n" +
+	"\airon_mg\x18\x0f \x01(\x01R\x06ironMg\x12\x1d\n" +
+	"\n" +
+	"calcium_mg\x18\x10 \x01(\x01R\tcalciumMg\x12\"\n" +
+	"\rvitamin_d_mcg\x18\x11 \x01(\x01R\vvitaminDMcg\x12!\n" +
+	"\fomega3_grams\x18\x12 \x01(\x01R\vomega3Grams\x12'\n" +
+	"\x0funtracked_items\x18\x13 \x01(\x05R\x0euntrackedItems\x12'\n" +
+	"\x0fcalories_burned\x18\x14 \x01(\x01R\x0ecaloriesBurned\x12!\n" +
+	"\fnet_calories\x18\x15 \x01(\x01R\vnetCalories\x127\n" +
+	"\x17unconverted_ingredients\x18\x16 \x01(\x05R\x16unconvertedIngredients\"\xfa\x01\n" +
+	"\vNutrientGap\x12\x1a\n" +
+	"\bnutrient\x18\x01 \x01(\tR\bnutrient\x12\x12\n" +
+	"\x04unit\x18\x02 \x01(\tR\x04unit\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12)\n" +
+	"\x10reference_intake\x18\x04 \x01(\x01R\x0freferenceIntake\x12%\n" +
+	"\x0eaverage_intake\x18\x05 \x01(\x01R\raverageIntake\x120\n" +
+	"\x14percent_of_reference\x18\x06 \x01(\x01R\x12percentOfReference\x12#\n" +
+	"\rflagged_dates\x18\a \x03(\tR\fflaggedDates\"\xe9\x02\n" +
+	"\x10NutritionTargets\x12\x10\n" +
+	"\x03bmr\x18\x01 \x01(\x01R\x03bmr\x12\x12\n" +
+	"\x04tdee\x18\x02 \x01(\x01R\x04tdee\x12<\n" +
+	"\x1acalorie_adjustment_percent\x18\x03 \x01(\x01R\x18calorieAdjustmentPercent\x12\x1a\n" +
+	"\bcalories\x18\x04 \x01(\x01R\bcalories\x12#\n" +
+	"\rprotein_grams\x18\x05 \x01(\x01R\fproteinGrams\x12\x1f\n" +
+	"\vcarbs_grams\x18\x06 \x01(\x01R\n" +
+	"carbsGrams\x12\x1b\n" +
+	"\tfat_grams\x18\a \x01(\x01R\bfatGrams\x12%\n" +
+	"\x0eactivity_level\x18\b \x01(\tR\ractivityLevel\x12\x14\n" +
+	"\x05goals\x18\t \x03(\tR\x05goals\x12\x14\n" +
+	"\x05notes\x18\n" +
+	" \x03(\tR\x05notes\x12\x1f\n" +
+	"\vcycle_phase\x18\v \x01(\tR\n" +
+	"cyclePhase\"\xa2\x01\n" +
+	"\n" +
+	"MealScores\x12\x1f\n" +
+	"\vmeal_number\x18\x01 \x01(\x05R\n" +
+	"mealNumber\x12\x1a\n" +
+	"\bcalories\x18\x02 \x01(\x01R\bcalories\x12-\n" +
+	"\x12inflammation_score\x18\x03 \x01(\x01R\x11inflammationScore\x12(\n" +
+	"\x10gut_health_score\x18\x04 \x01(\x01R\x0egutHealthScore\"\x9a\x02\n" +
+	"\tDayScores\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1a\n" +
+	"\bcalories\x18\x02 \x01(\x01R\bcalories\x12-\n" +
+	"\x12inflammation_score\x18\x03 \x01(\x01R\x11inflammationScore\x12(\n" +
+	"\x10gut_health_score\x18\x04 \x01(\x01R\x0egutHealthScore\x12-\n" +
+	"\x12probiotic_servings\x18\x05 \x01(\x01R\x11probioticServings\x12-\n" +
+	"\x12prebiotic_servings\x18\x06 \x01(\x01R\x11prebioticServings\x12&\n" +
+	"\x05meals\x18\a \x03(\v2\x10.user.MealScoresR\x05meals\"R\n" +
+	"\n" +
+	"ScoreTrend\x12&\n" +
+	"\x0fpoints_per_week\x18\x01 \x01(\x01R\rpointsPerWeek\x12\x1c\n" +
+	"\tdirection\x18\x02 \x01(\tR\tdirection\"\x8d\x01\n" +
+	"\x16NutritionReportRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12 \n" +
+	"\vgranularity\x18\x02 \x01(\tR\vgranularity\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\"\xa6\x02\n" +
+	"\x17NutritionReportResponse\x12 \n" +
+	"\vgranularity\x18\x01 \x01(\tR\vgranularity\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12/\n" +
+	"\aperiods\x18\x05 \x03(\v2\x15.user.NutritionPeriodR\aperiods\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x126\n" +
+	"\rnutrient_gaps\x18\a \x03(\v2\x11.user.NutrientGapR\fnutrientGaps\x12\x14\n" +
+	"\x05notes\x18\b \x03(\tR\x05notes\"5\n" +
+	"\x1aGetNutritionTargetsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"e\n" +
+	"\x1bGetNutritionTargetsResponse\x120\n" +
+	"\atargets\x18\x01 \x01(\v2\x16.user.NutritionTargetsR\atargets\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"h\n" +
+	"\x13HealthScoresRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\"\x95\x03\n" +
+	"\x14HealthScoresResponse\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12#\n" +
+	"\x04days\x18\x03 \x03(\v2\x0f.user.DayScoresR\x04days\x12<\n" +
+	"\x1aaverage_inflammation_score\x18\x04 \x01(\x01R\x18averageInflammationScore\x127\n" +
+	"\x18average_gut_health_score\x18\x05 \x01(\x01R\x15averageGutHealthScore\x12?\n" +
+	"\x12inflammation_trend\x18\x06 \x01(\v2\x10.user.ScoreTrendR\x11inflammationTrend\x12:\n" +
+	"\x10gut_health_trend\x18\a \x01(\v2\x10.user.ScoreTrendR\x0egutHealthTrend\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\x12\x14\n" +
+	"\x05notes\x18\t \x03(\tR\x05notes2\x85\x02\n" +
+	"\x10NutritionService\x12N\n" +
+	"\x0fNutritionReport\x12\x1c.user.NutritionReportRequest\x1a\x1d.user.NutritionReportResponse\x12Z\n" +
+	"\x13GetNutritionTargets\x12 .user.GetNutritionTargetsRequest\x1a!.user.GetNutritionTargetsResponse\x12E\n" +
+	"\fHealthScores\x12\x19.user.HealthScoresRequest\x1a\x1a.user.HealthScoresResponseB\tZ\a./protob\x06proto3"
+
+var (
+	file_proto_nutrition_proto_rawDescOnce sync.Once
+	file_proto_nutrition_proto_rawDescData []byte
+)
+
+func file_proto_nutrition_proto_rawDescGZIP() []byte {
+	file_proto_nutrition_proto_rawDescOnce.Do(func() {
+		file_proto_nutrition_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_nutrition_proto_rawDesc), len(file_proto_nutrition_proto_rawDesc)))
+	})
+	return file_proto_nutrition_proto_rawDescData
+}
+
+var file_proto_nutrition_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_proto_nutrition_proto_goTypes = []any{
+	(*NutritionPeriod)(nil),             // 0: user.NutritionPeriod
+	(*NutrientGap)(nil),                 // 1: user.NutrientGap
+	(*NutritionTargets)(nil),            // 2: user.NutritionTargets
+	(*MealScores)(nil),                  // 3: user.MealScores
+	(*DayScores)(nil),                   // 4: user.DayScores
+	(*ScoreTrend)(nil),                  // 5: user.ScoreTrend
+	(*NutritionReportRequest)(nil),      // 6: user.NutritionReportRequest
+	(*NutritionReportResponse)(nil),     // 7: user.NutritionReportResponse
+	(*GetNutritionTargetsRequest)(nil),  // 8: user.GetNutritionTargetsRequest
+	(*GetNutritionTargetsResponse)(nil), // 9: user.GetNutritionTargetsResponse
+	(*HealthScoresRequest)(nil),         // 10: user.HealthScoresRequest
+	(*HealthScoresResponse)(nil),        // 11: user.HealthScoresResponse
+}
+var file_proto_nutrition_proto_depIdxs = []int32{
+	3,  // 0: user.DayScores.meals:type_name -> user.MealScores
+	0,  // 1: user.NutritionReportResponse.periods:type_name -> user.NutritionPeriod
+	1,  // 2: user.NutritionReportResponse.nutrient_gaps:type_name -> user.NutrientGap
+	2,  // 3: user.GetNutritionTargetsResponse.targets:type_name -> user.NutritionTargets
+	4,  // 4: user.HealthScoresResponse.days:type_name -> user.DayScores
+	5,  // 5: user.HealthScoresResponse.inflammation_trend:type_name -> user.ScoreTrend
+	5,  // 6: user.HealthScoresResponse.gut_health_trend:type_name -> user.ScoreTrend
+	6,  // 7: user.NutritionService.NutritionReport:input_type -> user.NutritionReportRequest
+	8,  // 8: user.NutritionService.GetNutritionTargets:input_type -> user.GetNutritionTargetsRequest
+	10, // 9: user.NutritionService.HealthScores:input_type -> user.HealthScoresRequest
+	7,  // 10: user.NutritionService.NutritionReport:output_type -> user.NutritionReportResponse
+	9,  // 11: user.NutritionService.GetNutritionTargets:output_type -> user.GetNutritionTargetsResponse
+	11, // 12: user.NutritionService.HealthScores:output_type -> user.HealthScoresResponse
+	10, // [10:13] is the sub-list for method output_type
+	7,  // [7:10] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_proto_nutrition_proto_init() }
+func file_proto_nutrition_proto_init() {
+	if File_proto_nutrition_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_nutrition_proto_rawDesc), len(file_proto_nutrition_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_nutrition_proto_goTypes,
+		DependencyIndexes: file_proto_nutrition_proto_depIdxs,
+		MessageInfos:      file_proto_nutrition_proto_msgTypes,
+	}.Build()
+	File_proto_nutrition_proto = out.File
+	file_proto_nutrition_proto_goTypes = nil
+	file_proto_nutrition_proto_depIdxs = nil
+}
@@ -0,0 +1,201 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: proto/nutrition.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	NutritionService_NutritionReport_FullMethodName     = "/user.NutritionService/NutritionReport"
+	NutritionService_GetNutritionTargets_FullMethodName = "/user.NutritionService/GetNutritionTargets"
+	NutritionService_HealthScores_FullMethodName        = "/user.NutritionService/HealthScores"
+)
+
+// NutritionServiceClient is the client API for NutritionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Nutrition reporting gRPC definitions
+type NutritionServiceClient interface {
+	NutritionReport(ctx context.Context, in *NutritionReportRequest, opts ...grpc.CallOption) (*NutritionReportResponse, error)
+	GetNutritionTargets(ctx context.Context, in *GetNutritionTargetsRequest, opts ...grpc.CallOption) (*GetNutritionTargetsResponse, error)
+	HealthScores(ctx context.Context, in *HealthScoresRequest, opts ...grpc.CallOption) (*HealthScoresResponse, error)
+}
+
+type nutritionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNutritionServiceClient(cc grpc.ClientConnInterface) NutritionServiceClient {
+	return &nutritionServiceClient{cc}
+}
+
+func (c *nutritionServiceClient) NutritionReport(ctx context.Context, in *NutritionReportRequest, opts ...grpc.CallOption) (*NutritionReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NutritionReportResponse)
+	err := c.cc.Invoke(ctx, NutritionService_NutritionReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nutritionServiceClient) GetNutritionTargets(ctx context.Context, in *GetNutritionTargetsRequest, opts ...grpc.CallOption) (*GetNutritionTargetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNutritionTargetsResponse)
+	err := c.cc.Invoke(ctx, NutritionService_GetNutritionTargets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nutritionServiceClient) HealthScores(ctx context.Context, in *HealthScoresRequest, opts ...grpc.CallOption) (*HealthScoresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealthScoresResponse)
+	err := c.cc.Invoke(ctx, NutritionService_HealthScores_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NutritionServiceServer is the server API for NutritionService service.
+// All implementations must embed UnimplementedNutritionServiceServer
+// for forward compatibility.
+//
+// Nutrition reporting gRPC definitions
+type NutritionServiceServer interface {
+	NutritionReport(context.Context, *NutritionReportRequest) (*NutritionReportResponse, error)
+	GetNutritionTargets(context.Context, *GetNutritionTargetsRequest) (*GetNutritionTargetsResponse, error)
+	HealthScores(context.Context, *HealthScoresRequest) (*HealthScoresResponse, error)
+	mustEmbedUnimplementedNutritionServiceServer()
+}
+
+// UnimplementedNutritionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNutritionServiceServer struct{}
+
+func (UnimplementedNutritionServiceServer) NutritionReport(context.Context, *NutritionReportRequest) (*NutritionReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NutritionReport not implemented")
+}
+func (UnimplementedNutritionServiceServer) GetNutritionTargets(context.Context, *GetNutritionTargetsRequest) (*GetNutritionTargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNutritionTargets not implemented")
+}
+func (UnimplementedNutritionServiceServer) HealthScores(context.Context, *HealthScoresRequest) (*HealthScoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HealthScores not implemented")
+}
+func (UnimplementedNutritionServiceServer) mustEmbedUnimplementedNutritionServiceServer() {}
+func (UnimplementedNutritionServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeNutritionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NutritionServiceServer will
+// result in compilation errors.
+type UnsafeNutritionServiceServer interface {
+	mustEmbedUnimplementedNutritionServiceServer()
+}
+
+func RegisterNutritionServiceServer(s grpc.ServiceRegistrar, srv NutritionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNutritionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NutritionService_ServiceDesc, srv)
+}
+
+func _NutritionService_NutritionReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NutritionReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NutritionServiceServer).NutritionReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NutritionService_NutritionReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NutritionServiceServer).NutritionReport(ctx, req.(*NutritionReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NutritionService_GetNutritionTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNutritionTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NutritionServiceServer).GetNutritionTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NutritionService_GetNutritionTargets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NutritionServiceServer).GetNutritionTargets(ctx, req.(*GetNutritionTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NutritionService_HealthScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NutritionServiceServer).HealthScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NutritionService_HealthScores_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NutritionServiceServer).HealthScores(ctx, req.(*HealthScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NutritionService_ServiceDesc is the grpc.ServiceDesc for NutritionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NutritionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.NutritionService",
+	HandlerType: (*NutritionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "NutritionReport",
+			Handler:    _NutritionService_NutritionReport_Handler,
+		},
+		{
+			MethodName: "GetNutritionTargets",
+			Handler:    _NutritionService_GetNutritionTargets_Handler,
+		},
+		{
+			MethodName: "HealthScores",
+			Handler:    _NutritionService_HealthScores_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/nutrition.proto",
+}
@@ -0,0 +1,777 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        v5.29.3
+// source: proto/substitutions.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A food sized to a quantity, with nutrition for that quantity. distance is 0
+// for a perfect macro match and grows as the nutrition drifts apart.
+type SubstituteFood struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	FoodId            int32                  `protobuf:"varint,1,opt,name=food_id,json=foodId,proto3" json:"food_id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Category          string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	ServingUnits      string                 `protobuf:"bytes,4,opt,name=serving_units,json=servingUnits,proto3" json:"serving_units,omitempty"`
+	Servings          float64                `protobuf:"fixed64,5,opt,name=servings,proto3" json:"servings,omitempty"` // in serving_units
+	Calories          float64                `protobuf:"fixed64,6,opt,name=calories,proto3" json:"calories,omitempty"`
+	ProteinGrams      float64                `protobuf:"fixed64,7,opt,name=protein_grams,json=proteinGrams,proto3" json:"protein_grams,omitempty"`
+	CarbsGrams        float64                `protobuf:"fixed64,8,opt,name=carbs_grams,json=carbsGrams,proto3" json:"carbs_grams,omitempty"`
+	FatGrams          float64                `protobuf:"fixed64,9,opt,name=fat_grams,json=fatGrams,proto3" json:"fat_grams,omitempty"`
+	IsNonInflammatory bool                   `protobuf:"varint,10,opt,name=is_non_inflammatory,json=isNonInflammatory,proto3" json:"is_non_inflammatory,omitempty"`
+	Liked             bool                   `protobuf:"varint,11,opt,name=liked,proto3" json:"liked,omitempty"`
+	Distance          float64                `protobuf:"fixed64,12,opt,name=distance,proto3" json:"distance,omitempty"`
+	SameCategory      bool                   `protobuf:"varint,13,opt,name=same_category,json=sameCategory,proto3" json:"same_category,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SubstituteFood) Reset() {
+	*x = SubstituteFood{}
+	mi := &file_proto_substitutions_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubstituteFood) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubstituteFood) ProtoMessage() {}
+
+func (x *SubstituteFood) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_substitutions_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubstituteFood.ProtoReflect.Descriptor instead.
+func (*SubstituteFood) Descriptor() ([]byte, []int) {
+	return file_proto_substitutions_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubstituteFood) GetFoodId() int32 {
+	if x != nil {
+		return x.FoodId
+	}
+	return 0
+}
+
+func (x *SubstituteFood) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SubstituteFood) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SubstituteFood) GetServingUnits() string {
+	if x != nil {
+		return x.ServingUnits
+	}
+	return ""
+}
+
+func (x *SubstituteFood) GetServings() float64 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *SubstituteFood) GetCalories() float64 {
+	if x != nil {
+		return x.Calories
+	}
+	return 0
+}
+
+func (x *SubstituteFood) GetProteinGrams() float64 {
+	if x != nil {
+		return x.ProteinGrams
+	}
+	return 0
+}
+
+func (x *SubstituteFood) GetCarbsGrams() float64 {
+	if x != nil {
+		return x.CarbsGrams
+	}
+	return 0
+}
+
+func (x *SubstituteFood) GetFatGrams() float64 {
+	if x != nil {
+		return x.FatGrams
+	}
+	return 0
+}
+
+func (x *SubstituteFood) GetIsNonInflammatory() bool {
+	if x != nil {
+		return x.IsNonInflammatory
+	}
+	return false
+}
+
+func (x *SubstituteFood) GetLiked() bool {
+	if x != nil {
+		return x.Liked
+	}
+	return false
+}
+
+func (x *SubstituteFood) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *SubstituteFood) GetSameCategory() bool {
+	if x != nil {
+		return x.SameCategory
+	}
+	return false
+}
+
+// One food entry of a meal
+type MealFood struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       int32                  `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"` // USER_MEALS id
+	FoodId        int32                  `protobuf:"varint,2,opt,name=food_id,json=foodId,proto3" json:"food_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Servings      float64                `protobuf:"fixed64,4,opt,name=servings,proto3" json:"servings,omitempty"`
+	Calories      float64                `protobuf:"fixed64,5,opt,name=calories,proto3" json:"calories,omitempty"`
+	ProteinGrams  float64                `protobuf:"fixed64,6,opt,name=protein_grams,json=proteinGrams,proto3" json:"protein_grams,omitempty"`
+	CarbsGrams    float64                `protobuf:"fixed64,7,opt,name=carbs_grams,json=carbsGrams,proto3" json:"carbs_grams,omitempty"`
+	FatGrams      float64                `protobuf:"fixed64,8,opt,name=fat_grams,json=fatGrams,proto3" json:"fat_grams,omitempty"`
+	MealId        int32                  `protobuf:"varint,9,opt,name=meal_id,json=mealId,proto3" json:"meal_id,omitempty"` // set for a meal entry; a copy when a shared meal was changed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MealFood) Reset() {
+	*x = MealFood{}
+	mi := &file_proto_substitutions_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealFood) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealFood) ProtoMessage() {}
+
+func (x *MealFood) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_substitutions_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealFood.ProtoReflect.Descriptor instead.
+func (*MealFood) Descriptor() ([]byte, []int) {
+	return file_proto_substitutions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MealFood) GetEntryId() int32 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+func (x *MealFood) GetFoodId() int32 {
+	if x != nil {
+		return x.FoodId
+	}
+	return 0
+}
+
+func (x *MealFood) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MealFood) GetServings() float64 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *MealFood) GetCalories() float64 {
+	if x != nil {
+		return x.Calories
+	}
+	return 0
+}
+
+func (x *MealFood) GetProteinGrams() float64 {
+	if x != nil {
+		return x.ProteinGrams
+	}
+	return 0
+}
+
+func (x *MealFood) GetCarbsGrams() float64 {
+	if x != nil {
+		return x.CarbsGrams
+	}
+	return 0
+}
+
+func (x *MealFood) GetFatGrams() float64 {
+	if x != nil {
+		return x.FatGrams
+	}
+	return 0
+}
+
+func (x *MealFood) GetMealId() int32 {
+	if x != nil {
+		return x.MealId
+	}
+	return 0
+}
+
+// The entries sharing a date and meal number, planned or logged
+type SwappedMeal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	MealNumber    int32                  `protobuf:"varint,2,opt,name=meal_number,json=mealNumber,proto3" json:"meal_number,omitempty"`
+	IsPlanned     bool                   `protobuf:"varint,3,opt,name=is_planned,json=isPlanned,proto3" json:"is_planned,omitempty"`
+	Foods         []*MealFood            `protobuf:"bytes,4,rep,name=foods,proto3" json:"foods,omitempty"`
+	Calories      float64                `protobuf:"fixed64,5,opt,name=calories,proto3" json:"calories,omitempty"`
+	ProteinGrams  float64                `protobuf:"fixed64,6,opt,name=protein_grams,json=proteinGrams,proto3" json:"protein_grams,omitempty"`
+	CarbsGrams    float64                `protobuf:"fixed64,7,opt,name=carbs_grams,json=carbsGrams,proto3" json:"carbs_grams,omitempty"`
+	FatGrams      float64                `protobuf:"fixed64,8,opt,name=fat_grams,json=fatGrams,proto3" json:"fat_grams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwappedMeal) Reset() {
+	*x = SwappedMeal{}
+	mi := &file_proto_substitutions_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwappedMeal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwappedMeal) ProtoMessage() {}
+
+func (x *SwappedMeal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_substitutions_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwappedMeal.ProtoReflect.Descriptor instead.
+func (*SwappedMeal) Descriptor() ([]byte, []int) {
+	return file_proto_substitutions_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SwappedMeal) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SwappedMeal) GetMealNumber() int32 {
+	if x != nil {
+		return x.MealNumber
+	}
+	return 0
+}
+
+func (x *SwappedMeal) GetIsPlanned() bool {
+	if x != nil {
+		return x.IsPlanned
+	}
+	return false
+}
+
+func (x *SwappedMeal) GetFoods() []*MealFood {
+	if x != nil {
+		return x.Foods
+	}
+	return nil
+}
+
+func (x *SwappedMeal) GetCalories() float64 {
+	if x != nil {
+		return x.Calories
+	}
+	return 0
+}
+
+func (x *SwappedMeal) GetProteinGrams() float64 {
+	if x != nil {
+		return x.ProteinGrams
+	}
+	return 0
+}
+
+func (x *SwappedMeal) GetCarbsGrams() float64 {
+	if x != nil {
+		return x.CarbsGrams
+	}
+	return 0
+}
+
+func (x *SwappedMeal) GetFatGrams() float64 {
+	if x != nil {
+		return x.FatGrams
+	}
+	return 0
+}
+
+// Request/Response messages
+type SuggestSubstitutesRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserId              int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FoodId              int32                  `protobuf:"varint,2,opt,name=food_id,json=foodId,proto3" json:"food_id,omitempty"`
+	Quantity            float64                `protobuf:"fixed64,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Unit                string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"` // optional, defaults to the food's serving unit
+	NonInflammatoryOnly bool                   `protobuf:"varint,5,opt,name=non_inflammatory_only,json=nonInflammatoryOnly,proto3" json:"non_inflammatory_only,omitempty"`
+	Limit               int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"` // optional, defaults to 5
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SuggestSubstitutesRequest) Reset() {
+	*x = SuggestSubstitutesRequest{}
+	mi := &file_proto_substitutions_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestSubstitutesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestSubstitutesRequest) ProtoMessage() {}
+
+func (x *SuggestSubstitutesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_substitutions_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestSubstitutesRequest.ProtoReflect.Descriptor instead.
+func (*SuggestSubstitutesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_substitutions_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SuggestSubstitutesRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SuggestSubstitutesRequest) GetFoodId() int32 {
+	if x != nil {
+		return x.FoodId
+	}
+	return 0
+}
+
+func (x *SuggestSubstitutesRequest) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *SuggestSubstitutesRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *SuggestSubstitutesRequest) GetNonInflammatoryOnly() bool {
+	if x != nil {
+		return x.NonInflammatoryOnly
+	}
+	return false
+}
+
+func (x *SuggestSubstitutesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SuggestSubstitutesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Original      *SubstituteFood        `protobuf:"bytes,1,opt,name=original,proto3" json:"original,omitempty"`
+	Substitutes   []*SubstituteFood      `protobuf:"bytes,2,rep,name=substitutes,proto3" json:"substitutes,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestSubstitutesResponse) Reset() {
+	*x = SuggestSubstitutesResponse{}
+	mi := &file_proto_substitutions_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestSubstitutesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestSubstitutesResponse) ProtoMessage() {}
+
+func (x *SuggestSubstitutesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_substitutions_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestSubstitutesResponse.ProtoReflect.Descriptor instead.
+func (*SuggestSubstitutesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_substitutions_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SuggestSubstitutesResponse) GetOriginal() *SubstituteFood {
+	if x != nil {
+		return x.Original
+	}
+	return nil
+}
+
+func (x *SuggestSubstitutesResponse) GetSubstitutes() []*SubstituteFood {
+	if x != nil {
+		return x.Substitutes
+	}
+	return nil
+}
+
+func (x *SuggestSubstitutesResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ApplySubstituteRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserId              int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EntryId             int32                  `protobuf:"varint,2,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"` // USER_MEALS id of a single-food or meal entry
+	FoodId              int32                  `protobuf:"varint,3,opt,name=food_id,json=foodId,proto3" json:"food_id,omitempty"`    // the substitute
+	NonInflammatoryOnly bool                   `protobuf:"varint,4,opt,name=non_inflammatory_only,json=nonInflammatoryOnly,proto3" json:"non_inflammatory_only,omitempty"`
+	IngredientId        int32                  `protobuf:"varint,5,opt,name=ingredient_id,json=ingredientId,proto3" json:"ingredient_id,omitempty"` // MEAL_INGREDIENTS id to replace, required for a meal entry
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ApplySubstituteRequest) Reset() {
+	*x = ApplySubstituteRequest{}
+	mi := &file_proto_substitutions_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplySubstituteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplySubstituteRequest) ProtoMessage() {}
+
+func (x *ApplySubstituteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_substitutions_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplySubstituteRequest.ProtoReflect.Descriptor instead.
+func (*ApplySubstituteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_substitutions_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApplySubstituteRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ApplySubstituteRequest) GetEntryId() int32 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+func (x *ApplySubstituteRequest) GetFoodId() int32 {
+	if x != nil {
+		return x.FoodId
+	}
+	return 0
+}
+
+func (x *ApplySubstituteRequest) GetNonInflammatoryOnly() bool {
+	if x != nil {
+		return x.NonInflammatoryOnly
+	}
+	return false
+}
+
+func (x *ApplySubstituteRequest) GetIngredientId() int32 {
+	if x != nil {
+		return x.IngredientId
+	}
+	return 0
+}
+
+type ApplySubstituteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meal          *SwappedMeal           `protobuf:"bytes,1,opt,name=meal,proto3" json:"meal,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplySubstituteResponse) Reset() {
+	*x = ApplySubstituteResponse{}
+	mi := &file_proto_substitutions_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplySubstituteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplySubstituteResponse) ProtoMessage() {}
+
+func (x *ApplySubstituteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_substitutions_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplySubstituteResponse.ProtoReflect.Descriptor instead.
+func (*ApplySubstituteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_substitutions_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ApplySubstituteResponse) GetMeal() *SwappedMeal {
+	if x != nil {
+		return x.Meal
+	}
+	return nil
+}
+
+func (x *ApplySubstituteResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_proto_substitutions_proto protoreflect.FileDescriptor
+
+const file_proto_substitutions_proto_rawDesc = "" +
+	"\n" +
+	"\x19proto/substitutions.proto\x12\x04user\"\xa0\x03\n" +
+	"\x0eSubstituteFood\x12\x17\n" +
+	"\afood_id\x18\x01 \x01(\x05R\x06foodId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12#\n" +
+	"\rserving_units\x18\x04 \x01(\tR\fservingUnits\x12\x1a\n" +
+	"\bservings\x18\x05 \x01(\x01R\bservings\x12\x1a\n" +
+	"\bcalories\x18\x06 \x01(\x01R\bcalories\x12#\n" +
+	"\rprotein_grams\x18\a \x01(\x01R\fproteinGrams\x12\x1f\n" +
+	"\vcarbs_grams\x18\b \x01(\x01R\n" +
+	"carbsGrams\x12\x1b\n" +
+	"\tfat_grams\x18\t \x01(\x01R\bfatGrams\x12.\n" +
+	"\x13is_non_inflammatory\x18\n" +
+	" \x01(\bR\x11isNonInflammatory\x12\x14\n" +
+	"\x05liked\x18\v \x01(\bR\x05liked\x12\x1a\n" +
+	"\bdistance\x18\f \x01(\x01R\bdistance\x12#\n" +
+	"\rsame_category\x18\r \x01(\bR\fsameCategory\"\x86\x02\n" +
+	"\bMealFood\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\x05R\aentryId\x12\x17\n" +
+	"\afood_id\x18\x02 \x01(\x05R\x06foodId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bservings\x18\x04 \x01(\x01R\bservings\x12\x1a\n" +
+	"\bcalories\x18\x05 \x01(\x01R\bcalories\x12#\n" +
+	"\rprotein_grams\x18\x06 \x01(\x01R\fproteinGrams\x12\x1f\n" +
+	"\vcarbs_grams\x18\a \x01(\x01R\n" +
+	"carbsGrams\x12\x1b\n" +
+	"\tfat_grams\x18\b \x01(\x01R\bfatGrams\x12\x17\n" +
+	"\ameal_id\x18\t \x01(\x05R\x06mealId\"\x86\x02\n" +
+	"\vSwappedMeal\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1f\n" +
+	"\vmeal_number\x18\x02 \x01(\x05R\n" +
+	"mealNumber\x12\x1d\n" +
+	"\n" +
+	"is_planned\x18\x03 \x01(\bR\tisPlanned\x12$\n" +
+	"\x05foods\x18\x04 \x03(\v2\x0e.user.MealFoodR\x05foods\x12\x1a\n" +
+	"\bcalories\x18\x05 \x01(\x01R\bcalories\x12#\n" +
+	"\rprotein_grams\x18\x06 \x01(\x01R\fproteinGrams\x12\x1f\n" +
+	"\vcarbs_grams\x18\a \x01(\x01R\n" +
+	"carbsGrams\x12\x1b\n" +
+	"\tfat_grams\x18\b \x01(\x01R\bfatGrams\"\xc7\x01\n" +
+	"\x19SuggestSubstitutesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x17\n" +
+	"\afood_id\x18\x02 \x01(\x05R\x06foodId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x01R\bquantity\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\x122\n" +
+	"\x15non_inflammatory_only\x18\x05 \x01(\bR\x13nonInflammatoryOnly\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\"\x9c\x01\n" +
+	"\x1aSuggestSubstitutesResponse\x120\n" +
+	"\boriginal\x18\x01 \x01(\v2\x14.user.SubstituteFoodR\boriginal\x126\n" +
+	"\vsubstitutes\x18\x02 \x03(\v2\x14.user.SubstituteFoodR\vsubstitutes\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\xbe\x01\n" +
+	"\x16ApplySubstituteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x19\n" +
+	"\bentry_id\x18\x02 \x01(\x05R\aentryId\x12\x17\n" +
+	"\afood_id\x18\x03 \x01(\x05R\x06foodId\x122\n" +
+	"\x15non_inflammatory_only\x18\x04 \x01(\bR\x13nonInflammatoryOnly\x12#\n" +
+	"\ringredient_id\x18\x05 \x01(\x05R\fingredientId\"V\n" +
+	"\x17ApplySubstituteResponse\x12%\n" +
+	"\x04meal\x18\x01 \x01(\v2\x11.user.SwappedMealR\x04meal\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error2\xbe\x01\n" +
+	"\x13SubstitutionService\x12W\n" +
+	"\x12SuggestSubstitutes\x12\x1f.user.SuggestSubstitutesRequest\x1a .user.SuggestSubstitutesResponse\x12N\n" +
+	"\x0fApplySubstitute\x12\x1c.user.ApplySubstituteRequest\x1a\x1d.user.ApplySubstituteResponseB\tZ\a./protob\x06proto3"
+
+var (
+	file_proto_substitutions_proto_rawDescOnce sync.Once
+	file_proto_substitutions_proto_rawDescData []byte
+)
+
+func file_proto_substitutions_proto_rawDescGZIP() []byte {
+	file_proto_substitutions_proto_rawDescOnce.Do(func() {
+		file_proto_substitutions_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_substitutions_proto_rawDesc), len(file_proto_substitutions_proto_rawDesc)))
+	})
+	return file_proto_substitutions_proto_rawDescData
+}
+
+var file_proto_substitutions_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_substitutions_proto_goTypes = []any{
+	(*SubstituteFood)(nil),             // 0: user.SubstituteFood
+	(*MealFood)(nil),                   // 1: user.MealFood
+	(*SwappedMeal)(nil),                // 2: user.SwappedMeal
+	(*SuggestSubstitutesRequest)(nil),  // 3: user.SuggestSubstitutesRequest
+	(*SuggestSubstitutesResponse)(nil), // 4: user.SuggestSubstitutesResponse
+	(*ApplySubstituteRequest)(nil),     // 5: user.ApplySubstituteRequest
+	(*ApplySubstituteResponse)(nil),    // 6: user.ApplySubstituteResponse
+}
+var file_proto_substitutions_proto_depIdxs = []int32{
+	1, // 0: user.SwappedMeal.foods:type_name -> user.MealFood
+	0, // 1: user.SuggestSubstitutesResponse.original:type_name -> user.SubstituteFood
+	0, // 2: user.SuggestSubstitutesResponse.substitutes:type_name -> user.SubstituteFood
+	2, // 3: user.ApplySubstituteResponse.meal:type_name -> user.SwappedMeal
+	3, // 4: user.SubstitutionService.SuggestSubstitutes:input_type -> user.SuggestSubstitutesRequest
+	5, // 5: user.SubstitutionService.ApplySubstitute:input_type -> user.ApplySubstituteRequest
+	4, // 6: user.SubstitutionService.SuggestSubstitutes:output_type -> user.SuggestSubstitutesResponse
+	6, // 7: user.SubstitutionService.ApplySubstitute:output_type -> user.ApplySubstituteResponse
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_proto_substitutions_proto_init() }
+func file_proto_substitutions_proto_init() {
+	if File_proto_substitutions_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_substitutions_proto_rawDesc), len(file_proto_substitutions_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_substitutions_proto_goTypes,
+		DependencyIndexes: file_proto_substitutions_proto_depIdxs,
+		MessageInfos:      file_proto_substitutions_proto_msgTypes,
+	}.Build()
+	File_proto_substitutions_proto = out.File
+	file_proto_substitutions_proto_goTypes = nil
+	file_proto_substitutions_proto_depIdxs = nil
+}
@@ -0,0 +1,46 @@
+package checkins
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// DailyWeight is the average weight a user logged on one calendar day
+type DailyWeight struct {
+	Date     time.Time `db:"date"`
+	WeightKg float64   `db:"weight_kg"`
+}
+
+// Repository handles check-in database operations
+type Repository struct {
+	db *sqlx.DB
+}
+
+// NewRepository creates a new check-in repository
+func NewRepository(db *sqlx.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// DailyWeights returns one average weight per calendar day between two dates
+// (inclusive). recorded_at is stored in UTC and converted to the given IANA
+// timezone so days line up with diary dates.
+func (r *Repository) DailyWeights(userID int, timezone string, start, end time.Time) ([]DailyWeight, error) {
+	weights := []DailyWeight{}
+	query := `
+		SELECT (recorded_at AT TIME ZONE 'UTC' AT TIME ZONE $2)::date AS date,
+		       AVG(weight_kg) AS weight_kg
+		FROM CHECK_INS
+		WHERE user_id = $1
+		  AND weight_kg IS NOT NULL
+		  AND (recorded_at AT TIME ZONE 'UTC' AT TIME ZONE $2)::date BETWEEN $3 AND $4
+		GROUP BY 1
+		ORDER BY 1`
+
+	err := r.db.Select(&weights, query, userID, timezone, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return weights, nil
+}
@@ -0,0 +1,34 @@
+package meals
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// GetFoodByBarcode retrieves the catalog food with a normalized barcode. A
+// barcode no food has is recorded in UNKNOWN_BARCODES for curation, counting
+// how often it was looked up.
+func (r *Repository) GetFoodByBarcode(userID int, barcode string) (*Food, error) {
+	var food Food
+	query := foodSelect + `
+		JOIN FOOD_BARCODES b ON b.food_id = f.id
+		LEFT JOIN FOOD_USER_LIKES l ON l.food_id = f.id AND l.user_id = $1
+		WHERE ` + visibleFood + ` AND b.barcode = $2`
+
+	err := r.db.Get(&food, query, userID, barcode)
+	if err == nil {
+		return &food, nil
+	}
+	if err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	_, err = r.db.Exec(`
+		INSERT INTO UNKNOWN_BARCODES (barcode) VALUES ($1)
+		ON CONFLICT (barcode) DO UPDATE
+		SET lookup_count = UNKNOWN_BARCODES.lookup_count + 1, last_seen_at = CURRENT_TIMESTAMP`, barcode)
+	if err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("barcode not found")
+}
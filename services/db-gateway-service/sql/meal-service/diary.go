@@ -0,0 +1,180 @@
+package meals
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// DiaryEntry represents a USER_MEALS row with nutrition scaled by servings
+type DiaryEntry struct {
+	ID            int       `db:"id"`
+	UserID        int       `db:"user_id"`
+	MealID        *int      `db:"meal_id"`
+	FoodID        *int      `db:"food_id"`
+	Date          time.Time `db:"date"`
+	MealNumber    int       `db:"meal_number"`
+	Servings      float64   `db:"servings"`
+	QuickCalories *float64  `db:"quick_calories"`
+	Description   *string   `db:"description"`
+	IsPlanned     bool      `db:"is_planned"`
+	Name          string    `db:"name"`
+	Calories      float64   `db:"calories"`
+	ProteinGrams  float64   `db:"protein_grams"`
+	CarbsGrams    float64   `db:"carbs_grams"`
+	FatGrams      float64   `db:"fat_grams"`
+	CreatedAt     time.Time `db:"created_at"`
+	UpdatedAt     time.Time `db:"updated_at"`
+}
+
+// Repository handles meal and diary database operations
+type Repository struct {
+	db *sqlx.DB
+}
+
+// NewRepository creates a new meal repository
+func NewRepository(db *sqlx.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// diaryEntrySelect joins diary rows to their meal or food so that names and
+// nutrition totals come back already multiplied by the servings multiplier
+var diaryEntrySelect = `
+		SELECT um.id, um.user_id, um.meal_id, um.food_id, um.date, um.meal_number,
+		       um.servings, um.quick_calories, um.description, um.is_planned,
+		       COALESCE(m.name, f.food_name, um.description, 'Quick add') AS name,
+		       COALESCE(mn.calories * um.servings, f.calories * um.servings, um.quick_calories, 0) AS calories,
+		       COALESCE(mn.protein_grams * um.servings, f.protein_grams * um.servings, 0) AS protein_grams,
+		       COALESCE(mn.carbs_grams * um.servings, f.carbs_grams * um.servings, 0) AS carbs_grams,
+		       COALESCE(mn.fat_grams * um.servings, f.fat_grams * um.servings, 0) AS fat_grams,
+		       um.created_at, um.updated_at
+		FROM USER_MEALS um
+		LEFT JOIN MEALS m ON m.id = um.meal_id` + mealNutrition + `
+		LEFT JOIN FOOD_CATALOG f ON f.id = um.food_id`
+
+// GetUserTimezone returns the IANA timezone stored on the user's profile
+func (r *Repository) GetUserTimezone(userID int) (string, error) {
+	var timezone *string
+	err := r.db.Get(&timezone, `SELECT timezone FROM USERS WHERE id = $1`, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", fmt.Errorf("user not found")
+		}
+		return "", err
+	}
+
+	if timezone == nil {
+		return "", nil
+	}
+	return *timezone, nil
+}
+
+// CreateDiaryEntry inserts a new diary entry. A food must be visible to the
+// user, so that another user's custom food cannot be logged.
+func (r *Repository) CreateDiaryEntry(entry *DiaryEntry) error {
+	if entry.FoodID != nil {
+		if err := r.requireVisibleFood(entry.UserID, *entry.FoodID); err != nil {
+			return err
+		}
+	}
+
+	query := `
+		INSERT INTO USER_MEALS (user_id, meal_id, food_id, date, meal_number, servings,
+		                        quick_calories, description, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		RETURNING id`
+
+	return r.db.QueryRow(
+		query, entry.UserID, entry.MealID, entry.FoodID, entry.Date, entry.MealNumber,
+		entry.Servings, entry.QuickCalories, entry.Description,
+	).Scan(&entry.ID)
+}
+
+// GetDiaryEntry retrieves a single diary entry owned by the user
+func (r *Repository) GetDiaryEntry(userID, id int) (*DiaryEntry, error) {
+	var entry DiaryEntry
+	query := diaryEntrySelect + `
+		WHERE um.id = $1 AND um.user_id = $2 AND NOT um.is_planned`
+
+	err := r.db.Get(&entry, query, id, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("diary entry not found")
+		}
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+// ListDiaryEntries retrieves a user's diary entries for a single date
+func (r *Repository) ListDiaryEntries(userID int, date time.Time) ([]DiaryEntry, error) {
+	entries := []DiaryEntry{}
+	query := diaryEntrySelect + `
+		WHERE um.user_id = $1 AND um.date = $2 AND NOT um.is_planned
+		ORDER BY um.meal_number, um.created_at, um.id`
+
+	err := r.db.Select(&entries, query, userID, date)
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// UpdateDiaryEntry updates an existing diary entry owned by the user. A food
+// must be visible to the user, as when logging it.
+func (r *Repository) UpdateDiaryEntry(entry *DiaryEntry) error {
+	if entry.FoodID != nil {
+		if err := r.requireVisibleFood(entry.UserID, *entry.FoodID); err != nil {
+			return err
+		}
+	}
+
+	query := `
+		UPDATE USER_MEALS
+		SET meal_id = $1, food_id = $2, date = $3, meal_number = $4, servings = $5,
+		    quick_calories = $6, description = $7, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $8 AND user_id = $9 AND NOT is_planned`
+
+	result, err := r.db.Exec(
+		query, entry.MealID, entry.FoodID, entry.Date, entry.MealNumber, entry.Servings,
+		entry.QuickCalories, entry.Description, entry.ID, entry.UserID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("diary entry not found")
+	}
+
+	return nil
+}
+
+// DeleteDiaryEntry deletes a diary entry owned by the user
+func (r *Repository) DeleteDiaryEntry(userID, id int) error {
+	query := `DELETE FROM USER_MEALS WHERE id = $1 AND user_id = $2 AND NOT is_planned`
+	result, err := r.db.Exec(query, id, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("diary entry not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package meals
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// CustomFood is a FOOD_CATALOG row owned by the user whose diary import created it
+type CustomFood struct {
+	ID           int
+	FoodName     string
+	Calories     float64
+	ProteinGrams float64
+	CarbsGrams   float64
+	FatGrams     float64
+	FiberGrams   *float64
+	SugarGrams   *float64
+	SodiumMg     *float64
+}
+
+// ImportedEntry is a diary entry written by an import. When NewFood is set the
+// entry logs that custom food, whose ID is only known once it is created.
+type ImportedEntry struct {
+	Date       time.Time
+	MealNumber int
+	FoodID     int
+	Servings   float64
+	NewFood    *CustomFood
+}
+
+// ListVisibleFoods retrieves the names and calories of the shared catalog
+// foods and the user's custom foods
+func (r *Repository) ListVisibleFoods(userID int) ([]Food, error) {
+	foods := []Food{}
+	query := `
+		SELECT f.id, f.food_name, f.calories FROM FOOD_CATALOG f
+		WHERE ` + visibleFood + `
+		ORDER BY f.user_id NULLS FIRST, f.id`
+
+	err := r.db.Select(&foods, query, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return foods, nil
+}
+
+// ListLoggedFoods retrieves the single-food diary entries a user logged
+// between two dates, inclusive
+func (r *Repository) ListLoggedFoods(userID int, start, end time.Time) ([]DiaryEntry, error) {
+	entries := []DiaryEntry{}
+	query := `
+		SELECT food_id, date, meal_number, servings FROM USER_MEALS
+		WHERE user_id = $1 AND date BETWEEN $2 AND $3 AND food_id IS NOT NULL AND NOT is_planned`
+
+	err := r.db.Select(&entries, query, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// ImportDiaryEntries creates the custom foods the entries need and logs the
+// entries in one transaction
+func (r *Repository) ImportDiaryEntries(userID int, foods []*CustomFood, entries []ImportedEntry) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, food := range foods {
+		err := tx.QueryRow(`
+			INSERT INTO FOOD_CATALOG (user_id, source, food_name, category, serving_units, calories,
+			                          protein_grams, carbs_grams, fat_grams,
+			                          fiber_grams, sugar_grams, sodium_mg, notes)
+			VALUES ($1, 'USER', $2, 'OTHER', 'PIECES', $3, $4, $5, $6, $7, $8, $9, 'Created by a diary import; one piece is one serving')
+			RETURNING id`,
+			userID, food.FoodName, food.Calories, food.ProteinGrams, food.CarbsGrams, food.FatGrams,
+			food.FiberGrams, food.SugarGrams, food.SodiumMg,
+		).Scan(&food.ID)
+		if isForeignKeyViolation(err) {
+			return fmt.Errorf("user not found")
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(entries) > 0 {
+		foodIDs := make([]int64, len(entries))
+		dates := make([]string, len(entries))
+		mealNumbers := make([]int64, len(entries))
+		servings := make([]float64, len(entries))
+		for i, entry := range entries {
+			foodID := entry.FoodID
+			if entry.NewFood != nil {
+				foodID = entry.NewFood.ID
+			}
+			foodIDs[i] = int64(foodID)
+			dates[i] = entry.Date.Format("2006-01-02")
+			mealNumbers[i] = int64(entry.MealNumber)
+			servings[i] = entry.Servings
+		}
+
+		_, err = tx.Exec(`
+			INSERT INTO USER_MEALS (user_id, food_id, date, meal_number, servings, created_at, updated_at)
+			SELECT $1, unnest($2::int[]), unnest($3::date[]), unnest($4::int[]), unnest($5::numeric[]),
+			       CURRENT_TIMESTAMP, CURRENT_TIMESTAMP`,
+			userID, pq.Int64Array(foodIDs), pq.StringArray(dates), pq.Int64Array(mealNumbers), pq.Float64Array(servings))
+		if isForeignKeyViolation(err) {
+			return fmt.Errorf("user not found")
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
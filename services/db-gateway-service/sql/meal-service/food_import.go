@@ -0,0 +1,120 @@
+package meals
+
+// ImportedFood is a shared catalog food read from a bulk import source.
+// Nutrition is per serving unit, and optional nutrients are nil when the
+// source does not report them.
+type ImportedFood struct {
+	Source       string
+	SourceID     string
+	FoodName     string
+	Category     string
+	ServingUnits string
+	Calories     float64
+	ProteinGrams float64
+	CarbsGrams   float64
+	FatGrams     float64
+	FiberGrams   *float64
+	SugarGrams   *float64
+	SodiumMg     *float64
+	PotassiumMg  *float64
+	IronMg       *float64
+	CalciumMg    *float64
+	VitaminDMcg  *float64
+	Omega3Grams  *float64
+	Barcode      string // "" when the food has none
+}
+
+// FoodImportResult counts the catalog rows a batch of imported foods wrote
+type FoodImportResult struct {
+	Inserted int
+	Updated  int
+	Barcodes int
+}
+
+// UpsertImportedFoods writes a batch of imported foods in one transaction.
+// A food already imported from the same source and source ID is updated in
+// place, so its ID and the diary entries that log it are kept. A barcode
+// that already belongs to a food stays with that food, and a new barcode is
+// no longer an unknown barcode.
+func (r *Repository) UpsertImportedFoods(foods []ImportedFood) (FoodImportResult, error) {
+	var result FoodImportResult
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return result, err
+	}
+	defer tx.Rollback()
+
+	upsertFood, err := tx.Preparex(`
+		INSERT INTO FOOD_CATALOG (source, source_id, food_name, category, serving_units, calories,
+		                          protein_grams, carbs_grams, fat_grams, fiber_grams, sugar_grams,
+		                          sodium_mg, potassium_mg, iron_mg, calcium_mg, vitamin_d_mcg, omega3_grams)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+		ON CONFLICT (source, source_id) WHERE source_id IS NOT NULL DO UPDATE
+		SET food_name = EXCLUDED.food_name, category = EXCLUDED.category,
+		    serving_units = EXCLUDED.serving_units, calories = EXCLUDED.calories,
+		    protein_grams = EXCLUDED.protein_grams, carbs_grams = EXCLUDED.carbs_grams,
+		    fat_grams = EXCLUDED.fat_grams, fiber_grams = EXCLUDED.fiber_grams,
+		    sugar_grams = EXCLUDED.sugar_grams, sodium_mg = EXCLUDED.sodium_mg,
+		    potassium_mg = EXCLUDED.potassium_mg, iron_mg = EXCLUDED.iron_mg,
+		    calcium_mg = EXCLUDED.calcium_mg, vitamin_d_mcg = EXCLUDED.vitamin_d_mcg,
+		    omega3_grams = EXCLUDED.omega3_grams, updated_at = CURRENT_TIMESTAMP
+		RETURNING id, (xmax = 0) AS inserted`)
+	if err != nil {
+		return result, err
+	}
+	defer upsertFood.Close()
+
+	insertBarcode, err := tx.Preparex(`
+		INSERT INTO FOOD_BARCODES (barcode, food_id) VALUES ($1, $2)
+		ON CONFLICT (barcode) DO NOTHING`)
+	if err != nil {
+		return result, err
+	}
+	defer insertBarcode.Close()
+
+	resolveBarcode, err := tx.Preparex(`DELETE FROM UNKNOWN_BARCODES WHERE barcode = $1`)
+	if err != nil {
+		return result, err
+	}
+	defer resolveBarcode.Close()
+
+	for _, food := range foods {
+		var id int
+		var inserted bool
+		err := upsertFood.QueryRow(
+			food.Source, food.SourceID, food.FoodName, food.Category, food.ServingUnits, food.Calories,
+			food.ProteinGrams, food.CarbsGrams, food.FatGrams, food.FiberGrams, food.SugarGrams,
+			food.SodiumMg, food.PotassiumMg, food.IronMg, food.CalciumMg, food.VitaminDMcg, food.Omega3Grams,
+		).Scan(&id, &inserted)
+		if err != nil {
+			return result, err
+		}
+		if inserted {
+			result.Inserted++
+		} else {
+			result.Updated++
+		}
+
+		if food.Barcode == "" {
+			continue
+		}
+		res, err := insertBarcode.Exec(food.Barcode, id)
+		if err != nil {
+			return result, err
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return result, err
+		}
+		if rowsAffected == 0 {
+			continue
+		}
+		result.Barcodes++
+		if _, err := resolveBarcode.Exec(food.Barcode); err != nil {
+			return result, err
+		}
+	}
+
+	return result, tx.Commit()
+}
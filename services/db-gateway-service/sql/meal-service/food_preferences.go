@@ -0,0 +1,247 @@
+package meals
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+// Food represents a FOOD_CATALOG row with its allergens and the user's like
+type Food struct {
+	ID                int            `db:"id"`
+	FoodName          string         `db:"food_name"`
+	Category          string         `db:"category"`
+	ServingUnits      string         `db:"serving_units"`
+	Calories          float64        `db:"calories"`
+	ProteinGrams      float64        `db:"protein_grams"`
+	CarbsGrams        float64        `db:"carbs_grams"`
+	FatGrams          float64        `db:"fat_grams"`
+	IsNonInflammatory bool           `db:"is_non_inflammatory"`
+	IsProbiotic       bool           `db:"is_probiotic"`
+	IsPrebiotic       bool           `db:"is_prebiotic"`
+	FiberGrams        *float64       `db:"fiber_grams"`
+	IronMg            *float64       `db:"iron_mg"`
+	Allergens         pq.StringArray `db:"allergens"`
+	Liked             bool           `db:"liked"`
+}
+
+// FoodPreferences holds a user's liked and disliked foods, allergies and diet restrictions
+type FoodPreferences struct {
+	Likes            []Food
+	Dislikes         []Food
+	Allergies        []string
+	DietRestrictions []string
+}
+
+// FoodFilter selects catalog foods for a user. Foods in an excluded category,
+// containing an excluded allergen or disliked by the user are hidden.
+type FoodFilter struct {
+	ExcludedCategories []string
+	ExcludedAllergens  []string
+	Category           string // optional
+	LikedOnly          bool
+}
+
+// foodSelect selects a Food from FOOD_CATALOG f joined with the user's FOOD_USER_LIKES l
+const foodSelect = `
+		SELECT f.id, f.food_name, f.category, f.serving_units, f.calories,
+		       f.protein_grams, f.carbs_grams, f.fat_grams,
+		       f.is_non_inflammatory, f.is_probiotic, f.is_prebiotic,
+		       f.fiber_grams, f.iron_mg,
+		       ARRAY(SELECT a.allergen::text FROM FOOD_ALLERGENS a WHERE a.food_id = f.id ORDER BY a.allergen) AS allergens,
+		       COALESCE(l.preference = 'LIKE', false) AS liked
+		FROM FOOD_CATALOG f`
+
+// visibleFood limits FOOD_CATALOG f to shared foods and the custom foods of user $1
+const visibleFood = `(f.user_id IS NULL OR f.user_id = $1)`
+
+// userFoodFilter hides foods excluded for the user; $1 is the user ID,
+// $2 and $3 the excluded categories and allergens, $4 an optional category
+const userFoodFilter = `
+		LEFT JOIN FOOD_USER_LIKES l ON l.food_id = f.id AND l.user_id = $1
+		WHERE ` + visibleFood + ` AND ($4 = '' OR f.category::text = $4)
+		  AND NOT (
+		      f.category::text = ANY($2)
+		      OR EXISTS (SELECT 1 FROM FOOD_ALLERGENS a WHERE a.food_id = f.id AND a.allergen::text = ANY($3))
+		      OR COALESCE(l.preference = 'DISLIKE', false)
+		  )`
+
+// GetFoodPreferences retrieves a user's food preferences
+func (r *Repository) GetFoodPreferences(userID int) (*FoodPreferences, error) {
+	if err := r.requireUser(userID); err != nil {
+		return nil, err
+	}
+
+	preferences := &FoodPreferences{Likes: []Food{}, Dislikes: []Food{}, Allergies: []string{}, DietRestrictions: []string{}}
+
+	// Every row has a preference, so foods that are not liked are disliked
+	rated := []Food{}
+	err := r.db.Select(&rated, foodSelect+`
+		JOIN FOOD_USER_LIKES l ON l.food_id = f.id
+		WHERE l.user_id = $1
+		ORDER BY f.food_name`, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, food := range rated {
+		if food.Liked {
+			preferences.Likes = append(preferences.Likes, food)
+		} else {
+			preferences.Dislikes = append(preferences.Dislikes, food)
+		}
+	}
+
+	err = r.db.Select(&preferences.Allergies, `
+		SELECT allergen::text FROM USER_ALLERGIES WHERE user_id = $1 ORDER BY allergen::text`, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.db.Select(&preferences.DietRestrictions, `
+		SELECT restriction::text FROM USER_DIET_RESTRICTIONS WHERE user_id = $1 ORDER BY restriction::text`, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return preferences, nil
+}
+
+// SetFoodPreference records that a user likes or dislikes a food, replacing any earlier preference
+func (r *Repository) SetFoodPreference(userID, foodID int, preference string) error {
+	if err := r.requireVisibleFood(userID, foodID); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO FOOD_USER_LIKES (user_id, food_id, preference, created_at, updated_at)
+		VALUES ($1, $2, $3, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		ON CONFLICT (user_id, food_id)
+		DO UPDATE SET preference = EXCLUDED.preference, updated_at = CURRENT_TIMESTAMP`
+
+	_, err := r.db.Exec(query, userID, foodID, preference)
+	if isForeignKeyViolation(err) {
+		return fmt.Errorf("user not found")
+	}
+	return err
+}
+
+// ClearFoodPreference removes a user's like or dislike of a food
+func (r *Repository) ClearFoodPreference(userID, foodID int) error {
+	result, err := r.db.Exec(`DELETE FROM FOOD_USER_LIKES WHERE user_id = $1 AND food_id = $2`, userID, foodID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("food preference not found")
+	}
+
+	return nil
+}
+
+// SetAllergies replaces a user's allergies
+func (r *Repository) SetAllergies(userID int, allergies []string) error {
+	return r.replaceUserValues(userID, allergies,
+		`DELETE FROM USER_ALLERGIES WHERE user_id = $1`,
+		`INSERT INTO USER_ALLERGIES (user_id, allergen)
+		 SELECT $1, unnest($2::text[])::allergen_type`)
+}
+
+// SetDietRestrictions replaces a user's diet restrictions
+func (r *Repository) SetDietRestrictions(userID int, restrictions []string) error {
+	return r.replaceUserValues(userID, restrictions,
+		`DELETE FROM USER_DIET_RESTRICTIONS WHERE user_id = $1`,
+		`INSERT INTO USER_DIET_RESTRICTIONS (user_id, restriction)
+		 SELECT $1, unnest($2::text[])::diet_restriction_type`)
+}
+
+// replaceUserValues deletes a user's rows and inserts the new values in one transaction
+func (r *Repository) replaceUserValues(userID int, values []string, deleteQuery, insertQuery string) error {
+	if err := r.requireUser(userID); err != nil {
+		return err
+	}
+
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(deleteQuery, userID); err != nil {
+		return err
+	}
+	if len(values) > 0 {
+		if _, err := tx.Exec(insertQuery, userID, pq.StringArray(values)); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ListFoodsForUser retrieves the catalog foods that pass the filter, liked
+// foods first, and the number of foods the filter hid
+func (r *Repository) ListFoodsForUser(userID int, filter FoodFilter) ([]Food, int, error) {
+	// A nil array would be sent as NULL, and ANY(NULL) would hide every food
+	categories := pq.StringArray(append([]string{}, filter.ExcludedCategories...))
+	allergens := pq.StringArray(append([]string{}, filter.ExcludedAllergens...))
+
+	foods := []Food{}
+	query := foodSelect + userFoodFilter + `
+		  AND (NOT $5 OR l.preference = 'LIKE')
+		ORDER BY liked DESC, f.food_name`
+
+	err := r.db.Select(&foods, query, userID, categories, allergens, filter.Category, filter.LikedOnly)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var visible, total int
+	err = r.db.QueryRow(`
+		SELECT (SELECT COUNT(*) FROM FOOD_CATALOG f`+userFoodFilter+`),
+		       (SELECT COUNT(*) FROM FOOD_CATALOG f WHERE `+visibleFood+` AND ($4 = '' OR f.category::text = $4))`,
+		userID, categories, allergens, filter.Category).Scan(&visible, &total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return foods, total - visible, nil
+}
+
+// requireUser returns "user not found" when the user does not exist
+func (r *Repository) requireUser(userID int) error {
+	var exists bool
+	if err := r.db.Get(&exists, `SELECT EXISTS (SELECT 1 FROM USERS WHERE id = $1)`, userID); err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("user not found")
+	}
+	return nil
+}
+
+// requireVisibleFood returns "food not found" when the food is neither a
+// shared catalog food nor a custom food of the user
+func (r *Repository) requireVisibleFood(userID, foodID int) error {
+	var exists bool
+	err := r.db.Get(&exists, `SELECT EXISTS (SELECT 1 FROM FOOD_CATALOG f WHERE `+visibleFood+` AND f.id = $2)`, userID, foodID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("food not found")
+	}
+	return nil
+}
+
+// isForeignKeyViolation reports whether err is a Postgres foreign key violation
+func isForeignKeyViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23503"
+}
@@ -0,0 +1,30 @@
+package meals
+
+import "github.com/lib/pq"
+
+// SearchFoods retrieves up to limit shared catalog foods and custom foods of
+// the user whose name contains any of the words. Custom foods come first, as
+// they are what the user logs most.
+func (r *Repository) SearchFoods(userID int, words []string, limit int) ([]Food, error) {
+	foods := []Food{}
+	if len(words) == 0 {
+		return foods, nil
+	}
+
+	patterns := make([]string, len(words))
+	for i, word := range words {
+		patterns[i] = "%" + word + "%"
+	}
+	query := `
+		SELECT f.id, f.food_name, f.serving_units, f.calories FROM FOOD_CATALOG f
+		WHERE ` + visibleFood + ` AND f.food_name ILIKE ANY($2)
+		ORDER BY f.user_id NULLS LAST, f.id
+		LIMIT $3`
+
+	err := r.db.Select(&foods, query, userID, pq.StringArray(patterns), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return foods, nil
+}
@@ -0,0 +1,54 @@
+package meals
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"db-gateway-service/internal/units"
+)
+
+// unitSizes returns a VALUES table of every serving unit with its dimension
+// and size, built from the units package so that queries convert quantities
+// with the same factors as units.Convert
+func unitSizes(alias string) string {
+	rows := make([]string, len(units.Names))
+	for i, name := range units.Names {
+		dimension, _ := units.Dimension(name)
+		size, _ := units.Size(name)
+		rows[i] = fmt.Sprintf("('%s', '%s', %s)", name, dimension, strconv.FormatFloat(size, 'f', -1, 64))
+	}
+	return fmt.Sprintf("(VALUES %s) AS %s(unit, dimension, size)", strings.Join(rows, ", "), alias)
+}
+
+// ingredientServings is MEAL_INGREDIENTS with each quantity converted to
+// servings of its food, whose nutrition is per one serving unit. servings is
+// NULL when the ingredient's unit cannot be converted to the food's serving
+// unit (e.g. pieces against grams); those ingredients are left out of totals.
+var ingredientServings = `(
+			SELECT mi.id, mi.meal_id, mi.food_id, mi.quantity * iu.size / fu.size AS servings
+			FROM MEAL_INGREDIENTS mi
+			JOIN FOOD_CATALOG f ON f.id = mi.food_id
+			JOIN ` + unitSizes("iu") + ` ON iu.unit = mi.unit::text
+			LEFT JOIN ` + unitSizes("fu") + ` ON fu.unit = f.serving_units::text AND fu.dimension = iu.dimension
+		)`
+
+// mealNutrition joins a meal aliased m to its nutrition per serving as mn. A
+// meal with ingredients totals them (converted as in ingredientServings) over
+// the servings they make; one without falls back to the stored MEALS totals.
+// The diary, quick logging, meal details and the reports all read meal
+// nutrition through it, so they agree on the same meal.
+var mealNutrition = `
+		LEFT JOIN LATERAL (
+			SELECT CASE WHEN COUNT(mi.id) = 0 THEN m.total_calories
+			            ELSE COALESCE(SUM(mi.servings * f.calories), 0) / m.servings END AS calories,
+			       CASE WHEN COUNT(mi.id) = 0 THEN m.total_protein
+			            ELSE COALESCE(SUM(mi.servings * f.protein_grams), 0) / m.servings END AS protein_grams,
+			       CASE WHEN COUNT(mi.id) = 0 THEN m.total_carbs
+			            ELSE COALESCE(SUM(mi.servings * f.carbs_grams), 0) / m.servings END AS carbs_grams,
+			       CASE WHEN COUNT(mi.id) = 0 THEN m.total_fat
+			            ELSE COALESCE(SUM(mi.servings * f.fat_grams), 0) / m.servings END AS fat_grams
+			FROM ` + ingredientServings + ` mi
+			JOIN FOOD_CATALOG f ON f.id = mi.food_id
+			WHERE mi.meal_id = m.id
+		) mn ON true`
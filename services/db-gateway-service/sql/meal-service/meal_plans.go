@@ -0,0 +1,71 @@
+package meals
+
+import (
+	"time"
+)
+
+// PlannedFood represents a planned USER_MEALS row with nutrition scaled by servings
+type PlannedFood struct {
+	ID           int       `db:"id"`
+	FoodID       int       `db:"food_id"`
+	Date         time.Time `db:"date"`
+	MealNumber   int       `db:"meal_number"`
+	Servings     float64   `db:"servings"`
+	Name         string    `db:"name"`
+	ServingUnits string    `db:"serving_units"`
+	Calories     float64   `db:"calories"`
+	ProteinGrams float64   `db:"protein_grams"`
+	CarbsGrams   float64   `db:"carbs_grams"`
+	FatGrams     float64   `db:"fat_grams"`
+}
+
+// ReplaceMealPlan replaces a user's planned meals between two dates (inclusive)
+// with the given foods in one transaction. Logged diary entries are untouched.
+func (r *Repository) ReplaceMealPlan(userID int, start, end time.Time, foods []PlannedFood) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`
+		DELETE FROM USER_MEALS
+		WHERE user_id = $1 AND is_planned AND date BETWEEN $2 AND $3`, userID, start, end)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO USER_MEALS (user_id, food_id, date, meal_number, servings, is_planned, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, true, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)`
+	for _, food := range foods {
+		if _, err := tx.Exec(query, userID, food.FoodID, food.Date, food.MealNumber, food.Servings); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ListPlannedMeals retrieves a user's planned meals between two dates (inclusive)
+func (r *Repository) ListPlannedMeals(userID int, start, end time.Time) ([]PlannedFood, error) {
+	foods := []PlannedFood{}
+	query := `
+		SELECT um.id, um.food_id, um.date, um.meal_number, um.servings,
+		       f.food_name AS name, f.serving_units::text AS serving_units,
+		       f.calories * um.servings AS calories,
+		       f.protein_grams * um.servings AS protein_grams,
+		       f.carbs_grams * um.servings AS carbs_grams,
+		       f.fat_grams * um.servings AS fat_grams
+		FROM USER_MEALS um
+		JOIN FOOD_CATALOG f ON f.id = um.food_id
+		WHERE um.user_id = $1 AND um.is_planned AND um.date BETWEEN $2 AND $3
+		ORDER BY um.date, um.meal_number, um.id`
+
+	err := r.db.Select(&foods, query, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return foods, nil
+}
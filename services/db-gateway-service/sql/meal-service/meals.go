@@ -0,0 +1,139 @@
+package meals
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// Meal represents a MEALS row. Nutrition totals are per serving and come from
+// the ingredients when the meal has any (see mealNutrition); the ingredient
+// quantities make Servings servings.
+type Meal struct {
+	ID               int      `db:"id"`
+	Name             string   `db:"name"`
+	Description      *string  `db:"description"`
+	Servings         int      `db:"servings"`
+	TotalCalories    *float64 `db:"total_calories"`
+	TotalProtein     *float64 `db:"total_protein"`
+	TotalCarbs       *float64 `db:"total_carbs"`
+	TotalFat         *float64 `db:"total_fat"`
+	PrepTime         *int     `db:"prep_time"`
+	PrepInstructions *string  `db:"prep_instructions"`
+	// PrepInstructionsFormat is MARKDOWN or HTML
+	PrepInstructionsFormat string `db:"prep_instructions_format"`
+}
+
+// MealIngredient represents a MEAL_INGREDIENTS row with its food name
+type MealIngredient struct {
+	ID       int     `db:"id"`
+	FoodID   int     `db:"food_id"`
+	Name     string  `db:"name"`
+	Quantity float64 `db:"quantity"`
+	Unit     string  `db:"unit"`
+	Notes    *string `db:"notes"`
+}
+
+// BatchEntry is a planned serving of a meal on one day
+type BatchEntry struct {
+	ID         int
+	Date       time.Time
+	MealNumber int
+	Servings   float64
+}
+
+// GetMeal retrieves a meal by ID
+func (r *Repository) GetMeal(id int) (*Meal, error) {
+	var meal Meal
+	query := `
+		SELECT m.id, m.name, m.description, m.servings, mn.calories AS total_calories,
+		       mn.protein_grams AS total_protein, mn.carbs_grams AS total_carbs,
+		       mn.fat_grams AS total_fat, m.prep_time, m.prep_instructions,
+		       m.prep_instructions_format::text AS prep_instructions_format
+		FROM MEALS m` + mealNutrition + `
+		WHERE m.id = $1`
+
+	err := r.db.Get(&meal, query, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("meal not found")
+		}
+		return nil, err
+	}
+
+	return &meal, nil
+}
+
+// UpdateMealInstructions replaces the preparation instructions of a meal.
+// A nil text clears them.
+func (r *Repository) UpdateMealInstructions(id int, text *string, format string) error {
+	query := `
+		UPDATE MEALS
+		SET prep_instructions = $2, prep_instructions_format = $3, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1`
+
+	result, err := r.db.Exec(query, id, text, format)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("meal not found")
+	}
+
+	return nil
+}
+
+// ListMealIngredients retrieves the ingredients of a meal
+func (r *Repository) ListMealIngredients(mealID int) ([]MealIngredient, error) {
+	ingredients := []MealIngredient{}
+	query := `
+		SELECT mi.id, mi.food_id, f.food_name AS name, mi.quantity, mi.unit::text AS unit, mi.notes
+		FROM MEAL_INGREDIENTS mi
+		JOIN FOOD_CATALOG f ON f.id = mi.food_id
+		WHERE mi.meal_id = $1
+		ORDER BY mi.id`
+
+	err := r.db.Select(&ingredients, query, mealID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ingredients, nil
+}
+
+// PlanBatchEntries plans servings of a meal on several days in one
+// transaction, replacing whatever was planned in the same meal slots.
+// The IDs of the new entries are set on the entries.
+func (r *Repository) PlanBatchEntries(userID, mealID int, entries []BatchEntry) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for i := range entries {
+		_, err := tx.Exec(`
+			DELETE FROM USER_MEALS
+			WHERE user_id = $1 AND is_planned AND date = $2 AND meal_number = $3`,
+			userID, entries[i].Date, entries[i].MealNumber)
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRow(`
+			INSERT INTO USER_MEALS (user_id, meal_id, date, meal_number, servings, is_planned, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, true, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+			RETURNING id`,
+			userID, mealID, entries[i].Date, entries[i].MealNumber, entries[i].Servings).Scan(&entries[i].ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
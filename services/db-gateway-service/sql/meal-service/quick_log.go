@@ -0,0 +1,195 @@
+package meals
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Favorite represents a USER_FAVORITES row with the name and calories of its
+// food or meal scaled by servings
+type Favorite struct {
+	ID        int       `db:"id"`
+	FoodID    *int      `db:"food_id"`
+	MealID    *int      `db:"meal_id"`
+	Name      string    `db:"name"`
+	Servings  float64   `db:"servings"`
+	Calories  float64   `db:"calories"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// RecentItem is a food or meal from a user's diary history, with the servings
+// and date it was last logged with
+type RecentItem struct {
+	FoodID      *int      `db:"food_id"`
+	MealID      *int      `db:"meal_id"`
+	Name        string    `db:"name"`
+	Servings    float64   `db:"servings"`
+	Calories    float64   `db:"calories"`
+	LastLogged  time.Time `db:"last_logged"`
+	TimesLogged int       `db:"times_logged"`
+	IsFavorite  bool      `db:"is_favorite"`
+}
+
+// favoriteSelect joins favorites to their food or meal
+var favoriteSelect = `
+		SELECT fav.id, fav.food_id, fav.meal_id,
+		       COALESCE(m.name, f.food_name) AS name, fav.servings,
+		       COALESCE(mn.calories, f.calories, 0) * fav.servings AS calories,
+		       fav.created_at
+		FROM USER_FAVORITES fav
+		LEFT JOIN MEALS m ON m.id = fav.meal_id` + mealNutrition + `
+		LEFT JOIN FOOD_CATALOG f ON f.id = fav.food_id`
+
+// ListFavorites retrieves a user's favorite foods and meals by name
+func (r *Repository) ListFavorites(userID int) ([]Favorite, error) {
+	favorites := []Favorite{}
+	query := favoriteSelect + `
+		WHERE fav.user_id = $1
+		ORDER BY name, fav.id`
+
+	err := r.db.Select(&favorites, query, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return favorites, nil
+}
+
+// GetFavorite retrieves a single favorite owned by the user
+func (r *Repository) GetFavorite(userID, id int) (*Favorite, error) {
+	var favorite Favorite
+	query := favoriteSelect + `
+		WHERE fav.id = $1 AND fav.user_id = $2`
+
+	err := r.db.Get(&favorite, query, id, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("favorite not found")
+		}
+		return nil, err
+	}
+
+	return &favorite, nil
+}
+
+// AddFavoriteFood marks a food the user can see as a favorite. Adding a
+// favorite food again updates its servings.
+func (r *Repository) AddFavoriteFood(userID, foodID int, servings float64) (int, error) {
+	query := `
+		INSERT INTO USER_FAVORITES (user_id, food_id, servings)
+		SELECT $1, f.id, $3 FROM FOOD_CATALOG f
+		WHERE ` + visibleFood + ` AND f.id = $2
+		ON CONFLICT (user_id, food_id) WHERE food_id IS NOT NULL DO UPDATE
+		SET servings = EXCLUDED.servings
+		RETURNING id`
+
+	return r.addFavorite(query, userID, foodID, servings, "food not found")
+}
+
+// AddFavoriteMeal marks a meal as a favorite. Adding a favorite meal again
+// updates its servings.
+func (r *Repository) AddFavoriteMeal(userID, mealID int, servings float64) (int, error) {
+	query := `
+		INSERT INTO USER_FAVORITES (user_id, meal_id, servings)
+		SELECT $1, m.id, $3 FROM MEALS m
+		WHERE m.id = $2
+		ON CONFLICT (user_id, meal_id) WHERE meal_id IS NOT NULL DO UPDATE
+		SET servings = EXCLUDED.servings
+		RETURNING id`
+
+	return r.addFavorite(query, userID, mealID, servings, "meal not found")
+}
+
+func (r *Repository) addFavorite(query string, userID, id int, servings float64, notFound string) (int, error) {
+	var favoriteID int
+	err := r.db.QueryRow(query, userID, id, servings).Scan(&favoriteID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, errors.New(notFound)
+		}
+		return 0, err
+	}
+	return favoriteID, nil
+}
+
+// RemoveFavorite deletes a favorite owned by the user
+func (r *Repository) RemoveFavorite(userID, id int) error {
+	result, err := r.db.Exec(`DELETE FROM USER_FAVORITES WHERE id = $1 AND user_id = $2`, id, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("favorite not found")
+	}
+
+	return nil
+}
+
+// ListRecentItems retrieves up to limit foods and meals the user logged on or
+// after a date, most recently logged first. Each comes with the servings it
+// was last logged with and how often it was logged; quick-add calories are
+// left out as there is nothing to log again.
+func (r *Repository) ListRecentItems(userID int, since time.Time, limit int) ([]RecentItem, error) {
+	items := []RecentItem{}
+	query := `
+		WITH logged AS (
+		    SELECT um.food_id, um.meal_id, um.servings, um.date, um.created_at, um.id,
+		           COUNT(*) OVER (PARTITION BY um.food_id, um.meal_id) AS times_logged,
+		           ROW_NUMBER() OVER (PARTITION BY um.food_id, um.meal_id ORDER BY um.created_at DESC, um.id DESC) AS latest
+		    FROM USER_MEALS um
+		    WHERE um.user_id = $1 AND um.date >= $2 AND NOT um.is_planned AND um.quick_calories IS NULL
+		)
+		SELECT l.food_id, l.meal_id, COALESCE(m.name, f.food_name) AS name, l.servings,
+		       COALESCE(mn.calories, f.calories, 0) * l.servings AS calories,
+		       l.date AS last_logged, l.times_logged,
+		       EXISTS (SELECT 1 FROM USER_FAVORITES fav
+		               WHERE fav.user_id = $1 AND (fav.food_id = l.food_id OR fav.meal_id = l.meal_id)) AS is_favorite
+		FROM logged l
+		LEFT JOIN MEALS m ON m.id = l.meal_id` + mealNutrition + `
+		LEFT JOIN FOOD_CATALOG f ON f.id = l.food_id
+		WHERE l.latest = 1
+		ORDER BY l.created_at DESC, l.id DESC
+		LIMIT $3`
+
+	err := r.db.Select(&items, query, userID, since, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// CopyDiaryEntries duplicates the diary entries a user logged on one date
+// onto another, keeping their meal numbers. A non-zero mealNumber copies that
+// meal slot only, into toMealNumber when it is non-zero. It returns the
+// number of entries copied.
+func (r *Repository) CopyDiaryEntries(userID int, from, to time.Time, mealNumber, toMealNumber int) (int, error) {
+	query := `
+		INSERT INTO USER_MEALS (user_id, meal_id, food_id, date, meal_number, servings,
+		                        quick_calories, description, created_at, updated_at)
+		SELECT user_id, meal_id, food_id, $3, CASE WHEN $5 = 0 THEN meal_number ELSE $5 END, servings,
+		       quick_calories, description, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+		FROM USER_MEALS
+		WHERE user_id = $1 AND date = $2 AND NOT is_planned AND ($4 = 0 OR meal_number = $4)
+		ORDER BY meal_number, created_at, id`
+
+	result, err := r.db.Exec(query, userID, from, to, mealNumber, toMealNumber)
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(rowsAffected), nil
+}
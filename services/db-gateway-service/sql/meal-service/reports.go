@@ -0,0 +1,197 @@
+package meals
+
+import (
+	"time"
+)
+
+// NutritionPeriod represents nutrition consumed during one reporting bucket
+type NutritionPeriod struct {
+	PeriodStart          time.Time `db:"period_start"`
+	DaysLogged           int       `db:"days_logged"`
+	Calories             float64   `db:"calories"`
+	ProteinGrams         float64   `db:"protein_grams"`
+	CarbsGrams           float64   `db:"carbs_grams"`
+	FatGrams             float64   `db:"fat_grams"`
+	NonInflammatoryFoods int       `db:"non_inflammatory_foods"`
+	ProbioticFoods       int       `db:"probiotic_foods"`
+	PrebioticFoods       int       `db:"prebiotic_foods"`
+	FiberGrams           float64   `db:"fiber_grams"`
+	SugarGrams           float64   `db:"sugar_grams"`
+	SodiumMg             float64   `db:"sodium_mg"`
+	PotassiumMg          float64   `db:"potassium_mg"`
+	IronMg               float64   `db:"iron_mg"`
+	CalciumMg            float64   `db:"calcium_mg"`
+	VitaminDMcg          float64   `db:"vitamin_d_mcg"`
+	Omega3Grams          float64   `db:"omega3_grams"`
+	// UntrackedItems counts consumed items without micronutrient data: meals
+	// without ingredients, quick-add calories and foods missing values
+	UntrackedItems int `db:"untracked_items"`
+	// UnconvertedIngredients counts meal ingredients left out of every total
+	// because their unit cannot be converted to their food's serving unit
+	UnconvertedIngredients int `db:"unconverted_ingredients"`
+}
+
+// consumedFoodsCTE expands a user's diary between two dates into one row per
+// food eaten, scaled by servings. Meal entries are broken down through
+// MEAL_INGREDIENTS (quantities are converted to the food's serving unit and
+// make MEALS.servings servings), so a meal adds up to the same nutrition as
+// mealNutrition gives the diary; an ingredient whose unit cannot be converted
+// becomes an empty row flagged unconverted. Meals without ingredients fall
+// back to the per-serving MEALS totals, and quick-add entries only contribute
+// calories. Servings are in the food's serving unit for food rows.
+// Micronutrients are NULL when unknown, which has_micronutrients records.
+// Planned meals from the meal plan generator are left out.
+var consumedFoodsCTE = `
+		WITH consumed AS (
+			SELECT um.date, um.meal_number, f.id AS food_id, f.category::text AS category,
+			       um.servings / m.servings * mi.servings AS servings,
+			       um.servings / m.servings * mi.servings * f.calories AS calories,
+			       um.servings / m.servings * mi.servings * f.protein_grams AS protein_grams,
+			       um.servings / m.servings * mi.servings * f.carbs_grams AS carbs_grams,
+			       um.servings / m.servings * mi.servings * f.fat_grams AS fat_grams,
+			       f.is_non_inflammatory, f.is_probiotic, f.is_prebiotic,
+			       um.servings / m.servings * mi.servings * f.fiber_grams AS fiber_grams,
+			       um.servings / m.servings * mi.servings * f.sugar_grams AS sugar_grams,
+			       um.servings / m.servings * mi.servings * f.sodium_mg AS sodium_mg,
+			       um.servings / m.servings * mi.servings * f.potassium_mg AS potassium_mg,
+			       um.servings / m.servings * mi.servings * f.iron_mg AS iron_mg,
+			       um.servings / m.servings * mi.servings * f.calcium_mg AS calcium_mg,
+			       um.servings / m.servings * mi.servings * f.vitamin_d_mcg AS vitamin_d_mcg,
+			       um.servings / m.servings * mi.servings * f.omega3_grams AS omega3_grams,
+			       num_nulls(f.fiber_grams, f.sugar_grams, f.sodium_mg, f.potassium_mg,
+			                 f.iron_mg, f.calcium_mg, f.vitamin_d_mcg, f.omega3_grams) = 0 AS has_micronutrients,
+			       false AS unconverted
+			FROM USER_MEALS um
+			JOIN MEALS m ON m.id = um.meal_id
+			JOIN ` + ingredientServings + ` mi ON mi.meal_id = um.meal_id
+			JOIN FOOD_CATALOG f ON f.id = mi.food_id
+			WHERE um.user_id = $1 AND um.date BETWEEN $2 AND $3 AND NOT um.is_planned
+			  AND mi.servings IS NOT NULL
+
+			UNION ALL
+
+			SELECT um.date, um.meal_number, NULL, NULL, NULL, NULL, NULL, NULL, NULL, false, false, false,
+			       NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, false, true
+			FROM USER_MEALS um
+			JOIN ` + ingredientServings + ` mi ON mi.meal_id = um.meal_id
+			WHERE um.user_id = $1 AND um.date BETWEEN $2 AND $3 AND NOT um.is_planned
+			  AND mi.servings IS NULL
+
+			UNION ALL
+
+			SELECT um.date, um.meal_number, NULL, NULL, um.servings,
+			       um.servings * COALESCE(mn.calories, 0),
+			       um.servings * COALESCE(mn.protein_grams, 0),
+			       um.servings * COALESCE(mn.carbs_grams, 0),
+			       um.servings * COALESCE(mn.fat_grams, 0),
+			       false, false, false,
+			       NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, false, false
+			FROM USER_MEALS um
+			JOIN MEALS m ON m.id = um.meal_id` + mealNutrition + `
+			WHERE um.user_id = $1 AND um.date BETWEEN $2 AND $3 AND NOT um.is_planned
+			  AND NOT EXISTS (SELECT 1 FROM MEAL_INGREDIENTS mi WHERE mi.meal_id = um.meal_id)
+
+			UNION ALL
+
+			SELECT um.date, um.meal_number, f.id, f.category::text, um.servings,
+			       um.servings * f.calories,
+			       um.servings * f.protein_grams,
+			       um.servings * f.carbs_grams,
+			       um.servings * f.fat_grams,
+			       f.is_non_inflammatory, f.is_probiotic, f.is_prebiotic,
+			       um.servings * f.fiber_grams,
+			       um.servings * f.sugar_grams,
+			       um.servings * f.sodium_mg,
+			       um.servings * f.potassium_mg,
+			       um.servings * f.iron_mg,
+			       um.servings * f.calcium_mg,
+			       um.servings * f.vitamin_d_mcg,
+			       um.servings * f.omega3_grams,
+			       num_nulls(f.fiber_grams, f.sugar_grams, f.sodium_mg, f.potassium_mg,
+			                 f.iron_mg, f.calcium_mg, f.vitamin_d_mcg, f.omega3_grams) = 0,
+			       false
+			FROM USER_MEALS um
+			JOIN FOOD_CATALOG f ON f.id = um.food_id
+			WHERE um.user_id = $1 AND um.date BETWEEN $2 AND $3 AND NOT um.is_planned
+
+			UNION ALL
+
+			SELECT um.date, um.meal_number, NULL, NULL, NULL, um.quick_calories, 0, 0, 0, false, false, false,
+			       NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, false, false
+			FROM USER_MEALS um
+			WHERE um.user_id = $1 AND um.date BETWEEN $2 AND $3 AND NOT um.is_planned
+			  AND um.quick_calories IS NOT NULL
+		)`
+
+// NutritionByPeriod aggregates a user's diary between two dates (inclusive)
+// into day, week (ISO, Monday start) or month buckets. Only buckets with
+// logged entries are returned.
+func (r *Repository) NutritionByPeriod(userID int, start, end time.Time, granularity string) ([]NutritionPeriod, error) {
+	periods := []NutritionPeriod{}
+	query := consumedFoodsCTE + `
+		SELECT date_trunc($4, date)::date AS period_start,
+		       COUNT(DISTINCT date) AS days_logged,
+		       COALESCE(SUM(calories), 0) AS calories,
+		       COALESCE(SUM(protein_grams), 0) AS protein_grams,
+		       COALESCE(SUM(carbs_grams), 0) AS carbs_grams,
+		       COALESCE(SUM(fat_grams), 0) AS fat_grams,
+		       COUNT(DISTINCT food_id) FILTER (WHERE is_non_inflammatory) AS non_inflammatory_foods,
+		       COUNT(DISTINCT food_id) FILTER (WHERE is_probiotic) AS probiotic_foods,
+		       COUNT(DISTINCT food_id) FILTER (WHERE is_prebiotic) AS prebiotic_foods,
+		       COALESCE(SUM(fiber_grams), 0) AS fiber_grams,
+		       COALESCE(SUM(sugar_grams), 0) AS sugar_grams,
+		       COALESCE(SUM(sodium_mg), 0) AS sodium_mg,
+		       COALESCE(SUM(potassium_mg), 0) AS potassium_mg,
+		       COALESCE(SUM(iron_mg), 0) AS iron_mg,
+		       COALESCE(SUM(calcium_mg), 0) AS calcium_mg,
+		       COALESCE(SUM(vitamin_d_mcg), 0) AS vitamin_d_mcg,
+		       COALESCE(SUM(omega3_grams), 0) AS omega3_grams,
+		       COUNT(*) FILTER (WHERE NOT has_micronutrients AND NOT unconverted) AS untracked_items,
+		       COUNT(*) FILTER (WHERE unconverted) AS unconverted_ingredients
+		FROM consumed
+		GROUP BY 1
+		ORDER BY 1`
+
+	err := r.db.Select(&periods, query, userID, start, end, granularity)
+	if err != nil {
+		return nil, err
+	}
+
+	return periods, nil
+}
+
+// ConsumedFood is a catalog food eaten in one meal, scaled by servings, or
+// an ingredient left out because its unit cannot be converted
+type ConsumedFood struct {
+	Date              time.Time `db:"date"`
+	MealNumber        int       `db:"meal_number"`
+	Category          string    `db:"category"`
+	Servings          float64   `db:"servings"`
+	Calories          float64   `db:"calories"`
+	IsNonInflammatory bool      `db:"is_non_inflammatory"`
+	IsProbiotic       bool      `db:"is_probiotic"`
+	IsPrebiotic       bool      `db:"is_prebiotic"`
+	Unconverted       bool      `db:"unconverted"`
+}
+
+// ListConsumedFoods returns the catalog foods a user ate between two dates
+// (inclusive), ordered by date and meal. Meals without ingredients and
+// quick-add calories are left out since their foods are unknown; ingredients
+// that cannot be converted come back flagged Unconverted with no food.
+func (r *Repository) ListConsumedFoods(userID int, start, end time.Time) ([]ConsumedFood, error) {
+	foods := []ConsumedFood{}
+	query := consumedFoodsCTE + `
+		SELECT date, meal_number, COALESCE(category, '') AS category,
+		       COALESCE(servings, 0) AS servings, COALESCE(calories, 0) AS calories,
+		       is_non_inflammatory, is_probiotic, is_prebiotic, unconverted
+		FROM consumed
+		WHERE food_id IS NOT NULL OR unconverted
+		ORDER BY date, meal_number`
+
+	err := r.db.Select(&foods, query, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return foods, nil
+}
@@ -0,0 +1,68 @@
+package meals
+
+import (
+	"time"
+)
+
+// ShoppingLine is the amount of a food in one unit needed by planned meals
+type ShoppingLine struct {
+	FoodID   int     `db:"food_id"`
+	Name     string  `db:"name"`
+	Category string  `db:"category"`
+	Quantity float64 `db:"quantity"`
+	Unit     string  `db:"unit"`
+}
+
+// ListShoppingLines adds up the foods of a user's planned meals between two
+// dates (inclusive) per food and unit. Meals contribute their MEAL_INGREDIENTS
+// per serving of the recipe and single-food entries their servings, both
+// multiplied by the planned servings.
+func (r *Repository) ListShoppingLines(userID int, start, end time.Time) ([]ShoppingLine, error) {
+	lines := []ShoppingLine{}
+	query := `
+		WITH needed AS (
+			SELECT mi.food_id, mi.unit::text AS unit, mi.quantity * um.servings / m.servings AS quantity
+			FROM USER_MEALS um
+			JOIN MEALS m ON m.id = um.meal_id
+			JOIN MEAL_INGREDIENTS mi ON mi.meal_id = um.meal_id
+			WHERE um.user_id = $1 AND um.is_planned AND um.date BETWEEN $2 AND $3
+			UNION ALL
+			SELECT um.food_id, f.serving_units::text AS unit, um.servings AS quantity
+			FROM USER_MEALS um
+			JOIN FOOD_CATALOG f ON f.id = um.food_id
+			WHERE um.user_id = $1 AND um.is_planned AND um.date BETWEEN $2 AND $3
+		)
+		SELECT f.id AS food_id, f.food_name AS name, f.category::text AS category,
+		       SUM(n.quantity) AS quantity, n.unit
+		FROM needed n
+		JOIN FOOD_CATALOG f ON f.id = n.food_id
+		GROUP BY f.id, f.food_name, f.category, n.unit
+		ORDER BY f.food_name, n.unit`
+
+	err := r.db.Select(&lines, query, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
+
+// ListPlannedMealsWithoutIngredients returns the names of planned meals between
+// two dates (inclusive) that have no MEAL_INGREDIENTS to shop for
+func (r *Repository) ListPlannedMealsWithoutIngredients(userID int, start, end time.Time) ([]string, error) {
+	names := []string{}
+	query := `
+		SELECT DISTINCT m.name
+		FROM USER_MEALS um
+		JOIN MEALS m ON m.id = um.meal_id
+		WHERE um.user_id = $1 AND um.is_planned AND um.date BETWEEN $2 AND $3
+		  AND NOT EXISTS (SELECT 1 FROM MEAL_INGREDIENTS mi WHERE mi.meal_id = um.meal_id)
+		ORDER BY m.name`
+
+	err := r.db.Select(&names, query, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return names, nil
+}
@@ -0,0 +1,206 @@
+package meals
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// GetFood retrieves a catalog food visible to the user with the user's like
+func (r *Repository) GetFood(userID, foodID int) (*Food, error) {
+	var food Food
+	query := foodSelect + `
+		LEFT JOIN FOOD_USER_LIKES l ON l.food_id = f.id AND l.user_id = $1
+		WHERE ` + visibleFood + ` AND f.id = $2`
+
+	err := r.db.Get(&food, query, userID, foodID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("food not found")
+		}
+		return nil, err
+	}
+
+	return &food, nil
+}
+
+// GetMealEntry retrieves a planned or logged USER_MEALS entry owned by the user
+func (r *Repository) GetMealEntry(userID, id int) (*DiaryEntry, error) {
+	var entry DiaryEntry
+	query := diaryEntrySelect + `
+		WHERE um.id = $1 AND um.user_id = $2`
+
+	err := r.db.Get(&entry, query, id, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("meal entry not found")
+		}
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+// SwapMealFood replaces the food of a single-food entry owned by the user
+func (r *Repository) SwapMealFood(userID, id, foodID int, servings float64) error {
+	query := `
+		UPDATE USER_MEALS
+		SET food_id = $1, servings = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $3 AND user_id = $4 AND food_id IS NOT NULL`
+
+	result, err := r.db.Exec(query, foodID, servings, id, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("meal entry not found")
+	}
+
+	return nil
+}
+
+// GetMealIngredient retrieves an ingredient of a meal
+func (r *Repository) GetMealIngredient(mealID, id int) (*MealIngredient, error) {
+	var ingredient MealIngredient
+	query := `
+		SELECT mi.id, mi.food_id, f.food_name AS name, mi.quantity, mi.unit::text AS unit, mi.notes
+		FROM MEAL_INGREDIENTS mi
+		JOIN FOOD_CATALOG f ON f.id = mi.food_id
+		WHERE mi.id = $1 AND mi.meal_id = $2`
+
+	err := r.db.Get(&ingredient, query, id, mealID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("meal ingredient not found")
+		}
+		return nil, err
+	}
+
+	return &ingredient, nil
+}
+
+// SwapMealIngredient replaces an ingredient of the meal logged by an entry
+// owned by the user with quantity of another food in unit, then recomputes
+// the meal's stored totals from its ingredients. A meal that other entries or
+// favorites also use is copied first and the entry moved to the copy, so only
+// this entry changes. It returns the ID of the meal the entry now logs.
+func (r *Repository) SwapMealIngredient(userID, entryID, mealID, ingredientID, foodID int, quantity float64, unit string) (int, error) {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var shared bool
+	err = tx.Get(&shared, `
+		SELECT EXISTS (SELECT 1 FROM USER_MEALS WHERE meal_id = $1 AND id <> $2)
+		    OR EXISTS (SELECT 1 FROM USER_FAVORITES WHERE meal_id = $1)`,
+		mealID, entryID)
+	if err != nil {
+		return 0, err
+	}
+
+	if shared {
+		var copyID int
+		err = tx.Get(&copyID, `
+			INSERT INTO MEALS (name, description, servings, total_calories, total_protein, total_carbs,
+			                   total_fat, prep_time, prep_instructions, prep_instructions_format,
+			                   created_at, updated_at)
+			SELECT name, description, servings, total_calories, total_protein, total_carbs,
+			       total_fat, prep_time, prep_instructions, prep_instructions_format,
+			       CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+			FROM MEALS
+			WHERE id = $1
+			RETURNING id`,
+			mealID)
+		if err != nil {
+			return 0, err
+		}
+
+		// Copy the ingredients in order, swapping the replaced one on the way
+		_, err = tx.Exec(`
+			INSERT INTO MEAL_INGREDIENTS (meal_id, food_id, quantity, unit, notes, created_at)
+			SELECT $1,
+			       CASE WHEN mi.id = $3 THEN $4 ELSE mi.food_id END,
+			       CASE WHEN mi.id = $3 THEN $5 ELSE mi.quantity END,
+			       CASE WHEN mi.id = $3 THEN $6::serving_unit_type ELSE mi.unit END,
+			       mi.notes, CURRENT_TIMESTAMP
+			FROM MEAL_INGREDIENTS mi
+			WHERE mi.meal_id = $2
+			ORDER BY mi.id`,
+			copyID, mealID, ingredientID, foodID, quantity, unit)
+		if err != nil {
+			return 0, err
+		}
+
+		result, err := tx.Exec(`
+			UPDATE USER_MEALS
+			SET meal_id = $1, updated_at = CURRENT_TIMESTAMP
+			WHERE id = $2 AND user_id = $3`,
+			copyID, entryID, userID)
+		if err != nil {
+			return 0, err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+		if rowsAffected == 0 {
+			return 0, fmt.Errorf("meal entry not found")
+		}
+		mealID = copyID
+	} else {
+		result, err := tx.Exec(`
+			UPDATE MEAL_INGREDIENTS
+			SET food_id = $1, quantity = $2, unit = $3::serving_unit_type
+			WHERE id = $4 AND meal_id = $5`,
+			foodID, quantity, unit, ingredientID, mealID)
+		if err != nil {
+			return 0, err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+		if rowsAffected == 0 {
+			return 0, fmt.Errorf("meal ingredient not found")
+		}
+	}
+
+	_, err = tx.Exec(`
+		UPDATE MEALS
+		SET total_calories = mn.calories, total_protein = mn.protein_grams,
+		    total_carbs = mn.carbs_grams, total_fat = mn.fat_grams,
+		    updated_at = CURRENT_TIMESTAMP
+		FROM MEALS m`+mealNutrition+`
+		WHERE MEALS.id = m.id AND m.id = $1`,
+		mealID)
+	if err != nil {
+		return 0, err
+	}
+
+	return mealID, tx.Commit()
+}
+
+// ListMealEntries retrieves the entries that share a date, meal number and
+// planned flag with the given entry, i.e. the meal the entry belongs to
+func (r *Repository) ListMealEntries(userID, id int) ([]DiaryEntry, error) {
+	entries := []DiaryEntry{}
+	query := diaryEntrySelect + `
+		JOIN USER_MEALS e ON e.user_id = um.user_id AND e.date = um.date
+		     AND e.meal_number = um.meal_number AND e.is_planned = um.is_planned
+		WHERE e.id = $1 AND e.user_id = $2
+		ORDER BY um.created_at, um.id`
+
+	err := r.db.Select(&entries, query, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,144 @@
+package users
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// CycleLog is a day of a user's menstrual cycle log
+type CycleLog struct {
+	ID            int            `db:"id"`
+	Date          time.Time      `db:"date"`
+	PeriodStarted bool           `db:"period_started"`
+	Symptoms      pq.StringArray `db:"symptoms"`
+	CreatedAt     time.Time      `db:"created_at"`
+	UpdatedAt     time.Time      `db:"updated_at"`
+}
+
+const cycleLogColumns = `id, date, period_started, symptoms::text[] AS symptoms, created_at, updated_at`
+
+// CycleTrackingEnabled reports whether a user opted in to cycle tracking
+func (r *Repository) CycleTrackingEnabled(userID int) (bool, error) {
+	var enabled bool
+	err := r.db.Get(&enabled, `SELECT EXISTS (SELECT 1 FROM CYCLE_TRACKING WHERE user_id = $1)`, userID)
+	if err != nil {
+		return false, err
+	}
+	return enabled, nil
+}
+
+// EnableCycleTracking opts a user in to cycle tracking. Opting in again is a no-op.
+func (r *Repository) EnableCycleTracking(userID int) error {
+	_, err := r.db.Exec(`
+		INSERT INTO CYCLE_TRACKING (user_id) VALUES ($1)
+		ON CONFLICT (user_id) DO NOTHING`, userID)
+	if isForeignKeyViolation(err) {
+		return fmt.Errorf("user not found")
+	}
+	return err
+}
+
+// DisableCycleTracking opts a user out of cycle tracking, deleting every cycle
+// log with it. It reports whether the user had opted in.
+func (r *Repository) DisableCycleTracking(userID int) (bool, error) {
+	result, err := r.db.Exec(`DELETE FROM CYCLE_TRACKING WHERE user_id = $1`, userID)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+// UpsertCycleLog records a day's period start and symptoms, replacing any
+// earlier log for that day
+func (r *Repository) UpsertCycleLog(userID int, date time.Time, periodStarted bool, symptoms []string) (*CycleLog, error) {
+	var entry CycleLog
+	query := `
+		INSERT INTO CYCLE_LOGS (user_id, date, period_started, symptoms)
+		VALUES ($1, $2, $3, $4::cycle_symptom_type[])
+		ON CONFLICT (user_id, date) DO UPDATE
+		SET period_started = EXCLUDED.period_started,
+		    symptoms = EXCLUDED.symptoms,
+		    updated_at = CURRENT_TIMESTAMP
+		RETURNING ` + cycleLogColumns
+
+	err := r.db.Get(&entry, query, userID, date, periodStarted, pq.StringArray(append([]string{}, symptoms...)))
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return nil, fmt.Errorf("cycle tracking is not enabled")
+		}
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+// ListCycleLogs returns a user's cycle logs between two dates (inclusive)
+func (r *Repository) ListCycleLogs(userID int, start, end time.Time) ([]CycleLog, error) {
+	logs := []CycleLog{}
+	query := `SELECT ` + cycleLogColumns + `
+		FROM CYCLE_LOGS
+		WHERE user_id = $1 AND date BETWEEN $2 AND $3
+		ORDER BY date`
+
+	err := r.db.Select(&logs, query, userID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
+
+// DeleteCycleLog deletes a user's cycle log for one day
+func (r *Repository) DeleteCycleLog(userID int, date time.Time) error {
+	result, err := r.db.Exec(`DELETE FROM CYCLE_LOGS WHERE user_id = $1 AND date = $2`, userID, date)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("cycle log not found")
+	}
+
+	return nil
+}
+
+// ListPeriodStarts returns the most recent period starts on or before a date,
+// oldest first
+func (r *Repository) ListPeriodStarts(userID int, onOrBefore time.Time, limit int) ([]time.Time, error) {
+	starts := []time.Time{}
+	query := `
+		SELECT date FROM (
+			SELECT date
+			FROM CYCLE_LOGS
+			WHERE user_id = $1 AND period_started AND date <= $2
+			ORDER BY date DESC
+			LIMIT $3
+		) recent
+		ORDER BY date`
+
+	err := r.db.Select(&starts, query, userID, onOrBefore, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return starts, nil
+}
+
+// isForeignKeyViolation reports whether err is a Postgres foreign key violation
+func isForeignKeyViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23503"
+}
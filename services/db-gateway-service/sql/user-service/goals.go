@@ -0,0 +1,27 @@
+package users
+
+// Goal represents a fitness goal selected by a user
+type Goal struct {
+	ID          int     `db:"id"`
+	Category    string  `db:"category"`
+	Name        string  `db:"name"`
+	Description *string `db:"description"`
+}
+
+// GetUserGoals retrieves the goals a user has selected
+func (r *Repository) GetUserGoals(userID int) ([]Goal, error) {
+	goals := []Goal{}
+	query := `
+		SELECT g.id, g.category, g.name, g.description
+		FROM USER_GOALS ug
+		JOIN GOALS g ON g.id = ug.goal_id
+		WHERE ug.user_id = $1
+		ORDER BY g.category, g.name`
+
+	err := r.db.Select(&goals, query, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return goals, nil
+}
@@ -17,6 +17,10 @@ type User struct {
 	Password      string     `db:"password"`
 	PhoneNumber   *string    `db:"phone_number"`
 	Sex           *string    `db:"sex"`
+	HeightCm      *float64   `db:"height_cm"`
+	WeightKg      *float64   `db:"weight_kg"`
+	BirthDate     *time.Time `db:"birth_date"`
+	ActivityLevel *string    `db:"activity_level"`
 	City          *string    `db:"city"`
 	StateProvince *string    `db:"state_province"`
 	PostalCode    *string    `db:"postal_code"`
@@ -43,14 +47,16 @@ func NewRepository(db *sqlx.DB) *Repository {
 func (r *Repository) CreateUser(user *User) error {
 	query := `
 		INSERT INTO USERS (full_name, email, password, phone_number, sex, 
-		                  city, state_province, postal_code, country_code, locale, timezone, utc_offset, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		                  city, state_province, postal_code, country_code, locale, timezone, utc_offset,
+		                  height_cm, weight_kg, birth_date, activity_level, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
 		RETURNING id, created_at, updated_at`
 
 	return r.db.QueryRow(
 		query, user.FullName, user.Email, user.Password,
 		user.PhoneNumber, user.Sex, user.City, user.StateProvince,
 		user.PostalCode, user.CountryCode, user.Locale, user.Timezone, user.UtcOffset,
+		user.HeightCm, user.WeightKg, user.BirthDate, user.ActivityLevel,
 	).Scan(&user.ID, &user.CreatedAt, &user.UpdatedAt)
 }
 
@@ -59,7 +65,8 @@ func (r *Repository) GetUserByID(id int) (*User, error) {
 	var user User
 	query := `
 		SELECT id, full_name, email, phone_number, sex, city, 
-		       state_province, postal_code, country_code, locale, timezone, utc_offset, created_at, updated_at 
+		       state_province, postal_code, country_code, locale, timezone, utc_offset,
+		       height_cm, weight_kg, birth_date, activity_level, created_at, updated_at 
 		FROM USERS 
 		WHERE id = $1`
 
@@ -79,7 +86,8 @@ func (r *Repository) GetAllUsers() ([]User, error) {
 	var users []User
 	query := `
 		SELECT id, full_name, email, phone_number, sex, city, 
-		       state_province, postal_code, country_code, locale, timezone, utc_offset, created_at, updated_at 
+		       state_province, postal_code, country_code, locale, timezone, utc_offset,
+		       height_cm, weight_kg, birth_date, activity_level, created_at, updated_at 
 		FROM USERS 
 		ORDER BY created_at DESC`
 
@@ -97,19 +105,22 @@ func (r *Repository) UpdateUser(user *User) error {
 		UPDATE USERS 
 		SET full_name = $1, password = $2, phone_number = $3, sex = $4, 
 		    city = $5, state_province = $6, postal_code = $7, country_code = $8, locale = $9, timezone = $10, 
-		    utc_offset = $11, updated_at = CURRENT_TIMESTAMP
-		WHERE id = $12
+		    utc_offset = $11, height_cm = $12, weight_kg = $13, birth_date = $14, activity_level = $15,
+		    updated_at = CURRENT_TIMESTAMP
+		WHERE id = $16
 		RETURNING id, full_name, email, phone_number, sex, city, 
-		          state_province, postal_code, country_code, locale, timezone, utc_offset, created_at, updated_at`
+		          state_province, postal_code, country_code, locale, timezone, utc_offset,
+		          height_cm, weight_kg, birth_date, activity_level, created_at, updated_at`
 
 	return r.db.QueryRow(
 		query, user.FullName, user.Password, user.PhoneNumber, user.Sex,
 		user.City, user.StateProvince, user.PostalCode, user.CountryCode, user.Locale, user.Timezone, 
-		user.UtcOffset, user.ID,
+		user.UtcOffset, user.HeightCm, user.WeightKg, user.BirthDate, user.ActivityLevel, user.ID,
 	).Scan(
 		&user.ID, &user.FullName, &user.Email, &user.PhoneNumber, &user.Sex,
 		&user.City, &user.StateProvince, &user.PostalCode,
 		&user.CountryCode, &user.Locale, &user.Timezone, &user.UtcOffset, 
+		&user.HeightCm, &user.WeightKg, &user.BirthDate, &user.ActivityLevel,
 		&user.CreatedAt, &user.UpdatedAt,
 	)
 }
@@ -139,7 +150,8 @@ func (r *Repository) VerifyUser(email, password string) (*User, error) {
 	var user User
 	query := `
 		SELECT id, full_name, email, password, phone_number, sex, city, 
-		       state_province, postal_code, country_code, locale, timezone, utc_offset, created_at, updated_at 
+		       state_province, postal_code, country_code, locale, timezone, utc_offset,
+		       height_cm, weight_kg, birth_date, activity_level, created_at, updated_at 
 		FROM USERS 
 		WHERE email = $1`
 
@@ -197,7 +209,8 @@ func (r *Repository) UpdateUserPartial(id int, updates map[string]interface{}) (
 	query := fmt.Sprintf(`
 		UPDATE USERS SET %s WHERE id = $%d
 		RETURNING id, full_name, email, phone_number, sex, city, 
-		          state_province, postal_code, country_code, locale, timezone, utc_offset, created_at, updated_at`,
+		          state_province, postal_code, country_code, locale, timezone, utc_offset,
+		          height_cm, weight_kg, birth_date, activity_level, created_at, updated_at`,
 		strings.Join(setParts, ", "), argIndex)
 
 	var user User
@@ -205,6 +218,7 @@ func (r *Repository) UpdateUserPartial(id int, updates map[string]interface{}) (
 		&user.ID, &user.FullName, &user.Email, &user.PhoneNumber, &user.Sex,
 		&user.City, &user.StateProvince, &user.PostalCode,
 		&user.CountryCode, &user.Locale, &user.Timezone, &user.UtcOffset, 
+		&user.HeightCm, &user.WeightKg, &user.BirthDate, &user.ActivityLevel,
 		&user.CreatedAt, &user.UpdatedAt,
 	)
 
@@ -0,0 +1,47 @@
+package workouts
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// CaloriesBurned is the energy a user burned in workouts during one reporting period
+type CaloriesBurned struct {
+	PeriodStart time.Time `db:"period_start"`
+	Calories    float64   `db:"calories"`
+}
+
+// Repository handles workout database operations
+type Repository struct {
+	db *sqlx.DB
+}
+
+// NewRepository creates a new workout repository
+func NewRepository(db *sqlx.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// CaloriesBurnedByPeriod sums the calories burned in workouts started between
+// two dates (inclusive) into day, week (ISO, Monday start) or month buckets.
+// started_at is stored in UTC and converted to the given IANA timezone so
+// days line up with diary dates. Workouts without calories are left out.
+func (r *Repository) CaloriesBurnedByPeriod(userID int, timezone string, start, end time.Time, granularity string) ([]CaloriesBurned, error) {
+	burned := []CaloriesBurned{}
+	query := `
+		SELECT date_trunc($5, (started_at AT TIME ZONE 'UTC' AT TIME ZONE $2)::date)::date AS period_start,
+		       SUM(calories_burned) AS calories
+		FROM WORKOUT_SESSIONS
+		WHERE user_id = $1
+		  AND calories_burned IS NOT NULL
+		  AND (started_at AT TIME ZONE 'UTC' AT TIME ZONE $2)::date BETWEEN $3 AND $4
+		GROUP BY 1
+		ORDER BY 1`
+
+	err := r.db.Select(&burned, query, userID, timezone, start, end, granularity)
+	if err != nil {
+		return nil, err
+	}
+
+	return burned, nil
+}
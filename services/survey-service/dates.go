@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// dateLayout is the calendar date format used in requests and responses
+const dateLayout = "2006-01-02"
+
+// now returns the current time; tests replace it to pin "today"
+var now = time.Now
+
+// parseDate parses a YYYY-MM-DD calendar date
+func parseDate(date string) (time.Time, error) {
+	parsed, err := time.Parse(dateLayout, date)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q: expected YYYY-MM-DD", date)
+	}
+	return parsed, nil
+}
+
+// userToday returns the current calendar date in the user's timezone,
+// falling back to UTC when the timezone is unset or unknown
+func userToday(q sqlx.Queryer, userID int) (time.Time, error) {
+	loc, err := userLocation(q, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return localToday(loc), nil
+}
+
+// userLocation returns the user's timezone, falling back to UTC when it is
+// unset or unknown
+func userLocation(q sqlx.Queryer, userID int) (*time.Location, error) {
+	var timezone string
+	err := sqlx.Get(q, &timezone, `SELECT COALESCE(timezone, '') FROM USERS WHERE id = $1`, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil || timezone == "" {
+		loc = time.UTC
+	}
+	return loc, nil
+}
+
+// localToday returns the current calendar date in loc
+func localToday(loc *time.Location) time.Time {
+	local := now().In(loc)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+}
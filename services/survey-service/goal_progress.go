@@ -0,0 +1,109 @@
+package main
+
+import (
+	"math"
+	"time"
+)
+
+// goalTarget is the quantified part of a USER_GOALS row
+type goalTarget struct {
+	BaselineValue float64
+	TargetValue   float64
+	BaselineDate  time.Time
+	TargetDate    *time.Time
+}
+
+// goalMeasurement is a single logged value
+type goalMeasurement struct {
+	Date  time.Time
+	Value float64
+}
+
+// goalProgress is the computed state of a quantified goal
+type goalProgress struct {
+	CurrentValue        float64
+	ProgressPercent     float64
+	Remaining           float64
+	Achieved            bool
+	RatePerWeek         *float64
+	ProjectedDate       *time.Time
+	RequiredRatePerWeek *float64
+	OnTrack             *bool
+}
+
+// computeGoalProgress measures how far the latest check-in has moved from the
+// baseline toward the target and projects completion from the trend.
+// Check-ins must be sorted by date.
+func computeGoalProgress(target goalTarget, checkIns []goalMeasurement, today time.Time) goalProgress {
+	progress := goalProgress{CurrentValue: target.BaselineValue}
+	lastDate := target.BaselineDate
+	if len(checkIns) > 0 {
+		latest := checkIns[len(checkIns)-1]
+		progress.CurrentValue = latest.Value
+		lastDate = latest.Date
+	}
+
+	// direction is +1 when the value should go up and -1 when it should go down
+	distance := target.TargetValue - target.BaselineValue
+	direction := math.Copysign(1, distance)
+
+	covered := (progress.CurrentValue - target.BaselineValue) / distance
+	progress.ProgressPercent = round2(math.Max(0, math.Min(1, covered)) * 100)
+	progress.Remaining = round2(math.Max(0, (target.TargetValue-progress.CurrentValue)*direction))
+	progress.Achieved = progress.Remaining == 0
+
+	// Trend through the baseline and every check-in
+	points := append([]goalMeasurement{{Date: target.BaselineDate, Value: target.BaselineValue}}, checkIns...)
+	if slope, ok := trendPerDay(points, target.BaselineDate); ok {
+		rate := round2(slope * 7)
+		progress.RatePerWeek = &rate
+
+		if !progress.Achieved && slope*direction > 0 {
+			days := math.Ceil((target.TargetValue - progress.CurrentValue) / slope)
+			projected := lastDate.AddDate(0, 0, int(days))
+			progress.ProjectedDate = &projected
+		}
+	}
+
+	if target.TargetDate != nil {
+		onTrack := progress.Achieved ||
+			(progress.ProjectedDate != nil && !progress.ProjectedDate.After(*target.TargetDate))
+		progress.OnTrack = &onTrack
+
+		if daysLeft := target.TargetDate.Sub(today).Hours() / 24; !progress.Achieved && daysLeft > 0 {
+			required := round2((target.TargetValue - progress.CurrentValue) / daysLeft * 7)
+			progress.RequiredRatePerWeek = &required
+		}
+	}
+
+	return progress
+}
+
+// trendPerDay fits a least-squares line through the points and returns its
+// slope in units per day. It needs at least two distinct dates.
+func trendPerDay(points []goalMeasurement, origin time.Time) (float64, bool) {
+	if len(points) < 2 {
+		return 0, false
+	}
+
+	var sumX, sumY, sumXY, sumXX float64
+	for _, p := range points {
+		x := p.Date.Sub(origin).Hours() / 24
+		sumX += x
+		sumY += p.Value
+		sumXY += x * p.Value
+		sumXX += x * x
+	}
+
+	n := float64(len(points))
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return 0, false
+	}
+
+	return (n*sumXY - sumX*sumY) / denominator, true
+}
+
+func round2(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func day(date string) time.Time {
+	parsed, err := parseDate(date)
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+func dayPtr(date string) *time.Time {
+	parsed := day(date)
+	return &parsed
+}
+
+func TestComputeGoalProgress(t *testing.T) {
+	// Lose 5 kg from 80 kg by March 1st
+	loseWeight := goalTarget{
+		BaselineValue: 80,
+		TargetValue:   75,
+		BaselineDate:  day("2025-01-01"),
+		TargetDate:    dayPtr("2025-03-01"),
+	}
+
+	tests := []struct {
+		name          string
+		target        goalTarget
+		checkIns      []goalMeasurement
+		today         time.Time
+		wantCurrent   float64
+		wantPercent   float64
+		wantRemaining float64
+		wantAchieved  bool
+		wantRate      *float64
+		wantProjected string
+		wantRequired  *float64
+		wantOnTrack   *bool
+	}{
+		{
+			name:          "no check-ins",
+			target:        loseWeight,
+			today:         day("2025-01-01"),
+			wantCurrent:   80,
+			wantPercent:   0,
+			wantRemaining: 5,
+			wantRequired:  floatPtr(-0.59),
+			wantOnTrack:   boolPtr(false),
+		},
+		{
+			name:   "losing 0.5 kg a week",
+			target: loseWeight,
+			checkIns: []goalMeasurement{
+				{Date: day("2025-01-08"), Value: 79.5},
+				{Date: day("2025-01-15"), Value: 79},
+			},
+			today:         day("2025-01-15"),
+			wantCurrent:   79,
+			wantPercent:   20,
+			wantRemaining: 4,
+			wantRate:      floatPtr(-0.5),
+			wantProjected: "2025-03-12",
+			wantRequired:  floatPtr(-0.62),
+			wantOnTrack:   boolPtr(false),
+		},
+		{
+			name:   "losing 1 kg a week",
+			target: loseWeight,
+			checkIns: []goalMeasurement{
+				{Date: day("2025-01-08"), Value: 79},
+				{Date: day("2025-01-15"), Value: 78},
+			},
+			today:         day("2025-01-15"),
+			wantCurrent:   78,
+			wantPercent:   40,
+			wantRemaining: 3,
+			wantRate:      floatPtr(-1),
+			wantProjected: "2025-02-05",
+			wantRequired:  floatPtr(-0.47),
+			wantOnTrack:   boolPtr(true),
+		},
+		{
+			name:   "moving away from target",
+			target: loseWeight,
+			checkIns: []goalMeasurement{
+				{Date: day("2025-01-08"), Value: 81},
+			},
+			today:         day("2025-01-08"),
+			wantCurrent:   81,
+			wantPercent:   0,
+			wantRemaining: 6,
+			wantRate:      floatPtr(1),
+			wantRequired:  floatPtr(-0.81),
+			wantOnTrack:   boolPtr(false),
+		},
+		{
+			name:   "target passed",
+			target: loseWeight,
+			checkIns: []goalMeasurement{
+				{Date: day("2025-02-01"), Value: 74.5},
+			},
+			today:         day("2025-02-01"),
+			wantCurrent:   74.5,
+			wantPercent:   100,
+			wantRemaining: 0,
+			wantAchieved:  true,
+			wantRate:      floatPtr(-1.24),
+			wantOnTrack:   boolPtr(true),
+		},
+		{
+			// Run 10k in 50 minutes, down from 56, with no deadline
+			name: "no deadline",
+			target: goalTarget{
+				BaselineValue: 56,
+				TargetValue:   50,
+				BaselineDate:  day("2025-01-01"),
+			},
+			checkIns: []goalMeasurement{
+				{Date: day("2025-01-29"), Value: 54},
+			},
+			today:         day("2025-01-29"),
+			wantCurrent:   54,
+			wantPercent:   33.33,
+			wantRemaining: 4,
+			wantRate:      floatPtr(-0.5),
+			wantProjected: "2025-03-26",
+		},
+		{
+			name: "increasing target",
+			target: goalTarget{
+				BaselineValue: 100,
+				TargetValue:   120,
+				BaselineDate:  day("2025-01-01"),
+			},
+			checkIns: []goalMeasurement{
+				{Date: day("2025-01-15"), Value: 105},
+			},
+			today:         day("2025-01-15"),
+			wantCurrent:   105,
+			wantPercent:   25,
+			wantRemaining: 15,
+			wantRate:      floatPtr(2.5),
+			wantProjected: "2025-02-26",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			progress := computeGoalProgress(tt.target, tt.checkIns, tt.today)
+
+			assert.Equal(t, tt.wantCurrent, progress.CurrentValue)
+			assert.Equal(t, tt.wantPercent, progress.ProgressPercent)
+			assert.Equal(t, tt.wantRemaining, progress.Remaining)
+			assert.Equal(t, tt.wantAchieved, progress.Achieved)
+			assert.Equal(t, tt.wantRate, progress.RatePerWeek)
+			assert.Equal(t, tt.wantRequired, progress.RequiredRatePerWeek)
+			assert.Equal(t, tt.wantOnTrack, progress.OnTrack)
+			if tt.wantProjected == "" {
+				assert.Nil(t, progress.ProjectedDate)
+			} else {
+				require.NotNil(t, progress.ProjectedDate)
+				assert.Equal(t, tt.wantProjected, progress.ProjectedDate.Format(dateLayout))
+			}
+		})
+	}
+}
+
+func floatPtr(v float64) *float64 { return &v }
+
+func boolPtr(v bool) *bool { return &v }
@@ -0,0 +1,68 @@
+package main
+
+import "fmt"
+
+// Violation codes returned when a goal assignment is rejected
+const violationGoalConflict = "GOAL_CONFLICT"
+
+// GoalConflictRule is a row of GOAL_CONFLICTS. Rules are symmetric: a rule
+// between A and B blocks selecting B while A is selected and vice versa.
+type GoalConflictRule struct {
+	GoalID            int    `db:"goal_id"`
+	ConflictingGoalID int    `db:"conflicting_goal_id"`
+	Reason            string `db:"reason"`
+}
+
+// GoalRef identifies a goal in a violation
+type GoalRef struct {
+	ID       int    `json:"id"`
+	Category string `json:"category"`
+	Name     string `json:"name"`
+}
+
+// Violation describes a field that failed validation
+type Violation struct {
+	Field           string   `json:"field"`
+	Code            string   `json:"code"`
+	Message         string   `json:"message"`
+	ConflictingGoal *GoalRef `json:"conflictingGoal,omitempty"`
+}
+
+// checkGoalConflicts evaluates the conflict rules for adding candidate to a
+// user's selected goals and returns one violation per conflicting goal
+func checkGoalConflicts(candidate Goal, selected []Goal, rules []GoalConflictRule) []Violation {
+	selectedByID := make(map[int]Goal, len(selected))
+	for _, g := range selected {
+		selectedByID[g.ID] = g
+	}
+
+	violations := []Violation{}
+	reported := map[int]bool{}
+	for _, rule := range rules {
+		var otherID int
+		switch candidate.ID {
+		case rule.GoalID:
+			otherID = rule.ConflictingGoalID
+		case rule.ConflictingGoalID:
+			otherID = rule.GoalID
+		default:
+			continue
+		}
+
+		other, ok := selectedByID[otherID]
+		if !ok || reported[otherID] {
+			continue
+		}
+		reported[otherID] = true
+
+		violations = append(violations, Violation{
+			Field: "goalId",
+			Code:  violationGoalConflict,
+			Message: fmt.Sprintf("%s %s conflicts with your selected goal %s %s: %s",
+				candidate.Category, candidate.Name, other.Category, other.Name, rule.Reason),
+			ConflictingGoal: &GoalRef{ID: other.ID, Category: other.Category, Name: other.Name},
+		})
+	}
+
+	return violations
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckGoalConflicts(t *testing.T) {
+	lose := Goal{ID: 1, Category: "Weight", Name: "Lose"}
+	maintain := Goal{ID: 2, Category: "Weight", Name: "Maintain"}
+	gain := Goal{ID: 3, Category: "Weight", Name: "Gain"}
+	bulk := Goal{ID: 4, Category: "Appearance", Name: "Bulk"}
+	strength := Goal{ID: 7, Category: "Strength", Name: "Gain"}
+
+	rules := []GoalConflictRule{
+		{GoalID: 1, ConflictingGoalID: 3, Reason: "You cannot lose and gain weight at the same time"},
+		{GoalID: 1, ConflictingGoalID: 2, Reason: "Losing weight and maintaining weight are opposite targets"},
+		{GoalID: 1, ConflictingGoalID: 4, Reason: "Bulking requires a calorie surplus"},
+	}
+
+	tests := []struct {
+		name          string
+		candidate     Goal
+		selected      []Goal
+		wantConflicts []int
+	}{
+		{name: "no selections", candidate: lose, wantConflicts: []int{}},
+		{name: "compatible goals", candidate: gain, selected: []Goal{strength, bulk}, wantConflicts: []int{}},
+		{name: "rule matches in forward direction", candidate: lose, selected: []Goal{gain, strength}, wantConflicts: []int{3}},
+		{name: "rule matches in reverse direction", candidate: gain, selected: []Goal{lose}, wantConflicts: []int{1}},
+		{name: "several conflicts", candidate: lose, selected: []Goal{maintain, bulk}, wantConflicts: []int{2, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations := checkGoalConflicts(tt.candidate, tt.selected, rules)
+
+			conflicts := []int{}
+			for _, v := range violations {
+				assert.Equal(t, "goalId", v.Field)
+				assert.Equal(t, violationGoalConflict, v.Code)
+				conflicts = append(conflicts, v.ConflictingGoal.ID)
+			}
+			assert.Equal(t, tt.wantConflicts, conflicts)
+		})
+	}
+}
+
+func TestCheckGoalConflicts_Message(t *testing.T) {
+	violations := checkGoalConflicts(
+		Goal{ID: 3, Category: "Weight", Name: "Gain"},
+		[]Goal{{ID: 1, Category: "Weight", Name: "Lose"}},
+		[]GoalConflictRule{{GoalID: 1, ConflictingGoalID: 3, Reason: "You cannot lose and gain weight at the same time"}},
+	)
+
+	assert.Equal(t, []Violation{{
+		Field:           "goalId",
+		Code:            violationGoalConflict,
+		Message:         "Weight Gain conflicts with your selected goal Weight Lose: You cannot lose and gain weight at the same time",
+		ConflictingGoal: &GoalRef{ID: 1, Category: "Weight", Name: "Lose"},
+	}}, violations)
+}
@@ -0,0 +1,457 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Column limits from the USER_GOALS and GOAL_CHECK_INS tables
+const (
+	maxGoalMetricLength   = 50
+	maxGoalUnitLength     = 20
+	maxCheckInNotesLength = 255
+)
+
+// GoalTargetRequest is the body for quantifying a selected goal
+type GoalTargetRequest struct {
+	Metric        string   `json:"metric"`
+	Unit          string   `json:"unit"`
+	BaselineValue *float64 `json:"baselineValue"`
+	TargetValue   *float64 `json:"targetValue"`
+	BaselineDate  string   `json:"baselineDate"`
+	TargetDate    string   `json:"targetDate"`
+}
+
+// GoalCheckInRequest is the body for logging a measurement against a goal
+type GoalCheckInRequest struct {
+	Value *float64 `json:"value"`
+	Date  string   `json:"date"`
+	Notes *string  `json:"notes"`
+}
+
+// GoalCheckIn is a measurement from the GOAL_CHECK_INS table, or for body
+// metrics a day of the user's CHECK_INS
+type GoalCheckIn struct {
+	ID    int     `db:"id" json:"id"`
+	Date  string  `db:"date" json:"date"`
+	Value float64 `db:"value" json:"value"`
+	Notes *string `db:"notes" json:"notes,omitempty"`
+}
+
+// checkInColumns selects a GoalCheckIn from GOAL_CHECK_INS
+const checkInColumns = `id, to_char(date, 'YYYY-MM-DD') AS date, value, notes`
+
+// GoalProgress reports how far a quantified goal has moved toward its target
+type GoalProgress struct {
+	GoalID              int           `json:"goalId"`
+	Category            string        `json:"category"`
+	Name                string        `json:"name"`
+	Metric              string        `json:"metric"`
+	Unit                string        `json:"unit"`
+	BaselineValue       float64       `json:"baselineValue"`
+	TargetValue         float64       `json:"targetValue"`
+	BaselineDate        string        `json:"baselineDate"`
+	TargetDate          *string       `json:"targetDate,omitempty"`
+	CurrentValue        float64       `json:"currentValue"`
+	ProgressPercent     float64       `json:"progressPercent"`
+	Remaining           float64       `json:"remaining"`
+	Achieved            bool          `json:"achieved"`
+	RatePerWeek         *float64      `json:"ratePerWeek,omitempty"`
+	ProjectedDate       *string       `json:"projectedDate,omitempty"`
+	RequiredRatePerWeek *float64      `json:"requiredRatePerWeek,omitempty"`
+	OnTrack             *bool         `json:"onTrack,omitempty"`
+	CheckIns            []GoalCheckIn `json:"checkIns"`
+}
+
+// quantifiedUserGoal is the USER_GOALS row behind check-ins and progress
+type quantifiedUserGoal struct {
+	ID            int        `db:"id"`
+	GoalID        int        `db:"goal_id"`
+	Category      string     `db:"category"`
+	Name          string     `db:"name"`
+	Metric        *string    `db:"metric"`
+	Unit          *string    `db:"unit"`
+	BaselineValue *float64   `db:"baseline_value"`
+	TargetValue   *float64   `db:"target_value"`
+	BaselineDate  *time.Time `db:"baseline_date"`
+	TargetDate    *time.Time `db:"target_date"`
+}
+
+// bodyMetric is a goal metric that check-in-service already records in
+// CHECK_INS, so its progress is read from there rather than GOAL_CHECK_INS
+type bodyMetric struct {
+	Column string
+	// Units maps each accepted goal unit to the factor converting from Column's unit
+	Units map[string]float64
+}
+
+// bodyMetrics are keyed by goal metric
+var bodyMetrics = map[string]bodyMetric{
+	"body_weight": {Column: "weight_kg", Units: map[string]float64{"kg": 1, "lb": 1 / 0.45359237}},
+	"body_fat":    {Column: "body_fat_percent", Units: map[string]float64{"%": 1}},
+	"waist":       {Column: "waist_cm", Units: map[string]float64{"cm": 1, "in": 1 / 2.54}},
+	"hip":         {Column: "hip_cm", Units: map[string]float64{"cm": 1, "in": 1 / 2.54}},
+}
+
+// unitNames lists the accepted units for error messages
+func (m bodyMetric) unitNames() string {
+	names := make([]string, 0, len(m.Units))
+	for unit := range m.Units {
+		names = append(names, unit)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// validate checks a target request against the USER_GOALS constraints and
+// resolves its dates; baselineDate defaults to today
+func (req *GoalTargetRequest) validate(today time.Time) (baselineDate time.Time, targetDate *time.Time, err error) {
+	req.Metric = strings.TrimSpace(req.Metric)
+	req.Unit = strings.TrimSpace(req.Unit)
+	if req.Metric == "" {
+		return time.Time{}, nil, fmt.Errorf("metric is required")
+	}
+	if len(req.Metric) > maxGoalMetricLength {
+		return time.Time{}, nil, fmt.Errorf("invalid metric: must be at most %d characters", maxGoalMetricLength)
+	}
+	if req.Unit == "" {
+		return time.Time{}, nil, fmt.Errorf("unit is required")
+	}
+	if len(req.Unit) > maxGoalUnitLength {
+		return time.Time{}, nil, fmt.Errorf("invalid unit: must be at most %d characters", maxGoalUnitLength)
+	}
+	if metric, ok := bodyMetrics[req.Metric]; ok {
+		if _, ok := metric.Units[req.Unit]; !ok {
+			return time.Time{}, nil, fmt.Errorf("invalid unit: %s is tracked in %s", req.Metric, metric.unitNames())
+		}
+	}
+	if req.BaselineValue == nil || req.TargetValue == nil {
+		return time.Time{}, nil, fmt.Errorf("baselineValue and targetValue are required")
+	}
+	if *req.BaselineValue == *req.TargetValue {
+		return time.Time{}, nil, fmt.Errorf("invalid targetValue: must differ from baselineValue")
+	}
+
+	baselineDate = today
+	if req.BaselineDate != "" {
+		if baselineDate, err = parseDate(req.BaselineDate); err != nil {
+			return time.Time{}, nil, err
+		}
+		if baselineDate.After(today) {
+			return time.Time{}, nil, fmt.Errorf("invalid baselineDate: cannot be in the future")
+		}
+	}
+	if req.TargetDate != "" {
+		parsed, err := parseDate(req.TargetDate)
+		if err != nil {
+			return time.Time{}, nil, err
+		}
+		if !parsed.After(baselineDate) {
+			return time.Time{}, nil, fmt.Errorf("invalid targetDate: must be after baselineDate")
+		}
+		targetDate = &parsed
+	}
+	return baselineDate, targetDate, nil
+}
+
+func handleSetUserGoalTarget(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := userIDFromRequest(r)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		goalID, err := pathID(r, "goalId")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req GoalTargetRequest
+		if err := decodeJSON(r, &req); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		today, err := userToday(db, userID)
+		if errors.Is(err, sql.ErrNoRows) {
+			writeError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		if err != nil {
+			log.Printf("Failed to get user timezone: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to set goal target")
+			return
+		}
+
+		baselineDate, targetDate, err := req.validate(today)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Earlier check-ins are kept; progress only counts those from the new baseline date
+		var goal UserGoal
+		err = db.Get(&goal, `
+			WITH ug AS (
+				UPDATE USER_GOALS
+				SET metric = $3, unit = $4, baseline_value = $5, target_value = $6,
+				    baseline_date = $7, target_date = $8, updated_at = CURRENT_TIMESTAMP
+				WHERE user_id = $1 AND goal_id = $2
+				RETURNING *
+			)
+			SELECT `+userGoalColumns+`
+			FROM ug
+			JOIN GOALS g ON g.id = ug.goal_id`,
+			userID, goalID, req.Metric, req.Unit, *req.BaselineValue, *req.TargetValue, baselineDate, targetDate)
+		if errors.Is(err, sql.ErrNoRows) {
+			writeError(w, http.StatusNotFound, "goal not selected")
+			return
+		}
+		if err != nil {
+			log.Printf("Failed to set goal target: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to set goal target")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, goal)
+	}
+}
+
+// getQuantifiedUserGoal loads a selected goal with its target, returning the
+// HTTP status and message to report when it cannot be used for progress
+func getQuantifiedUserGoal(db *sqlx.DB, userID, goalID int) (*quantifiedUserGoal, int, string) {
+	var goal quantifiedUserGoal
+	err := db.Get(&goal, `
+		SELECT ug.id, ug.goal_id, g.category, g.name, ug.metric, ug.unit,
+		       ug.baseline_value, ug.target_value, ug.baseline_date, ug.target_date
+		FROM USER_GOALS ug
+		JOIN GOALS g ON g.id = ug.goal_id
+		WHERE ug.user_id = $1 AND ug.goal_id = $2`, userID, goalID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, http.StatusNotFound, "goal not selected"
+	}
+	if err != nil {
+		log.Printf("Failed to get user goal: %v", err)
+		return nil, http.StatusInternalServerError, "Failed to get goal"
+	}
+	if goal.TargetValue == nil {
+		return nil, http.StatusConflict, "goal has no target: set one before tracking progress"
+	}
+	return &goal, http.StatusOK, ""
+}
+
+func handleLogGoalCheckIn(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := userIDFromRequest(r)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		goalID, err := pathID(r, "goalId")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req GoalCheckInRequest
+		if err := decodeJSON(r, &req); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if req.Value == nil {
+			writeError(w, http.StatusBadRequest, "value is required")
+			return
+		}
+		if req.Notes != nil && len(*req.Notes) > maxCheckInNotesLength {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid notes: must be at most %d characters", maxCheckInNotesLength))
+			return
+		}
+
+		goal, status, message := getQuantifiedUserGoal(db, userID, goalID)
+		if goal == nil {
+			writeError(w, status, message)
+			return
+		}
+		// Body metrics have a single store: the user's check-ins
+		if _, ok := bodyMetrics[*goal.Metric]; ok {
+			writeError(w, http.StatusConflict, *goal.Metric+" is tracked from body check-ins: log it with POST /api/check-ins")
+			return
+		}
+
+		today, err := userToday(db, userID)
+		if err != nil {
+			log.Printf("Failed to get user timezone: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to log check-in")
+			return
+		}
+
+		date := today
+		if req.Date != "" {
+			if date, err = parseDate(req.Date); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		if date.After(today) {
+			writeError(w, http.StatusBadRequest, "invalid date: cannot log a check-in in the future")
+			return
+		}
+		if date.Before(*goal.BaselineDate) {
+			writeError(w, http.StatusBadRequest, "invalid date: cannot be before the goal baselineDate")
+			return
+		}
+
+		// One check-in per day; logging the same day again replaces it
+		var checkIn GoalCheckIn
+		err = db.Get(&checkIn, `
+			INSERT INTO GOAL_CHECK_INS (user_goal_id, date, value, notes)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (user_goal_id, date)
+			DO UPDATE SET value = EXCLUDED.value, notes = EXCLUDED.notes, updated_at = CURRENT_TIMESTAMP
+			RETURNING `+checkInColumns, goal.ID, date, *req.Value, req.Notes)
+		if err != nil {
+			log.Printf("Failed to log check-in: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to log check-in")
+			return
+		}
+
+		writeJSON(w, http.StatusCreated, checkIn)
+	}
+}
+
+func handleGetUserGoalProgress(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := userIDFromRequest(r)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		goalID, err := pathID(r, "goalId")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		goal, status, message := getQuantifiedUserGoal(db, userID, goalID)
+		if goal == nil {
+			writeError(w, status, message)
+			return
+		}
+
+		loc, err := userLocation(db, userID)
+		if err != nil {
+			log.Printf("Failed to get user timezone: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to get goal progress")
+			return
+		}
+
+		var checkIns []GoalCheckIn
+		if metric, ok := bodyMetrics[*goal.Metric]; ok {
+			checkIns, err = listBodyCheckIns(db, userID, loc, goal, metric)
+		} else {
+			checkIns = []GoalCheckIn{}
+			err = db.Select(&checkIns, `
+				SELECT `+checkInColumns+`
+				FROM GOAL_CHECK_INS
+				WHERE user_goal_id = $1 AND date >= $2
+				ORDER BY date`, goal.ID, *goal.BaselineDate)
+		}
+		if err != nil {
+			log.Printf("Failed to list check-ins: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to get goal progress")
+			return
+		}
+
+		today := localToday(loc)
+		writeJSON(w, http.StatusOK, buildGoalProgress(goal, checkIns, today))
+	}
+}
+
+// listBodyCheckIns returns one measurement per calendar day from the user's
+// CHECK_INS since the goal baseline, converted to the goal unit. Days with
+// several check-ins are averaged; recorded_at is stored in UTC and converted
+// to the user's timezone so days line up with the goal dates.
+func listBodyCheckIns(db *sqlx.DB, userID int, loc *time.Location, goal *quantifiedUserGoal, metric bodyMetric) ([]GoalCheckIn, error) {
+	checkIns := []GoalCheckIn{}
+	err := db.Select(&checkIns, `
+		SELECT MAX(id) AS id,
+		       to_char((recorded_at AT TIME ZONE 'UTC' AT TIME ZONE $2)::date, 'YYYY-MM-DD') AS date,
+		       AVG(`+metric.Column+`) AS value
+		FROM CHECK_INS
+		WHERE user_id = $1
+		  AND `+metric.Column+` IS NOT NULL
+		  AND (recorded_at AT TIME ZONE 'UTC' AT TIME ZONE $2)::date >= $3
+		GROUP BY 2
+		ORDER BY 2`, userID, loc.String(), *goal.BaselineDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Units are checked when the target is set; anything else is left as recorded
+	factor, ok := metric.Units[*goal.Unit]
+	if !ok {
+		factor = 1
+	}
+	for i := range checkIns {
+		checkIns[i].Value = math.Round(checkIns[i].Value*factor*100) / 100
+	}
+	return checkIns, nil
+}
+
+// buildGoalProgress computes progress for a quantified goal and shapes the response
+func buildGoalProgress(goal *quantifiedUserGoal, checkIns []GoalCheckIn, today time.Time) GoalProgress {
+	target := goalTarget{
+		BaselineValue: *goal.BaselineValue,
+		TargetValue:   *goal.TargetValue,
+		BaselineDate:  *goal.BaselineDate,
+		TargetDate:    goal.TargetDate,
+	}
+	measurements := make([]goalMeasurement, len(checkIns))
+	for i, checkIn := range checkIns {
+		// Dates come from to_char, so they always parse
+		date, _ := parseDate(checkIn.Date)
+		measurements[i] = goalMeasurement{Date: date, Value: checkIn.Value}
+	}
+	computed := computeGoalProgress(target, measurements, today)
+
+	progress := GoalProgress{
+		GoalID:              goal.GoalID,
+		Category:            goal.Category,
+		Name:                goal.Name,
+		Metric:              *goal.Metric,
+		Unit:                *goal.Unit,
+		BaselineValue:       target.BaselineValue,
+		TargetValue:         target.TargetValue,
+		BaselineDate:        target.BaselineDate.Format(dateLayout),
+		CurrentValue:        computed.CurrentValue,
+		ProgressPercent:     computed.ProgressPercent,
+		Remaining:           computed.Remaining,
+		Achieved:            computed.Achieved,
+		RatePerWeek:         computed.RatePerWeek,
+		RequiredRatePerWeek: computed.RequiredRatePerWeek,
+		OnTrack:             computed.OnTrack,
+		CheckIns:            checkIns,
+	}
+	if target.TargetDate != nil {
+		date := target.TargetDate.Format(dateLayout)
+		progress.TargetDate = &date
+	}
+	if computed.ProjectedDate != nil {
+		date := computed.ProjectedDate.Format(dateLayout)
+		progress.ProjectedDate = &date
+	}
+	return progress
+}
@@ -0,0 +1,263 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var quantifiedGoalColumns = []string{"id", "goal_id", "category", "name", "metric", "unit",
+	"baseline_value", "target_value", "baseline_date", "target_date"}
+
+// pinNow fixes the clock used for "today" for the duration of a test
+func pinNow(t *testing.T, at time.Time) {
+	original := now
+	now = func() time.Time { return at }
+	t.Cleanup(func() { now = original })
+}
+
+func expectUserTimezone(mock sqlmock.Sqlmock, userID int, timezone string) {
+	mock.ExpectQuery(`SELECT COALESCE\(timezone, ''\) FROM USERS WHERE id = \$1`).
+		WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows([]string{"timezone"}).AddRow(timezone))
+}
+
+func TestHandleSetUserGoalTarget_Validation(t *testing.T) {
+	pinNow(t, time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name    string
+		body    string
+		wantErr string
+	}{
+		{name: "missing metric", body: `{"unit":"kg","baselineValue":80,"targetValue":75}`, wantErr: "metric is required"},
+		{name: "unit too long", body: `{"metric":"body_weight","unit":"` + strings.Repeat("x", 21) + `","baselineValue":80,"targetValue":75}`, wantErr: "at most 20 characters"},
+		{name: "missing target", body: `{"metric":"body_weight","unit":"kg","baselineValue":80}`, wantErr: "targetValue are required"},
+		{name: "target equals baseline", body: `{"metric":"body_weight","unit":"kg","baselineValue":80,"targetValue":80}`, wantErr: "must differ from baselineValue"},
+		{name: "unit not tracked for body metric", body: `{"metric":"body_weight","unit":"stone","baselineValue":12,"targetValue":11}`, wantErr: "body_weight is tracked in kg, lb"},
+		{name: "bad date", body: `{"metric":"body_weight","unit":"kg","baselineValue":80,"targetValue":75,"targetDate":"03/01/2025"}`, wantErr: "expected YYYY-MM-DD"},
+		{name: "baseline in future", body: `{"metric":"body_weight","unit":"kg","baselineValue":80,"targetValue":75,"baselineDate":"2025-01-16"}`, wantErr: "cannot be in the future"},
+		{name: "deadline before baseline", body: `{"metric":"body_weight","unit":"kg","baselineValue":80,"targetValue":75,"targetDate":"2025-01-15"}`, wantErr: "must be after baselineDate"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := setupTestDB(t)
+			defer db.Close()
+			expectUserTimezone(mock, 7, "")
+
+			req := httptest.NewRequest("PUT", "/users/me/goals/1/target", strings.NewReader(tt.body))
+			req.Header.Set(userIDHeader, "7")
+			rec := serve("/users/me/goals/{goalId}/target", handleSetUserGoalTarget(db), req)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestHandleSetUserGoalTarget_DefaultsBaselineToUserToday(t *testing.T) {
+	// Late evening UTC is already the next day in Auckland
+	pinNow(t, time.Date(2025, 1, 15, 20, 0, 0, 0, time.UTC))
+
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	selectedAt := time.Now()
+	expectUserTimezone(mock, 7, "Pacific/Auckland")
+	mock.ExpectQuery(`UPDATE USER_GOALS`).
+		WithArgs(7, 1, "body_weight", "kg", 80.0, 75.0, day("2025-01-16"), dayPtr("2025-03-01")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "category", "name", "description", "created_at", "updated_at",
+			"selected_at", "metric", "unit", "baseline_value", "target_value", "baseline_date", "target_date"}).
+			AddRow(1, "Weight", "Lose", nil, selectedAt, selectedAt,
+				selectedAt, "body_weight", "kg", "80.00", "75.00", "2025-01-16", "2025-03-01"))
+
+	body := `{"metric":"body_weight","unit":"kg","baselineValue":80,"targetValue":75,"targetDate":"2025-03-01"}`
+	req := httptest.NewRequest("PUT", "/users/me/goals/1/target", strings.NewReader(body))
+	req.Header.Set(userIDHeader, "7")
+	rec := serve("/users/me/goals/{goalId}/target", handleSetUserGoalTarget(db), req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var goal UserGoal
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &goal))
+	assert.Equal(t, 75.0, *goal.TargetValue)
+	assert.Equal(t, "2025-01-16", *goal.BaselineDate)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHandleLogGoalCheckIn(t *testing.T) {
+	pinNow(t, time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name       string
+		body       string
+		goalRows   *sqlmock.Rows
+		wantToday  bool
+		setupMock  func(mock sqlmock.Sqlmock)
+		wantStatus int
+		wantErr    string
+	}{
+		{
+			name: "logs check-in for today",
+			body: `{"value":52.5}`,
+			goalRows: sqlmock.NewRows(quantifiedGoalColumns).
+				AddRow(11, 1, "Endurance", "Run Faster", "10k_time", "min", "55.00", "50.00", day("2025-01-01"), nil),
+			wantToday: true,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`INSERT INTO GOAL_CHECK_INS .+ ON CONFLICT \(user_goal_id, date\)`).
+					WithArgs(11, day("2025-01-15"), 52.5, nil).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "date", "value", "notes"}).
+						AddRow(5, "2025-01-15", "52.50", nil))
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name: "body metric is logged through check-ins",
+			body: `{"value":79.4}`,
+			goalRows: sqlmock.NewRows(quantifiedGoalColumns).
+				AddRow(11, 1, "Weight", "Lose", "body_weight", "kg", "80.00", "75.00", day("2025-01-01"), nil),
+			wantStatus: http.StatusConflict,
+			wantErr:    "log it with POST /api/check-ins",
+		},
+		{
+			name: "future date",
+			body: `{"value":52.5,"date":"2025-01-16"}`,
+			goalRows: sqlmock.NewRows(quantifiedGoalColumns).
+				AddRow(11, 1, "Endurance", "Run Faster", "10k_time", "min", "55.00", "50.00", day("2025-01-01"), nil),
+			wantToday:  true,
+			wantStatus: http.StatusBadRequest,
+			wantErr:    "cannot log a check-in in the future",
+		},
+		{
+			name: "before baseline",
+			body: `{"value":52.5,"date":"2024-12-31"}`,
+			goalRows: sqlmock.NewRows(quantifiedGoalColumns).
+				AddRow(11, 1, "Endurance", "Run Faster", "10k_time", "min", "55.00", "50.00", day("2025-01-01"), nil),
+			wantToday:  true,
+			wantStatus: http.StatusBadRequest,
+			wantErr:    "before the goal baselineDate",
+		},
+		{
+			name: "goal without target",
+			body: `{"value":52.5}`,
+			goalRows: sqlmock.NewRows(quantifiedGoalColumns).
+				AddRow(11, 1, "Endurance", "Run Faster", nil, nil, nil, nil, nil, nil),
+			wantStatus: http.StatusConflict,
+			wantErr:    "goal has no target",
+		},
+		{
+			name:       "goal not selected",
+			body:       `{"value":52.5}`,
+			goalRows:   sqlmock.NewRows(quantifiedGoalColumns),
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "missing value",
+			body:       `{"date":"2025-01-15"}`,
+			wantStatus: http.StatusBadRequest,
+			wantErr:    "value is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := setupTestDB(t)
+			defer db.Close()
+			if tt.goalRows != nil {
+				mock.ExpectQuery(`FROM USER_GOALS ug\s+JOIN GOALS g .+ WHERE ug.user_id = \$1 AND ug.goal_id = \$2`).
+					WithArgs(7, 1).
+					WillReturnRows(tt.goalRows)
+			}
+			if tt.wantToday {
+				expectUserTimezone(mock, 7, "")
+			}
+			if tt.setupMock != nil {
+				tt.setupMock(mock)
+			}
+
+			req := httptest.NewRequest("POST", "/users/me/goals/1/check-ins", strings.NewReader(tt.body))
+			req.Header.Set(userIDHeader, "7")
+			rec := serve("/users/me/goals/{goalId}/check-ins", handleLogGoalCheckIn(db), req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantErr != "" {
+				assert.Contains(t, rec.Body.String(), tt.wantErr)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestHandleGetUserGoalProgress(t *testing.T) {
+	pinNow(t, time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC))
+
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM USER_GOALS ug\s+JOIN GOALS g`).
+		WithArgs(7, 1).
+		WillReturnRows(sqlmock.NewRows(quantifiedGoalColumns).
+			AddRow(11, 1, "Endurance", "Run Faster", "10k_time", "min", "55.00", "50.00", day("2025-01-01"), day("2025-03-01")))
+	expectUserTimezone(mock, 7, "")
+	mock.ExpectQuery(`FROM GOAL_CHECK_INS\s+WHERE user_goal_id = \$1 AND date >= \$2\s+ORDER BY date`).
+		WithArgs(11, day("2025-01-01")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "date", "value", "notes"}).
+			AddRow(4, "2025-01-08", "54.00", nil).
+			AddRow(5, "2025-01-15", "53.00", "after holiday"))
+
+	req := httptest.NewRequest("GET", "/users/me/goals/1/progress", nil)
+	req.Header.Set(userIDHeader, "7")
+	rec := serve("/users/me/goals/{goalId}/progress", handleGetUserGoalProgress(db), req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var progress GoalProgress
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &progress))
+	assert.Equal(t, "10k_time", progress.Metric)
+	assert.Equal(t, "2025-03-01", *progress.TargetDate)
+	assert.Equal(t, 53.0, progress.CurrentValue)
+	assert.Equal(t, 40.0, progress.ProgressPercent)
+	assert.Equal(t, "2025-02-05", *progress.ProjectedDate)
+	assert.True(t, *progress.OnTrack)
+	require.Len(t, progress.CheckIns, 2)
+	assert.Equal(t, "2025-01-08", progress.CheckIns[0].Date)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHandleGetUserGoalProgress_BodyWeightFromCheckIns(t *testing.T) {
+	pinNow(t, time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC))
+
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM USER_GOALS ug\s+JOIN GOALS g`).
+		WithArgs(7, 1).
+		WillReturnRows(sqlmock.NewRows(quantifiedGoalColumns).
+			AddRow(11, 1, "Weight", "Lose", "body_weight", "lb", "180.00", "170.00", day("2025-01-01"), nil))
+	expectUserTimezone(mock, 7, "America/New_York")
+	mock.ExpectQuery(`AVG\(weight_kg\) AS value\s+FROM CHECK_INS\s+WHERE user_id = \$1\s+AND weight_kg IS NOT NULL`).
+		WithArgs(7, "America/New_York", day("2025-01-01")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "date", "value"}).
+			AddRow(21, "2025-01-08", "80.7").
+			AddRow(23, "2025-01-15", "79.8"))
+
+	req := httptest.NewRequest("GET", "/users/me/goals/1/progress", nil)
+	req.Header.Set(userIDHeader, "7")
+	rec := serve("/users/me/goals/{goalId}/progress", handleGetUserGoalProgress(db), req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var progress GoalProgress
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &progress))
+	require.Len(t, progress.CheckIns, 2)
+	assert.Equal(t, 177.91, progress.CheckIns[0].Value)
+	assert.Equal(t, 175.93, progress.CurrentValue)
+	assert.Equal(t, 23, progress.CheckIns[1].ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
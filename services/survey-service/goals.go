@@ -0,0 +1,392 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// goalCategories mirrors the goal_category enum in the database schema
+var goalCategories = []string{"Weight", "Appearance", "Strength", "Endurance"}
+
+// Column limits from the GOALS table
+const (
+	maxGoalNameLength        = 50
+	maxGoalDescriptionLength = 200
+)
+
+// Goal represents a fitness goal from the GOALS table
+type Goal struct {
+	ID          int       `db:"id" json:"id"`
+	Category    string    `db:"category" json:"category"`
+	Name        string    `db:"name" json:"name"`
+	Description *string   `db:"description" json:"description,omitempty"`
+	CreatedAt   time.Time `db:"created_at" json:"createdAt"`
+	UpdatedAt   time.Time `db:"updated_at" json:"updatedAt"`
+}
+
+// UserGoal is a goal selected by a user, optionally quantified with a target
+type UserGoal struct {
+	Goal
+	SelectedAt    time.Time `db:"selected_at" json:"selectedAt"`
+	Metric        *string   `db:"metric" json:"metric,omitempty"`
+	Unit          *string   `db:"unit" json:"unit,omitempty"`
+	BaselineValue *float64  `db:"baseline_value" json:"baselineValue,omitempty"`
+	TargetValue   *float64  `db:"target_value" json:"targetValue,omitempty"`
+	BaselineDate  *string   `db:"baseline_date" json:"baselineDate,omitempty"`
+	TargetDate    *string   `db:"target_date" json:"targetDate,omitempty"`
+}
+
+// GoalRequest is the body for creating or updating a goal
+type GoalRequest struct {
+	Category    string  `json:"category"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+}
+
+// AssignGoalRequest is the body for selecting a goal
+type AssignGoalRequest struct {
+	GoalID int `json:"goalId"`
+}
+
+const goalColumns = `id, category, name, description, created_at, updated_at`
+
+// userGoalColumns selects a UserGoal from USER_GOALS ug joined with GOALS g
+const userGoalColumns = `g.id, g.category, g.name, g.description, g.created_at, g.updated_at,
+	ug.created_at AS selected_at, ug.metric, ug.unit, ug.baseline_value, ug.target_value,
+	to_char(ug.baseline_date, 'YYYY-MM-DD') AS baseline_date, to_char(ug.target_date, 'YYYY-MM-DD') AS target_date`
+
+// validateGoalCategory checks a category against the goal_category enum
+func validateGoalCategory(category string) error {
+	for _, c := range goalCategories {
+		if c == category {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid category %q: must be one of %s", category, strings.Join(goalCategories, ", "))
+}
+
+// validate checks a goal request against the GOALS table constraints
+func (req *GoalRequest) validate() error {
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if len(req.Name) > maxGoalNameLength {
+		return fmt.Errorf("invalid name: must be at most %d characters", maxGoalNameLength)
+	}
+	if req.Description != nil && len(*req.Description) > maxGoalDescriptionLength {
+		return fmt.Errorf("invalid description: must be at most %d characters", maxGoalDescriptionLength)
+	}
+	return validateGoalCategory(req.Category)
+}
+
+func handleGetGoals(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		category := r.URL.Query().Get("category")
+
+		goals := []Goal{}
+		var err error
+		if category != "" {
+			if err := validateGoalCategory(category); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			err = db.Select(&goals, `SELECT `+goalColumns+` FROM GOALS WHERE category = $1 ORDER BY id`, category)
+		} else {
+			err = db.Select(&goals, `SELECT `+goalColumns+` FROM GOALS ORDER BY category, id`)
+		}
+		if err != nil {
+			log.Printf("Failed to list goals: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to list goals")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, goals)
+	}
+}
+
+func handleCreateGoal(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req GoalRequest
+		if err := decodeJSON(r, &req); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if err := req.validate(); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var goal Goal
+		err := db.Get(&goal, `
+			INSERT INTO GOALS (category, name, description)
+			VALUES ($1, $2, $3)
+			RETURNING `+goalColumns,
+			req.Category, req.Name, req.Description)
+		if err != nil {
+			log.Printf("Failed to create goal: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to create goal")
+			return
+		}
+
+		writeJSON(w, http.StatusCreated, goal)
+	}
+}
+
+func handleGetGoal(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := pathID(r, "id")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var goal Goal
+		err = db.Get(&goal, `SELECT `+goalColumns+` FROM GOALS WHERE id = $1`, id)
+		if errors.Is(err, sql.ErrNoRows) {
+			writeError(w, http.StatusNotFound, "goal not found")
+			return
+		}
+		if err != nil {
+			log.Printf("Failed to get goal: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to get goal")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, goal)
+	}
+}
+
+func handleUpdateGoal(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := pathID(r, "id")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req GoalRequest
+		if err := decodeJSON(r, &req); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if err := req.validate(); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var goal Goal
+		err = db.Get(&goal, `
+			UPDATE GOALS
+			SET category = $1, name = $2, description = $3, updated_at = CURRENT_TIMESTAMP
+			WHERE id = $4
+			RETURNING `+goalColumns,
+			req.Category, req.Name, req.Description, id)
+		if errors.Is(err, sql.ErrNoRows) {
+			writeError(w, http.StatusNotFound, "goal not found")
+			return
+		}
+		if err != nil {
+			log.Printf("Failed to update goal: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to update goal")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, goal)
+	}
+}
+
+func handleDeleteGoal(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := pathID(r, "id")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// USER_GOALS rows referencing the goal are removed by ON DELETE CASCADE
+		result, err := db.Exec(`DELETE FROM GOALS WHERE id = $1`, id)
+		if err != nil {
+			log.Printf("Failed to delete goal: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to delete goal")
+			return
+		}
+		if rows, _ := result.RowsAffected(); rows == 0 {
+			writeError(w, http.StatusNotFound, "goal not found")
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func handleGetUserGoals(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := userIDFromRequest(r)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		goals := []UserGoal{}
+		err = db.Select(&goals, `
+			SELECT `+userGoalColumns+`
+			FROM USER_GOALS ug
+			JOIN GOALS g ON g.id = ug.goal_id
+			WHERE ug.user_id = $1
+			ORDER BY g.category, g.id`, userID)
+		if err != nil {
+			log.Printf("Failed to list user goals: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to list goals")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, goals)
+	}
+}
+
+func handleAssignUserGoal(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := userIDFromRequest(r)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		var req AssignGoalRequest
+		if err := decodeJSON(r, &req); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if req.GoalID <= 0 {
+			writeError(w, http.StatusBadRequest, "goalId is required")
+			return
+		}
+
+		tx, err := db.Beginx()
+		if err != nil {
+			log.Printf("Failed to begin transaction: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to select goal")
+			return
+		}
+		defer tx.Rollback()
+
+		status, body := assignUserGoal(tx, userID, req.GoalID)
+		if status == http.StatusCreated {
+			if err := tx.Commit(); err != nil {
+				log.Printf("Failed to commit goal assignment: %v", err)
+				writeError(w, http.StatusInternalServerError, "Failed to select goal")
+				return
+			}
+		}
+
+		writeJSON(w, status, body)
+	}
+}
+
+// assignUserGoal validates a goal selection against the user's current goals
+// and the GOAL_CONFLICTS rules, then records it. It returns the HTTP status
+// and response body; the caller commits only on success.
+func assignUserGoal(tx *sqlx.Tx, userID, goalID int) (int, interface{}) {
+	// Lock the user row so concurrent selections are validated one at a time
+	var lockedID int
+	err := tx.Get(&lockedID, `SELECT id FROM USERS WHERE id = $1 FOR UPDATE`, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound, errorResponse{Error: "user not found"}
+	}
+	if err != nil {
+		log.Printf("Failed to lock user: %v", err)
+		return http.StatusInternalServerError, errorResponse{Error: "Failed to select goal"}
+	}
+
+	var candidate Goal
+	err = tx.Get(&candidate, `SELECT `+goalColumns+` FROM GOALS WHERE id = $1`, goalID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound, errorResponse{Error: "goal not found"}
+	}
+	if err != nil {
+		log.Printf("Failed to get goal: %v", err)
+		return http.StatusInternalServerError, errorResponse{Error: "Failed to select goal"}
+	}
+
+	selected := []Goal{}
+	err = tx.Select(&selected, `
+		SELECT g.id, g.category, g.name, g.description, g.created_at, g.updated_at
+		FROM USER_GOALS ug
+		JOIN GOALS g ON g.id = ug.goal_id
+		WHERE ug.user_id = $1`, userID)
+	if err != nil {
+		log.Printf("Failed to list user goals: %v", err)
+		return http.StatusInternalServerError, errorResponse{Error: "Failed to select goal"}
+	}
+	for _, g := range selected {
+		if g.ID == candidate.ID {
+			return http.StatusConflict, errorResponse{Error: "goal already selected"}
+		}
+	}
+
+	rules := []GoalConflictRule{}
+	err = tx.Select(&rules, `
+		SELECT goal_id, conflicting_goal_id, reason
+		FROM GOAL_CONFLICTS
+		WHERE goal_id = $1 OR conflicting_goal_id = $1`, candidate.ID)
+	if err != nil {
+		log.Printf("Failed to load goal conflict rules: %v", err)
+		return http.StatusInternalServerError, errorResponse{Error: "Failed to select goal"}
+	}
+
+	if violations := checkGoalConflicts(candidate, selected, rules); len(violations) > 0 {
+		return http.StatusUnprocessableEntity, validationErrorResponse{
+			Error:      "goal conflicts with selected goals",
+			Violations: violations,
+		}
+	}
+
+	goal := UserGoal{Goal: candidate}
+	err = tx.Get(&goal.SelectedAt, `
+		INSERT INTO USER_GOALS (user_id, goal_id)
+		VALUES ($1, $2)
+		RETURNING created_at`, userID, candidate.ID)
+	if err != nil {
+		log.Printf("Failed to assign goal: %v", err)
+		return http.StatusInternalServerError, errorResponse{Error: "Failed to select goal"}
+	}
+
+	return http.StatusCreated, goal
+}
+
+func handleUnassignUserGoal(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := userIDFromRequest(r)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		goalID, err := pathID(r, "goalId")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		result, err := db.Exec(`DELETE FROM USER_GOALS WHERE user_id = $1 AND goal_id = $2`, userID, goalID)
+		if err != nil {
+			log.Printf("Failed to unassign goal: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to remove goal")
+			return
+		}
+		if rows, _ := result.RowsAffected(); rows == 0 {
+			writeError(w, http.StatusNotFound, "goal not selected")
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var goalRowColumns = []string{"id", "category", "name", "description", "created_at", "updated_at"}
+
+func setupTestDB(t *testing.T) (*sqlx.DB, sqlmock.Sqlmock) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	return sqlx.NewDb(mockDB, "postgres"), mock
+}
+
+// serve routes a request through a router with the given pattern so path variables resolve
+func serve(pattern string, handler http.HandlerFunc, req *http.Request) *httptest.ResponseRecorder {
+	r := mux.NewRouter()
+	r.HandleFunc(pattern, handler)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandleGetGoals_FilterByCategory(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	now := time.Now()
+
+	// Setup mock expectations
+	mock.ExpectQuery(`SELECT .+ FROM GOALS WHERE category = \$1`).
+		WithArgs("Weight").
+		WillReturnRows(sqlmock.NewRows(goalRowColumns).
+			AddRow(1, "Weight", "Lose", "Lose body weight gradually", now, now).
+			AddRow(2, "Weight", "Maintain", nil, now, now))
+
+	// Execute
+	rec := serve("/goals", handleGetGoals(db), httptest.NewRequest("GET", "/goals?category=Weight", nil))
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var goals []Goal
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &goals))
+	require.Len(t, goals, 2)
+	assert.Equal(t, "Lose", goals[0].Name)
+	assert.Nil(t, goals[1].Description)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHandleCreateGoal_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr string
+	}{
+		{name: "unknown category", body: `{"category":"Flexibility","name":"Gain"}`, wantErr: "invalid category"},
+		{name: "lowercase category", body: `{"category":"weight","name":"Gain"}`, wantErr: "invalid category"},
+		{name: "missing name", body: `{"category":"Weight","name":"  "}`, wantErr: "name is required"},
+		{name: "name too long", body: `{"category":"Weight","name":"` + strings.Repeat("x", 51) + `"}`, wantErr: "at most 50 characters"},
+		{name: "unknown field", body: `{"category":"Weight","name":"Gain","priority":1}`, wantErr: "invalid request body"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := setupTestDB(t)
+			defer db.Close()
+
+			rec := serve("/goals", handleCreateGoal(db), httptest.NewRequest("POST", "/goals", strings.NewReader(tt.body)))
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestHandleUpdateGoal_NotFound(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(`UPDATE GOALS`).
+		WithArgs("Strength", "Gain", nil, 99).
+		WillReturnRows(sqlmock.NewRows(goalRowColumns))
+
+	req := httptest.NewRequest("PUT", "/goals/99", strings.NewReader(`{"category":"Strength","name":"Gain"}`))
+	rec := serve("/goals/{id}", handleUpdateGoal(db), req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHandleAssignUserGoal(t *testing.T) {
+	now := time.Now()
+
+	// expectGoalLookups sets up the user lock, candidate goal and current selections
+	expectGoalLookups := func(mock sqlmock.Sqlmock, candidate *sqlmock.Rows, selected *sqlmock.Rows) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT id FROM USERS WHERE id = \$1 FOR UPDATE`).
+			WithArgs(7).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+		mock.ExpectQuery(`SELECT .+ FROM GOALS WHERE id = \$1`).
+			WithArgs(3).
+			WillReturnRows(candidate)
+		if selected != nil {
+			mock.ExpectQuery(`FROM USER_GOALS ug\s+JOIN GOALS g`).
+				WithArgs(7).
+				WillReturnRows(selected)
+		}
+	}
+
+	tests := []struct {
+		name           string
+		userID         string
+		body           string
+		setupMock      func(mock sqlmock.Sqlmock)
+		wantStatus     int
+		wantViolations int
+	}{
+		{
+			name:   "assigns goal",
+			userID: "7",
+			body:   `{"goalId":3}`,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				expectGoalLookups(mock,
+					sqlmock.NewRows(goalRowColumns).AddRow(3, "Weight", "Gain", nil, now, now),
+					sqlmock.NewRows(goalRowColumns).AddRow(7, "Strength", "Gain", nil, now, now))
+				mock.ExpectQuery(`SELECT goal_id, conflicting_goal_id, reason\s+FROM GOAL_CONFLICTS`).
+					WithArgs(3).
+					WillReturnRows(sqlmock.NewRows([]string{"goal_id", "conflicting_goal_id", "reason"}).
+						AddRow(1, 3, "You cannot lose and gain weight at the same time"))
+				mock.ExpectQuery(`INSERT INTO USER_GOALS`).
+					WithArgs(7, 3).
+					WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(now))
+				mock.ExpectCommit()
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:   "conflicting goal",
+			userID: "7",
+			body:   `{"goalId":3}`,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				expectGoalLookups(mock,
+					sqlmock.NewRows(goalRowColumns).AddRow(3, "Weight", "Gain", nil, now, now),
+					sqlmock.NewRows(goalRowColumns).AddRow(1, "Weight", "Lose", nil, now, now))
+				mock.ExpectQuery(`FROM GOAL_CONFLICTS`).
+					WithArgs(3).
+					WillReturnRows(sqlmock.NewRows([]string{"goal_id", "conflicting_goal_id", "reason"}).
+						AddRow(1, 3, "You cannot lose and gain weight at the same time"))
+				mock.ExpectRollback()
+			},
+			wantStatus:     http.StatusUnprocessableEntity,
+			wantViolations: 1,
+		},
+		{
+			name:   "already selected",
+			userID: "7",
+			body:   `{"goalId":3}`,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				expectGoalLookups(mock,
+					sqlmock.NewRows(goalRowColumns).AddRow(3, "Weight", "Gain", nil, now, now),
+					sqlmock.NewRows(goalRowColumns).AddRow(3, "Weight", "Gain", nil, now, now))
+				mock.ExpectRollback()
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:   "unknown goal",
+			userID: "7",
+			body:   `{"goalId":3}`,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				expectGoalLookups(mock, sqlmock.NewRows(goalRowColumns), nil)
+				mock.ExpectRollback()
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "missing user header",
+			body:       `{"goalId":3}`,
+			setupMock:  func(mock sqlmock.Sqlmock) {},
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := setupTestDB(t)
+			defer db.Close()
+			tt.setupMock(mock)
+
+			req := httptest.NewRequest("POST", "/users/me/goals", strings.NewReader(tt.body))
+			if tt.userID != "" {
+				req.Header.Set(userIDHeader, tt.userID)
+			}
+			rec := serve("/users/me/goals", handleAssignUserGoal(db), req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantViolations > 0 {
+				var body validationErrorResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+				require.Len(t, body.Violations, tt.wantViolations)
+				assert.Equal(t, "goalId", body.Violations[0].Field)
+				assert.Equal(t, 1, body.Violations[0].ConflictingGoal.ID)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestHandleUnassignUserGoal(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectExec(`DELETE FROM USER_GOALS WHERE user_id = \$1 AND goal_id = \$2`).
+		WithArgs(7, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	req := httptest.NewRequest("DELETE", "/users/me/goals/3", nil)
+	req.Header.Set(userIDHeader, "7")
+	rec := serve("/users/me/goals/{goalId}", handleUnassignUserGoal(db), req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -51,6 +51,8 @@ func main() {
 	r.HandleFunc("/surveys/{id}", handleGetSurvey(db)).Methods("GET")
 	r.HandleFunc("/surveys/{id}", handleUpdateSurvey(db)).Methods("PUT")
 	r.HandleFunc("/surveys/{id}", handleDeleteSurvey(db)).Methods("DELETE")
+	r.HandleFunc("/surveys/{id}/versions", handleCreateSurveyVersion(db)).Methods("POST")
+	r.HandleFunc("/surveys/{id}/versions/{version}", handleGetSurveyVersion(db)).Methods("GET")
 
 	// Goals endpoints
 	r.HandleFunc("/goals", handleGetGoals(db)).Methods("GET")
@@ -59,79 +61,20 @@ func main() {
 	r.HandleFunc("/goals/{id}", handleUpdateGoal(db)).Methods("PUT")
 	r.HandleFunc("/goals/{id}", handleDeleteGoal(db)).Methods("DELETE")
 
+	// Goals selected by the authenticated user (identified by the X-User-ID header)
+	r.HandleFunc("/users/me/goals", handleGetUserGoals(db)).Methods("GET")
+	r.HandleFunc("/users/me/goals", handleAssignUserGoal(db)).Methods("POST")
+	r.HandleFunc("/users/me/goals/{goalId}", handleUnassignUserGoal(db)).Methods("DELETE")
+	r.HandleFunc("/users/me/goals/{goalId}/target", handleSetUserGoalTarget(db)).Methods("PUT")
+	r.HandleFunc("/users/me/goals/{goalId}/check-ins", handleLogGoalCheckIn(db)).Methods("POST")
+	r.HandleFunc("/users/me/goals/{goalId}/progress", handleGetUserGoalProgress(db)).Methods("GET")
+
+	// Survey responses of the authenticated user
+	r.HandleFunc("/users/me/surveys/{surveyId}/responses", handleSubmitSurveyResponse(db)).Methods("POST")
+	r.HandleFunc("/users/me/surveys/{surveyId}/responses/latest", handleGetLatestSurveyResponse(db)).Methods("GET")
+
 	// Start server
 	port := os.Getenv("PORT")
 	log.Printf("Survey service starting on port %s", port)
 	log.Fatal(http.ListenAndServe(":"+port, r))
 }
-
-// Handler functions (to be implemented)
-func handleGetSurveys(db *sqlx.DB) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("Get surveys endpoint"))
-	}
-}
-
-func handleCreateSurvey(db *sqlx.DB) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("Create survey endpoint"))
-	}
-}
-
-func handleGetSurvey(db *sqlx.DB) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("Get survey by ID endpoint"))
-	}
-}
-
-func handleUpdateSurvey(db *sqlx.DB) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("Update survey endpoint"))
-	}
-}
-
-func handleDeleteSurvey(db *sqlx.DB) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("Delete survey endpoint"))
-	}
-}
-
-func handleGetGoals(db *sqlx.DB) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("Get goals endpoint"))
-	}
-}
-
-func handleCreateGoal(db *sqlx.DB) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("Create goal endpoint"))
-	}
-}
-
-func handleGetGoal(db *sqlx.DB) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("Get goal by ID endpoint"))
-	}
-}
-
-func handleUpdateGoal(db *sqlx.DB) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("Update goal endpoint"))
-	}
-}
-
-func handleDeleteGoal(db *sqlx.DB) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("Delete goal endpoint"))
-	}
-}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+)
+
+// userIDHeader carries the authenticated user's ID. It is set by api-service
+// after validating the JWT, so this service must not be exposed directly.
+const userIDHeader = "X-User-ID"
+
+// errorResponse is the JSON body returned for failed requests
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// validationErrorResponse is returned when a request breaks a business rule
+type validationErrorResponse struct {
+	Error      string      `json:"error"`
+	Violations []Violation `json:"violations"`
+}
+
+// writeJSON writes v as a JSON response with the given status code
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("Failed to encode response: %v", err)
+	}
+}
+
+// writeError writes a JSON error response
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, errorResponse{Error: message})
+}
+
+// decodeJSON decodes a JSON request body, rejecting unknown fields
+func decodeJSON(r *http.Request, v interface{}) error {
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("invalid request body: %v", err)
+	}
+	return nil
+}
+
+// userIDFromRequest returns the authenticated user's ID forwarded by api-service
+func userIDFromRequest(r *http.Request) (int, error) {
+	userID, err := strconv.Atoi(r.Header.Get(userIDHeader))
+	if err != nil || userID <= 0 {
+		return 0, fmt.Errorf("missing or invalid %s header", userIDHeader)
+	}
+	return userID, nil
+}
+
+// pathID parses a positive integer path variable
+func pathID(r *http.Request, name string) (int, error) {
+	id, err := strconv.Atoi(mux.Vars(r)[name])
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid %s", name)
+	}
+	return id, nil
+}
@@ -0,0 +1,387 @@
+package main
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Violation codes returned when a survey response is rejected
+const (
+	violationRequired        = "REQUIRED"
+	violationInvalidAnswer   = "INVALID_ANSWER"
+	violationInvalidOption   = "INVALID_OPTION"
+	violationOutOfRange      = "OUT_OF_RANGE"
+	violationTooLong         = "TOO_LONG"
+	violationNotShown        = "NOT_SHOWN"
+	violationUnknownQuestion = "UNKNOWN_QUESTION"
+)
+
+// SurveyResponseRequest is the body for submitting answers to a survey.
+// Answers are keyed by question key; version defaults to the current version.
+type SurveyResponseRequest struct {
+	Version *int                       `json:"version"`
+	Answers map[string]json.RawMessage `json:"answers"`
+}
+
+// SurveyResponse is a submitted response with its answers keyed by question key
+type SurveyResponse struct {
+	ID          int                    `db:"id" json:"id"`
+	SurveyID    int                    `db:"survey_id" json:"surveyId"`
+	Version     int                    `db:"version" json:"version"`
+	SubmittedAt time.Time              `db:"submitted_at" json:"submittedAt"`
+	Answers     map[string]interface{} `db:"-" json:"answers"`
+}
+
+// surveyAnswer is a validated answer as stored in SURVEY_ANSWERS; exactly one
+// value is set depending on the question type
+type surveyAnswer struct {
+	QuestionID   int            `db:"question_id"`
+	QuestionKey  string         `db:"question_key"`
+	QuestionType string         `db:"question_type"`
+	TextValue    *string        `db:"text_value"`
+	NumericValue *float64       `db:"numeric_value"`
+	ChoiceValues pq.StringArray `db:"choice_values"`
+}
+
+// value returns the answer in the shape it was submitted in
+func (a surveyAnswer) value() interface{} {
+	switch a.QuestionType {
+	case questionSingleChoice:
+		if len(a.ChoiceValues) > 0 {
+			return a.ChoiceValues[0]
+		}
+	case questionMultiChoice:
+		return []string(a.ChoiceValues)
+	case questionNumeric, questionScale:
+		if a.NumericValue != nil {
+			return *a.NumericValue
+		}
+	case questionFreeText:
+		if a.TextValue != nil {
+			return *a.TextValue
+		}
+	}
+	return nil
+}
+
+// evaluateAnswers validates answers against the questions of a survey
+// version. Questions are walked in order so that branching conditions only
+// see answers to questions that were themselves shown; hidden questions must
+// be left unanswered and are never required.
+func evaluateAnswers(questions []SurveyQuestion, answers map[string]json.RawMessage) ([]surveyAnswer, []Violation) {
+	accepted := []surveyAnswer{}
+	violations := []Violation{}
+	chosen := make(map[string][]string, len(questions))
+	known := make(map[string]bool, len(questions))
+
+	for _, q := range questions {
+		known[q.Key] = true
+		raw, answered := answers[q.Key]
+		if answered && isBlankAnswer(raw) {
+			answered = false
+		}
+
+		if !isShown(q, chosen) {
+			if answered {
+				violations = append(violations, answerViolation(q.Key, violationNotShown,
+					fmt.Sprintf("%s is not asked for your earlier answers", q.Key)))
+			}
+			continue
+		}
+		if !answered {
+			if q.Required {
+				violations = append(violations, answerViolation(q.Key, violationRequired,
+					fmt.Sprintf("%s is required", q.Key)))
+			}
+			continue
+		}
+
+		answer, violation := parseAnswer(q, raw)
+		if violation != nil {
+			violations = append(violations, *violation)
+			continue
+		}
+		if isChoiceQuestion(q.Type) {
+			chosen[q.Key] = answer.ChoiceValues
+		}
+		accepted = append(accepted, answer)
+	}
+
+	unknown := []string{}
+	for key := range answers {
+		if !known[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+	for _, key := range unknown {
+		violations = append(violations, answerViolation(key, violationUnknownQuestion,
+			fmt.Sprintf("%s is not a question of this survey version", key)))
+	}
+
+	return accepted, violations
+}
+
+// isShown evaluates a question's branching condition against earlier choices
+func isShown(q SurveyQuestion, chosen map[string][]string) bool {
+	if q.ShowIf == nil {
+		return true
+	}
+	for _, value := range chosen[q.ShowIf.QuestionKey] {
+		for _, wanted := range q.ShowIf.Values {
+			if value == wanted {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isBlankAnswer treats null, empty strings and empty lists as unanswered
+func isBlankAnswer(raw json.RawMessage) bool {
+	trimmed := bytes.TrimSpace(raw)
+	if bytes.Equal(trimmed, []byte("null")) || bytes.Equal(trimmed, []byte("[]")) {
+		return true
+	}
+	var text string
+	return json.Unmarshal(trimmed, &text) == nil && strings.TrimSpace(text) == ""
+}
+
+// parseAnswer decodes and checks a single answer against its question
+func parseAnswer(q SurveyQuestion, raw json.RawMessage) (surveyAnswer, *Violation) {
+	answer := surveyAnswer{QuestionID: q.ID, QuestionKey: q.Key, QuestionType: q.Type}
+	invalid := func(code, message string) (surveyAnswer, *Violation) {
+		violation := answerViolation(q.Key, code, message)
+		return answer, &violation
+	}
+
+	switch q.Type {
+	case questionSingleChoice:
+		var value string
+		if json.Unmarshal(raw, &value) != nil {
+			return invalid(violationInvalidAnswer, fmt.Sprintf("%s must be one option value", q.Key))
+		}
+		if !hasOption(q.Options, value) {
+			return invalid(violationInvalidOption, fmt.Sprintf("%q is not an option of %s", value, q.Key))
+		}
+		answer.ChoiceValues = pq.StringArray{value}
+
+	case questionMultiChoice:
+		var values []string
+		if json.Unmarshal(raw, &values) != nil {
+			return invalid(violationInvalidAnswer, fmt.Sprintf("%s must be a list of option values", q.Key))
+		}
+		seen := make(map[string]bool, len(values))
+		for _, value := range values {
+			if !hasOption(q.Options, value) {
+				return invalid(violationInvalidOption, fmt.Sprintf("%q is not an option of %s", value, q.Key))
+			}
+			if seen[value] {
+				return invalid(violationInvalidAnswer, fmt.Sprintf("%q is selected more than once in %s", value, q.Key))
+			}
+			seen[value] = true
+		}
+		answer.ChoiceValues = values
+
+	case questionNumeric, questionScale:
+		var value float64
+		if json.Unmarshal(raw, &value) != nil {
+			return invalid(violationInvalidAnswer, fmt.Sprintf("%s must be a number", q.Key))
+		}
+		if q.Type == questionScale && value != math.Trunc(value) {
+			return invalid(violationInvalidAnswer, fmt.Sprintf("%s must be a whole number", q.Key))
+		}
+		if (q.MinValue != nil && value < *q.MinValue) || (q.MaxValue != nil && value > *q.MaxValue) {
+			return invalid(violationOutOfRange, fmt.Sprintf("%s must be %s", q.Key, describeRange(q.MinValue, q.MaxValue)))
+		}
+		answer.NumericValue = &value
+
+	case questionFreeText:
+		var value string
+		if json.Unmarshal(raw, &value) != nil {
+			return invalid(violationInvalidAnswer, fmt.Sprintf("%s must be text", q.Key))
+		}
+		value = strings.TrimSpace(value)
+		limit := maxFreeTextLength
+		if q.MaxLength != nil {
+			limit = *q.MaxLength
+		}
+		if len([]rune(value)) > limit {
+			return invalid(violationTooLong, fmt.Sprintf("%s must be at most %d characters", q.Key, limit))
+		}
+		answer.TextValue = &value
+	}
+
+	return answer, nil
+}
+
+func describeRange(min, max *float64) string {
+	switch {
+	case min != nil && max != nil:
+		return fmt.Sprintf("between %g and %g", *min, *max)
+	case min != nil:
+		return fmt.Sprintf("at least %g", *min)
+	default:
+		return fmt.Sprintf("at most %g", *max)
+	}
+}
+
+func answerViolation(key, code, message string) Violation {
+	return Violation{Field: "answers." + key, Code: code, Message: message}
+}
+
+func handleSubmitSurveyResponse(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := userIDFromRequest(r)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		surveyID, err := pathID(r, "surveyId")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req SurveyResponseRequest
+		if err := decodeJSON(r, &req); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if req.Version != nil && *req.Version <= 0 {
+			writeError(w, http.StatusBadRequest, "invalid version")
+			return
+		}
+
+		sv, err := getSurveyVersion(db, surveyID, req.Version)
+		if errors.Is(err, sql.ErrNoRows) {
+			writeError(w, http.StatusNotFound, "survey version not found")
+			return
+		}
+		if err != nil {
+			log.Printf("Failed to get survey version: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to submit survey response")
+			return
+		}
+
+		answers, violations := evaluateAnswers(sv.Questions, req.Answers)
+		if len(violations) > 0 {
+			writeJSON(w, http.StatusUnprocessableEntity, validationErrorResponse{
+				Error:      "survey response is invalid",
+				Violations: violations,
+			})
+			return
+		}
+
+		tx, err := db.Beginx()
+		if err != nil {
+			log.Printf("Failed to begin transaction: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to submit survey response")
+			return
+		}
+		defer tx.Rollback()
+
+		response := SurveyResponse{SurveyID: sv.SurveyID, Version: sv.Version, Answers: map[string]interface{}{}}
+		err = tx.QueryRowx(`
+			INSERT INTO SURVEY_RESPONSES (survey_version_id, user_id)
+			VALUES ($1, $2)
+			RETURNING id, submitted_at`, sv.ID, userID).Scan(&response.ID, &response.SubmittedAt)
+		if isForeignKeyViolation(err) {
+			writeError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		if err != nil {
+			log.Printf("Failed to create survey response: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to submit survey response")
+			return
+		}
+
+		for _, answer := range answers {
+			_, err = tx.Exec(`
+				INSERT INTO SURVEY_ANSWERS (response_id, question_id, text_value, numeric_value, choice_values)
+				VALUES ($1, $2, $3, $4, $5)`,
+				response.ID, answer.QuestionID, answer.TextValue, answer.NumericValue, answer.ChoiceValues)
+			if err != nil {
+				log.Printf("Failed to store survey answer: %v", err)
+				writeError(w, http.StatusInternalServerError, "Failed to submit survey response")
+				return
+			}
+			response.Answers[answer.QuestionKey] = answer.value()
+		}
+
+		if err := tx.Commit(); err != nil {
+			log.Printf("Failed to commit survey response: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to submit survey response")
+			return
+		}
+
+		writeJSON(w, http.StatusCreated, response)
+	}
+}
+
+func handleGetLatestSurveyResponse(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := userIDFromRequest(r)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		surveyID, err := pathID(r, "surveyId")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var response SurveyResponse
+		err = db.Get(&response, `
+			SELECT r.id, v.survey_id, v.version, r.submitted_at
+			FROM SURVEY_RESPONSES r
+			JOIN SURVEY_VERSIONS v ON v.id = r.survey_version_id
+			WHERE r.user_id = $1 AND v.survey_id = $2
+			ORDER BY r.submitted_at DESC, r.id DESC
+			LIMIT 1`, userID, surveyID)
+		if errors.Is(err, sql.ErrNoRows) {
+			writeError(w, http.StatusNotFound, "survey response not found")
+			return
+		}
+		if err != nil {
+			log.Printf("Failed to get survey response: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to get survey response")
+			return
+		}
+
+		answers := []surveyAnswer{}
+		err = db.Select(&answers, `
+			SELECT a.question_id, q.question_key, q.question_type, a.text_value, a.numeric_value, a.choice_values
+			FROM SURVEY_ANSWERS a
+			JOIN SURVEY_QUESTIONS q ON q.id = a.question_id
+			WHERE a.response_id = $1
+			ORDER BY q.position`, response.ID)
+		if err != nil {
+			log.Printf("Failed to get survey answers: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to get survey response")
+			return
+		}
+
+		response.Answers = make(map[string]interface{}, len(answers))
+		for _, answer := range answers {
+			response.Answers[answer.QuestionKey] = answer.value()
+		}
+
+		writeJSON(w, http.StatusOK, response)
+	}
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// activityQuestions is a small survey with a branch on the first answer
+func activityQuestions() []SurveyQuestion {
+	yesNo := []QuestionOption{{Value: "yes", Label: "Yes"}, {Value: "no", Label: "No"}}
+	return []SurveyQuestion{
+		{ID: 1, Key: "exercises", Type: questionSingleChoice, Required: true, Options: yesNo},
+		{ID: 2, Key: "days_per_week", Type: questionNumeric, Required: true, MinValue: floatPtr(1), MaxValue: floatPtr(7),
+			ShowIf: &ShowIf{QuestionKey: "exercises", Values: []string{"yes"}}},
+		{ID: 3, Key: "activities", Type: questionMultiChoice, Required: false,
+			Options: []QuestionOption{{Value: "running", Label: "Running"}, {Value: "lifting", Label: "Lifting"}},
+			ShowIf:  &ShowIf{QuestionKey: "exercises", Values: []string{"yes"}}},
+		{ID: 4, Key: "energy", Type: questionScale, Required: true, MinValue: floatPtr(1), MaxValue: floatPtr(5)},
+		{ID: 5, Key: "notes", Type: questionFreeText, Required: false, MaxLength: intPtr(10)},
+	}
+}
+
+func intPtr(v int) *int { return &v }
+
+func answersJSON(t *testing.T, body string) map[string]json.RawMessage {
+	answers := map[string]json.RawMessage{}
+	require.NoError(t, json.Unmarshal([]byte(body), &answers))
+	return answers
+}
+
+func TestEvaluateAnswers(t *testing.T) {
+	tests := []struct {
+		name      string
+		answers   string
+		wantCodes map[string]string
+		wantKeys  []string
+	}{
+		{
+			name:     "branch taken",
+			answers:  `{"exercises":"yes","days_per_week":3,"activities":["running","lifting"],"energy":4}`,
+			wantKeys: []string{"exercises", "days_per_week", "activities", "energy"},
+		},
+		{
+			name:     "branch skipped",
+			answers:  `{"exercises":"no","energy":2,"notes":"  "}`,
+			wantKeys: []string{"exercises", "energy"},
+		},
+		{
+			name:      "required question in taken branch",
+			answers:   `{"exercises":"yes","energy":3}`,
+			wantCodes: map[string]string{"answers.days_per_week": violationRequired},
+		},
+		{
+			name:      "answer to hidden question",
+			answers:   `{"exercises":"no","days_per_week":3,"energy":3}`,
+			wantCodes: map[string]string{"answers.days_per_week": violationNotShown},
+		},
+		{
+			name:      "invalid branch answer hides dependents",
+			answers:   `{"exercises":"sometimes","energy":3}`,
+			wantCodes: map[string]string{"answers.exercises": violationInvalidOption},
+		},
+		{
+			name:    "type and range errors",
+			answers: `{"exercises":"yes","days_per_week":9,"activities":"running","energy":2.5,"notes":"far too long"}`,
+			wantCodes: map[string]string{
+				"answers.days_per_week": violationOutOfRange,
+				"answers.activities":    violationInvalidAnswer,
+				"answers.energy":        violationInvalidAnswer,
+				"answers.notes":         violationTooLong,
+			},
+		},
+		{
+			name:      "duplicate choice",
+			answers:   `{"exercises":"yes","days_per_week":2,"activities":["running","running"],"energy":1}`,
+			wantCodes: map[string]string{"answers.activities": violationInvalidAnswer},
+		},
+		{
+			name:      "unknown question",
+			answers:   `{"exercises":"no","energy":5,"favourite_color":"blue"}`,
+			wantCodes: map[string]string{"answers.favourite_color": violationUnknownQuestion},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accepted, violations := evaluateAnswers(activityQuestions(), answersJSON(t, tt.answers))
+
+			codes := map[string]string{}
+			for _, v := range violations {
+				codes[v.Field] = v.Code
+			}
+			if tt.wantCodes == nil {
+				tt.wantCodes = map[string]string{}
+			}
+			assert.Equal(t, tt.wantCodes, codes)
+
+			if tt.wantKeys != nil {
+				keys := []string{}
+				for _, a := range accepted {
+					keys = append(keys, a.QuestionKey)
+				}
+				assert.Equal(t, tt.wantKeys, keys)
+			}
+		})
+	}
+}
+
+func TestEvaluateAnswers_StoresOneValuePerType(t *testing.T) {
+	accepted, violations := evaluateAnswers(activityQuestions(),
+		answersJSON(t, `{"exercises":"yes","days_per_week":4,"activities":["lifting"],"energy":5,"notes":" rest days "}`))
+
+	require.Empty(t, violations)
+	require.Len(t, accepted, 5)
+	assert.Equal(t, "yes", accepted[0].value())
+	assert.Equal(t, 4.0, accepted[1].value())
+	assert.Equal(t, []string{"lifting"}, accepted[2].value())
+	assert.Equal(t, 5.0, accepted[3].value())
+	assert.Equal(t, "rest days", accepted[4].value())
+	for _, a := range accepted {
+		set := 0
+		if a.TextValue != nil {
+			set++
+		}
+		if a.NumericValue != nil {
+			set++
+		}
+		if a.ChoiceValues != nil {
+			set++
+		}
+		assert.Equal(t, 1, set, a.QuestionKey)
+	}
+}
+
+// expectSurveyVersion mocks loading version 1 of survey 1 with a yes/no question and a follow-up
+func expectSurveyVersion(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(`FROM SURVEY_VERSIONS`).
+		WithArgs(1, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "survey_id", "version", "created_at"}).AddRow(5, 1, 1, time.Now()))
+	mock.ExpectQuery(`FROM SURVEY_QUESTIONS`).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows(questionRowColumns).
+			AddRow(10, "exercises", 1, "SINGLE_CHOICE", "Do you exercise?", true, nil, nil, nil, nil, nil).
+			AddRow(11, "days_per_week", 2, "NUMERIC", "How many days a week?", true, 1.0, 7.0, nil, "exercises", "{yes}"))
+	mock.ExpectQuery(`FROM SURVEY_QUESTION_OPTIONS o`).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"question_id", "value", "label"}).
+			AddRow(10, "yes", "Yes").
+			AddRow(10, "no", "No"))
+}
+
+func TestHandleSubmitSurveyResponse(t *testing.T) {
+	const pattern = "/users/me/surveys/{surveyId}/responses"
+
+	t.Run("stores answers to the current version", func(t *testing.T) {
+		db, mock := setupTestDB(t)
+		defer db.Close()
+
+		submittedAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+		// Setup mock expectations
+		expectSurveyVersion(mock)
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO SURVEY_RESPONSES`).
+			WithArgs(5, 7).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "submitted_at"}).AddRow(30, submittedAt))
+		mock.ExpectExec(`INSERT INTO SURVEY_ANSWERS`).
+			WithArgs(30, 10, nil, nil, "{\"yes\"}").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`INSERT INTO SURVEY_ANSWERS`).
+			WithArgs(30, 11, nil, 3.0, nil).
+			WillReturnResult(sqlmock.NewResult(2, 1))
+		mock.ExpectCommit()
+
+		// Execute
+		req := httptest.NewRequest("POST", "/users/me/surveys/1/responses",
+			strings.NewReader(`{"answers":{"exercises":"yes","days_per_week":3}}`))
+		req.Header.Set(userIDHeader, "7")
+		rec := serve(pattern, handleSubmitSurveyResponse(db), req)
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		var response SurveyResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, 30, response.ID)
+		assert.Equal(t, 1, response.Version)
+		assert.Equal(t, map[string]interface{}{"exercises": "yes", "days_per_week": 3.0}, response.Answers)
+
+		// Verify all expectations were met
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rejects invalid answers without writing", func(t *testing.T) {
+		db, mock := setupTestDB(t)
+		defer db.Close()
+
+		expectSurveyVersion(mock)
+
+		req := httptest.NewRequest("POST", "/users/me/surveys/1/responses",
+			strings.NewReader(`{"answers":{"exercises":"yes"}}`))
+		req.Header.Set(userIDHeader, "7")
+		rec := serve(pattern, handleSubmitSurveyResponse(db), req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		var body validationErrorResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		require.Len(t, body.Violations, 1)
+		assert.Equal(t, "answers.days_per_week", body.Violations[0].Field)
+		assert.Equal(t, violationRequired, body.Violations[0].Code)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("missing user header", func(t *testing.T) {
+		db, mock := setupTestDB(t)
+		defer db.Close()
+
+		rec := serve(pattern, handleSubmitSurveyResponse(db),
+			httptest.NewRequest("POST", "/users/me/surveys/1/responses", strings.NewReader(`{"answers":{}}`)))
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestHandleGetLatestSurveyResponse_NotFound(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM SURVEY_RESPONSES r`).
+		WithArgs(7, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "survey_id", "version", "submitted_at"}))
+
+	req := httptest.NewRequest("GET", "/users/me/surveys/1/responses/latest", nil)
+	req.Header.Set(userIDHeader, "7")
+	rec := serve("/users/me/surveys/{surveyId}/responses/latest", handleGetLatestSurveyResponse(db), req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Contains(t, rec.Body.String(), "survey response not found")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,454 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Question types, mirroring the survey_question_type enum in the database schema
+const (
+	questionSingleChoice = "SINGLE_CHOICE"
+	questionMultiChoice  = "MULTI_CHOICE"
+	questionNumeric      = "NUMERIC"
+	questionFreeText     = "FREE_TEXT"
+	questionScale        = "SCALE"
+)
+
+var questionTypes = []string{questionSingleChoice, questionMultiChoice, questionNumeric, questionFreeText, questionScale}
+
+// Limits from the SURVEY_QUESTIONS and SURVEY_QUESTION_OPTIONS tables
+const (
+	maxSurveyQuestions       = 100
+	maxQuestionKeyLength     = 50
+	maxQuestionPromptLength  = 255
+	maxFreeTextLength        = 2000
+	maxOptionValueLength     = 50
+	maxOptionLabelLength     = 100
+	maxScaleSteps            = 11
+	minChoiceQuestionOptions = 2
+)
+
+// questionKeyPattern allows snake_case keys, which double as answer keys
+var questionKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// QuestionOption is a choice of a SINGLE_CHOICE or MULTI_CHOICE question
+type QuestionOption struct {
+	Value string `db:"value" json:"value"`
+	Label string `db:"label" json:"label"`
+}
+
+// ShowIf makes a question conditional on the answer to an earlier choice
+// question: it is shown only when that answer includes one of Values
+type ShowIf struct {
+	QuestionKey string   `json:"questionKey"`
+	Values      []string `json:"values"`
+}
+
+// SurveyQuestion is a question of a survey version from the SURVEY_QUESTIONS table
+type SurveyQuestion struct {
+	ID        int              `db:"id" json:"id"`
+	Key       string           `db:"question_key" json:"key"`
+	Position  int              `db:"position" json:"position"`
+	Type      string           `db:"question_type" json:"type"`
+	Prompt    string           `db:"prompt" json:"prompt"`
+	Required  bool             `db:"required" json:"required"`
+	MinValue  *float64         `db:"min_value" json:"minValue,omitempty"`
+	MaxValue  *float64         `db:"max_value" json:"maxValue,omitempty"`
+	MaxLength *int             `db:"max_length" json:"maxLength,omitempty"`
+	Options   []QuestionOption `db:"-" json:"options,omitempty"`
+	ShowIf    *ShowIf          `db:"-" json:"showIf,omitempty"`
+
+	ShowIfQuestionKey *string        `db:"show_if_question_key" json:"-"`
+	ShowIfValues      pq.StringArray `db:"show_if_values" json:"-"`
+}
+
+// SurveyVersion is an immutable set of questions from the SURVEY_VERSIONS table
+type SurveyVersion struct {
+	ID        int              `db:"id" json:"-"`
+	SurveyID  int              `db:"survey_id" json:"surveyId"`
+	Version   int              `db:"version" json:"version"`
+	CreatedAt time.Time        `db:"created_at" json:"createdAt"`
+	Questions []SurveyQuestion `db:"-" json:"questions"`
+}
+
+// SurveyQuestionRequest defines a question in a new survey version
+type SurveyQuestionRequest struct {
+	Key       string           `json:"key"`
+	Type      string           `json:"type"`
+	Prompt    string           `json:"prompt"`
+	Required  *bool            `json:"required"`
+	MinValue  *float64         `json:"minValue"`
+	MaxValue  *float64         `json:"maxValue"`
+	MaxLength *int             `json:"maxLength"`
+	Options   []QuestionOption `json:"options"`
+	ShowIf    *ShowIf          `json:"showIf"`
+}
+
+// SurveyVersionRequest is the body for publishing a new survey version
+type SurveyVersionRequest struct {
+	Questions []SurveyQuestionRequest `json:"questions"`
+}
+
+// questionOptionRow is a SURVEY_QUESTION_OPTIONS row with its question
+type questionOptionRow struct {
+	QuestionID int `db:"question_id"`
+	QuestionOption
+}
+
+const surveyQuestionColumns = `id, question_key, position, question_type, prompt, required,
+	min_value, max_value, max_length, show_if_question_key, show_if_values`
+
+// isChoiceQuestion reports whether answers to the question are option values
+func isChoiceQuestion(questionType string) bool {
+	return questionType == questionSingleChoice || questionType == questionMultiChoice
+}
+
+// validate checks a version definition and returns its questions in order.
+// Branching may only depend on earlier choice questions, so the questions
+// shown for a set of answers can always be resolved in a single pass.
+func (req *SurveyVersionRequest) validate() ([]SurveyQuestion, error) {
+	if len(req.Questions) == 0 {
+		return nil, fmt.Errorf("questions are required")
+	}
+	if len(req.Questions) > maxSurveyQuestions {
+		return nil, fmt.Errorf("invalid questions: at most %d are allowed", maxSurveyQuestions)
+	}
+
+	questions := make([]SurveyQuestion, len(req.Questions))
+	byKey := make(map[string]*SurveyQuestion, len(req.Questions))
+	for i, q := range req.Questions {
+		question, err := q.toQuestion(i+1, byKey)
+		if err != nil {
+			return nil, fmt.Errorf("questions[%d]: %v", i, err)
+		}
+		questions[i] = question
+		byKey[question.Key] = &questions[i]
+	}
+	return questions, nil
+}
+
+// toQuestion validates a question definition against the questions before it
+func (q SurveyQuestionRequest) toQuestion(position int, earlier map[string]*SurveyQuestion) (SurveyQuestion, error) {
+	question := SurveyQuestion{
+		Key:       strings.TrimSpace(q.Key),
+		Position:  position,
+		Type:      q.Type,
+		Prompt:    strings.TrimSpace(q.Prompt),
+		Required:  q.Required == nil || *q.Required,
+		MinValue:  q.MinValue,
+		MaxValue:  q.MaxValue,
+		MaxLength: q.MaxLength,
+		Options:   q.Options,
+	}
+
+	if question.Key == "" {
+		return question, fmt.Errorf("key is required")
+	}
+	if len(question.Key) > maxQuestionKeyLength || !questionKeyPattern.MatchString(question.Key) {
+		return question, fmt.Errorf("invalid key %q: must be at most %d lowercase letters, digits and underscores", question.Key, maxQuestionKeyLength)
+	}
+	if _, ok := earlier[question.Key]; ok {
+		return question, fmt.Errorf("duplicate key %q", question.Key)
+	}
+	if question.Prompt == "" {
+		return question, fmt.Errorf("prompt is required")
+	}
+	if len(question.Prompt) > maxQuestionPromptLength {
+		return question, fmt.Errorf("invalid prompt: must be at most %d characters", maxQuestionPromptLength)
+	}
+
+	if err := question.validateType(); err != nil {
+		return question, err
+	}
+
+	if q.ShowIf != nil {
+		if err := validateShowIf(*q.ShowIf, earlier); err != nil {
+			return question, err
+		}
+		question.ShowIf = q.ShowIf
+		question.ShowIfQuestionKey = &q.ShowIf.QuestionKey
+		question.ShowIfValues = q.ShowIf.Values
+	}
+	return question, nil
+}
+
+// validateType checks the settings that apply to the question's type
+func (q *SurveyQuestion) validateType() error {
+	switch q.Type {
+	case questionSingleChoice, questionMultiChoice:
+		if q.MinValue != nil || q.MaxValue != nil || q.MaxLength != nil {
+			return fmt.Errorf("invalid %s question: minValue, maxValue and maxLength do not apply", q.Type)
+		}
+		return validateOptions(q.Options)
+	case questionNumeric:
+		if q.MaxLength != nil || len(q.Options) > 0 {
+			return fmt.Errorf("invalid NUMERIC question: maxLength and options do not apply")
+		}
+		if q.MinValue != nil && q.MaxValue != nil && *q.MinValue > *q.MaxValue {
+			return fmt.Errorf("invalid NUMERIC question: minValue must not exceed maxValue")
+		}
+	case questionScale:
+		if q.MaxLength != nil || len(q.Options) > 0 {
+			return fmt.Errorf("invalid SCALE question: maxLength and options do not apply")
+		}
+		if q.MinValue == nil || q.MaxValue == nil {
+			return fmt.Errorf("invalid SCALE question: minValue and maxValue are required")
+		}
+		min, max := *q.MinValue, *q.MaxValue
+		if min != math.Trunc(min) || max != math.Trunc(max) {
+			return fmt.Errorf("invalid SCALE question: minValue and maxValue must be whole numbers")
+		}
+		if min >= max || max-min+1 > maxScaleSteps {
+			return fmt.Errorf("invalid SCALE question: must have between 2 and %d steps", maxScaleSteps)
+		}
+	case questionFreeText:
+		if q.MinValue != nil || q.MaxValue != nil || len(q.Options) > 0 {
+			return fmt.Errorf("invalid FREE_TEXT question: minValue, maxValue and options do not apply")
+		}
+		if q.MaxLength != nil && (*q.MaxLength < 1 || *q.MaxLength > maxFreeTextLength) {
+			return fmt.Errorf("invalid FREE_TEXT question: maxLength must be between 1 and %d", maxFreeTextLength)
+		}
+	default:
+		return fmt.Errorf("invalid type %q: must be one of %s", q.Type, strings.Join(questionTypes, ", "))
+	}
+	return nil
+}
+
+// validateOptions checks the choices of a choice question
+func validateOptions(options []QuestionOption) error {
+	if len(options) < minChoiceQuestionOptions {
+		return fmt.Errorf("invalid options: at least %d are required", minChoiceQuestionOptions)
+	}
+	seen := make(map[string]bool, len(options))
+	for i := range options {
+		options[i].Value = strings.TrimSpace(options[i].Value)
+		options[i].Label = strings.TrimSpace(options[i].Label)
+		option := options[i]
+		if option.Value == "" || option.Label == "" {
+			return fmt.Errorf("invalid options[%d]: value and label are required", i)
+		}
+		if len(option.Value) > maxOptionValueLength {
+			return fmt.Errorf("invalid options[%d]: value must be at most %d characters", i, maxOptionValueLength)
+		}
+		if len(option.Label) > maxOptionLabelLength {
+			return fmt.Errorf("invalid options[%d]: label must be at most %d characters", i, maxOptionLabelLength)
+		}
+		if seen[option.Value] {
+			return fmt.Errorf("invalid options: duplicate value %q", option.Value)
+		}
+		seen[option.Value] = true
+	}
+	return nil
+}
+
+// validateShowIf checks that a condition refers to an earlier choice question and its option values
+func validateShowIf(showIf ShowIf, earlier map[string]*SurveyQuestion) error {
+	parent, ok := earlier[showIf.QuestionKey]
+	if !ok {
+		return fmt.Errorf("invalid showIf: %q is not an earlier question", showIf.QuestionKey)
+	}
+	if !isChoiceQuestion(parent.Type) {
+		return fmt.Errorf("invalid showIf: %q is not a choice question", showIf.QuestionKey)
+	}
+	if len(showIf.Values) == 0 {
+		return fmt.Errorf("invalid showIf: values are required")
+	}
+	for _, value := range showIf.Values {
+		if !hasOption(parent.Options, value) {
+			return fmt.Errorf("invalid showIf: %q is not an option of %q", value, showIf.QuestionKey)
+		}
+	}
+	return nil
+}
+
+func hasOption(options []QuestionOption, value string) bool {
+	for _, option := range options {
+		if option.Value == value {
+			return true
+		}
+	}
+	return false
+}
+
+// getSurveyVersion loads a survey version with its questions, or the current
+// (highest) version when version is nil. It returns sql.ErrNoRows when the
+// survey or version does not exist.
+func getSurveyVersion(q sqlx.Queryer, surveyID int, version *int) (*SurveyVersion, error) {
+	var sv SurveyVersion
+	err := sqlx.Get(q, &sv, `
+		SELECT id, survey_id, version, created_at
+		FROM SURVEY_VERSIONS
+		WHERE survey_id = $1
+		  AND version = COALESCE($2, (SELECT MAX(version) FROM SURVEY_VERSIONS WHERE survey_id = $1))`,
+		surveyID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	sv.Questions = []SurveyQuestion{}
+	err = sqlx.Select(q, &sv.Questions, `
+		SELECT `+surveyQuestionColumns+`
+		FROM SURVEY_QUESTIONS
+		WHERE survey_version_id = $1
+		ORDER BY position`, sv.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	options := []questionOptionRow{}
+	err = sqlx.Select(q, &options, `
+		SELECT o.question_id, o.value, o.label
+		FROM SURVEY_QUESTION_OPTIONS o
+		JOIN SURVEY_QUESTIONS q ON q.id = o.question_id
+		WHERE q.survey_version_id = $1
+		ORDER BY o.question_id, o.position`, sv.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int]*SurveyQuestion, len(sv.Questions))
+	for i := range sv.Questions {
+		question := &sv.Questions[i]
+		byID[question.ID] = question
+		if question.ShowIfQuestionKey != nil {
+			question.ShowIf = &ShowIf{QuestionKey: *question.ShowIfQuestionKey, Values: question.ShowIfValues}
+		}
+	}
+	for _, option := range options {
+		if question, ok := byID[option.QuestionID]; ok {
+			question.Options = append(question.Options, option.QuestionOption)
+		}
+	}
+	return &sv, nil
+}
+
+func handleCreateSurveyVersion(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		surveyID, err := pathID(r, "id")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req SurveyVersionRequest
+		if err := decodeJSON(r, &req); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		questions, err := req.validate()
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx, err := db.Beginx()
+		if err != nil {
+			log.Printf("Failed to begin transaction: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to create survey version")
+			return
+		}
+		defer tx.Rollback()
+
+		// Lock the survey row so concurrent publishes get consecutive version numbers
+		var lockedID int
+		err = tx.Get(&lockedID, `SELECT id FROM SURVEYS WHERE id = $1 FOR UPDATE`, surveyID)
+		if errors.Is(err, sql.ErrNoRows) {
+			writeError(w, http.StatusNotFound, "survey not found")
+			return
+		}
+		if err != nil {
+			log.Printf("Failed to lock survey: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to create survey version")
+			return
+		}
+
+		sv, err := insertSurveyVersion(tx, surveyID, questions)
+		if err != nil {
+			log.Printf("Failed to create survey version: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to create survey version")
+			return
+		}
+		if err := tx.Commit(); err != nil {
+			log.Printf("Failed to commit survey version: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to create survey version")
+			return
+		}
+
+		writeJSON(w, http.StatusCreated, sv)
+	}
+}
+
+// insertSurveyVersion stores validated questions as the survey's next version
+func insertSurveyVersion(tx *sqlx.Tx, surveyID int, questions []SurveyQuestion) (*SurveyVersion, error) {
+	sv := SurveyVersion{Questions: questions}
+	err := tx.Get(&sv, `
+		INSERT INTO SURVEY_VERSIONS (survey_id, version)
+		SELECT $1, COALESCE(MAX(version), 0) + 1 FROM SURVEY_VERSIONS WHERE survey_id = $1
+		RETURNING id, survey_id, version, created_at`, surveyID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range sv.Questions {
+		question := &sv.Questions[i]
+		err = tx.Get(&question.ID, `
+			INSERT INTO SURVEY_QUESTIONS (survey_version_id, question_key, position, question_type, prompt,
+				required, min_value, max_value, max_length, show_if_question_key, show_if_values)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+			RETURNING id`,
+			sv.ID, question.Key, question.Position, question.Type, question.Prompt,
+			question.Required, question.MinValue, question.MaxValue, question.MaxLength,
+			question.ShowIfQuestionKey, question.ShowIfValues)
+		if err != nil {
+			return nil, err
+		}
+
+		for j, option := range question.Options {
+			_, err = tx.Exec(`
+				INSERT INTO SURVEY_QUESTION_OPTIONS (question_id, value, label, position)
+				VALUES ($1, $2, $3, $4)`, question.ID, option.Value, option.Label, j+1)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &sv, nil
+}
+
+func handleGetSurveyVersion(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		surveyID, err := pathID(r, "id")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		version, err := pathID(r, "version")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		sv, err := getSurveyVersion(db, surveyID, &version)
+		if errors.Is(err, sql.ErrNoRows) {
+			writeError(w, http.StatusNotFound, "survey version not found")
+			return
+		}
+		if err != nil {
+			log.Printf("Failed to get survey version: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to get survey version")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, sv)
+	}
+}
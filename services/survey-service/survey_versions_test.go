@@ -0,0 +1,195 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var surveyRowColumns = []string{"id", "slug", "title", "description", "created_at", "updated_at", "current_version"}
+
+var questionRowColumns = []string{"id", "question_key", "position", "question_type", "prompt", "required",
+	"min_value", "max_value", "max_length", "show_if_question_key", "show_if_values"}
+
+func TestSurveyVersionRequest_Validate(t *testing.T) {
+	exercise := `{"key":"exercises","type":"SINGLE_CHOICE","prompt":"Do you exercise?","options":[{"value":"yes","label":"Yes"},{"value":"no","label":"No"}]}`
+
+	tests := []struct {
+		name    string
+		body    string
+		wantErr string
+	}{
+		{name: "no questions", body: `{"questions":[]}`, wantErr: "questions are required"},
+		{name: "invalid key", body: `{"questions":[{"key":"Days Per Week","type":"NUMERIC","prompt":"Days?"}]}`, wantErr: "invalid key"},
+		{name: "duplicate key", body: `{"questions":[` + exercise + `,` + exercise + `]}`, wantErr: `questions[1]: duplicate key "exercises"`},
+		{name: "unknown type", body: `{"questions":[{"key":"q","type":"DATE","prompt":"When?"}]}`, wantErr: "invalid type"},
+		{name: "missing prompt", body: `{"questions":[{"key":"q","type":"FREE_TEXT","prompt":" "}]}`, wantErr: "prompt is required"},
+		{name: "choice with one option", body: `{"questions":[{"key":"q","type":"SINGLE_CHOICE","prompt":"?","options":[{"value":"a","label":"A"}]}]}`, wantErr: "at least 2"},
+		{name: "duplicate option value", body: `{"questions":[{"key":"q","type":"MULTI_CHOICE","prompt":"?","options":[{"value":"a","label":"A"},{"value":"a","label":"B"}]}]}`, wantErr: `duplicate value "a"`},
+		{name: "choice with range", body: `{"questions":[{"key":"q","type":"SINGLE_CHOICE","prompt":"?","minValue":1,"options":[{"value":"a","label":"A"},{"value":"b","label":"B"}]}]}`, wantErr: "do not apply"},
+		{name: "numeric min above max", body: `{"questions":[{"key":"q","type":"NUMERIC","prompt":"?","minValue":7,"maxValue":0}]}`, wantErr: "minValue must not exceed maxValue"},
+		{name: "scale without bounds", body: `{"questions":[{"key":"q","type":"SCALE","prompt":"?","minValue":1}]}`, wantErr: "minValue and maxValue are required"},
+		{name: "scale with fractions", body: `{"questions":[{"key":"q","type":"SCALE","prompt":"?","minValue":1,"maxValue":4.5}]}`, wantErr: "whole numbers"},
+		{name: "scale too wide", body: `{"questions":[{"key":"q","type":"SCALE","prompt":"?","minValue":0,"maxValue":100}]}`, wantErr: "between 2 and 11 steps"},
+		{name: "free text too long", body: `{"questions":[{"key":"q","type":"FREE_TEXT","prompt":"?","maxLength":5000}]}`, wantErr: "maxLength must be between 1 and 2000"},
+		{name: "showIf on later question", body: `{"questions":[{"key":"days","type":"NUMERIC","prompt":"?","showIf":{"questionKey":"exercises","values":["yes"]}},` + exercise + `]}`, wantErr: "not an earlier question"},
+		{name: "showIf on numeric question", body: `{"questions":[{"key":"days","type":"NUMERIC","prompt":"?"},{"key":"q","type":"FREE_TEXT","prompt":"?","showIf":{"questionKey":"days","values":["3"]}}]}`, wantErr: "not a choice question"},
+		{name: "showIf with unknown value", body: `{"questions":[` + exercise + `,{"key":"days","type":"NUMERIC","prompt":"?","showIf":{"questionKey":"exercises","values":["sometimes"]}}]}`, wantErr: `"sometimes" is not an option`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req SurveyVersionRequest
+			require.NoError(t, json.Unmarshal([]byte(tt.body), &req))
+
+			_, err := req.validate()
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestSurveyVersionRequest_Validate_BuildsQuestions(t *testing.T) {
+	var req SurveyVersionRequest
+	require.NoError(t, json.Unmarshal([]byte(`{"questions":[
+		{"key":"exercises","type":"SINGLE_CHOICE","prompt":"Do you exercise?","options":[{"value":"yes","label":"Yes"},{"value":"no","label":"No"}]},
+		{"key":"days_per_week","type":"NUMERIC","prompt":"How many days a week?","minValue":1,"maxValue":7,"showIf":{"questionKey":"exercises","values":["yes"]}},
+		{"key":"notes","type":"FREE_TEXT","prompt":"Anything else?","required":false}
+	]}`), &req))
+
+	questions, err := req.validate()
+
+	require.NoError(t, err)
+	require.Len(t, questions, 3)
+	assert.Equal(t, 2, questions[1].Position)
+	assert.True(t, questions[1].Required)
+	require.NotNil(t, questions[1].ShowIfQuestionKey)
+	assert.Equal(t, "exercises", *questions[1].ShowIfQuestionKey)
+	assert.Equal(t, []string{"yes"}, []string(questions[1].ShowIfValues))
+	assert.False(t, questions[2].Required)
+	assert.Nil(t, questions[2].ShowIfValues)
+}
+
+func TestHandleGetSurvey_IncludesCurrentQuestions(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	now := time.Now()
+
+	// Setup mock expectations
+	mock.ExpectQuery(`SELECT .+ FROM SURVEYS s WHERE s.id = \$1`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(surveyRowColumns).
+			AddRow(1, "activity-level", "Activity Level", nil, now, now, 2))
+	mock.ExpectQuery(`SELECT id, survey_id, version, created_at\s+FROM SURVEY_VERSIONS`).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "survey_id", "version", "created_at"}).AddRow(5, 1, 2, now))
+	mock.ExpectQuery(`FROM SURVEY_QUESTIONS\s+WHERE survey_version_id = \$1`).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows(questionRowColumns).
+			AddRow(10, "exercises", 1, "SINGLE_CHOICE", "Do you exercise?", true, nil, nil, nil, nil, nil).
+			AddRow(11, "days_per_week", 2, "NUMERIC", "How many days a week?", true, 1.0, 7.0, nil, "exercises", "{yes}"))
+	mock.ExpectQuery(`FROM SURVEY_QUESTION_OPTIONS o`).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"question_id", "value", "label"}).
+			AddRow(10, "yes", "Yes").
+			AddRow(10, "no", "No"))
+
+	// Execute
+	rec := serve("/surveys/{id}", handleGetSurvey(db), httptest.NewRequest("GET", "/surveys/1", nil))
+
+	// Assert
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var detail SurveyDetail
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &detail))
+	require.NotNil(t, detail.CurrentVersion)
+	assert.Equal(t, 2, *detail.CurrentVersion)
+	require.Len(t, detail.Questions, 2)
+	assert.Len(t, detail.Questions[0].Options, 2)
+	require.NotNil(t, detail.Questions[1].ShowIf)
+	assert.Equal(t, ShowIf{QuestionKey: "exercises", Values: []string{"yes"}}, *detail.Questions[1].ShowIf)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHandleCreateSurvey_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr string
+	}{
+		{name: "missing slug", body: `{"title":"Activity Level"}`, wantErr: "slug is required"},
+		{name: "invalid slug", body: `{"slug":"Activity Level","title":"Activity Level"}`, wantErr: "invalid slug"},
+		{name: "missing title", body: `{"slug":"activity-level"}`, wantErr: "title is required"},
+		{name: "title too long", body: `{"slug":"activity-level","title":"` + strings.Repeat("x", 101) + `"}`, wantErr: "at most 100 characters"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := setupTestDB(t)
+			defer db.Close()
+
+			rec := serve("/surveys", handleCreateSurvey(db), httptest.NewRequest("POST", "/surveys", strings.NewReader(tt.body)))
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestHandleCreateSurveyVersion(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	body := `{"questions":[
+		{"key":"exercises","type":"SINGLE_CHOICE","prompt":"Do you exercise?","options":[{"value":"yes","label":"Yes"},{"value":"no","label":"No"}]},
+		{"key":"days_per_week","type":"NUMERIC","prompt":"How many days a week?","minValue":1,"maxValue":7,"showIf":{"questionKey":"exercises","values":["yes"]}}
+	]}`
+
+	// Setup mock expectations
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM SURVEYS WHERE id = \$1 FOR UPDATE`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`INSERT INTO SURVEY_VERSIONS .+ COALESCE\(MAX\(version\), 0\) \+ 1`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "survey_id", "version", "created_at"}).AddRow(6, 1, 3, time.Now()))
+	mock.ExpectQuery(`INSERT INTO SURVEY_QUESTIONS`).
+		WithArgs(6, "exercises", 1, "SINGLE_CHOICE", "Do you exercise?", true, nil, nil, nil, nil, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(20))
+	mock.ExpectExec(`INSERT INTO SURVEY_QUESTION_OPTIONS`).
+		WithArgs(20, "yes", "Yes", 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`INSERT INTO SURVEY_QUESTION_OPTIONS`).
+		WithArgs(20, "no", "No", 2).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectQuery(`INSERT INTO SURVEY_QUESTIONS`).
+		WithArgs(6, "days_per_week", 2, "NUMERIC", "How many days a week?", true, 1.0, 7.0, nil, "exercises", "{\"yes\"}").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(21))
+	mock.ExpectCommit()
+
+	// Execute
+	rec := serve("/surveys/{id}/versions", handleCreateSurveyVersion(db),
+		httptest.NewRequest("POST", "/surveys/1/versions", strings.NewReader(body)))
+
+	// Assert
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	var sv SurveyVersion
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &sv))
+	assert.Equal(t, 3, sv.Version)
+	require.Len(t, sv.Questions, 2)
+	assert.Equal(t, 21, sv.Questions[1].ID)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,247 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Column limits from the SURVEYS table
+const (
+	maxSurveySlugLength        = 50
+	maxSurveyTitleLength       = 100
+	maxSurveyDescriptionLength = 500
+)
+
+// surveySlugPattern allows lowercase words separated by single hyphens
+var surveySlugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// Survey represents a questionnaire from the SURVEYS table
+type Survey struct {
+	ID             int       `db:"id" json:"id"`
+	Slug           string    `db:"slug" json:"slug"`
+	Title          string    `db:"title" json:"title"`
+	Description    *string   `db:"description" json:"description,omitempty"`
+	CurrentVersion *int      `db:"current_version" json:"currentVersion,omitempty"`
+	CreatedAt      time.Time `db:"created_at" json:"createdAt"`
+	UpdatedAt      time.Time `db:"updated_at" json:"updatedAt"`
+}
+
+// SurveyDetail is a survey with the questions of its current version
+type SurveyDetail struct {
+	Survey
+	Questions []SurveyQuestion `json:"questions"`
+}
+
+// SurveyRequest is the body for creating or updating a survey
+type SurveyRequest struct {
+	Slug        string  `json:"slug"`
+	Title       string  `json:"title"`
+	Description *string `json:"description"`
+}
+
+// surveyColumns selects a Survey from SURVEYS s, with the highest version as current
+const surveyColumns = `s.id, s.slug, s.title, s.description, s.created_at, s.updated_at,
+	(SELECT MAX(v.version) FROM SURVEY_VERSIONS v WHERE v.survey_id = s.id) AS current_version`
+
+// validate checks a survey request against the SURVEYS table constraints
+func (req *SurveyRequest) validate() error {
+	req.Slug = strings.TrimSpace(req.Slug)
+	req.Title = strings.TrimSpace(req.Title)
+	if req.Slug == "" {
+		return fmt.Errorf("slug is required")
+	}
+	if len(req.Slug) > maxSurveySlugLength || !surveySlugPattern.MatchString(req.Slug) {
+		return fmt.Errorf("invalid slug: must be at most %d lowercase letters, digits and hyphens", maxSurveySlugLength)
+	}
+	if req.Title == "" {
+		return fmt.Errorf("title is required")
+	}
+	if len(req.Title) > maxSurveyTitleLength {
+		return fmt.Errorf("invalid title: must be at most %d characters", maxSurveyTitleLength)
+	}
+	if req.Description != nil && len(*req.Description) > maxSurveyDescriptionLength {
+		return fmt.Errorf("invalid description: must be at most %d characters", maxSurveyDescriptionLength)
+	}
+	return nil
+}
+
+func handleGetSurveys(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		surveys := []Survey{}
+		err := db.Select(&surveys, `SELECT `+surveyColumns+` FROM SURVEYS s ORDER BY s.id`)
+		if err != nil {
+			log.Printf("Failed to list surveys: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to list surveys")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, surveys)
+	}
+}
+
+func handleCreateSurvey(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SurveyRequest
+		if err := decodeJSON(r, &req); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if err := req.validate(); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// A new survey has no versions; questions are added with POST /surveys/{id}/versions
+		var survey Survey
+		err := db.Get(&survey, `
+			WITH s AS (
+				INSERT INTO SURVEYS (slug, title, description)
+				VALUES ($1, $2, $3)
+				RETURNING *
+			)
+			SELECT `+surveyColumns+` FROM s`,
+			req.Slug, req.Title, req.Description)
+		if isUniqueViolation(err) {
+			writeError(w, http.StatusConflict, "survey slug already exists")
+			return
+		}
+		if err != nil {
+			log.Printf("Failed to create survey: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to create survey")
+			return
+		}
+
+		writeJSON(w, http.StatusCreated, survey)
+	}
+}
+
+func handleGetSurvey(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := pathID(r, "id")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var detail SurveyDetail
+		err = db.Get(&detail.Survey, `SELECT `+surveyColumns+` FROM SURVEYS s WHERE s.id = $1`, id)
+		if errors.Is(err, sql.ErrNoRows) {
+			writeError(w, http.StatusNotFound, "survey not found")
+			return
+		}
+		if err != nil {
+			log.Printf("Failed to get survey: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to get survey")
+			return
+		}
+
+		detail.Questions = []SurveyQuestion{}
+		if detail.CurrentVersion != nil {
+			version, err := getSurveyVersion(db, id, detail.CurrentVersion)
+			if err != nil {
+				log.Printf("Failed to get survey questions: %v", err)
+				writeError(w, http.StatusInternalServerError, "Failed to get survey")
+				return
+			}
+			detail.Questions = version.Questions
+		}
+
+		writeJSON(w, http.StatusOK, detail)
+	}
+}
+
+func handleUpdateSurvey(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := pathID(r, "id")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req SurveyRequest
+		if err := decodeJSON(r, &req); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if err := req.validate(); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Questions are versioned separately and are not changed here
+		var survey Survey
+		err = db.Get(&survey, `
+			WITH s AS (
+				UPDATE SURVEYS
+				SET slug = $1, title = $2, description = $3, updated_at = CURRENT_TIMESTAMP
+				WHERE id = $4
+				RETURNING *
+			)
+			SELECT `+surveyColumns+` FROM s`,
+			req.Slug, req.Title, req.Description, id)
+		if errors.Is(err, sql.ErrNoRows) {
+			writeError(w, http.StatusNotFound, "survey not found")
+			return
+		}
+		if isUniqueViolation(err) {
+			writeError(w, http.StatusConflict, "survey slug already exists")
+			return
+		}
+		if err != nil {
+			log.Printf("Failed to update survey: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to update survey")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, survey)
+	}
+}
+
+func handleDeleteSurvey(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := pathID(r, "id")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Versions and questions cascade, but SURVEY_RESPONSES blocks deleting answered versions
+		result, err := db.Exec(`DELETE FROM SURVEYS WHERE id = $1`, id)
+		if isForeignKeyViolation(err) {
+			writeError(w, http.StatusConflict, "survey has responses and cannot be deleted")
+			return
+		}
+		if err != nil {
+			log.Printf("Failed to delete survey: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to delete survey")
+			return
+		}
+		if rows, _ := result.RowsAffected(); rows == 0 {
+			writeError(w, http.StatusNotFound, "survey not found")
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// isForeignKeyViolation reports whether err is a Postgres foreign key violation
+func isForeignKeyViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23503"
+}
+
+// isUniqueViolation reports whether err is a Postgres unique constraint violation
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// Activity file formats, stored as the workout source
+const (
+	sourceManual = "MANUAL"
+	sourceGPX    = "GPX"
+	sourceTCX    = "TCX"
+	sourceFIT    = "FIT"
+)
+
+// elevationNoiseMeters is the rise an elevation track must show before it
+// counts as climbing; smaller wobbles are GPS and barometer noise
+const elevationNoiseMeters = 3
+
+// earthRadiusMeters is the mean Earth radius used for track distances
+const earthRadiusMeters = 6371000
+
+// trackPoint is one sample of a recorded activity. Optional values are nil
+// when the device did not record them.
+type trackPoint struct {
+	Time           time.Time
+	Lat, Lon       *float64
+	ElevationM     *float64
+	DistanceMeters *float64 // cumulative, as measured by the device
+	HeartRate      int      // 0 when not recorded
+}
+
+// HeartRateSample is the heart rate at a point of a workout
+type HeartRateSample struct {
+	ElapsedSeconds int `db:"elapsed_seconds" json:"elapsedSeconds"`
+	HeartRate      int `db:"heart_rate" json:"heartRate"`
+}
+
+// Activity is an endurance effort parsed from a GPX, TCX or FIT file
+type Activity struct {
+	Format              string
+	Sport               string // normalized; empty when the file does not say
+	StartedAt           time.Time
+	DurationSeconds     int
+	DistanceMeters      *float64
+	ElevationGainMeters *float64
+	CaloriesBurned      *float64
+	AvgHeartRate        *int
+	MaxHeartRate        *int
+	HeartRate           []HeartRateSample
+}
+
+// parseActivity detects the format of an activity file and parses it
+func parseActivity(data []byte) (*Activity, error) {
+	var activity *Activity
+	var err error
+	switch {
+	case isFIT(data):
+		activity, err = parseFIT(data)
+	case bytes.Contains(firstBytes(data, 1024), []byte("<TrainingCenterDatabase")):
+		activity, err = parseTCX(data)
+	case bytes.Contains(firstBytes(data, 1024), []byte("<gpx")):
+		activity, err = parseGPX(data)
+	default:
+		return nil, fmt.Errorf("unsupported file format: expected GPX, TCX or FIT")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if activity.StartedAt.IsZero() || activity.DurationSeconds < 1 {
+		return nil, fmt.Errorf("no timed track points in %s file", activity.Format)
+	}
+	return activity, nil
+}
+
+func firstBytes(data []byte, n int) []byte {
+	if len(data) < n {
+		return data
+	}
+	return data[:n]
+}
+
+// summarize builds an activity from its track points. Distance comes from the
+// device when it recorded one, otherwise from the positions.
+func summarize(format, sport string, points []trackPoint) *Activity {
+	activity := &Activity{Format: format, Sport: normalizeSport(sport)}
+	if len(points) == 0 {
+		return activity
+	}
+
+	start, end := points[0].Time, points[len(points)-1].Time
+	activity.StartedAt = start.UTC()
+	activity.DurationSeconds = int(end.Sub(start).Seconds())
+
+	var measured, traced float64
+	var hasMeasured, hasTraced bool
+	var climb, low float64
+	var hasElevation bool
+	var prev *trackPoint
+	for i := range points {
+		point := &points[i]
+		if point.DistanceMeters != nil {
+			measured, hasMeasured = math.Max(measured, *point.DistanceMeters), true
+		}
+		if prev != nil && prev.Lat != nil && point.Lat != nil {
+			traced += haversine(*prev.Lat, *prev.Lon, *point.Lat, *point.Lon)
+			hasTraced = true
+		}
+		if point.ElevationM != nil {
+			elevation := *point.ElevationM
+			switch {
+			case !hasElevation:
+				low, hasElevation = elevation, true
+			case elevation > low+elevationNoiseMeters:
+				climb += elevation - low
+				low = elevation
+			case elevation < low:
+				low = elevation
+			}
+		}
+		if point.Lat != nil {
+			prev = point
+		}
+	}
+
+	switch {
+	case hasMeasured && measured > 0:
+		activity.DistanceMeters = roundTenth(measured)
+	case hasTraced && traced > 0:
+		activity.DistanceMeters = roundTenth(traced)
+	}
+	if hasElevation {
+		activity.ElevationGainMeters = roundTenth(climb)
+	}
+	activity.HeartRate = heartRateSamples(start, points)
+	activity.AvgHeartRate, activity.MaxHeartRate = heartRateSummary(activity.HeartRate)
+	return activity
+}
+
+// heartRateSamples keeps one in-range sample per elapsed second
+func heartRateSamples(start time.Time, points []trackPoint) []HeartRateSample {
+	samples := []HeartRateSample{}
+	last := -1
+	for _, point := range points {
+		elapsed := int(point.Time.Sub(start).Seconds())
+		if point.HeartRate < minHeartRate || point.HeartRate > maxHeartRate || elapsed <= last {
+			continue
+		}
+		samples = append(samples, HeartRateSample{ElapsedSeconds: elapsed, HeartRate: point.HeartRate})
+		last = elapsed
+	}
+	return samples
+}
+
+// heartRateSummary returns the average and maximum of the samples
+func heartRateSummary(samples []HeartRateSample) (*int, *int) {
+	if len(samples) == 0 {
+		return nil, nil
+	}
+	sum, max := 0, 0
+	for _, sample := range samples {
+		sum += sample.HeartRate
+		if sample.HeartRate > max {
+			max = sample.HeartRate
+		}
+	}
+	avg := int(math.Round(float64(sum) / float64(len(samples))))
+	return &avg, &max
+}
+
+// normalizeSport maps the sport names used by GPX, TCX and FIT files to the
+// keys of sportExercises
+func normalizeSport(sport string) string {
+	sport = strings.ToLower(strings.TrimSpace(sport))
+	switch {
+	case strings.Contains(sport, "run"):
+		return "running"
+	case strings.Contains(sport, "bik") || strings.Contains(sport, "cycl") || strings.Contains(sport, "ride"):
+		return "cycling"
+	case strings.Contains(sport, "walk"):
+		return "walking"
+	case strings.Contains(sport, "hik"):
+		return "hiking"
+	case strings.Contains(sport, "swim"):
+		return "swimming"
+	case strings.Contains(sport, "row"):
+		return "rowing"
+	}
+	return ""
+}
+
+// haversine returns the great-circle distance in meters between two points
+func haversine(lat1, lon1, lat2, lon2 float64) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dLat := toRad(lat2 - lat1)
+	dLon := toRad(lon2 - lon1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Sqrt(a))
+}
+
+func roundTenth(v float64) *float64 {
+	rounded := math.Round(v*10) / 10
+	return &rounded
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGPX = `<?xml version="1.0" encoding="UTF-8"?>
+<gpx version="1.1" creator="test" xmlns="http://www.topografix.com/GPX/1/1"
+     xmlns:gpxtpx="http://www.garmin.com/xmlschemas/TrackPointExtension/v1">
+  <trk>
+    <type>running</type>
+    <trkseg>
+      <trkpt lat="52.5200" lon="13.4050"><ele>34.0</ele><time>2025-03-08T09:00:00Z</time>
+        <extensions><gpxtpx:TrackPointExtension><gpxtpx:hr>120</gpxtpx:hr></gpxtpx:TrackPointExtension></extensions>
+      </trkpt>
+      <trkpt lat="52.5290" lon="13.4050"><ele>36.0</ele><time>2025-03-08T09:05:00Z</time>
+        <extensions><gpxtpx:TrackPointExtension><gpxtpx:hr>150</gpxtpx:hr></gpxtpx:TrackPointExtension></extensions>
+      </trkpt>
+      <trkpt lat="52.5380" lon="13.4050"><ele>44.0</ele><time>2025-03-08T09:10:00Z</time>
+        <extensions><gpxtpx:TrackPointExtension><gpxtpx:hr>160</gpxtpx:hr></gpxtpx:TrackPointExtension></extensions>
+      </trkpt>
+    </trkseg>
+  </trk>
+</gpx>`
+
+const testTCX = `<?xml version="1.0" encoding="UTF-8"?>
+<TrainingCenterDatabase xmlns="http://www.garmin.com/xmlschemas/TrainingCenterDatabase/v2">
+  <Activities>
+    <Activity Sport="Biking">
+      <Id>2025-03-08T09:00:00Z</Id>
+      <Lap StartTime="2025-03-08T09:00:00Z">
+        <TotalTimeSeconds>1750</TotalTimeSeconds>
+        <DistanceMeters>15000</DistanceMeters>
+        <Calories>410</Calories>
+        <Track>
+          <Trackpoint><Time>2025-03-08T09:00:00Z</Time><AltitudeMeters>100</AltitudeMeters><DistanceMeters>0</DistanceMeters><HeartRateBpm><Value>110</Value></HeartRateBpm></Trackpoint>
+          <Trackpoint><Time>2025-03-08T09:30:00Z</Time><AltitudeMeters>150</AltitudeMeters><DistanceMeters>15000</DistanceMeters><HeartRateBpm><Value>140</Value></HeartRateBpm></Trackpoint>
+        </Track>
+      </Lap>
+    </Activity>
+  </Activities>
+</TrainingCenterDatabase>`
+
+// fitWriter builds FIT files for tests
+type fitWriter struct {
+	buf bytes.Buffer
+}
+
+// define writes a little-endian definition message with the given field sizes
+// and one developer field of devSize bytes when devSize is positive
+func (f *fitWriter) define(local byte, global uint16, fields [][2]byte, devSize byte) {
+	header := 0x40 | local
+	if devSize > 0 {
+		header |= 0x20
+	}
+	f.buf.WriteByte(header)
+	f.buf.Write([]byte{0, 0})
+	binary.Write(&f.buf, binary.LittleEndian, global)
+	f.buf.WriteByte(byte(len(fields)))
+	for _, field := range fields {
+		f.buf.Write([]byte{field[0], field[1], 0})
+	}
+	if devSize > 0 {
+		f.buf.Write([]byte{1, 0, devSize, 0})
+	}
+}
+
+// data writes a data message; values are written with their field size
+func (f *fitWriter) data(header byte, values ...interface{}) {
+	f.buf.WriteByte(header)
+	for _, v := range values {
+		binary.Write(&f.buf, binary.LittleEndian, v)
+	}
+}
+
+// bytes returns the file with its header and CRC
+func (f *fitWriter) bytes() []byte {
+	var file bytes.Buffer
+	file.Write([]byte{12, 0x10, 0, 0})
+	binary.Write(&file, binary.LittleEndian, uint32(f.buf.Len()))
+	file.WriteString(".FIT")
+	file.Write(f.buf.Bytes())
+	binary.Write(&file, binary.LittleEndian, fitCRC(file.Bytes()))
+	return file.Bytes()
+}
+
+func fitTimestamp(t time.Time) uint32 {
+	return uint32(t.Sub(fitEpoch).Seconds())
+}
+
+func testFIT(start time.Time) []byte {
+	var f fitWriter
+	// Record: timestamp, heart rate, distance, enhanced altitude, plus a developer field
+	f.define(0, fitMesgRecord, [][2]byte{{253, 4}, {3, 1}, {5, 4}, {78, 4}}, 2)
+	f.data(0x00, fitTimestamp(start), uint8(130), uint32(0), uint32((100+500)*5), uint16(7))
+	f.data(0x00, fitTimestamp(start.Add(10*time.Second)), uint8(140), uint32(3000), uint32((110+500)*5), uint16(7))
+	// Compressed timestamp header: local type 1, 5 seconds after the last record
+	f.define(1, fitMesgRecord, [][2]byte{{3, 1}, {5, 4}}, 0)
+	offset := byte((fitTimestamp(start) + 15) & 0x1F)
+	f.data(0x80|1<<5|offset, uint8(255), uint32(4500))
+	// Session: start time, sport, timer time, distance, calories, ascent
+	f.define(2, fitMesgSession, [][2]byte{{2, 4}, {5, 1}, {8, 4}, {9, 4}, {11, 2}, {22, 2}}, 0)
+	f.data(0x02, fitTimestamp(start), uint8(1), uint32(15000), uint32(4500), uint16(25), uint16(12))
+	return f.bytes()
+}
+
+func TestParseActivity_GPX(t *testing.T) {
+	activity, err := parseActivity([]byte(testGPX))
+
+	require.NoError(t, err)
+	assert.Equal(t, sourceGPX, activity.Format)
+	assert.Equal(t, "running", activity.Sport)
+	assert.Equal(t, time.Date(2025, 3, 8, 9, 0, 0, 0, time.UTC), activity.StartedAt)
+	assert.Equal(t, 600, activity.DurationSeconds)
+	// 0.018 degrees of latitude
+	assert.InDelta(t, 2001.5, *activity.DistanceMeters, 1)
+	// The 2 m rise is noise; the climb to 44 m counts from the 34 m low
+	assert.Equal(t, 10.0, *activity.ElevationGainMeters)
+	assert.Nil(t, activity.CaloriesBurned)
+	assert.Equal(t, []HeartRateSample{{0, 120}, {300, 150}, {600, 160}}, activity.HeartRate)
+	assert.Equal(t, 143, *activity.AvgHeartRate)
+	assert.Equal(t, 160, *activity.MaxHeartRate)
+}
+
+func TestParseActivity_TCX(t *testing.T) {
+	activity, err := parseActivity([]byte(testTCX))
+
+	require.NoError(t, err)
+	assert.Equal(t, sourceTCX, activity.Format)
+	assert.Equal(t, "cycling", activity.Sport)
+	// Lap totals are moving time, not elapsed time
+	assert.Equal(t, 1750, activity.DurationSeconds)
+	assert.Equal(t, 15000.0, *activity.DistanceMeters)
+	assert.Equal(t, 50.0, *activity.ElevationGainMeters)
+	assert.Equal(t, 410.0, *activity.CaloriesBurned)
+	assert.Len(t, activity.HeartRate, 2)
+}
+
+func TestParseActivity_FIT(t *testing.T) {
+	start := time.Date(2025, 3, 8, 9, 0, 0, 0, time.UTC)
+
+	activity, err := parseActivity(testFIT(start))
+
+	require.NoError(t, err)
+	assert.Equal(t, sourceFIT, activity.Format)
+	assert.Equal(t, "running", activity.Sport)
+	assert.Equal(t, start, activity.StartedAt)
+	assert.Equal(t, 15, activity.DurationSeconds)
+	assert.Equal(t, 45.0, *activity.DistanceMeters)
+	// The session's ascent overrides the 10 m derived from the records
+	assert.Equal(t, 12.0, *activity.ElevationGainMeters)
+	assert.Equal(t, 25.0, *activity.CaloriesBurned)
+	// The compressed-timestamp record has an invalid heart rate and is left out of the samples
+	assert.Equal(t, []HeartRateSample{{0, 130}, {10, 140}}, activity.HeartRate)
+}
+
+func TestParseActivity_Errors(t *testing.T) {
+	corrupt := testFIT(time.Date(2025, 3, 8, 9, 0, 0, 0, time.UTC))
+	corrupt[20] ^= 0xFF
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{name: "unknown format", data: "date,distance\n", wantErr: "unsupported file format"},
+		{name: "malformed GPX", data: `<gpx><trk>`, wantErr: "invalid GPX file"},
+		{name: "route without times", data: `<gpx><trk><trkseg><trkpt lat="1" lon="2"/></trkseg></trk></gpx>`, wantErr: "no timed track points in GPX file"},
+		{name: "TCX without activity", data: `<TrainingCenterDatabase></TrainingCenterDatabase>`, wantErr: "no activity"},
+		{name: "corrupt FIT", data: string(corrupt), wantErr: "checksum mismatch"},
+		{name: "truncated FIT", data: string(corrupt[:30]), wantErr: "truncated"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseActivity([]byte(tt.data))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestNormalizeSport(t *testing.T) {
+	assert.Equal(t, "running", normalizeSport("Running"))
+	assert.Equal(t, "running", normalizeSport("trail_running"))
+	assert.Equal(t, "cycling", normalizeSport("Biking"))
+	assert.Equal(t, "cycling", normalizeSport("VirtualRide"))
+	assert.Equal(t, "", normalizeSport("Other"))
+}
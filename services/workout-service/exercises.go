@@ -0,0 +1,165 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Exercise types from the exercise_type enum
+const (
+	exerciseStrength  = "STRENGTH"
+	exerciseEndurance = "ENDURANCE"
+)
+
+// maxExerciseNameLength is the EXERCISES.name column size
+const maxExerciseNameLength = 100
+
+// Exercise is a built-in or custom exercise from the EXERCISES table
+type Exercise struct {
+	ID           int    `db:"id" json:"id"`
+	Name         string `db:"name" json:"name"`
+	ExerciseType string `db:"exercise_type" json:"exerciseType"`
+	Custom       bool   `db:"custom" json:"custom"`
+}
+
+// ExerciseRequest is the body for creating a custom exercise
+type ExerciseRequest struct {
+	Name         string `json:"name"`
+	ExerciseType string `json:"exerciseType"`
+}
+
+// Users see the built-in exercises plus their own custom ones
+const exerciseColumns = `id, name, exercise_type, user_id IS NOT NULL AS custom`
+
+const visibleToUser = `(user_id IS NULL OR user_id = $1)`
+
+// validate trims the name and checks the request against the EXERCISES table constraints
+func (req *ExerciseRequest) validate() error {
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if len(req.Name) > maxExerciseNameLength {
+		return fmt.Errorf("invalid name: must be at most %d characters", maxExerciseNameLength)
+	}
+	req.ExerciseType = strings.ToUpper(strings.TrimSpace(req.ExerciseType))
+	if !validExerciseType(req.ExerciseType) {
+		return fmt.Errorf("invalid exerciseType: must be %s or %s", exerciseStrength, exerciseEndurance)
+	}
+	return nil
+}
+
+func validExerciseType(exerciseType string) bool {
+	return exerciseType == exerciseStrength || exerciseType == exerciseEndurance
+}
+
+func handleGetExercises(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := userIDFromRequest(r)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		query := `SELECT ` + exerciseColumns + ` FROM EXERCISES WHERE ` + visibleToUser
+		args := []interface{}{userID}
+		if exerciseType := strings.ToUpper(r.URL.Query().Get("type")); exerciseType != "" {
+			if !validExerciseType(exerciseType) {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid type: must be %s or %s", exerciseStrength, exerciseEndurance))
+				return
+			}
+			args = append(args, exerciseType)
+			query += ` AND exercise_type = $2`
+		}
+		query += ` ORDER BY name, id`
+
+		exercises := []Exercise{}
+		if err := db.Select(&exercises, query, args...); err != nil {
+			log.Printf("Failed to list exercises: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to list exercises")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, exercises)
+	}
+}
+
+func handleCreateExercise(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := userIDFromRequest(r)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		var req ExerciseRequest
+		if err := decodeJSON(r, &req); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if err := req.validate(); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Names must not clash with a built-in exercise or another of the user's own
+		var exercise Exercise
+		err = db.Get(&exercise, `
+			INSERT INTO EXERCISES (user_id, name, exercise_type)
+			SELECT $1, $2, $3
+			WHERE NOT EXISTS (SELECT 1 FROM EXERCISES WHERE lower(name) = lower($2) AND `+visibleToUser+`)
+			RETURNING `+exerciseColumns,
+			userID, req.Name, req.ExerciseType)
+		if errors.Is(err, sql.ErrNoRows) || isUniqueViolation(err) {
+			writeError(w, http.StatusConflict, fmt.Sprintf("exercise %q already exists", req.Name))
+			return
+		}
+		if isForeignKeyViolation(err) {
+			writeError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		if err != nil {
+			log.Printf("Failed to create exercise: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to create exercise")
+			return
+		}
+
+		writeJSON(w, http.StatusCreated, exercise)
+	}
+}
+
+// visibleExercises loads the exercises with the given IDs that the user can log sets against
+func visibleExercises(db sqlx.Queryer, userID int, ids []int64) (map[int]Exercise, error) {
+	var exercises []Exercise
+	err := sqlx.Select(db, &exercises,
+		`SELECT `+exerciseColumns+` FROM EXERCISES WHERE `+visibleToUser+` AND id = ANY($2)`,
+		userID, pq.Int64Array(ids))
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int]Exercise, len(exercises))
+	for _, exercise := range exercises {
+		byID[exercise.ID] = exercise
+	}
+	return byID, nil
+}
+
+// isForeignKeyViolation reports whether err is a Postgres foreign key violation
+func isForeignKeyViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23503"
+}
+
+// isUniqueViolation reports whether err is a Postgres unique violation
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleGetExercises_FiltersByType(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM EXERCISES WHERE \(user_id IS NULL OR user_id = \$1\) AND exercise_type = \$2 ORDER BY name, id`).
+		WithArgs(7, "ENDURANCE").
+		WillReturnRows(sqlmock.NewRows(exerciseRowColumns).
+			AddRow(24, "Running", "ENDURANCE", false).
+			AddRow(40, "Trail Running", "ENDURANCE", true))
+
+	req := httptest.NewRequest("GET", "/exercises?type=endurance", nil)
+	req.Header.Set(userIDHeader, "7")
+	rec := serve("/exercises", handleGetExercises(db), req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"custom":true`)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHandleCreateExercise(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		setupMock  func(mock sqlmock.Sqlmock)
+		wantStatus int
+		wantErr    string
+	}{
+		{
+			name: "creates custom exercise",
+			body: `{"name":" Trail Running ","exerciseType":"endurance"}`,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`INSERT INTO EXERCISES \(user_id, name, exercise_type\)\s+SELECT \$1, \$2, \$3\s+WHERE NOT EXISTS`).
+					WithArgs(7, "Trail Running", "ENDURANCE").
+					WillReturnRows(sqlmock.NewRows(exerciseRowColumns).AddRow(40, "Trail Running", "ENDURANCE", true))
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name: "name of a built-in exercise",
+			body: `{"name":"deadlift","exerciseType":"STRENGTH"}`,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`INSERT INTO EXERCISES`).
+					WillReturnRows(sqlmock.NewRows(exerciseRowColumns))
+			},
+			wantStatus: http.StatusConflict,
+			wantErr:    `exercise \"deadlift\" already exists`,
+		},
+		{
+			name: "concurrent duplicate",
+			body: `{"name":"Sled Push","exerciseType":"STRENGTH"}`,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`INSERT INTO EXERCISES`).
+					WillReturnError(&pq.Error{Code: "23505"})
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{name: "missing name", body: `{"name":"  ","exerciseType":"STRENGTH"}`, wantStatus: http.StatusBadRequest, wantErr: "name is required"},
+		{name: "name too long", body: `{"name":"` + strings.Repeat("x", 101) + `","exerciseType":"STRENGTH"}`, wantStatus: http.StatusBadRequest, wantErr: "invalid name"},
+		{name: "unknown type", body: `{"name":"Yoga","exerciseType":"FLEXIBILITY"}`, wantStatus: http.StatusBadRequest, wantErr: "invalid exerciseType"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := setupTestDB(t)
+			defer db.Close()
+			if tt.setupMock != nil {
+				tt.setupMock(mock)
+			}
+
+			req := httptest.NewRequest("POST", "/exercises", strings.NewReader(tt.body))
+			req.Header.Set(userIDHeader, "7")
+			rec := serve("/exercises", handleCreateExercise(db), req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantErr != "" {
+				assert.Contains(t, rec.Body.String(), tt.wantErr)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,332 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+)
+
+// FIT is Garmin's binary activity format. The decoder below reads only what an
+// endurance workout needs: the record messages (one per sample) and the
+// session summary. Other messages, developer fields and field types it does
+// not use are skipped by size.
+
+// FIT global message numbers
+const (
+	fitMesgSession = 18
+	fitMesgRecord  = 20
+)
+
+// FIT field numbers of the record and session messages
+const (
+	fitFieldTimestamp = 253
+
+	fitRecordLat              = 0
+	fitRecordLong             = 1
+	fitRecordAltitude         = 2
+	fitRecordHeartRate        = 3
+	fitRecordDistance         = 5
+	fitRecordEnhancedAltitude = 78
+
+	fitSessionStartTime        = 2
+	fitSessionSport            = 5
+	fitSessionTotalElapsedTime = 7
+	fitSessionTotalTimerTime   = 8
+	fitSessionTotalDistance    = 9
+	fitSessionTotalCalories    = 11
+	fitSessionAvgHeartRate     = 16
+	fitSessionMaxHeartRate     = 17
+	fitSessionTotalAscent      = 22
+)
+
+// fitEpoch is the zero of FIT timestamps, 1989-12-31T00:00:00Z
+var fitEpoch = time.Date(1989, 12, 31, 0, 0, 0, 0, time.UTC)
+
+// fitSports names the FIT sport enum values that map to a built-in exercise
+var fitSports = map[uint64]string{
+	1:  "running",
+	2:  "cycling",
+	5:  "swimming",
+	11: "walking",
+	15: "rowing",
+	17: "hiking",
+}
+
+// fitCRCTable is the nibble table of the CRC-16 used by FIT files
+var fitCRCTable = [16]uint16{
+	0x0000, 0xCC01, 0xD801, 0x1400, 0xF001, 0x3C00, 0x2800, 0xE401,
+	0xA001, 0x6C00, 0x7800, 0xB401, 0x5000, 0x9C01, 0x8801, 0x4400,
+}
+
+type fitField struct {
+	num  byte
+	size int
+}
+
+// fitDefinition describes the layout of the data messages of a local message type
+type fitDefinition struct {
+	global    uint16
+	bigEndian bool
+	fields    []fitField
+	devSize   int // total size of developer fields, which are skipped
+}
+
+// isFIT reports whether data starts with a FIT file header
+func isFIT(data []byte) bool {
+	return len(data) >= 12 && string(data[8:12]) == ".FIT"
+}
+
+// fitCRC computes the FIT CRC-16 of data
+func fitCRC(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		for _, nibble := range []byte{b & 0x0F, b >> 4} {
+			tmp := fitCRCTable[crc&0x0F]
+			crc = (crc >> 4) & 0x0FFF
+			crc = crc ^ tmp ^ fitCRCTable[nibble]
+		}
+	}
+	return crc
+}
+
+// parseFIT decodes the records and first session of a FIT activity file
+func parseFIT(data []byte) (*Activity, error) {
+	headerSize := int(data[0])
+	if headerSize < 12 || headerSize > len(data) {
+		return nil, fmt.Errorf("invalid FIT file: bad header size")
+	}
+	end := headerSize + int(binary.LittleEndian.Uint32(data[4:8]))
+	if end > len(data) {
+		return nil, fmt.Errorf("invalid FIT file: truncated")
+	}
+	if len(data) >= end+2 && fitCRC(data[:end]) != binary.LittleEndian.Uint16(data[end:end+2]) {
+		return nil, fmt.Errorf("invalid FIT file: checksum mismatch")
+	}
+
+	definitions := map[byte]*fitDefinition{}
+	var session map[byte]uint64
+	var points []trackPoint
+	var lastTimestamp uint32
+
+	pos := headerSize
+	for pos < end {
+		header := data[pos]
+		pos++
+
+		var local byte
+		var compressedTimestamp *uint32
+		switch {
+		case header&0x80 != 0:
+			// Compressed timestamp header: a 5-bit offset from the last full timestamp
+			local = (header >> 5) & 0x03
+			offset := uint32(header & 0x1F)
+			timestamp := lastTimestamp&^0x1F + offset
+			if offset < lastTimestamp&0x1F {
+				timestamp += 0x20
+			}
+			lastTimestamp = timestamp
+			compressedTimestamp = &timestamp
+		case header&0x40 != 0:
+			definition, next, err := readFITDefinition(data[:end], pos, header&0x20 != 0)
+			if err != nil {
+				return nil, err
+			}
+			definitions[header&0x0F] = definition
+			pos = next
+			continue
+		default:
+			local = header & 0x0F
+		}
+
+		definition := definitions[local]
+		if definition == nil {
+			return nil, fmt.Errorf("invalid FIT file: data message before its definition")
+		}
+		values, next, err := readFITData(data[:end], pos, definition)
+		if err != nil {
+			return nil, err
+		}
+		pos = next
+
+		if timestamp, ok := values[fitFieldTimestamp]; ok {
+			lastTimestamp = uint32(timestamp)
+		} else if compressedTimestamp != nil {
+			values[fitFieldTimestamp] = uint64(*compressedTimestamp)
+		}
+
+		switch definition.global {
+		case fitMesgRecord:
+			if point, ok := fitRecordPoint(values); ok {
+				points = append(points, point)
+			}
+		case fitMesgSession:
+			if session == nil {
+				session = values
+			}
+		}
+	}
+
+	activity := summarize(sourceFIT, fitSports[session[fitSessionSport]], points)
+	applyFITSession(activity, session)
+	return activity, nil
+}
+
+// readFITDefinition reads a definition message starting after its header byte
+func readFITDefinition(data []byte, pos int, developer bool) (*fitDefinition, int, error) {
+	if pos+5 > len(data) {
+		return nil, 0, fmt.Errorf("invalid FIT file: truncated definition")
+	}
+	definition := &fitDefinition{bigEndian: data[pos+1] == 1}
+	if definition.bigEndian {
+		definition.global = binary.BigEndian.Uint16(data[pos+2 : pos+4])
+	} else {
+		definition.global = binary.LittleEndian.Uint16(data[pos+2 : pos+4])
+	}
+	count := int(data[pos+4])
+	pos += 5
+	if pos+count*3 > len(data) {
+		return nil, 0, fmt.Errorf("invalid FIT file: truncated definition")
+	}
+	for i := 0; i < count; i++ {
+		definition.fields = append(definition.fields, fitField{num: data[pos], size: int(data[pos+1])})
+		pos += 3
+	}
+
+	if developer {
+		if pos >= len(data) {
+			return nil, 0, fmt.Errorf("invalid FIT file: truncated definition")
+		}
+		count = int(data[pos])
+		pos++
+		if pos+count*3 > len(data) {
+			return nil, 0, fmt.Errorf("invalid FIT file: truncated definition")
+		}
+		for i := 0; i < count; i++ {
+			definition.devSize += int(data[pos+1])
+			pos += 3
+		}
+	}
+	return definition, pos, nil
+}
+
+// readFITData reads the integer fields of a data message. Fields that hold the
+// invalid value (all bits set) or are not 1, 2, 4 or 8 bytes wide are left out.
+func readFITData(data []byte, pos int, definition *fitDefinition) (map[byte]uint64, int, error) {
+	var order binary.ByteOrder = binary.LittleEndian
+	if definition.bigEndian {
+		order = binary.BigEndian
+	}
+
+	values := map[byte]uint64{}
+	for _, field := range definition.fields {
+		if pos+field.size > len(data) {
+			return nil, 0, fmt.Errorf("invalid FIT file: truncated data message")
+		}
+		raw := data[pos : pos+field.size]
+		pos += field.size
+
+		var value, invalid uint64
+		switch field.size {
+		case 1:
+			value, invalid = uint64(raw[0]), math.MaxUint8
+		case 2:
+			value, invalid = uint64(order.Uint16(raw)), math.MaxUint16
+		case 4:
+			value, invalid = uint64(order.Uint32(raw)), math.MaxUint32
+		case 8:
+			value, invalid = order.Uint64(raw), math.MaxUint64
+		default:
+			continue
+		}
+		if value != invalid {
+			values[field.num] = value
+		}
+	}
+
+	pos += definition.devSize
+	if pos > len(data) {
+		return nil, 0, fmt.Errorf("invalid FIT file: truncated data message")
+	}
+	return values, pos, nil
+}
+
+// fitRecordPoint converts a record message to a track point
+func fitRecordPoint(values map[byte]uint64) (trackPoint, bool) {
+	timestamp, ok := values[fitFieldTimestamp]
+	if !ok {
+		return trackPoint{}, false
+	}
+	point := trackPoint{Time: fitTime(timestamp)}
+
+	lat, hasLat := fitSemicircles(values, fitRecordLat)
+	lon, hasLon := fitSemicircles(values, fitRecordLong)
+	if hasLat && hasLon {
+		point.Lat, point.Lon = &lat, &lon
+	}
+	if altitude, ok := values[fitRecordEnhancedAltitude]; ok {
+		point.ElevationM = fitAltitude(altitude)
+	} else if altitude, ok := values[fitRecordAltitude]; ok {
+		point.ElevationM = fitAltitude(altitude)
+	}
+	if distance, ok := values[fitRecordDistance]; ok {
+		meters := float64(distance) / 100
+		point.DistanceMeters = &meters
+	}
+	if heartRate, ok := values[fitRecordHeartRate]; ok {
+		point.HeartRate = int(heartRate)
+	}
+	return point, true
+}
+
+// applyFITSession overrides the values derived from the records with the
+// device's session totals
+func applyFITSession(activity *Activity, session map[byte]uint64) {
+	if start, ok := session[fitSessionStartTime]; ok {
+		activity.StartedAt = fitTime(start)
+	}
+	if timer, ok := session[fitSessionTotalTimerTime]; ok && timer >= 1000 {
+		activity.DurationSeconds = int((timer + 500) / 1000)
+	} else if elapsed, ok := session[fitSessionTotalElapsedTime]; ok && elapsed >= 1000 {
+		activity.DurationSeconds = int((elapsed + 500) / 1000)
+	}
+	if distance, ok := session[fitSessionTotalDistance]; ok && distance > 0 {
+		activity.DistanceMeters = roundTenth(float64(distance) / 100)
+	}
+	if ascent, ok := session[fitSessionTotalAscent]; ok {
+		activity.ElevationGainMeters = roundTenth(float64(ascent))
+	}
+	if calories, ok := session[fitSessionTotalCalories]; ok && calories > 0 {
+		activity.CaloriesBurned = roundTenth(float64(calories))
+	}
+	if len(activity.HeartRate) == 0 {
+		if avg, ok := session[fitSessionAvgHeartRate]; ok && avg >= minHeartRate && avg <= maxHeartRate {
+			v := int(avg)
+			activity.AvgHeartRate = &v
+		}
+		if max, ok := session[fitSessionMaxHeartRate]; ok && max >= minHeartRate && max <= maxHeartRate {
+			v := int(max)
+			activity.MaxHeartRate = &v
+		}
+	}
+}
+
+func fitTime(timestamp uint64) time.Time {
+	return fitEpoch.Add(time.Duration(timestamp) * time.Second)
+}
+
+// fitSemicircles converts a position field to degrees. Positions are signed,
+// so their invalid value is 0x7FFFFFFF rather than all bits set.
+func fitSemicircles(values map[byte]uint64, field byte) (float64, bool) {
+	value, ok := values[field]
+	if !ok || value == math.MaxInt32 {
+		return 0, false
+	}
+	return float64(int32(uint32(value))) * 180 / (1 << 31), true
+}
+
+// fitAltitude converts an altitude field (scale 5, offset 500 m) to meters
+func fitAltitude(value uint64) *float64 {
+	meters := float64(value)/5 - 500
+	return &meters
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+// gpxFile is the part of a GPX 1.1 document that describes a recorded track.
+// Heart rate comes from the Garmin TrackPointExtension used by most devices.
+type gpxFile struct {
+	Tracks []struct {
+		Type     string `xml:"type"`
+		Segments []struct {
+			Points []struct {
+				Lat       float64  `xml:"lat,attr"`
+				Lon       float64  `xml:"lon,attr"`
+				Elevation *float64 `xml:"ele"`
+				Time      string   `xml:"time"`
+				HeartRate int      `xml:"extensions>TrackPointExtension>hr"`
+			} `xml:"trkpt"`
+		} `xml:"trkseg"`
+	} `xml:"trk"`
+}
+
+// parseGPX parses the tracks of a GPX file as one activity. GPX records no
+// calories, so they are estimated by the caller.
+func parseGPX(data []byte) (*Activity, error) {
+	var file gpxFile
+	if err := xml.NewDecoder(bytes.NewReader(data)).Decode(&file); err != nil {
+		return nil, fmt.Errorf("invalid GPX file: %v", err)
+	}
+
+	var sport string
+	var points []trackPoint
+	for _, track := range file.Tracks {
+		if sport == "" {
+			sport = track.Type
+		}
+		for _, segment := range track.Segments {
+			for _, p := range segment.Points {
+				at, err := time.Parse(time.RFC3339, p.Time)
+				if err != nil {
+					// Points without a timestamp are route points, not a recording
+					continue
+				}
+				lat, lon := p.Lat, p.Lon
+				points = append(points, trackPoint{
+					Time:       at,
+					Lat:        &lat,
+					Lon:        &lon,
+					ElevationM: p.Elevation,
+					HeartRate:  p.HeartRate,
+				})
+			}
+		}
+	}
+	return summarize(sourceGPX, sport, points), nil
+}
@@ -0,0 +1,298 @@
+package main
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// maxActivityFileBytes caps the size of an uploaded activity file
+const maxActivityFileBytes = 25 << 20
+
+// sportExercise is the built-in exercise an activity's sport is logged as,
+// with the MET value used to estimate calories when the file has none
+type sportExercise struct {
+	Name string
+	MET  float64
+}
+
+// sportExercises maps normalized sports to built-in exercises. MET values are
+// moderate-effort figures from the Compendium of Physical Activities.
+var sportExercises = map[string]sportExercise{
+	"running":  {Name: "Running", MET: 9.8},
+	"cycling":  {Name: "Cycling", MET: 7.5},
+	"walking":  {Name: "Walking", MET: 3.5},
+	"hiking":   {Name: "Hiking", MET: 6.0},
+	"swimming": {Name: "Swimming", MET: 7.0},
+	"rowing":   {Name: "Rowing", MET: 7.0},
+}
+
+// WorkoutHeartRate is the response of the heart rate samples endpoint
+type WorkoutHeartRate struct {
+	WorkoutID int               `json:"workoutId"`
+	Samples   []HeartRateSample `json:"samples"`
+}
+
+// readActivityFile reads the uploaded file from a multipart form field named
+// "file" or, for any other content type, from the raw request body
+func readActivityFile(w http.ResponseWriter, r *http.Request) ([]byte, error) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxActivityFileBytes)
+	body := io.Reader(r.Body)
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("file is required")
+		}
+		defer file.Close()
+		body = file
+	}
+	return io.ReadAll(body)
+}
+
+// estimateCalories estimates the energy burned by an activity from the MET
+// value of its exercise and the user's weight. It returns nil when either is
+// unknown.
+func estimateCalories(exerciseName string, weightKg *float64, durationSeconds int) *float64 {
+	if weightKg == nil {
+		return nil
+	}
+	for _, sport := range sportExercises {
+		if sport.Name == exerciseName {
+			return roundTenth(sport.MET * *weightKg * float64(durationSeconds) / 3600)
+		}
+	}
+	return nil
+}
+
+// importExercise returns the exercise an activity is logged as: the one named
+// by the exerciseId parameter, or the built-in exercise for the file's sport.
+// Validation errors are returned as the string.
+func importExercise(db *sqlx.DB, r *http.Request, userID int, activity *Activity) (*Exercise, string, error) {
+	if value := r.URL.Query().Get("exerciseId"); value != "" {
+		id, err := strconv.Atoi(value)
+		if err != nil || id <= 0 {
+			return nil, "invalid exerciseId", nil
+		}
+		exercises, err := visibleExercises(db, userID, []int64{int64(id)})
+		if err != nil {
+			return nil, "", err
+		}
+		exercise, ok := exercises[id]
+		if !ok {
+			return nil, fmt.Sprintf("invalid exerciseId: exercise %d not found", id), nil
+		}
+		if exercise.ExerciseType != exerciseEndurance {
+			return nil, "invalid exerciseId: activity files can only be imported as endurance exercises", nil
+		}
+		return &exercise, "", nil
+	}
+
+	sport, ok := sportExercises[activity.Sport]
+	if !ok {
+		return nil, "exerciseId is required: the file does not record a supported sport", nil
+	}
+	var exercise Exercise
+	err := db.Get(&exercise, `SELECT `+exerciseColumns+` FROM EXERCISES WHERE user_id IS NULL AND name = $1`, sport.Name)
+	if err != nil {
+		return nil, "", err
+	}
+	return &exercise, "", nil
+}
+
+func handleImportWorkout(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := userIDFromRequest(r)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		data, err := readActivityFile(w, r)
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("file too large: at most %d MB", maxActivityFileBytes>>20))
+			return
+		}
+		if err == nil && len(data) == 0 {
+			err = fmt.Errorf("file is required")
+		}
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		activity, err := parseActivity(data)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if activity.StartedAt.After(now().Add(maxClockSkew)) {
+			writeError(w, http.StatusBadRequest, "invalid activity: starts in the future")
+			return
+		}
+
+		// Re-uploads of the same file are reported with the workout they created
+		sum := sha256.Sum256(data)
+		hash := hex.EncodeToString(sum[:])
+		var existingID int
+		err = db.Get(&existingID, `SELECT id FROM WORKOUT_SESSIONS WHERE user_id = $1 AND file_hash = $2`, userID, hash)
+		if err == nil {
+			writeError(w, http.StatusConflict, fmt.Sprintf("activity file already imported as workout %d", existingID))
+			return
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			log.Printf("Failed to check for imported file: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to import workout")
+			return
+		}
+
+		exercise, invalid, err := importExercise(db, r, userID, activity)
+		if err != nil {
+			log.Printf("Failed to load exercise: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to import workout")
+			return
+		}
+		if invalid != "" {
+			writeError(w, http.StatusBadRequest, invalid)
+			return
+		}
+
+		set := WorkoutSetRequest{
+			ExerciseID:          exercise.ID,
+			DurationSeconds:     &activity.DurationSeconds,
+			DistanceMeters:      activity.DistanceMeters,
+			ElevationGainMeters: activity.ElevationGainMeters,
+			AvgHeartRate:        activity.AvgHeartRate,
+			MaxHeartRate:        activity.MaxHeartRate,
+		}
+		if err := set.validate(); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid activity: %v", err))
+			return
+		}
+
+		calories := activity.CaloriesBurned
+		if calories == nil {
+			var weightKg *float64
+			err = db.Get(&weightKg, `SELECT weight_kg FROM USERS WHERE id = $1`, userID)
+			if errors.Is(err, sql.ErrNoRows) {
+				writeError(w, http.StatusNotFound, "user not found")
+				return
+			}
+			if err != nil {
+				log.Printf("Failed to get user weight: %v", err)
+				writeError(w, http.StatusInternalServerError, "Failed to import workout")
+				return
+			}
+			calories = estimateCalories(exercise.Name, weightKg, activity.DurationSeconds)
+		}
+		if calories != nil && *calories > maxCaloriesBurned {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid activity: more than %d kcal burned", maxCaloriesBurned))
+			return
+		}
+		durationMinutes := int(math.Max(1, math.Round(float64(activity.DurationSeconds)/60)))
+
+		tx, err := db.Beginx()
+		if err != nil {
+			log.Printf("Failed to begin transaction: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to import workout")
+			return
+		}
+		defer tx.Rollback()
+
+		var workout Workout
+		err = tx.Get(&workout, `
+			INSERT INTO WORKOUT_SESSIONS (user_id, started_at, duration_minutes, calories_burned, source, file_hash)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			RETURNING `+workoutColumns,
+			userID, activity.StartedAt, durationMinutes, calories, activity.Format, hash)
+		if isUniqueViolation(err) {
+			writeError(w, http.StatusConflict, "activity file already imported")
+			return
+		}
+		if isForeignKeyViolation(err) {
+			writeError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		if err != nil {
+			log.Printf("Failed to import workout: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to import workout")
+			return
+		}
+
+		workout.Sets, err = insertSets(tx, workout.ID, []WorkoutSetRequest{set}, map[int]Exercise{exercise.ID: *exercise})
+		if err == nil && len(activity.HeartRate) > 0 {
+			elapsed := make([]int64, len(activity.HeartRate))
+			heartRates := make([]int64, len(activity.HeartRate))
+			for i, sample := range activity.HeartRate {
+				elapsed[i], heartRates[i] = int64(sample.ElapsedSeconds), int64(sample.HeartRate)
+			}
+			_, err = tx.Exec(`
+				INSERT INTO WORKOUT_HEART_RATE_SAMPLES (session_id, elapsed_seconds, heart_rate)
+				SELECT $1, unnest($2::int[]), unnest($3::int[])`,
+				workout.ID, pq.Int64Array(elapsed), pq.Int64Array(heartRates))
+		}
+		if err == nil {
+			err = tx.Commit()
+		}
+		if err != nil {
+			log.Printf("Failed to import workout: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to import workout")
+			return
+		}
+
+		writeJSON(w, http.StatusCreated, workout)
+	}
+}
+
+func handleGetWorkoutHeartRate(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := userIDFromRequest(r)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		id, err := pathID(r, "id")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var exists bool
+		err = db.Get(&exists, `SELECT EXISTS (SELECT 1 FROM WORKOUT_SESSIONS WHERE id = $1 AND user_id = $2)`, id, userID)
+		if err == nil && !exists {
+			writeError(w, http.StatusNotFound, "workout not found")
+			return
+		}
+
+		samples := []HeartRateSample{}
+		if err == nil {
+			err = db.Select(&samples, `
+				SELECT elapsed_seconds, heart_rate FROM WORKOUT_HEART_RATE_SAMPLES
+				WHERE session_id = $1 ORDER BY elapsed_seconds`,
+				id)
+		}
+		if err != nil {
+			log.Printf("Failed to get heart rate samples: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to get heart rate samples")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, WorkoutHeartRate{WorkoutID: id, Samples: samples})
+	}
+}
@@ -0,0 +1,221 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fileHash(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestHandleImportWorkout_GPX(t *testing.T) {
+	pinNow(t, time.Date(2025, 3, 10, 18, 0, 0, 0, time.UTC))
+
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	started := time.Date(2025, 3, 8, 9, 0, 0, 0, time.UTC)
+	hash := fileHash(testGPX)
+	mock.ExpectQuery(`SELECT id FROM WORKOUT_SESSIONS WHERE user_id = \$1 AND file_hash = \$2`).
+		WithArgs(7, hash).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(`FROM EXERCISES WHERE user_id IS NULL AND name = \$1`).
+		WithArgs("Running").
+		WillReturnRows(sqlmock.NewRows(exerciseRowColumns).AddRow(24, "Running", "ENDURANCE", false))
+	// GPX has no calories: 9.8 MET x 70 kg x 10 minutes
+	mock.ExpectQuery(`SELECT weight_kg FROM USERS WHERE id = \$1`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"weight_kg"}).AddRow("70.0"))
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO WORKOUT_SESSIONS \(user_id, started_at, duration_minutes, calories_burned, source, file_hash\)`).
+		WithArgs(7, started, 10, 114.3, "GPX", hash).
+		WillReturnRows(sqlmock.NewRows(workoutRowColumns).AddRow(9, started, 10, nil, "114.3", "GPX", started, started))
+	mock.ExpectQuery(`INSERT INTO WORKOUT_SETS`).
+		WithArgs(9, 24, 1, nil, nil, 600, sqlmock.AnyArg(), 10.0, 143, 160).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(30))
+	mock.ExpectExec(`INSERT INTO WORKOUT_HEART_RATE_SAMPLES`).
+		WithArgs(9, pq.Int64Array{0, 300, 600}, pq.Int64Array{120, 150, 160}).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, err := form.CreateFormFile("file", "morning-run.gpx")
+	require.NoError(t, err)
+	part.Write([]byte(testGPX))
+	require.NoError(t, form.Close())
+
+	req := httptest.NewRequest("POST", "/workouts/import", &body)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	req.Header.Set(userIDHeader, "7")
+	rec := serve("/workouts/import", handleImportWorkout(db), req)
+
+	assert.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var workout Workout
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &workout))
+	assert.Equal(t, sourceGPX, workout.Source)
+	require.Len(t, workout.Sets, 1)
+	assert.Equal(t, "Running", workout.Sets[0].ExerciseName)
+	assert.NotNil(t, workout.Sets[0].PaceSecondsPerKm)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHandleImportWorkout_FITWithExerciseOverride(t *testing.T) {
+	start := time.Date(2025, 3, 8, 9, 0, 0, 0, time.UTC)
+	pinNow(t, start.Add(time.Hour))
+	data := testFIT(start)
+
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT id FROM WORKOUT_SESSIONS WHERE user_id = \$1 AND file_hash = \$2`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	expectExercises(mock, 7, []int64{40}, sqlmock.NewRows(exerciseRowColumns).AddRow(40, "Trail Running", "ENDURANCE", true))
+	// Calories come from the file, so the user's weight is not needed
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO WORKOUT_SESSIONS`).
+		WithArgs(7, start, 1, 25.0, "FIT", fileHash(string(data))).
+		WillReturnRows(sqlmock.NewRows(workoutRowColumns).AddRow(9, start, 1, nil, "25.0", "FIT", start, start))
+	mock.ExpectQuery(`INSERT INTO WORKOUT_SETS`).
+		WithArgs(9, 40, 1, nil, nil, 15, 45.0, 12.0, 135, 140).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(30))
+	mock.ExpectExec(`INSERT INTO WORKOUT_HEART_RATE_SAMPLES`).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	req := httptest.NewRequest("POST", "/workouts/import?exerciseId=40", bytes.NewReader(data))
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set(userIDHeader, "7")
+	rec := serve("/workouts/import", handleImportWorkout(db), req)
+
+	assert.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHandleImportWorkout_Rejected(t *testing.T) {
+	pinNow(t, time.Date(2025, 3, 10, 18, 0, 0, 0, time.UTC))
+
+	noDuplicate := func(mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`SELECT id FROM WORKOUT_SESSIONS WHERE user_id = \$1 AND file_hash = \$2`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	}
+	otherSport := strings.Replace(testGPX, "<type>running</type>", "<type>yoga</type>", 1)
+
+	tests := []struct {
+		name       string
+		body       string
+		query      string
+		setupMock  func(mock sqlmock.Sqlmock)
+		wantStatus int
+		wantErr    string
+	}{
+		{
+			name: "re-upload of the same file",
+			body: testGPX,
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT id FROM WORKOUT_SESSIONS WHERE user_id = \$1 AND file_hash = \$2`).
+					WithArgs(7, fileHash(testGPX)).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(9))
+			},
+			wantStatus: http.StatusConflict,
+			wantErr:    "already imported as workout 9",
+		},
+		{
+			name:       "unsupported sport",
+			body:       otherSport,
+			setupMock:  noDuplicate,
+			wantStatus: http.StatusBadRequest,
+			wantErr:    "exerciseId is required",
+		},
+		{
+			name:  "strength exercise override",
+			body:  testGPX,
+			query: "?exerciseId=1",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				noDuplicate(mock)
+				expectExercises(mock, 7, []int64{1}, sqlmock.NewRows(exerciseRowColumns).AddRow(1, "Back Squat", "STRENGTH", false))
+			},
+			wantStatus: http.StatusBadRequest,
+			wantErr:    "only be imported as endurance exercises",
+		},
+		{name: "empty body", body: "", wantStatus: http.StatusBadRequest, wantErr: "file is required"},
+		{name: "not an activity file", body: "hello", wantStatus: http.StatusBadRequest, wantErr: "unsupported file format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := setupTestDB(t)
+			defer db.Close()
+			if tt.setupMock != nil {
+				tt.setupMock(mock)
+			}
+
+			req := httptest.NewRequest("POST", "/workouts/import"+tt.query, strings.NewReader(tt.body))
+			req.Header.Set(userIDHeader, "7")
+			rec := serve("/workouts/import", handleImportWorkout(db), req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestEstimateCalories(t *testing.T) {
+	assert.Equal(t, floatPtr(686), estimateCalories("Running", floatPtr(70), 3600))
+	// No MET value for custom exercises, no estimate without a weight
+	assert.Nil(t, estimateCalories("Trail Running", floatPtr(70), 3600))
+	assert.Nil(t, estimateCalories("Running", nil, 3600))
+}
+
+func TestHandleGetWorkoutHeartRate(t *testing.T) {
+	tests := []struct {
+		name       string
+		exists     bool
+		wantStatus int
+	}{
+		{name: "own workout", exists: true, wantStatus: http.StatusOK},
+		{name: "other user's workout", exists: false, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := setupTestDB(t)
+			defer db.Close()
+
+			mock.ExpectQuery(`SELECT EXISTS \(SELECT 1 FROM WORKOUT_SESSIONS WHERE id = \$1 AND user_id = \$2\)`).
+				WithArgs(9, 7).
+				WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(tt.exists))
+			if tt.exists {
+				mock.ExpectQuery(`FROM WORKOUT_HEART_RATE_SAMPLES\s+WHERE session_id = \$1 ORDER BY elapsed_seconds`).
+					WithArgs(9).
+					WillReturnRows(sqlmock.NewRows([]string{"elapsed_seconds", "heart_rate"}).AddRow(0, 120).AddRow(5, 124))
+			}
+
+			req := httptest.NewRequest("GET", "/workouts/9/heart-rate", nil)
+			req.Header.Set(userIDHeader, "7")
+			rec := serve("/workouts/{id}/heart-rate", handleGetWorkoutHeartRate(db), req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.exists {
+				assert.JSONEq(t, `{"workoutId":9,"samples":[{"elapsedSeconds":0,"heartRate":120},{"elapsedSeconds":5,"heartRate":124}]}`, rec.Body.String())
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
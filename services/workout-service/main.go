@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/gorilla/mux"
+	_ "github.com/lib/pq"
+)
+
+func main() {
+	// Database connection
+	dbHost := os.Getenv("DB_HOST")
+	dbPort := os.Getenv("DB_PORT")
+	dbUser := os.Getenv("DB_USER")
+	dbPassword := os.Getenv("DB_PASSWORD")
+	dbName := os.Getenv("DB_NAME")
+
+	connStr := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+		dbHost, dbPort, dbUser, dbPassword, dbName)
+
+	db, err := sqlx.Open("postgres", connStr)
+	if err != nil {
+		log.Fatal("Error connecting to database:", err)
+	}
+	defer db.Close()
+
+	// Test database connection
+	err = db.Ping()
+	if err != nil {
+		log.Fatal("Error pinging database:", err)
+	}
+	log.Println("Successfully connected to database")
+
+	// Initialize router
+	r := mux.NewRouter()
+
+	// Health check endpoint
+	r.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("Workout service is healthy"))
+	}).Methods("GET")
+
+	// Exercise endpoints (built-in exercises plus the X-User-ID user's own)
+	r.HandleFunc("/exercises", handleGetExercises(db)).Methods("GET")
+	r.HandleFunc("/exercises", handleCreateExercise(db)).Methods("POST")
+	r.HandleFunc("/exercises/{id}/records", handleGetExerciseRecords(db)).Methods("GET")
+
+	// Workout endpoints (scoped to the user in the X-User-ID header)
+	r.HandleFunc("/workouts", handleGetWorkouts(db)).Methods("GET")
+	r.HandleFunc("/workouts", handleCreateWorkout(db)).Methods("POST")
+	r.HandleFunc("/workouts/import", handleImportWorkout(db)).Methods("POST")
+	r.HandleFunc("/workouts/{id}", handleGetWorkout(db)).Methods("GET")
+	r.HandleFunc("/workouts/{id}", handleUpdateWorkout(db)).Methods("PUT")
+	r.HandleFunc("/workouts/{id}", handleDeleteWorkout(db)).Methods("DELETE")
+	r.HandleFunc("/workouts/{id}/heart-rate", handleGetWorkoutHeartRate(db)).Methods("GET")
+
+	// Start server
+	port := os.Getenv("PORT")
+	log.Printf("Workout service starting on port %s", port)
+	log.Fatal(http.ListenAndServe(":"+port, r))
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// maxEstimateReps is the most reps a one-rep max is estimated from; the
+// Epley formula overestimates beyond it
+const maxEstimateReps = 12
+
+// minPaceDistanceMeters keeps short efforts out of the fastest pace record
+const minPaceDistanceMeters = 1000
+
+// Personal record types
+const (
+	recordEstimatedOneRepMax = "ESTIMATED_ONE_REP_MAX"
+	recordHeaviestWeight     = "HEAVIEST_WEIGHT"
+	recordMostReps           = "MOST_REPS"
+	recordBestSetVolume      = "BEST_SET_VOLUME"
+	recordLongestDistance    = "LONGEST_DISTANCE"
+	recordLongestDuration    = "LONGEST_DURATION"
+	recordFastestPace        = "FASTEST_PACE"
+)
+
+// PersonalRecord is the user's best set of an exercise by one measure
+type PersonalRecord struct {
+	Record     string    `json:"record"`
+	Value      float64   `json:"value"`
+	Unit       string    `json:"unit"`
+	WorkoutID  int       `json:"workoutId"`
+	SetNumber  int       `json:"setNumber"`
+	AchievedAt time.Time `json:"achievedAt"`
+}
+
+// ExerciseRecords is the response of the personal records endpoint
+type ExerciseRecords struct {
+	Exercise Exercise         `json:"exercise"`
+	Records  []PersonalRecord `json:"records"`
+}
+
+// recordSet is a logged set with the start time of its workout
+type recordSet struct {
+	WorkoutSet
+	StartedAt time.Time `db:"started_at"`
+}
+
+// estimateOneRepMax estimates the one-rep max of a set with the Epley formula,
+// rounded to 0.1 kg. It returns nil for bodyweight sets and sets of more than
+// maxEstimateReps reps.
+func estimateOneRepMax(weightKg float64, reps int) *float64 {
+	if weightKg <= 0 || reps < 1 || reps > maxEstimateReps {
+		return nil
+	}
+	estimate := weightKg
+	if reps > 1 {
+		estimate = math.Round(weightKg*(1+float64(reps)/30)*10) / 10
+	}
+	return &estimate
+}
+
+// personalRecords finds the best set by each measure of an exercise type.
+// Sets must be ordered oldest first; ties keep the earliest set.
+func personalRecords(exerciseType string, sets []recordSet) []PersonalRecord {
+	var kinds []string
+	var units map[string]string
+	if exerciseType == exerciseStrength {
+		kinds = []string{recordEstimatedOneRepMax, recordHeaviestWeight, recordMostReps, recordBestSetVolume}
+		units = map[string]string{recordEstimatedOneRepMax: "kg", recordHeaviestWeight: "kg", recordMostReps: "reps", recordBestSetVolume: "kg"}
+	} else {
+		kinds = []string{recordLongestDistance, recordLongestDuration, recordFastestPace}
+		units = map[string]string{recordLongestDistance: "m", recordLongestDuration: "s", recordFastestPace: "s/km"}
+	}
+
+	best := map[string]*PersonalRecord{}
+	consider := func(kind string, value float64, set recordSet, lowerIsBetter bool) {
+		if current := best[kind]; current != nil {
+			improved := value > current.Value
+			if lowerIsBetter {
+				improved = value < current.Value
+			}
+			if !improved {
+				return
+			}
+		}
+		best[kind] = &PersonalRecord{
+			Record:     kind,
+			Value:      value,
+			Unit:       units[kind],
+			WorkoutID:  set.WorkoutID,
+			SetNumber:  set.SetNumber,
+			AchievedAt: set.StartedAt,
+		}
+	}
+
+	for _, set := range sets {
+		set.derive()
+		if set.EstimatedOneRepMaxKg != nil {
+			consider(recordEstimatedOneRepMax, *set.EstimatedOneRepMaxKg, set, false)
+		}
+		if set.WeightKg != nil && *set.WeightKg > 0 {
+			consider(recordHeaviestWeight, *set.WeightKg, set, false)
+			if set.Reps != nil {
+				consider(recordBestSetVolume, *set.WeightKg*float64(*set.Reps), set, false)
+			}
+		}
+		if set.Reps != nil {
+			consider(recordMostReps, float64(*set.Reps), set, false)
+		}
+		if set.DistanceMeters != nil {
+			consider(recordLongestDistance, *set.DistanceMeters, set, false)
+			if set.PaceSecondsPerKm != nil && *set.DistanceMeters >= minPaceDistanceMeters {
+				consider(recordFastestPace, *set.PaceSecondsPerKm, set, true)
+			}
+		}
+		if set.DurationSeconds != nil {
+			consider(recordLongestDuration, float64(*set.DurationSeconds), set, false)
+		}
+	}
+
+	records := []PersonalRecord{}
+	for _, kind := range kinds {
+		if record := best[kind]; record != nil {
+			records = append(records, *record)
+		}
+	}
+	return records
+}
+
+func handleGetExerciseRecords(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := userIDFromRequest(r)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		id, err := pathID(r, "id")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		exercises, err := visibleExercises(db, userID, []int64{int64(id)})
+		if err != nil {
+			log.Printf("Failed to get exercise: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to get personal records")
+			return
+		}
+		exercise, ok := exercises[id]
+		if !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("exercise %d not found", id))
+			return
+		}
+
+		var sets []recordSet
+		err = db.Select(&sets, `
+			SELECT `+setColumns+`, w.started_at
+			FROM WORKOUT_SETS s
+			JOIN EXERCISES e ON e.id = s.exercise_id
+			JOIN WORKOUT_SESSIONS w ON w.id = s.session_id
+			WHERE w.user_id = $1 AND s.exercise_id = $2
+			ORDER BY w.started_at, s.set_number`,
+			userID, id)
+		if err != nil {
+			log.Printf("Failed to list sets: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to get personal records")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, ExerciseRecords{
+			Exercise: exercise,
+			Records:  personalRecords(exercise.ExerciseType, sets),
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(v int) *int           { return &v }
+func floatPtr(v float64) *float64 { return &v }
+
+func TestEstimateOneRepMax(t *testing.T) {
+	tests := []struct {
+		weightKg float64
+		reps     int
+		want     *float64
+	}{
+		{100, 1, floatPtr(100)},
+		{100, 5, floatPtr(116.7)},
+		{60, 10, floatPtr(80)},
+		{60, 13, nil},
+		{0, 10, nil},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, estimateOneRepMax(tt.weightKg, tt.reps), "%v kg x %d", tt.weightKg, tt.reps)
+	}
+}
+
+func TestPersonalRecords_Strength(t *testing.T) {
+	day1 := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	day2 := time.Date(2025, 3, 8, 9, 0, 0, 0, time.UTC)
+	strengthSet := func(workoutID, number, reps int, weightKg float64, startedAt time.Time) recordSet {
+		return recordSet{
+			WorkoutSet: WorkoutSet{WorkoutID: workoutID, SetNumber: number, Reps: intPtr(reps), WeightKg: floatPtr(weightKg)},
+			StartedAt:  startedAt,
+		}
+	}
+
+	records := personalRecords(exerciseStrength, []recordSet{
+		strengthSet(1, 1, 5, 100, day1),
+		strengthSet(1, 2, 12, 70, day1),
+		strengthSet(2, 1, 1, 105, day2),
+		// Ties keep the earlier set
+		strengthSet(2, 2, 5, 100, day2),
+	})
+
+	require.Len(t, records, 4)
+	assert.Equal(t, PersonalRecord{Record: recordEstimatedOneRepMax, Value: 116.7, Unit: "kg", WorkoutID: 1, SetNumber: 1, AchievedAt: day1}, records[0])
+	assert.Equal(t, recordHeaviestWeight, records[1].Record)
+	assert.Equal(t, 105.0, records[1].Value)
+	assert.Equal(t, 2, records[1].WorkoutID)
+	assert.Equal(t, 12.0, records[2].Value)
+	assert.Equal(t, recordBestSetVolume, records[3].Record)
+	assert.Equal(t, 840.0, records[3].Value)
+}
+
+func TestPersonalRecords_Endurance(t *testing.T) {
+	day1 := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	day2 := time.Date(2025, 3, 8, 9, 0, 0, 0, time.UTC)
+
+	records := personalRecords(exerciseEndurance, []recordSet{
+		{WorkoutSet: WorkoutSet{WorkoutID: 1, SetNumber: 1, DurationSeconds: intPtr(1800), DistanceMeters: floatPtr(5000)}, StartedAt: day1},
+		// A sprint is faster but too short to count for pace
+		{WorkoutSet: WorkoutSet{WorkoutID: 2, SetNumber: 1, DurationSeconds: intPtr(60), DistanceMeters: floatPtr(400)}, StartedAt: day2},
+		{WorkoutSet: WorkoutSet{WorkoutID: 2, SetNumber: 2, DurationSeconds: intPtr(3300), DistanceMeters: floatPtr(10000)}, StartedAt: day2},
+	})
+
+	require.Len(t, records, 3)
+	assert.Equal(t, recordLongestDistance, records[0].Record)
+	assert.Equal(t, 10000.0, records[0].Value)
+	assert.Equal(t, 3300.0, records[1].Value)
+	assert.Equal(t, recordFastestPace, records[2].Record)
+	assert.Equal(t, 330.0, records[2].Value)
+	assert.Equal(t, "s/km", records[2].Unit)
+	assert.Equal(t, 2, records[2].WorkoutID)
+}
+
+func TestHandleGetExerciseRecords(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	started := time.Date(2025, 3, 8, 9, 0, 0, 0, time.UTC)
+	expectExercises(mock, 7, []int64{5}, sqlmock.NewRows(exerciseRowColumns).AddRow(5, "Bench Press", "STRENGTH", false))
+	mock.ExpectQuery(`JOIN WORKOUT_SESSIONS w ON w.id = s.session_id\s+WHERE w.user_id = \$1 AND s.exercise_id = \$2`).
+		WithArgs(7, 5).
+		WillReturnRows(sqlmock.NewRows(append(append([]string{}, setRowColumns...), "started_at")).
+			AddRow(21, 9, 5, "Bench Press", "STRENGTH", 1, 8, "60.0", nil, nil, nil, nil, nil, started))
+
+	req := httptest.NewRequest("GET", "/exercises/5/records", nil)
+	req.Header.Set(userIDHeader, "7")
+	rec := serve("/exercises/{id}/records", handleGetExerciseRecords(db), req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var records ExerciseRecords
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &records))
+	assert.Equal(t, "Bench Press", records.Exercise.Name)
+	require.Len(t, records.Records, 4)
+	assert.Equal(t, 76.0, records.Records[0].Value)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHandleGetExerciseRecords_OtherUsersExerciseNotFound(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM EXERCISES`).
+		WithArgs(8, pq.Int64Array{40}).
+		WillReturnRows(sqlmock.NewRows(exerciseRowColumns))
+
+	req := httptest.NewRequest("GET", "/exercises/40/records", nil)
+	req.Header.Set(userIDHeader, "8")
+	rec := serve("/exercises/{id}/records", handleGetExerciseRecords(db), req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
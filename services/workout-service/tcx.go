@@ -0,0 +1,79 @@
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+// tcxFile is the part of a Garmin Training Center document that describes
+// the first activity. Lap totals are moving time, distance and calories as
+// measured by the device.
+type tcxFile struct {
+	Activities []struct {
+		Sport string `xml:"Sport,attr"`
+		Laps  []struct {
+			TotalTimeSeconds float64 `xml:"TotalTimeSeconds"`
+			DistanceMeters   float64 `xml:"DistanceMeters"`
+			Calories         float64 `xml:"Calories"`
+			Points           []struct {
+				Time           string   `xml:"Time"`
+				Lat            *float64 `xml:"Position>LatitudeDegrees"`
+				Lon            *float64 `xml:"Position>LongitudeDegrees"`
+				AltitudeMeters *float64 `xml:"AltitudeMeters"`
+				DistanceMeters *float64 `xml:"DistanceMeters"`
+				HeartRate      int      `xml:"HeartRateBpm>Value"`
+			} `xml:"Track>Trackpoint"`
+		} `xml:"Lap"`
+	} `xml:"Activities>Activity"`
+}
+
+// parseTCX parses the first activity of a TCX file. The lap totals take
+// precedence over the values derived from the track points.
+func parseTCX(data []byte) (*Activity, error) {
+	var file tcxFile
+	if err := xml.NewDecoder(bytes.NewReader(data)).Decode(&file); err != nil {
+		return nil, fmt.Errorf("invalid TCX file: %v", err)
+	}
+	if len(file.Activities) == 0 {
+		return nil, fmt.Errorf("invalid TCX file: no activity")
+	}
+
+	source := file.Activities[0]
+	var points []trackPoint
+	var seconds, distance, calories float64
+	for _, lap := range source.Laps {
+		seconds += lap.TotalTimeSeconds
+		distance += lap.DistanceMeters
+		calories += lap.Calories
+		for _, p := range lap.Points {
+			at, err := time.Parse(time.RFC3339, p.Time)
+			if err != nil {
+				continue
+			}
+			point := trackPoint{
+				Time:           at,
+				ElevationM:     p.AltitudeMeters,
+				DistanceMeters: p.DistanceMeters,
+				HeartRate:      p.HeartRate,
+			}
+			if p.Lat != nil && p.Lon != nil {
+				point.Lat, point.Lon = p.Lat, p.Lon
+			}
+			points = append(points, point)
+		}
+	}
+
+	activity := summarize(sourceTCX, source.Sport, points)
+	if seconds >= 1 {
+		activity.DurationSeconds = int(seconds + 0.5)
+	}
+	if distance > 0 {
+		activity.DistanceMeters = roundTenth(distance)
+	}
+	if calories > 0 {
+		activity.CaloriesBurned = roundTenth(calories)
+	}
+	return activity, nil
+}
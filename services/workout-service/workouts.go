@@ -0,0 +1,574 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Limits from the WORKOUT_SESSIONS and WORKOUT_SETS table constraints
+const (
+	maxDurationMinutes     = 1440
+	maxNotesLength         = 500
+	maxSets                = 100
+	maxReps                = 1000
+	maxWeightKg            = 1000
+	maxDurationSeconds     = 24 * 60 * 60
+	maxDistanceMeters      = 1000000
+	maxElevationGainMeters = 20000
+	maxCaloriesBurned      = 10000
+	minHeartRate           = 30
+	maxHeartRate           = 250
+)
+
+// List paging limits
+const (
+	defaultListLimit = 50
+	maxListLimit     = 200
+)
+
+// maxClockSkew tolerates client clocks running slightly ahead of the server
+const maxClockSkew = 5 * time.Minute
+
+// now returns the current time; tests replace it to pin the clock
+var now = time.Now
+
+// Workout is a training session from the WORKOUT_SESSIONS table with its sets
+type Workout struct {
+	ID              int          `db:"id" json:"id"`
+	StartedAt       time.Time    `db:"started_at" json:"startedAt"`
+	DurationMinutes *int         `db:"duration_minutes" json:"durationMinutes,omitempty"`
+	Notes           *string      `db:"notes" json:"notes,omitempty"`
+	CaloriesBurned  *float64     `db:"calories_burned" json:"caloriesBurned,omitempty"`
+	Source          string       `db:"source" json:"source"`
+	Sets            []WorkoutSet `db:"-" json:"sets"`
+	CreatedAt       time.Time    `db:"created_at" json:"createdAt"`
+	UpdatedAt       time.Time    `db:"updated_at" json:"updatedAt"`
+}
+
+// WorkoutSet is a set from the WORKOUT_SETS table. Pace and the estimated
+// one-rep max are derived, not stored.
+type WorkoutSet struct {
+	ID                   int      `db:"id" json:"id"`
+	WorkoutID            int      `db:"session_id" json:"-"`
+	ExerciseID           int      `db:"exercise_id" json:"exerciseId"`
+	ExerciseName         string   `db:"exercise_name" json:"exerciseName"`
+	ExerciseType         string   `db:"exercise_type" json:"exerciseType"`
+	SetNumber            int      `db:"set_number" json:"setNumber"`
+	Reps                 *int     `db:"reps" json:"reps,omitempty"`
+	WeightKg             *float64 `db:"weight_kg" json:"weightKg,omitempty"`
+	DurationSeconds      *int     `db:"duration_seconds" json:"durationSeconds,omitempty"`
+	DistanceMeters       *float64 `db:"distance_meters" json:"distanceMeters,omitempty"`
+	ElevationGainMeters  *float64 `db:"elevation_gain_meters" json:"elevationGainMeters,omitempty"`
+	AvgHeartRate         *int     `db:"avg_heart_rate" json:"avgHeartRate,omitempty"`
+	MaxHeartRate         *int     `db:"max_heart_rate" json:"maxHeartRate,omitempty"`
+	PaceSecondsPerKm     *float64 `db:"-" json:"paceSecondsPerKm,omitempty"`
+	EstimatedOneRepMaxKg *float64 `db:"-" json:"estimatedOneRepMaxKg,omitempty"`
+}
+
+// WorkoutRequest is the body for logging or replacing a workout
+type WorkoutRequest struct {
+	StartedAt       *time.Time          `json:"startedAt"`
+	DurationMinutes *int                `json:"durationMinutes"`
+	Notes           *string             `json:"notes"`
+	CaloriesBurned  *float64            `json:"caloriesBurned"`
+	Sets            []WorkoutSetRequest `json:"sets"`
+}
+
+// WorkoutSetRequest is a set of a workout request. Strength sets need reps
+// and may have a weight; endurance sets need a duration and may have a
+// distance and elevation gain.
+type WorkoutSetRequest struct {
+	ExerciseID          int      `json:"exerciseId"`
+	Reps                *int     `json:"reps"`
+	WeightKg            *float64 `json:"weightKg"`
+	DurationSeconds     *int     `json:"durationSeconds"`
+	DistanceMeters      *float64 `json:"distanceMeters"`
+	ElevationGainMeters *float64 `json:"elevationGainMeters"`
+	AvgHeartRate        *int     `json:"avgHeartRate"`
+	MaxHeartRate        *int     `json:"maxHeartRate"`
+}
+
+const workoutColumns = `id, started_at, duration_minutes, notes, calories_burned, source, created_at, updated_at`
+
+const setColumns = `s.id, s.session_id, s.exercise_id, e.name AS exercise_name, e.exercise_type, s.set_number,
+	s.reps, s.weight_kg, s.duration_seconds, s.distance_meters, s.elevation_gain_meters, s.avg_heart_rate, s.max_heart_rate`
+
+// validate checks a workout request against the table constraints and
+// normalizes startedAt to UTC. Sets are checked against their exercise type
+// separately, once the exercises are loaded.
+func (req *WorkoutRequest) validate() error {
+	if len(req.Sets) == 0 {
+		return fmt.Errorf("at least one set is required")
+	}
+	if len(req.Sets) > maxSets {
+		return fmt.Errorf("invalid sets: at most %d sets per workout", maxSets)
+	}
+	if req.DurationMinutes != nil && (*req.DurationMinutes < 1 || *req.DurationMinutes > maxDurationMinutes) {
+		return fmt.Errorf("invalid durationMinutes: must be between 1 and %d", maxDurationMinutes)
+	}
+	if req.Notes != nil && len(*req.Notes) > maxNotesLength {
+		return fmt.Errorf("invalid notes: must be at most %d characters", maxNotesLength)
+	}
+	if req.CaloriesBurned != nil && (*req.CaloriesBurned < 0 || *req.CaloriesBurned > maxCaloriesBurned) {
+		return fmt.Errorf("invalid caloriesBurned: must be between 0 and %d", maxCaloriesBurned)
+	}
+	for i, set := range req.Sets {
+		if err := set.validate(); err != nil {
+			return fmt.Errorf("invalid sets[%d]: %v", i, err)
+		}
+	}
+	if req.StartedAt != nil {
+		if req.StartedAt.After(now().Add(maxClockSkew)) {
+			return fmt.Errorf("invalid startedAt: cannot be in the future")
+		}
+		utc := req.StartedAt.UTC()
+		req.StartedAt = &utc
+	}
+	return nil
+}
+
+// validate checks the ranges of a set's values
+func (set WorkoutSetRequest) validate() error {
+	if set.ExerciseID <= 0 {
+		return fmt.Errorf("exerciseId is required")
+	}
+	if set.Reps != nil && (*set.Reps < 1 || *set.Reps > maxReps) {
+		return fmt.Errorf("reps must be between 1 and %d", maxReps)
+	}
+	if set.WeightKg != nil && (*set.WeightKg < 0 || *set.WeightKg > maxWeightKg) {
+		return fmt.Errorf("weightKg must be between 0 and %d", maxWeightKg)
+	}
+	if set.DurationSeconds != nil && (*set.DurationSeconds < 1 || *set.DurationSeconds > maxDurationSeconds) {
+		return fmt.Errorf("durationSeconds must be between 1 and %d", maxDurationSeconds)
+	}
+	if set.DistanceMeters != nil && (*set.DistanceMeters <= 0 || *set.DistanceMeters > maxDistanceMeters) {
+		return fmt.Errorf("distanceMeters must be greater than 0 and at most %d", maxDistanceMeters)
+	}
+	if set.ElevationGainMeters != nil && (*set.ElevationGainMeters < 0 || *set.ElevationGainMeters > maxElevationGainMeters) {
+		return fmt.Errorf("elevationGainMeters must be between 0 and %d", maxElevationGainMeters)
+	}
+	if set.AvgHeartRate != nil && (*set.AvgHeartRate < minHeartRate || *set.AvgHeartRate > maxHeartRate) {
+		return fmt.Errorf("avgHeartRate must be between %d and %d", minHeartRate, maxHeartRate)
+	}
+	if set.MaxHeartRate != nil && (*set.MaxHeartRate < minHeartRate || *set.MaxHeartRate > maxHeartRate) {
+		return fmt.Errorf("maxHeartRate must be between %d and %d", minHeartRate, maxHeartRate)
+	}
+	if set.AvgHeartRate != nil && set.MaxHeartRate != nil && *set.AvgHeartRate > *set.MaxHeartRate {
+		return fmt.Errorf("avgHeartRate cannot be above maxHeartRate")
+	}
+	return nil
+}
+
+// validateFor checks that a set records what its exercise type measures
+func (set WorkoutSetRequest) validateFor(exerciseType string) error {
+	switch exerciseType {
+	case exerciseStrength:
+		if set.Reps == nil {
+			return fmt.Errorf("reps is required for strength exercises")
+		}
+		if set.DurationSeconds != nil || set.DistanceMeters != nil || set.ElevationGainMeters != nil {
+			return fmt.Errorf("durationSeconds, distanceMeters and elevationGainMeters only apply to endurance exercises")
+		}
+	case exerciseEndurance:
+		if set.DurationSeconds == nil {
+			return fmt.Errorf("durationSeconds is required for endurance exercises")
+		}
+		if set.Reps != nil || set.WeightKg != nil {
+			return fmt.Errorf("reps and weightKg only apply to strength exercises")
+		}
+	}
+	return nil
+}
+
+// checkSetExercises loads the exercises of a workout request and checks each
+// set against its exercise type. Validation errors are returned as the string.
+func checkSetExercises(db *sqlx.DB, userID int, sets []WorkoutSetRequest) (map[int]Exercise, string, error) {
+	ids := make([]int64, len(sets))
+	for i, set := range sets {
+		ids[i] = int64(set.ExerciseID)
+	}
+	exercises, err := visibleExercises(db, userID, ids)
+	if err != nil {
+		return nil, "", err
+	}
+
+	for i, set := range sets {
+		exercise, ok := exercises[set.ExerciseID]
+		if !ok {
+			return nil, fmt.Sprintf("invalid sets[%d]: exercise %d not found", i, set.ExerciseID), nil
+		}
+		if err := set.validateFor(exercise.ExerciseType); err != nil {
+			return nil, fmt.Sprintf("invalid sets[%d]: %v", i, err), nil
+		}
+	}
+	return exercises, "", nil
+}
+
+// insertSets inserts the sets of a workout, numbered in request order
+func insertSets(tx *sqlx.Tx, workoutID int, sets []WorkoutSetRequest, exercises map[int]Exercise) ([]WorkoutSet, error) {
+	result := make([]WorkoutSet, len(sets))
+	for i, req := range sets {
+		set := WorkoutSet{
+			WorkoutID:           workoutID,
+			ExerciseID:          req.ExerciseID,
+			ExerciseName:        exercises[req.ExerciseID].Name,
+			ExerciseType:        exercises[req.ExerciseID].ExerciseType,
+			SetNumber:           i + 1,
+			Reps:                req.Reps,
+			WeightKg:            req.WeightKg,
+			DurationSeconds:     req.DurationSeconds,
+			DistanceMeters:      req.DistanceMeters,
+			ElevationGainMeters: req.ElevationGainMeters,
+			AvgHeartRate:        req.AvgHeartRate,
+			MaxHeartRate:        req.MaxHeartRate,
+		}
+		err := tx.Get(&set.ID, `
+			INSERT INTO WORKOUT_SETS (session_id, exercise_id, set_number, reps, weight_kg, duration_seconds,
+			                          distance_meters, elevation_gain_meters, avg_heart_rate, max_heart_rate)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			RETURNING id`,
+			workoutID, set.ExerciseID, set.SetNumber, set.Reps, set.WeightKg, set.DurationSeconds,
+			set.DistanceMeters, set.ElevationGainMeters, set.AvgHeartRate, set.MaxHeartRate)
+		if err != nil {
+			return nil, err
+		}
+		set.derive()
+		result[i] = set
+	}
+	return result, nil
+}
+
+// loadSets attaches the sets of each workout, in set order
+func loadSets(db *sqlx.DB, workouts []Workout) error {
+	if len(workouts) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(workouts))
+	byID := make(map[int]*Workout, len(workouts))
+	for i := range workouts {
+		ids[i] = int64(workouts[i].ID)
+		workouts[i].Sets = []WorkoutSet{}
+		byID[workouts[i].ID] = &workouts[i]
+	}
+
+	var sets []WorkoutSet
+	err := db.Select(&sets, `
+		SELECT `+setColumns+`
+		FROM WORKOUT_SETS s
+		JOIN EXERCISES e ON e.id = s.exercise_id
+		WHERE s.session_id = ANY($1)
+		ORDER BY s.session_id, s.set_number`,
+		pq.Int64Array(ids))
+	if err != nil {
+		return err
+	}
+
+	for _, set := range sets {
+		set.derive()
+		workout := byID[set.WorkoutID]
+		workout.Sets = append(workout.Sets, set)
+	}
+	return nil
+}
+
+// derive fills in the pace of endurance sets and the estimated one-rep max of strength sets
+func (set *WorkoutSet) derive() {
+	if set.DurationSeconds != nil && set.DistanceMeters != nil {
+		pace := math.Round(float64(*set.DurationSeconds) / (*set.DistanceMeters / 1000))
+		set.PaceSecondsPerKm = &pace
+	}
+	if set.Reps != nil && set.WeightKg != nil {
+		set.EstimatedOneRepMaxKg = estimateOneRepMax(*set.WeightKg, *set.Reps)
+	}
+}
+
+// parseTimeParam parses an optional RFC 3339 query parameter
+func parseTimeParam(r *http.Request, name string) (*time.Time, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: expected RFC 3339 timestamp", name)
+	}
+	utc := parsed.UTC()
+	return &utc, nil
+}
+
+func handleGetWorkouts(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := userIDFromRequest(r)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		from, err := parseTimeParam(r, "from")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		to, err := parseTimeParam(r, "to")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		limit := defaultListLimit
+		if value := r.URL.Query().Get("limit"); value != "" {
+			limit, err = strconv.Atoi(value)
+			if err != nil || limit < 1 || limit > maxListLimit {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid limit: must be between 1 and %d", maxListLimit))
+				return
+			}
+		}
+
+		query := `SELECT ` + workoutColumns + ` FROM WORKOUT_SESSIONS WHERE user_id = $1`
+		args := []interface{}{userID}
+		if from != nil {
+			args = append(args, *from)
+			query += fmt.Sprintf(" AND started_at >= $%d", len(args))
+		}
+		if to != nil {
+			args = append(args, *to)
+			query += fmt.Sprintf(" AND started_at < $%d", len(args))
+		}
+		args = append(args, limit)
+		query += fmt.Sprintf(" ORDER BY started_at DESC, id DESC LIMIT $%d", len(args))
+
+		workouts := []Workout{}
+		if err := db.Select(&workouts, query, args...); err != nil {
+			log.Printf("Failed to list workouts: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to list workouts")
+			return
+		}
+		if err := loadSets(db, workouts); err != nil {
+			log.Printf("Failed to load workout sets: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to list workouts")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, workouts)
+	}
+}
+
+func handleCreateWorkout(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := userIDFromRequest(r)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		var req WorkoutRequest
+		if err := decodeJSON(r, &req); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if err := req.validate(); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if req.StartedAt == nil {
+			startedAt := now().UTC()
+			req.StartedAt = &startedAt
+		}
+
+		exercises, invalid, err := checkSetExercises(db, userID, req.Sets)
+		if err != nil {
+			log.Printf("Failed to load exercises: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to create workout")
+			return
+		}
+		if invalid != "" {
+			writeError(w, http.StatusBadRequest, invalid)
+			return
+		}
+
+		tx, err := db.Beginx()
+		if err != nil {
+			log.Printf("Failed to begin transaction: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to create workout")
+			return
+		}
+		defer tx.Rollback()
+
+		var workout Workout
+		err = tx.Get(&workout, `
+			INSERT INTO WORKOUT_SESSIONS (user_id, started_at, duration_minutes, notes, calories_burned)
+			VALUES ($1, $2, $3, $4, $5)
+			RETURNING `+workoutColumns,
+			userID, req.StartedAt, req.DurationMinutes, req.Notes, req.CaloriesBurned)
+		if isForeignKeyViolation(err) {
+			writeError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		if err != nil {
+			log.Printf("Failed to create workout: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to create workout")
+			return
+		}
+
+		workout.Sets, err = insertSets(tx, workout.ID, req.Sets, exercises)
+		if err == nil {
+			err = tx.Commit()
+		}
+		if err != nil {
+			log.Printf("Failed to create workout: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to create workout")
+			return
+		}
+
+		writeJSON(w, http.StatusCreated, workout)
+	}
+}
+
+func handleGetWorkout(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := userIDFromRequest(r)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		id, err := pathID(r, "id")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Other users' workouts are reported as not found rather than forbidden
+		workouts := make([]Workout, 1)
+		err = db.Get(&workouts[0], `SELECT `+workoutColumns+` FROM WORKOUT_SESSIONS WHERE id = $1 AND user_id = $2`, id, userID)
+		if errors.Is(err, sql.ErrNoRows) {
+			writeError(w, http.StatusNotFound, "workout not found")
+			return
+		}
+		if err == nil {
+			err = loadSets(db, workouts)
+		}
+		if err != nil {
+			log.Printf("Failed to get workout: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to get workout")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, workouts[0])
+	}
+}
+
+func handleUpdateWorkout(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := userIDFromRequest(r)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		id, err := pathID(r, "id")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req WorkoutRequest
+		if err := decodeJSON(r, &req); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if err := req.validate(); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		exercises, invalid, err := checkSetExercises(db, userID, req.Sets)
+		if err != nil {
+			log.Printf("Failed to load exercises: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to update workout")
+			return
+		}
+		if invalid != "" {
+			writeError(w, http.StatusBadRequest, invalid)
+			return
+		}
+
+		tx, err := db.Beginx()
+		if err != nil {
+			log.Printf("Failed to begin transaction: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to update workout")
+			return
+		}
+		defer tx.Rollback()
+
+		// PUT replaces the session and all of its sets; started_at is kept when omitted
+		var workout Workout
+		err = tx.Get(&workout, `
+			UPDATE WORKOUT_SESSIONS
+			SET started_at = COALESCE($3, started_at), duration_minutes = $4, notes = $5,
+			    calories_burned = $6, updated_at = CURRENT_TIMESTAMP
+			WHERE id = $1 AND user_id = $2
+			RETURNING `+workoutColumns,
+			id, userID, req.StartedAt, req.DurationMinutes, req.Notes, req.CaloriesBurned)
+		if errors.Is(err, sql.ErrNoRows) {
+			writeError(w, http.StatusNotFound, "workout not found")
+			return
+		}
+		if err == nil {
+			_, err = tx.Exec(`DELETE FROM WORKOUT_SETS WHERE session_id = $1`, id)
+		}
+		if err == nil {
+			workout.Sets, err = insertSets(tx, workout.ID, req.Sets, exercises)
+		}
+		if err == nil {
+			err = tx.Commit()
+		}
+		if err != nil {
+			log.Printf("Failed to update workout: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to update workout")
+			return
+		}
+
+		writeJSON(w, http.StatusOK, workout)
+	}
+}
+
+func handleDeleteWorkout(db *sqlx.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := userIDFromRequest(r)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		id, err := pathID(r, "id")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Sets are removed by the cascade from WORKOUT_SESSIONS
+		result, err := db.Exec(`DELETE FROM WORKOUT_SESSIONS WHERE id = $1 AND user_id = $2`, id, userID)
+		if err != nil {
+			log.Printf("Failed to delete workout: %v", err)
+			writeError(w, http.StatusInternalServerError, "Failed to delete workout")
+			return
+		}
+		if rows, _ := result.RowsAffected(); rows == 0 {
+			writeError(w, http.StatusNotFound, "workout not found")
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
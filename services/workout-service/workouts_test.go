@@ -0,0 +1,261 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	workoutRowColumns  = []string{"id", "started_at", "duration_minutes", "notes", "calories_burned", "source", "created_at", "updated_at"}
+	exerciseRowColumns = []string{"id", "name", "exercise_type", "custom"}
+	setRowColumns      = []string{"id", "session_id", "exercise_id", "exercise_name", "exercise_type", "set_number",
+		"reps", "weight_kg", "duration_seconds", "distance_meters", "elevation_gain_meters", "avg_heart_rate", "max_heart_rate"}
+)
+
+func setupTestDB(t *testing.T) (*sqlx.DB, sqlmock.Sqlmock) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	return sqlx.NewDb(mockDB, "postgres"), mock
+}
+
+// serve routes a request through a router with the given pattern so path variables resolve
+func serve(pattern string, handler http.HandlerFunc, req *http.Request) *httptest.ResponseRecorder {
+	r := mux.NewRouter()
+	r.HandleFunc(pattern, handler)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	return rec
+}
+
+// pinNow fixes the clock for the duration of a test
+func pinNow(t *testing.T, at time.Time) {
+	original := now
+	now = func() time.Time { return at }
+	t.Cleanup(func() { now = original })
+}
+
+// expectExercises expects the lookup of a workout's exercises
+func expectExercises(mock sqlmock.Sqlmock, userID int, ids []int64, rows *sqlmock.Rows) {
+	mock.ExpectQuery(`FROM EXERCISES WHERE \(user_id IS NULL OR user_id = \$1\) AND id = ANY\(\$2\)`).
+		WithArgs(userID, pq.Int64Array(ids)).
+		WillReturnRows(rows)
+}
+
+func TestHandleCreateWorkout(t *testing.T) {
+	clock := time.Date(2025, 3, 10, 18, 0, 0, 0, time.UTC)
+	pinNow(t, clock)
+
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	expectExercises(mock, 7, []int64{1, 1, 24}, sqlmock.NewRows(exerciseRowColumns).
+		AddRow(1, "Back Squat", "STRENGTH", false).
+		AddRow(24, "Running", "ENDURANCE", false))
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO WORKOUT_SESSIONS`).
+		WithArgs(7, clock, 60, nil, 420.0).
+		WillReturnRows(sqlmock.NewRows(workoutRowColumns).AddRow(5, clock, 60, nil, "420.0", "MANUAL", clock, clock))
+	mock.ExpectQuery(`INSERT INTO WORKOUT_SETS`).
+		WithArgs(5, 1, 1, 5, 100.0, nil, nil, nil, nil, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(11))
+	mock.ExpectQuery(`INSERT INTO WORKOUT_SETS`).
+		WithArgs(5, 1, 2, 15, 60.0, nil, nil, nil, nil, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(12))
+	mock.ExpectQuery(`INSERT INTO WORKOUT_SETS`).
+		WithArgs(5, 24, 3, nil, nil, 1500, 5000.0, 35.0, 152, 171).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(13))
+	mock.ExpectCommit()
+
+	body := `{"durationMinutes":60,"caloriesBurned":420,"sets":[
+		{"exerciseId":1,"reps":5,"weightKg":100},
+		{"exerciseId":1,"reps":15,"weightKg":60},
+		{"exerciseId":24,"durationSeconds":1500,"distanceMeters":5000,"elevationGainMeters":35,"avgHeartRate":152,"maxHeartRate":171}]}`
+	req := httptest.NewRequest("POST", "/workouts", strings.NewReader(body))
+	req.Header.Set(userIDHeader, "7")
+	rec := serve("/workouts", handleCreateWorkout(db), req)
+
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	var workout Workout
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &workout))
+	require.Len(t, workout.Sets, 3)
+	assert.Equal(t, "Back Squat", workout.Sets[0].ExerciseName)
+	assert.Equal(t, 116.7, *workout.Sets[0].EstimatedOneRepMaxKg)
+	// No estimate above 12 reps
+	assert.Nil(t, workout.Sets[1].EstimatedOneRepMaxKg)
+	assert.Equal(t, 300.0, *workout.Sets[2].PaceSecondsPerKm)
+	assert.Equal(t, 420.0, *workout.CaloriesBurned)
+	assert.Equal(t, sourceManual, workout.Source)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHandleCreateWorkout_Validation(t *testing.T) {
+	pinNow(t, time.Date(2025, 3, 10, 18, 0, 0, 0, time.UTC))
+
+	exercises := func(mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`FROM EXERCISES`).
+			WillReturnRows(sqlmock.NewRows(exerciseRowColumns).
+				AddRow(1, "Back Squat", "STRENGTH", false).
+				AddRow(24, "Running", "ENDURANCE", false))
+	}
+
+	tests := []struct {
+		name      string
+		body      string
+		setupMock func(mock sqlmock.Sqlmock)
+		wantErr   string
+	}{
+		{name: "no sets", body: `{"sets":[]}`, wantErr: "at least one set"},
+		{name: "missing exercise", body: `{"sets":[{"reps":5}]}`, wantErr: "invalid sets[0]: exerciseId is required"},
+		{name: "zero reps", body: `{"sets":[{"exerciseId":1,"reps":0}]}`, wantErr: "reps must be between"},
+		{name: "negative weight", body: `{"sets":[{"exerciseId":1,"reps":5,"weightKg":-5}]}`, wantErr: "weightKg must be between"},
+		{name: "heart rate out of range", body: `{"sets":[{"exerciseId":24,"durationSeconds":60,"avgHeartRate":20}]}`, wantErr: "avgHeartRate must be between"},
+		{name: "average above max", body: `{"sets":[{"exerciseId":24,"durationSeconds":60,"avgHeartRate":170,"maxHeartRate":160}]}`, wantErr: "cannot be above maxHeartRate"},
+		{name: "future startedAt", body: `{"startedAt":"2025-03-10T19:00:00Z","sets":[{"exerciseId":1,"reps":5}]}`, wantErr: "cannot be in the future"},
+		{name: "unknown field", body: `{"sets":[{"exerciseId":1,"reps":5,"rpe":8}]}`, wantErr: "invalid request body"},
+		{name: "unknown exercise", body: `{"sets":[{"exerciseId":99,"reps":5}]}`, setupMock: exercises, wantErr: "invalid sets[0]: exercise 99 not found"},
+		{name: "strength set without reps", body: `{"sets":[{"exerciseId":1,"weightKg":60}]}`, setupMock: exercises, wantErr: "reps is required for strength exercises"},
+		{name: "distance on strength set", body: `{"sets":[{"exerciseId":1,"reps":5,"distanceMeters":20}]}`, setupMock: exercises, wantErr: "only apply to endurance exercises"},
+		{name: "elevation on strength set", body: `{"sets":[{"exerciseId":1,"reps":5,"elevationGainMeters":2}]}`, setupMock: exercises, wantErr: "only apply to endurance exercises"},
+		{name: "negative calories", body: `{"caloriesBurned":-1,"sets":[{"exerciseId":1,"reps":5}]}`, wantErr: "invalid caloriesBurned"},
+		{name: "endurance set without duration", body: `{"sets":[{"exerciseId":24,"distanceMeters":5000}]}`, setupMock: exercises, wantErr: "durationSeconds is required for endurance exercises"},
+		{name: "reps on endurance set", body: `{"sets":[{"exerciseId":1,"reps":5},{"exerciseId":24,"durationSeconds":60,"reps":3}]}`, setupMock: exercises, wantErr: "invalid sets[1]: reps and weightKg only apply to strength exercises"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := setupTestDB(t)
+			defer db.Close()
+			if tt.setupMock != nil {
+				tt.setupMock(mock)
+			}
+
+			req := httptest.NewRequest("POST", "/workouts", strings.NewReader(tt.body))
+			req.Header.Set(userIDHeader, "7")
+			rec := serve("/workouts", handleCreateWorkout(db), req)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.wantErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestHandleGetWorkouts_LoadsSets(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	started := time.Date(2025, 3, 8, 9, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`FROM WORKOUT_SESSIONS WHERE user_id = \$1 AND started_at >= \$2 ORDER BY started_at DESC, id DESC LIMIT \$3`).
+		WithArgs(7, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), 50).
+		WillReturnRows(sqlmock.NewRows(workoutRowColumns).
+			AddRow(6, started.AddDate(0, 0, 1), nil, nil, "612.0", "GPX", started, started).
+			AddRow(5, started, nil, "legs", nil, "MANUAL", started, started))
+	mock.ExpectQuery(`FROM WORKOUT_SETS s\s+JOIN EXERCISES e ON e.id = s.exercise_id\s+WHERE s.session_id = ANY\(\$1\)`).
+		WithArgs(pq.Int64Array{6, 5}).
+		WillReturnRows(sqlmock.NewRows(setRowColumns).
+			AddRow(11, 5, 1, "Back Squat", "STRENGTH", 1, 5, "100.0", nil, nil, nil, nil, nil).
+			AddRow(12, 5, 1, "Back Squat", "STRENGTH", 2, 5, "100.0", nil, nil, nil, nil, nil))
+
+	req := httptest.NewRequest("GET", "/workouts?from=2025-03-01T00:00:00Z", nil)
+	req.Header.Set(userIDHeader, "7")
+	rec := serve("/workouts", handleGetWorkouts(db), req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var workouts []Workout
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &workouts))
+	require.Len(t, workouts, 2)
+	assert.Equal(t, sourceGPX, workouts[0].Source)
+	assert.Empty(t, workouts[0].Sets)
+	require.Len(t, workouts[1].Sets, 2)
+	assert.Equal(t, 2, workouts[1].Sets[1].SetNumber)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHandleGetWorkout_OtherUsersWorkoutNotFound(t *testing.T) {
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM WORKOUT_SESSIONS WHERE id = \$1 AND user_id = \$2`).
+		WithArgs(5, 8).
+		WillReturnRows(sqlmock.NewRows(workoutRowColumns))
+
+	req := httptest.NewRequest("GET", "/workouts/5", nil)
+	req.Header.Set(userIDHeader, "8")
+	rec := serve("/workouts/{id}", handleGetWorkout(db), req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHandleUpdateWorkout_ReplacesSets(t *testing.T) {
+	pinNow(t, time.Date(2025, 3, 10, 18, 0, 0, 0, time.UTC))
+
+	db, mock := setupTestDB(t)
+	defer db.Close()
+
+	started := time.Date(2025, 3, 8, 9, 0, 0, 0, time.UTC)
+	expectExercises(mock, 7, []int64{13}, sqlmock.NewRows(exerciseRowColumns).AddRow(13, "Pull-up", "STRENGTH", false))
+	mock.ExpectBegin()
+	mock.ExpectQuery(`UPDATE WORKOUT_SESSIONS\s+SET started_at = COALESCE\(\$3, started_at\)`).
+		WithArgs(5, 7, nil, nil, nil, nil).
+		WillReturnRows(sqlmock.NewRows(workoutRowColumns).AddRow(5, started, nil, nil, nil, "MANUAL", started, started))
+	mock.ExpectExec(`DELETE FROM WORKOUT_SETS WHERE session_id = \$1`).
+		WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectQuery(`INSERT INTO WORKOUT_SETS`).
+		WithArgs(5, 13, 1, 8, nil, nil, nil, nil, nil, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(14))
+	mock.ExpectCommit()
+
+	req := httptest.NewRequest("PUT", "/workouts/5", strings.NewReader(`{"sets":[{"exerciseId":13,"reps":8}]}`))
+	req.Header.Set(userIDHeader, "7")
+	rec := serve("/workouts/{id}", handleUpdateWorkout(db), req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var workout Workout
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &workout))
+	require.Len(t, workout.Sets, 1)
+	// Bodyweight sets have no estimated one-rep max
+	assert.Nil(t, workout.Sets[0].EstimatedOneRepMaxKg)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHandleDeleteWorkout(t *testing.T) {
+	tests := []struct {
+		name       string
+		affected   int64
+		wantStatus int
+	}{
+		{name: "deletes own workout", affected: 1, wantStatus: http.StatusNoContent},
+		{name: "other user's workout", affected: 0, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock := setupTestDB(t)
+			defer db.Close()
+
+			mock.ExpectExec(`DELETE FROM WORKOUT_SESSIONS WHERE id = \$1 AND user_id = \$2`).
+				WithArgs(5, 7).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			req := httptest.NewRequest("DELETE", "/workouts/5", nil)
+			req.Header.Set(userIDHeader, "7")
+			rec := serve("/workouts/{id}", handleDeleteWorkout(db), req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}